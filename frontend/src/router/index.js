@@ -1,6 +1,13 @@
 import { createRouter, createWebHistory } from "vue-router";
+import { useAuthStore } from "@/stores/auth.js";
 
 const routes = [
+    {
+        path: "/login",
+        name: "login",
+        component: () => import("@/views/LoginView.vue"),
+        meta: { title: "Sign In", public: true },
+    },
     {
         path: "/",
         name: "home",
@@ -86,4 +93,20 @@ router.beforeEach((to, from, next) => {
     next();
 });
 
+// Send visitors who are not signed in to the login page
+router.beforeEach(async (to) => {
+    if (to.meta.public) {
+        return true;
+    }
+
+    const authStore = useAuthStore();
+    if (!authStore.checked) {
+        await authStore.fetchUser();
+    }
+    if (!authStore.user) {
+        return { name: "login", query: { redirect: to.fullPath } };
+    }
+    return true;
+});
+
 export default router;
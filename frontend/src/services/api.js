@@ -7,9 +7,9 @@ const api = axios.create({
   timeout: 10000,
 });
 
-// Request interceptor for adding auth token if needed
+// Requests are authenticated by the session cookie set on login
 api.interceptors.request.use(
-  (config) => { // You can add auth token logic here if needed
+  (config) => {
     return config;
   },
   (error) => {
@@ -28,6 +28,18 @@ api.interceptors.response.use(
     if (response) {
       // Log the error details
       console.error("API Error:", response.status, response.data);
+
+      // Send expired sessions back to the login page, the auth calls handle
+      // their own errors
+      if (
+        response.status === 401 && !error.config.url.startsWith("/auth/") &&
+        window.location.pathname !== "/login"
+      ) {
+        const redirect = window.location.pathname + window.location.search;
+        window.location.assign(
+          `/login?redirect=${encodeURIComponent(redirect)}`,
+        );
+      }
     } else if (error.request) {
       // The request was made but no response was received
       console.error("Network Error:", error.request);
@@ -80,6 +92,9 @@ const exercisesAPI = {
   getImage: (exerciseId, imageId) =>
     api.get(`/exercises/${exerciseId}/images/${imageId}`),
   deleteImage: (id) => api.delete(`/exercise-images/${id}`),
+  addNotation: (exerciseId, data) =>
+    api.post(`/exercises/${exerciseId}/notations`, data),
+  deleteNotation: (id) => api.delete(`/exercise-notations/${id}`),
   addLink: (exerciseId, data) =>
     api.post(`/exercises/${exerciseId}/links`, data),
   deleteLink: (id) => api.delete(`/exercise-links/${id}`),
@@ -114,8 +129,17 @@ const historyAPI = {
   delete: (id) => api.delete(`/history/${id}`),
 };
 
+// Auth API
+const authAPI = {
+  login: (username, password) =>
+    api.post("/auth/login", { username, password }),
+  logout: () => api.post("/auth/logout", {}),
+  getUser: () => api.get("/auth/user"),
+};
+
 export {
   api as default,
+  authAPI,
   categoriesAPI,
   exercisesAPI,
   historyAPI,
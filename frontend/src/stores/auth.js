@@ -0,0 +1,48 @@
+import { defineStore } from "pinia";
+import { ref } from "vue";
+import { authAPI } from "@/services/api.js";
+
+export const useAuthStore = defineStore("auth", () => {
+    // State
+    const user = ref(null);
+    const checked = ref(false);
+
+    // Load the signed in user, null when the session is missing or expired
+    async function fetchUser() {
+        try {
+            const response = await authAPI.getUser();
+            user.value = response.data;
+        } catch (err) {
+            user.value = null;
+        } finally {
+            checked.value = true;
+        }
+        return user.value;
+    }
+
+    async function login(username, password) {
+        const response = await authAPI.login(username, password);
+        user.value = response.data.user;
+        checked.value = true;
+        return user.value;
+    }
+
+    async function logout() {
+        try {
+            await authAPI.logout();
+        } finally {
+            user.value = null;
+        }
+    }
+
+    return {
+        // State
+        user,
+        checked,
+
+        // Actions
+        fetchUser,
+        login,
+        logout,
+    };
+});
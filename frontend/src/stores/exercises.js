@@ -148,31 +148,6 @@ export const useExercisesStore = defineStore("exercises", () => {
       const exercise = response.data;
       currentExercise.value = exercise;
 
-      // Retrieve images separately, image data is not included in original
-      // exercise.
-      const imagePromises = exercise.images.map(
-        (img) =>
-          exercisesAPI.getImage(exercise.id, img.id)
-            .then((imgResp) => ({ success: true, imgResp, id: img.id }))
-            .catch((err) => {
-              console.warn(`Failed to fetch image ${img.id}:`, err);
-              return { success: false, id: img.id };
-            }),
-      );
-
-      const imageResults = await Promise.all(imagePromises);
-
-      imageResults.forEach(({ success, imgResp, id }) => {
-        if (success) {
-          const index = currentExercise.value.images.findIndex((i) =>
-            i.id === id
-          );
-          if (index !== -1) {
-            currentExercise.value.images[index] = imgResp.data;
-          }
-        }
-      });
-
       // Also update the exercise in the exercises array if it exists
       const index = exercises.value.findIndex((e) => e.id === id);
       if (index !== -1) {
@@ -333,6 +308,70 @@ export const useExercisesStore = defineStore("exercises", () => {
     }
   }
 
+  async function addExerciseNotation(exerciseId, notationData) {
+    loading.value = true;
+    error.value = null;
+
+    try {
+      const response = await exercisesAPI.addNotation(exerciseId, notationData);
+
+      // Update exercise with new notation if it's in the exercises array
+      const exercise = exercises.value.find((e) => e.id === exerciseId);
+      if (exercise) {
+        if (!exercise.notations) {
+          exercise.notations = [];
+        }
+        exercise.notations.push(response.data);
+      }
+
+      // Update currentExercise if applicable
+      if (currentExercise.value && currentExercise.value.id === exerciseId) {
+        if (!currentExercise.value.notations) {
+          currentExercise.value.notations = [];
+        }
+        currentExercise.value.notations.push(response.data);
+      }
+
+      return response.data;
+    } catch (err) {
+      error.value = err.message ||
+        `Failed to add notation to exercise with ID ${exerciseId}`;
+      console.error(`Error adding notation to exercise ${exerciseId}:`, err);
+      throw err;
+    } finally {
+      loading.value = false;
+    }
+  }
+
+  async function deleteExerciseNotation(notationId) {
+    loading.value = true;
+    error.value = null;
+
+    try {
+      await exercisesAPI.deleteNotation(notationId);
+
+      // Remove notation from exercises in the array
+      exercises.value.forEach((exercise) => {
+        if (exercise.notations) {
+          exercise.notations = exercise.notations.filter((n) => n.id !== notationId);
+        }
+      });
+
+      // Remove notation from currentExercise if applicable
+      if (currentExercise.value && currentExercise.value.notations) {
+        currentExercise.value.notations = currentExercise.value.notations.filter(
+          (n) => n.id !== notationId,
+        );
+      }
+    } catch (err) {
+      error.value = err.message || `Failed to delete notation with ID ${notationId}`;
+      console.error(`Error deleting notation ${notationId}:`, err);
+      throw err;
+    } finally {
+      loading.value = false;
+    }
+  }
+
   async function addExerciseLink(exerciseId, linkData) {
     loading.value = true;
     error.value = null;
@@ -447,6 +486,8 @@ export const useExercisesStore = defineStore("exercises", () => {
     deleteExercise,
     addExerciseImage,
     deleteExerciseImage,
+    addExerciseNotation,
+    deleteExerciseNotation,
     addExerciseLink,
     deleteExerciseLink,
     fetchExerciseStats,
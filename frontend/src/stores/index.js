@@ -1,5 +1,6 @@
 // Import all store files
 import { useAppStore } from "./app.js";
+import { useAuthStore } from "./auth.js";
 import { useCategoriesStore } from "./categories.js";
 import { useExercisesStore } from "./exercises.js";
 import { useHistoryStore } from "./history.js";
@@ -10,6 +11,7 @@ import { useTagsStore } from "./tags.js";
 // Export a function to get all stores for easy access
 export function useStores() {
     const appStore = useAppStore();
+    const authStore = useAuthStore();
     const categoriesStore = useCategoriesStore();
     const tagsStore = useTagsStore();
     const exercisesStore = useExercisesStore();
@@ -19,6 +21,7 @@ export function useStores() {
 
     return {
         app: appStore,
+        auth: authStore,
         categories: categoriesStore,
         tags: tagsStore,
         exercises: exercisesStore,
@@ -31,6 +34,7 @@ export function useStores() {
 // Individual exports for when only specific stores are needed
 export {
     useAppStore,
+    useAuthStore,
     useCategoriesStore,
     useExercisesStore,
     useHistoryStore,
@@ -3,7 +3,6 @@ package main
 import (
 	"bytes"
 	"context"
-	"database/sql"
 	"embed"
 	"flag"
 	"fmt"
@@ -15,14 +14,19 @@ import (
 	"os"
 	"os/signal"
 	"path"
+	"strings"
 	"syscall"
 	"time"
+	_ "time/tzdata" // Time zones for the statistics, the runtime image has no zoneinfo
 
 	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/auth"
+	"github.com/Zach-Johnson/tempus/server/backup"
+	"github.com/Zach-Johnson/tempus/server/blobstore"
 	storage "github.com/Zach-Johnson/tempus/server/db"
+	"github.com/Zach-Johnson/tempus/server/events"
 	"github.com/Zach-Johnson/tempus/server/handlers"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	_ "github.com/mattn/go-sqlite3"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -31,31 +35,156 @@ import (
 //go:embed frontend/dist/*
 var embeddedFiles embed.FS
 
+// maxMessageSize bounds the gRPC messages of the bulk server, raised from the
+// 4MB default so that data archives, exercise packs and images fit. The public
+// server keeps the default since it decodes messages before authenticating.
+const maxMessageSize = 256 << 20
+
+// maxPublicBodySize bounds the HTTP request bodies of the routes outside the
+// bulk server, the gateway reads bodies before the gRPC server authenticates
+const maxPublicBodySize = 1 << 20
+
 var (
+	dbDriver  string
 	dbPath    string
+	dbURL     string
 	grpcPort  int
+	bulkPort  int
 	httpPort  int
 	enableTLS bool
+
+	backupDir       string
+	backupInterval  time.Duration
+	backupKeep      int
+	backupMaxAge    time.Duration
+	restoreSnapshot string
+
+	blobStore   string
+	blobDir     string
+	blobGrace   time.Duration
+	s3Endpoint  string
+	s3Region    string
+	s3Bucket    string
+	s3Prefix    string
+	s3PathStyle bool
+
+	pageTokenKey string
+
+	secureCookies bool
 )
 
 func main() {
 	flag.BoolVar(&enableTLS, "tls", false, "Enable TLS for gRPC server")
 	flag.IntVar(&httpPort, "http-port", 8080, "HTTP server port")
 	flag.IntVar(&grpcPort, "grpc-port", 9090, "gRPC server port")
+	flag.IntVar(&bulkPort, "bulk-grpc-port", 9091, "Loopback port of the gRPC server accepting large messages, used by the gateway for data archives, exercise packs and images")
+	flag.StringVar(&dbDriver, "db-driver", storage.DriverSQLite, "Database driver (sqlite3 or postgres)")
 	flag.StringVar(&dbPath, "db-path", "./data/tempus.db", "Path to SQLite database file")
+	flag.StringVar(&dbURL, "db-url", "", "Postgres connection URL")
+	flag.StringVar(&backupDir, "backup-dir", "./data/backups", "Directory for SQLite snapshots")
+	flag.DurationVar(&backupInterval, "backup-interval", 0, "Interval between scheduled snapshots, 0 disables them")
+	flag.IntVar(&backupKeep, "backup-keep", 7, "Number of most recent snapshots that are always kept")
+	flag.DurationVar(&backupMaxAge, "backup-max-age", 0, "Remove snapshots older than this beyond the kept ones, 0 removes all of them")
+	flag.StringVar(&restoreSnapshot, "restore-snapshot", "", "Restore the named snapshot, or \"latest\", before starting")
+	flag.StringVar(&blobStore, "blob-store", "fs", "Where image and recording data is stored (fs or s3)")
+	flag.StringVar(&blobDir, "blob-dir", "./data/blobs", "Directory for image and recording data with the fs blob store")
+	flag.DurationVar(&blobGrace, "blob-grace", 24*time.Hour, "How long image and recording data nothing refers to is kept, longer while an older snapshot remains")
+	flag.StringVar(&s3Endpoint, "s3-endpoint", "", "S3 API URL, defaults to AWS in the region")
+	flag.StringVar(&s3Region, "s3-region", "us-east-1", "S3 region")
+	flag.StringVar(&s3Bucket, "s3-bucket", "", "S3 bucket for image data")
+	flag.StringVar(&s3Prefix, "s3-prefix", "", "Prefix of the S3 object keys")
+	flag.BoolVar(&s3PathStyle, "s3-path-style", false, "Address the S3 bucket in the path, as MinIO and most S3-compatible services expect")
+	flag.StringVar(&pageTokenKey, "page-token-key", "", "Secret signing the page tokens of List RPCs, random when empty so tokens expire on restart")
+	flag.BoolVar(&secureCookies, "secure-cookies", false, "Only send the session cookie over HTTPS")
 	flag.Parse()
 
 	// Set up logging
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 	log.Printf("Starting tempus application")
 
+	dbDriverEnv, ok := os.LookupEnv("DB_DRIVER")
+	if ok {
+		dbDriver = dbDriverEnv
+	}
+
 	dbPathEnv, ok := os.LookupEnv("DB_PATH")
 	if ok {
 		dbPath = dbPathEnv
 	}
 
+	dbURLEnv, ok := os.LookupEnv("DB_URL")
+	if ok {
+		dbURL = dbURLEnv
+	}
+
+	backupDirEnv, ok := os.LookupEnv("BACKUP_DIR")
+	if ok {
+		backupDir = backupDirEnv
+	}
+
+	backupIntervalEnv, ok := os.LookupEnv("BACKUP_INTERVAL")
+	if ok {
+		interval, err := time.ParseDuration(backupIntervalEnv)
+		if err != nil {
+			log.Fatalf("Invalid BACKUP_INTERVAL: %v", err)
+		}
+		backupInterval = interval
+	}
+
+	blobStoreEnv, ok := os.LookupEnv("BLOB_STORE")
+	if ok {
+		blobStore = blobStoreEnv
+	}
+
+	blobDirEnv, ok := os.LookupEnv("BLOB_DIR")
+	if ok {
+		blobDir = blobDirEnv
+	}
+
+	s3EndpointEnv, ok := os.LookupEnv("S3_ENDPOINT")
+	if ok {
+		s3Endpoint = s3EndpointEnv
+	}
+
+	s3BucketEnv, ok := os.LookupEnv("S3_BUCKET")
+	if ok {
+		s3Bucket = s3BucketEnv
+	}
+
+	pageTokenKeyEnv, ok := os.LookupEnv("PAGE_TOKEN_KEY")
+	if ok {
+		pageTokenKey = pageTokenKeyEnv
+	}
+	if pageTokenKey != "" {
+		handlers.SetPageTokenKey([]byte(pageTokenKey))
+	}
+
+	secureCookiesEnv, ok := os.LookupEnv("SECURE_COOKIES")
+	if ok {
+		secureCookies = secureCookiesEnv == "true"
+	}
+
+	dbSource := dbPath
+	if dbDriver == storage.DriverPostgres {
+		dbSource = dbURL
+	}
+
+	if restoreSnapshot != "" {
+		if dbDriver != storage.DriverSQLite {
+			log.Fatalf("Restoring snapshots is only supported for SQLite databases")
+		}
+		snapshot, previous, err := backup.Restore(backupDir, restoreSnapshot, dbPath)
+		if err != nil {
+			log.Fatalf("Failed to restore snapshot: %v", err)
+		}
+		if previous != nil {
+			log.Printf("Saved the replaced database as snapshot %s", previous.Name)
+		}
+		log.Printf("Restored snapshot %s", snapshot.Name)
+	}
+
 	// Initialize database
-	db, err := sql.Open("sqlite3", dbPath+"?_foreign_keys=1")
+	db, err := storage.Open(dbDriver, dbSource)
 	if err != nil {
 		log.Fatalf("Failed to open database: %v", err)
 	}
@@ -66,46 +195,158 @@ func main() {
 		log.Fatalf("Failed to ping database: %v", err)
 	}
 
+	blobs, err := openBlobStore()
+	if err != nil {
+		log.Fatalf("Failed to open blob store: %v", err)
+	}
+
 	// Initialize the storage layer
-	store := storage.NewSQLiteStore(db)
+	store, err := storage.NewStore(db, dbDriver, blobs)
+	if err != nil {
+		log.Fatalf("Failed to initialize storage: %v", err)
+	}
 
 	if os.Getenv("RUN_MIGRATIONS") == "true" {
-		if err := storage.RunMigrations(store.GetDB()); err != nil {
+		if err := storage.RunMigrations(store.GetDB(), dbDriver); err != nil {
 			log.Fatalf("Failed to run migrations: %v", err)
 		}
 		log.Println("Migrations ran successfully")
+
+		// Images used to be stored in the database
+		moved, err := store.MoveImageBlobs(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to move images to the blob store: %v", err)
+		}
+		if moved > 0 {
+			log.Printf("Moved %d images to the blob store", moved)
+		}
+
+		// So did recordings
+		movedRecordings, err := store.MoveRecordingBlobs(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to move recordings to the blob store: %v", err)
+		}
+		if movedRecordings > 0 {
+			log.Printf("Moved %d recordings to the blob store", movedRecordings)
+		}
+
+		// Images stored before uploads were processed have no thumbnails
+		generated, err := store.GenerateThumbnails(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to generate image thumbnails: %v", err)
+		}
+		if generated > 0 {
+			log.Printf("Generated thumbnails of %d images", generated)
+		}
+	}
+
+	if err := createAdmin(context.Background(), store.Users()); err != nil {
+		log.Fatalf("Failed to create admin user: %v", err)
 	}
 
+	// Data used to be shared by everyone
+	claimed, err := store.ClaimUnowned(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to assign existing data to the admin: %v", err)
+	}
+	if claimed > 0 {
+		log.Printf("Assigned %d rows of existing data to the first admin", claimed)
+	}
+
+	// Snapshots are taken with VACUUM INTO, which only SQLite supports
+	var backups *backup.Manager
+	if dbDriver == storage.DriverSQLite {
+		backups = backup.NewManager(store.GetDB(), backupDir, backup.Retention{
+			KeepLast: backupKeep,
+			MaxAge:   backupMaxAge,
+		})
+		if backupInterval > 0 {
+			go backups.Run(context.Background(), backupInterval)
+		}
+	}
+
+	go sweepBlobs(context.Background(), store, backups)
+
 	// Start the gRPC server
-	go startGRPCServer(store)
+	go startGRPCServer(store, backups)
 
 	// Start the HTTP server (gRPC-Gateway)
-	go startHTTPServer()
+	go startHTTPServer(store)
 
 	// Wait for interrupt signal
 	waitForShutdown()
 }
 
-func startGRPCServer(store *storage.SQLiteStore) {
-	// Create gRPC server
-	grpcServer := grpc.NewServer()
+func startGRPCServer(store *storage.Store, backups *backup.Manager) {
+	// Create gRPC server, every call is authenticated, including those
+	// forwarded by the gateway
+	authenticator := auth.NewAuthenticator(store.Users())
+	grpcServer := grpc.NewServer(
+		grpc.MaxSendMsgSize(maxMessageSize),
+		grpc.ChainUnaryInterceptor(authenticator.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(authenticator.StreamInterceptor()),
+	)
 
-	// Register services
-	categoryService := handlers.NewCategoryHandler(store.GetDB())
-	tagService := handlers.NewTagService(store.GetDB())
-	exerciseService := handlers.NewExerciseHandler(store.GetDB())
-	practiceSessionService := handlers.NewPracticeSessionHandler(store.GetDB())
-	exerciseHistoryService := handlers.NewExerciseHistoryHandler(store.GetDB())
+	// The bulk server receives large messages, it only listens on loopback
+	// for the gateway, which limits the bodies of unauthenticated requests
+	bulkServer := grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxMessageSize),
+		grpc.MaxSendMsgSize(maxMessageSize),
+		grpc.ChainUnaryInterceptor(authenticator.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(authenticator.StreamInterceptor()),
+	)
+
+	// Register services, the session changes are published to the watchers
+	broker := events.NewBroker()
+	categoryService := handlers.NewCategoryHandler(store.Categories())
+	tagService := handlers.NewTagService(store.Tags())
+	exerciseService := handlers.NewExerciseHandler(store.Exercises(), store.History())
+	practiceSessionService := handlers.NewPracticeSessionHandler(store.Sessions(), broker)
+	exerciseHistoryService := handlers.NewExerciseHistoryHandler(store.History(), broker)
+	goalService := handlers.NewGoalHandler(store.Goals())
+	routineService := handlers.NewRoutineHandler(store.Routines(), broker)
+	recommendationService := handlers.NewRecommendationHandler(store.Exercises(), store.Sessions())
+	searchService := handlers.NewSearchHandler(store.Search())
+	settingsService := handlers.NewSettingsHandler(store.Settings())
+	dataService := handlers.NewDataHandler(store.Data())
+	adminService := handlers.NewAdminHandler(backups)
+	authService := handlers.NewAuthHandler(store.Users())
+	userService := handlers.NewUserHandler(store.Users())
+	assignmentService := handlers.NewAssignmentHandler(store.Assignments(), store.Users())
 
 	pb.RegisterCategoryServiceServer(grpcServer, categoryService)
 	pb.RegisterTagServiceServer(grpcServer, tagService)
 	pb.RegisterExerciseServiceServer(grpcServer, exerciseService)
 	pb.RegisterPracticeSessionServiceServer(grpcServer, practiceSessionService)
 	pb.RegisterExerciseHistoryServiceServer(grpcServer, exerciseHistoryService)
+	pb.RegisterGoalServiceServer(grpcServer, goalService)
+	pb.RegisterRoutineServiceServer(grpcServer, routineService)
+	pb.RegisterRecommendationServiceServer(grpcServer, recommendationService)
+	pb.RegisterSearchServiceServer(grpcServer, searchService)
+	pb.RegisterSettingsServiceServer(grpcServer, settingsService)
+	pb.RegisterDataServiceServer(grpcServer, dataService)
+	pb.RegisterAdminServiceServer(grpcServer, adminService)
+	pb.RegisterAuthServiceServer(grpcServer, authService)
+	pb.RegisterUserServiceServer(grpcServer, userService)
+	pb.RegisterAssignmentServiceServer(grpcServer, assignmentService)
+
+	pb.RegisterExerciseServiceServer(bulkServer, exerciseService)
+	pb.RegisterDataServiceServer(bulkServer, dataService)
 
 	// Register reflection service on gRPC server
 	reflection.Register(grpcServer)
 
+	bulkLis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", bulkPort))
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+	go func() {
+		log.Printf("Bulk gRPC server listening on 127.0.0.1:%d", bulkPort)
+		if err := bulkServer.Serve(bulkLis); err != nil {
+			log.Fatalf("Failed to serve bulk gRPC: %v", err)
+		}
+	}()
+
 	// Start listening
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
 	if err != nil {
@@ -118,7 +359,7 @@ func startGRPCServer(store *storage.SQLiteStore) {
 	}
 }
 
-func startHTTPServer() {
+func startHTTPServer(store *storage.Store) {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -127,21 +368,43 @@ func startHTTPServer() {
 	conn, err := grpc.NewClient(
 		fmt.Sprintf("localhost:%d", grpcPort),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxMessageSize),
+			grpc.MaxCallSendMsgSize(maxMessageSize),
+		),
 	)
 	if err != nil {
 		log.Fatalf("Failed to dial gRPC server: %v", err)
 	}
 	defer conn.Close()
 
-	// Register gRPC-Gateway
-	gwmux := runtime.NewServeMux()
+	// Exercises and data archives go through the bulk server, whose messages
+	// may be large
+	bulkConn, err := grpc.NewClient(
+		fmt.Sprintf("127.0.0.1:%d", bulkPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxMessageSize),
+			grpc.MaxCallSendMsgSize(maxMessageSize),
+		),
+	)
+	if err != nil {
+		log.Fatalf("Failed to dial bulk gRPC server: %v", err)
+	}
+	defer bulkConn.Close()
+
+	// Register gRPC-Gateway, logins set the session cookie of the web app
+	gwmux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(events.EventStreamContentType, events.NewEventStream()),
+		runtime.WithForwardResponseOption(auth.SessionCookies(secureCookies)),
+	)
 	if err := pb.RegisterCategoryServiceHandler(ctx, gwmux, conn); err != nil {
 		log.Fatalf("Failed to register gateway for CategoryService: %v", err)
 	}
 	if err := pb.RegisterTagServiceHandler(ctx, gwmux, conn); err != nil {
 		log.Fatalf("Failed to register gateway for TagService: %v", err)
 	}
-	if err := pb.RegisterExerciseServiceHandler(ctx, gwmux, conn); err != nil {
+	if err := pb.RegisterExerciseServiceHandler(ctx, gwmux, bulkConn); err != nil {
 		log.Fatalf("Failed to register gateway for ExerciseService: %v", err)
 	}
 	if err := pb.RegisterPracticeSessionServiceHandler(ctx, gwmux, conn); err != nil {
@@ -150,6 +413,36 @@ func startHTTPServer() {
 	if err := pb.RegisterExerciseHistoryServiceHandler(ctx, gwmux, conn); err != nil {
 		log.Fatalf("Failed to register gateway for ExerciseHistoryService: %v", err)
 	}
+	if err := pb.RegisterGoalServiceHandler(ctx, gwmux, conn); err != nil {
+		log.Fatalf("Failed to register gateway for GoalService: %v", err)
+	}
+	if err := pb.RegisterRoutineServiceHandler(ctx, gwmux, conn); err != nil {
+		log.Fatalf("Failed to register gateway for RoutineService: %v", err)
+	}
+	if err := pb.RegisterRecommendationServiceHandler(ctx, gwmux, conn); err != nil {
+		log.Fatalf("Failed to register gateway for RecommendationService: %v", err)
+	}
+	if err := pb.RegisterSearchServiceHandler(ctx, gwmux, conn); err != nil {
+		log.Fatalf("Failed to register gateway for SearchService: %v", err)
+	}
+	if err := pb.RegisterSettingsServiceHandler(ctx, gwmux, conn); err != nil {
+		log.Fatalf("Failed to register gateway for SettingsService: %v", err)
+	}
+	if err := pb.RegisterDataServiceHandler(ctx, gwmux, bulkConn); err != nil {
+		log.Fatalf("Failed to register gateway for DataService: %v", err)
+	}
+	if err := pb.RegisterAdminServiceHandler(ctx, gwmux, conn); err != nil {
+		log.Fatalf("Failed to register gateway for AdminService: %v", err)
+	}
+	if err := pb.RegisterAuthServiceHandler(ctx, gwmux, conn); err != nil {
+		log.Fatalf("Failed to register gateway for AuthService: %v", err)
+	}
+	if err := pb.RegisterUserServiceHandler(ctx, gwmux, conn); err != nil {
+		log.Fatalf("Failed to register gateway for UserService: %v", err)
+	}
+	if err := pb.RegisterAssignmentServiceHandler(ctx, gwmux, conn); err != nil {
+		log.Fatalf("Failed to register gateway for AssignmentService: %v", err)
+	}
 
 	staticFS, err := fs.Sub(embeddedFiles, "frontend/dist")
 	if err != nil {
@@ -158,7 +451,22 @@ func startHTTPServer() {
 	mux := http.NewServeMux()
 
 	// API routes
-	mux.Handle("/api/", http.StripPrefix("/api", middleware(gwmux)))
+	authenticator := auth.NewAuthenticator(store.Users())
+	mux.Handle("/api/", http.StripPrefix("/api", middleware(limitBodies(gwmux))))
+
+	// Routes served outside the gateway authenticate on their own
+
+	// Images are served directly so that browsers can cache them
+	images := middleware(authenticator.Middleware(handlers.NewImageDownloadHandler(store.Exercises())))
+	mux.Handle("GET /api/v1/images/{id}", images)
+	mux.Handle("GET /api/v1/images/{id}/thumbnails/{size}", images)
+
+	// Notation previews are rendered to SVG
+	mux.Handle("GET /api/v1/notations/{id}/svg", middleware(authenticator.Middleware(handlers.NewNotationSVGHandler(store.Exercises()))))
+
+	// Recordings are served directly so that range requests work and large
+	// files do not go through the gateway
+	mux.Handle("GET /api/v1/recordings/{id}/audio", middleware(authenticator.Middleware(handlers.NewRecordingDownloadHandler(store.History()))))
 
 	// Frontend (embedded)
 	mux.Handle("/", spaHandlerFS(staticFS, "index.html"))
@@ -166,7 +474,7 @@ func startHTTPServer() {
 	// Create HTTP server
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", httpPort),
-		Handler: basicAuthMiddleware(mux),
+		Handler: mux,
 	}
 
 	// Start HTTP server
@@ -176,6 +484,97 @@ func startHTTPServer() {
 	}
 }
 
+// createAdmin creates an admin from ADMIN_USERNAME and ADMIN_PASSWORD, or the
+// BASIC_AUTH_USER and BASIC_AUTH_PASS that used to protect the app, when there
+// are no users yet
+func createAdmin(ctx context.Context, users storage.UserRepo) error {
+	count, err := users.Count(ctx)
+	if err != nil || count > 0 {
+		return err
+	}
+
+	username, password := os.Getenv("ADMIN_USERNAME"), os.Getenv("ADMIN_PASSWORD")
+	if username == "" {
+		username, password = os.Getenv("BASIC_AUTH_USER"), os.Getenv("BASIC_AUTH_PASS")
+	}
+	if username == "" {
+		log.Printf("No users exist, set ADMIN_USERNAME and ADMIN_PASSWORD to create an admin")
+		return nil
+	}
+
+	passwordHash, err := auth.HashPassword(password)
+	if err != nil {
+		return err
+	}
+	if _, err := users.Create(ctx, username, passwordHash, true, false); err != nil {
+		return err
+	}
+
+	log.Printf("Created admin user %s", username)
+	return nil
+}
+
+// blobSweepInterval is the interval between sweeps of released blobs
+const blobSweepInterval = time.Hour
+
+// sweepBlobs deletes the image and recording data nothing refers to anymore
+// every blobSweepInterval until ctx is done. Data released within the grace
+// period or before the oldest snapshot is kept, restoring a snapshot may need
+// it.
+func sweepBlobs(ctx context.Context, store *storage.Store, backups *backup.Manager) {
+	ticker := time.NewTicker(blobSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		before := time.Now().Add(-blobGrace)
+		if backups != nil {
+			snapshots, err := backups.List()
+			if err != nil {
+				log.Printf("Blob sweep skipped: %v", err)
+				continue
+			}
+			if n := len(snapshots); n > 0 && snapshots[n-1].CreatedAt.Before(before) {
+				before = snapshots[n-1].CreatedAt
+			}
+		}
+
+		deleted, err := store.SweepBlobs(ctx, before)
+		if err != nil {
+			log.Printf("Blob sweep failed: %v", err)
+		}
+		if deleted > 0 {
+			log.Printf("Deleted %d unused blobs", deleted)
+		}
+	}
+}
+
+// openBlobStore opens the configured store for image and recording data, S3
+// credentials are read from the environment
+func openBlobStore() (blobstore.BlobStore, error) {
+	switch blobStore {
+	case "fs":
+		return blobstore.NewFS(blobDir)
+	case "s3":
+		return blobstore.NewS3(blobstore.S3Config{
+			Endpoint:  s3Endpoint,
+			Region:    s3Region,
+			Bucket:    s3Bucket,
+			Prefix:    s3Prefix,
+			AccessKey: os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			PathStyle: s3PathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported blob store %q", blobStore)
+	}
+}
+
 func spaHandlerFS(staticFS fs.FS, indexPath string) http.Handler {
 	fileServer := http.FileServer(http.FS(staticFS))
 
@@ -220,6 +619,33 @@ func (r *statusRec) WriteHeader(status int) {
 	r.ResponseWriter.WriteHeader(status)
 }
 
+// Unwrap lets streaming responses flush through the recorder
+func (r *statusRec) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// limitBodies bounds the request bodies the gateway reads. Only the routes of
+// the bulk server may receive large ones, twice the message size since JSON
+// encodes bytes in base64, the gRPC server authenticates them.
+func limitBodies(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength != 0 {
+			limit := int64(maxPublicBodySize)
+			if bulkRoute(r.URL.Path) {
+				limit = 2 * maxMessageSize
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// bulkRoute reports whether a gateway path belongs to a service registered on
+// the bulk server
+func bulkRoute(path string) bool {
+	return strings.HasPrefix(path, "/v1/exercises") || strings.HasPrefix(path, "/v1/data/")
+}
+
 // Add middleware for REST API
 func middleware(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -248,26 +674,6 @@ func middleware(handler http.Handler) http.Handler {
 	})
 }
 
-func basicAuthMiddleware(next http.Handler) http.Handler {
-	if os.Getenv("ENV") != "prod" {
-		// Disable auth in non-production
-		return next
-	}
-
-	user := os.Getenv("BASIC_AUTH_USER")
-	pass := os.Getenv("BASIC_AUTH_PASS")
-
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		u, p, ok := r.BasicAuth()
-		if !ok || u != user || p != pass {
-			w.Header().Set("WWW-Authenticate", `Basic realm="Restricted"`)
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
-			return
-		}
-		next.ServeHTTP(w, r)
-	})
-}
-
 func waitForShutdown() {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
@@ -8,6 +8,7 @@ package tempusv1
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -25,16 +26,412 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Subdivision is the note value an exercise is played in
+type Subdivision int32
+
+const (
+	Subdivision_SUBDIVISION_UNSPECIFIED       Subdivision = 0
+	Subdivision_SUBDIVISION_QUARTER           Subdivision = 1
+	Subdivision_SUBDIVISION_EIGHTH            Subdivision = 2
+	Subdivision_SUBDIVISION_EIGHTH_TRIPLET    Subdivision = 3
+	Subdivision_SUBDIVISION_SIXTEENTH         Subdivision = 4
+	Subdivision_SUBDIVISION_SIXTEENTH_TRIPLET Subdivision = 5
+	Subdivision_SUBDIVISION_THIRTY_SECOND     Subdivision = 6
+)
+
+// Enum value maps for Subdivision.
+var (
+	Subdivision_name = map[int32]string{
+		0: "SUBDIVISION_UNSPECIFIED",
+		1: "SUBDIVISION_QUARTER",
+		2: "SUBDIVISION_EIGHTH",
+		3: "SUBDIVISION_EIGHTH_TRIPLET",
+		4: "SUBDIVISION_SIXTEENTH",
+		5: "SUBDIVISION_SIXTEENTH_TRIPLET",
+		6: "SUBDIVISION_THIRTY_SECOND",
+	}
+	Subdivision_value = map[string]int32{
+		"SUBDIVISION_UNSPECIFIED":       0,
+		"SUBDIVISION_QUARTER":           1,
+		"SUBDIVISION_EIGHTH":            2,
+		"SUBDIVISION_EIGHTH_TRIPLET":    3,
+		"SUBDIVISION_SIXTEENTH":         4,
+		"SUBDIVISION_SIXTEENTH_TRIPLET": 5,
+		"SUBDIVISION_THIRTY_SECOND":     6,
+	}
+)
+
+func (x Subdivision) Enum() *Subdivision {
+	p := new(Subdivision)
+	*p = x
+	return p
+}
+
+func (x Subdivision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Subdivision) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_tempus_tempus_proto_enumTypes[0].Descriptor()
+}
+
+func (Subdivision) Type() protoreflect.EnumType {
+	return &file_api_v1_tempus_tempus_proto_enumTypes[0]
+}
+
+func (x Subdivision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Subdivision.Descriptor instead.
+func (Subdivision) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{0}
+}
+
+// NotationFormat is the file format of exercise notation
+type NotationFormat int32
+
+const (
+	NotationFormat_NOTATION_FORMAT_UNSPECIFIED NotationFormat = 0
+	NotationFormat_NOTATION_FORMAT_MUSICXML    NotationFormat = 1 // Uncompressed or compressed (.mxl)
+	NotationFormat_NOTATION_FORMAT_DRUM_TAB    NotationFormat = 2 // Plain text, instrument lines such as "HH|x-x-x-x-|"
+)
+
+// Enum value maps for NotationFormat.
+var (
+	NotationFormat_name = map[int32]string{
+		0: "NOTATION_FORMAT_UNSPECIFIED",
+		1: "NOTATION_FORMAT_MUSICXML",
+		2: "NOTATION_FORMAT_DRUM_TAB",
+	}
+	NotationFormat_value = map[string]int32{
+		"NOTATION_FORMAT_UNSPECIFIED": 0,
+		"NOTATION_FORMAT_MUSICXML":    1,
+		"NOTATION_FORMAT_DRUM_TAB":    2,
+	}
+)
+
+func (x NotationFormat) Enum() *NotationFormat {
+	p := new(NotationFormat)
+	*p = x
+	return p
+}
+
+func (x NotationFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotationFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_tempus_tempus_proto_enumTypes[1].Descriptor()
+}
+
+func (NotationFormat) Type() protoreflect.EnumType {
+	return &file_api_v1_tempus_tempus_proto_enumTypes[1]
+}
+
+func (x NotationFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotationFormat.Descriptor instead.
+func (NotationFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{1}
+}
+
+// TagMatch is how the tags of an exercise filter combine
+type TagMatch int32
+
+const (
+	TagMatch_TAG_MATCH_UNSPECIFIED TagMatch = 0 // Same as any
+	TagMatch_TAG_MATCH_ANY         TagMatch = 1 // Exercises with at least one of the tags
+	TagMatch_TAG_MATCH_ALL         TagMatch = 2 // Exercises with every tag
+)
+
+// Enum value maps for TagMatch.
+var (
+	TagMatch_name = map[int32]string{
+		0: "TAG_MATCH_UNSPECIFIED",
+		1: "TAG_MATCH_ANY",
+		2: "TAG_MATCH_ALL",
+	}
+	TagMatch_value = map[string]int32{
+		"TAG_MATCH_UNSPECIFIED": 0,
+		"TAG_MATCH_ANY":         1,
+		"TAG_MATCH_ALL":         2,
+	}
+)
+
+func (x TagMatch) Enum() *TagMatch {
+	p := new(TagMatch)
+	*p = x
+	return p
+}
+
+func (x TagMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_tempus_tempus_proto_enumTypes[2].Descriptor()
+}
+
+func (TagMatch) Type() protoreflect.EnumType {
+	return &file_api_v1_tempus_tempus_proto_enumTypes[2]
+}
+
+func (x TagMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagMatch.Descriptor instead.
+func (TagMatch) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{2}
+}
+
+// ExerciseSort is the order exercises are listed in
+type ExerciseSort int32
+
+const (
+	ExerciseSort_EXERCISE_SORT_UNSPECIFIED         ExerciseSort = 0 // Same as name
+	ExerciseSort_EXERCISE_SORT_NAME                ExerciseSort = 1
+	ExerciseSort_EXERCISE_SORT_CREATED_AT          ExerciseSort = 2
+	ExerciseSort_EXERCISE_SORT_LAST_PRACTICE       ExerciseSort = 3 // Never practiced exercises first
+	ExerciseSort_EXERCISE_SORT_TOTAL_PRACTICE_TIME ExerciseSort = 4
+)
+
+// Enum value maps for ExerciseSort.
+var (
+	ExerciseSort_name = map[int32]string{
+		0: "EXERCISE_SORT_UNSPECIFIED",
+		1: "EXERCISE_SORT_NAME",
+		2: "EXERCISE_SORT_CREATED_AT",
+		3: "EXERCISE_SORT_LAST_PRACTICE",
+		4: "EXERCISE_SORT_TOTAL_PRACTICE_TIME",
+	}
+	ExerciseSort_value = map[string]int32{
+		"EXERCISE_SORT_UNSPECIFIED":         0,
+		"EXERCISE_SORT_NAME":                1,
+		"EXERCISE_SORT_CREATED_AT":          2,
+		"EXERCISE_SORT_LAST_PRACTICE":       3,
+		"EXERCISE_SORT_TOTAL_PRACTICE_TIME": 4,
+	}
+)
+
+func (x ExerciseSort) Enum() *ExerciseSort {
+	p := new(ExerciseSort)
+	*p = x
+	return p
+}
+
+func (x ExerciseSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExerciseSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_tempus_tempus_proto_enumTypes[3].Descriptor()
+}
+
+func (ExerciseSort) Type() protoreflect.EnumType {
+	return &file_api_v1_tempus_tempus_proto_enumTypes[3]
+}
+
+func (x ExerciseSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExerciseSort.Descriptor instead.
+func (ExerciseSort) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{3}
+}
+
+// SessionEventType is the kind of change a SessionEvent reports
+type SessionEventType int32
+
+const (
+	SessionEventType_SESSION_EVENT_TYPE_UNSPECIFIED      SessionEventType = 0
+	SessionEventType_SESSION_EVENT_TYPE_STARTED          SessionEventType = 1 // Created or made active
+	SessionEventType_SESSION_EVENT_TYPE_UPDATED          SessionEventType = 2 // Times, notes or timer changed
+	SessionEventType_SESSION_EVENT_TYPE_ENDED            SessionEventType = 3 // No longer active
+	SessionEventType_SESSION_EVENT_TYPE_DELETED          SessionEventType = 4
+	SessionEventType_SESSION_EVENT_TYPE_EXERCISE_ADDED   SessionEventType = 5
+	SessionEventType_SESSION_EVENT_TYPE_EXERCISE_UPDATED SessionEventType = 6 // BPMs, rating, notes or times changed
+	SessionEventType_SESSION_EVENT_TYPE_EXERCISE_REMOVED SessionEventType = 7
+)
+
+// Enum value maps for SessionEventType.
+var (
+	SessionEventType_name = map[int32]string{
+		0: "SESSION_EVENT_TYPE_UNSPECIFIED",
+		1: "SESSION_EVENT_TYPE_STARTED",
+		2: "SESSION_EVENT_TYPE_UPDATED",
+		3: "SESSION_EVENT_TYPE_ENDED",
+		4: "SESSION_EVENT_TYPE_DELETED",
+		5: "SESSION_EVENT_TYPE_EXERCISE_ADDED",
+		6: "SESSION_EVENT_TYPE_EXERCISE_UPDATED",
+		7: "SESSION_EVENT_TYPE_EXERCISE_REMOVED",
+	}
+	SessionEventType_value = map[string]int32{
+		"SESSION_EVENT_TYPE_UNSPECIFIED":      0,
+		"SESSION_EVENT_TYPE_STARTED":          1,
+		"SESSION_EVENT_TYPE_UPDATED":          2,
+		"SESSION_EVENT_TYPE_ENDED":            3,
+		"SESSION_EVENT_TYPE_DELETED":          4,
+		"SESSION_EVENT_TYPE_EXERCISE_ADDED":   5,
+		"SESSION_EVENT_TYPE_EXERCISE_UPDATED": 6,
+		"SESSION_EVENT_TYPE_EXERCISE_REMOVED": 7,
+	}
+)
+
+func (x SessionEventType) Enum() *SessionEventType {
+	p := new(SessionEventType)
+	*p = x
+	return p
+}
+
+func (x SessionEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_tempus_tempus_proto_enumTypes[4].Descriptor()
+}
+
+func (SessionEventType) Type() protoreflect.EnumType {
+	return &file_api_v1_tempus_tempus_proto_enumTypes[4]
+}
+
+func (x SessionEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionEventType.Descriptor instead.
+func (SessionEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{4}
+}
+
+// SearchEntityType is the kind of record a search result is
+type SearchEntityType int32
+
+const (
+	SearchEntityType_SEARCH_ENTITY_TYPE_UNSPECIFIED      SearchEntityType = 0
+	SearchEntityType_SEARCH_ENTITY_TYPE_EXERCISE         SearchEntityType = 1 // Name and description
+	SearchEntityType_SEARCH_ENTITY_TYPE_EXERCISE_LINK    SearchEntityType = 2 // Description and URL
+	SearchEntityType_SEARCH_ENTITY_TYPE_PRACTICE_SESSION SearchEntityType = 3 // Notes
+	SearchEntityType_SEARCH_ENTITY_TYPE_EXERCISE_HISTORY SearchEntityType = 4 // Notes
+)
+
+// Enum value maps for SearchEntityType.
+var (
+	SearchEntityType_name = map[int32]string{
+		0: "SEARCH_ENTITY_TYPE_UNSPECIFIED",
+		1: "SEARCH_ENTITY_TYPE_EXERCISE",
+		2: "SEARCH_ENTITY_TYPE_EXERCISE_LINK",
+		3: "SEARCH_ENTITY_TYPE_PRACTICE_SESSION",
+		4: "SEARCH_ENTITY_TYPE_EXERCISE_HISTORY",
+	}
+	SearchEntityType_value = map[string]int32{
+		"SEARCH_ENTITY_TYPE_UNSPECIFIED":      0,
+		"SEARCH_ENTITY_TYPE_EXERCISE":         1,
+		"SEARCH_ENTITY_TYPE_EXERCISE_LINK":    2,
+		"SEARCH_ENTITY_TYPE_PRACTICE_SESSION": 3,
+		"SEARCH_ENTITY_TYPE_EXERCISE_HISTORY": 4,
+	}
+)
+
+func (x SearchEntityType) Enum() *SearchEntityType {
+	p := new(SearchEntityType)
+	*p = x
+	return p
+}
+
+func (x SearchEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_tempus_tempus_proto_enumTypes[5].Descriptor()
+}
+
+func (SearchEntityType) Type() protoreflect.EnumType {
+	return &file_api_v1_tempus_tempus_proto_enumTypes[5]
+}
+
+func (x SearchEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchEntityType.Descriptor instead.
+func (SearchEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{5}
+}
+
+// AssignmentStatus is how far a student got with an assignment
+type AssignmentStatus int32
+
+const (
+	AssignmentStatus_ASSIGNMENT_STATUS_UNSPECIFIED AssignmentStatus = 0
+	AssignmentStatus_ASSIGNMENT_STATUS_NOT_STARTED AssignmentStatus = 1 // Not practiced since it was assigned
+	AssignmentStatus_ASSIGNMENT_STATUS_IN_PROGRESS AssignmentStatus = 2 // Practiced below the target BPM
+	AssignmentStatus_ASSIGNMENT_STATUS_MET_TARGET  AssignmentStatus = 3 // Practiced at the target BPM or above
+	AssignmentStatus_ASSIGNMENT_STATUS_OVERDUE     AssignmentStatus = 4 // Past its due date without meeting the target
+)
+
+// Enum value maps for AssignmentStatus.
+var (
+	AssignmentStatus_name = map[int32]string{
+		0: "ASSIGNMENT_STATUS_UNSPECIFIED",
+		1: "ASSIGNMENT_STATUS_NOT_STARTED",
+		2: "ASSIGNMENT_STATUS_IN_PROGRESS",
+		3: "ASSIGNMENT_STATUS_MET_TARGET",
+		4: "ASSIGNMENT_STATUS_OVERDUE",
+	}
+	AssignmentStatus_value = map[string]int32{
+		"ASSIGNMENT_STATUS_UNSPECIFIED": 0,
+		"ASSIGNMENT_STATUS_NOT_STARTED": 1,
+		"ASSIGNMENT_STATUS_IN_PROGRESS": 2,
+		"ASSIGNMENT_STATUS_MET_TARGET":  3,
+		"ASSIGNMENT_STATUS_OVERDUE":     4,
+	}
+)
+
+func (x AssignmentStatus) Enum() *AssignmentStatus {
+	p := new(AssignmentStatus)
+	*p = x
+	return p
+}
+
+func (x AssignmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssignmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_tempus_tempus_proto_enumTypes[6].Descriptor()
+}
+
+func (AssignmentStatus) Type() protoreflect.EnumType {
+	return &file_api_v1_tempus_tempus_proto_enumTypes[6]
+}
+
+func (x AssignmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssignmentStatus.Descriptor instead.
+func (AssignmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{6}
+}
+
 // Category represents a drumming category
 type Category struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description         string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	WeeklyTargetMinutes int32                  `protobuf:"varint,6,opt,name=weekly_target_minutes,json=weeklyTargetMinutes,proto3" json:"weekly_target_minutes,omitempty"` // Optional: minutes to practice per week
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Category) Reset() {
@@ -102,6 +499,13 @@ func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Category) GetWeeklyTargetMinutes() int32 {
+	if x != nil {
+		return x.WeeklyTargetMinutes
+	}
+	return 0
+}
+
 // Tag represents a tag used for categorizing exercises
 type Tag struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -186,6 +590,10 @@ type Exercise struct {
 	LastPractice  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_practice,json=lastPractice,proto3" json:"last_practice,omitempty"`
 	LastBpms      []int32                `protobuf:"varint,11,rep,packed,name=last_bpms,json=lastBpms,proto3" json:"last_bpms,omitempty"`
 	LastNotes     string                 `protobuf:"bytes,12,opt,name=last_notes,json=lastNotes,proto3" json:"last_notes,omitempty"`
+	TempoPlan     *TempoPlan             `protobuf:"bytes,13,opt,name=tempo_plan,json=tempoPlan,proto3" json:"tempo_plan,omitempty"`           // Optional
+	SuggestedBpm  int32                  `protobuf:"varint,14,opt,name=suggested_bpm,json=suggestedBpm,proto3" json:"suggested_bpm,omitempty"` // Output only: next rung of the tempo plan
+	Notations     []*ExerciseNotation    `protobuf:"bytes,15,rep,name=notations,proto3" json:"notations,omitempty"`
+	PackName      string                 `protobuf:"bytes,16,opt,name=pack_name,json=packName,proto3" json:"pack_name,omitempty"` // Output only: the exercise pack it was last imported from
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -304,34 +712,62 @@ func (x *Exercise) GetLastNotes() string {
 	return ""
 }
 
-// ExerciseImage represents an image associated with an exercise
-type ExerciseImage struct {
+func (x *Exercise) GetTempoPlan() *TempoPlan {
+	if x != nil {
+		return x.TempoPlan
+	}
+	return nil
+}
+
+func (x *Exercise) GetSuggestedBpm() int32 {
+	if x != nil {
+		return x.SuggestedBpm
+	}
+	return 0
+}
+
+func (x *Exercise) GetNotations() []*ExerciseNotation {
+	if x != nil {
+		return x.Notations
+	}
+	return nil
+}
+
+func (x *Exercise) GetPackName() string {
+	if x != nil {
+		return x.PackName
+	}
+	return ""
+}
+
+// TempoPlan is a ladder of tempos to work an exercise up through
+type TempoPlan struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ExerciseId    int32                  `protobuf:"varint,2,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
-	ImageData     []byte                 `protobuf:"bytes,3,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`
-	Filename      string                 `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
-	MimeType      string                 `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartBpm      int32                  `protobuf:"varint,1,opt,name=start_bpm,json=startBpm,proto3" json:"start_bpm,omitempty"`
+	EndBpm        int32                  `protobuf:"varint,2,opt,name=end_bpm,json=endBpm,proto3" json:"end_bpm,omitempty"`
+	Increment     int32                  `protobuf:"varint,3,opt,name=increment,proto3" json:"increment,omitempty"`                          // BPM added per rung
+	BarsPerStep   int32                  `protobuf:"varint,4,opt,name=bars_per_step,json=barsPerStep,proto3" json:"bars_per_step,omitempty"` // Bars played at each rung
+	TimeSignature string                 `protobuf:"bytes,5,opt,name=time_signature,json=timeSignature,proto3" json:"time_signature,omitempty"`
+	Subdivision   Subdivision            `protobuf:"varint,6,opt,name=subdivision,proto3,enum=drummer.v1.Subdivision" json:"subdivision,omitempty"`
+	AccentPattern string                 `protobuf:"bytes,7,opt,name=accent_pattern,json=accentPattern,proto3" json:"accent_pattern,omitempty"` // A character per note, '>' accented and '.' not, e.g. ">..>..>."
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExerciseImage) Reset() {
-	*x = ExerciseImage{}
+func (x *TempoPlan) Reset() {
+	*x = TempoPlan{}
 	mi := &file_api_v1_tempus_tempus_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExerciseImage) String() string {
+func (x *TempoPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExerciseImage) ProtoMessage() {}
+func (*TempoPlan) ProtoMessage() {}
 
-func (x *ExerciseImage) ProtoReflect() protoreflect.Message {
+func (x *TempoPlan) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_tempus_tempus_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -343,86 +779,92 @@ func (x *ExerciseImage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExerciseImage.ProtoReflect.Descriptor instead.
-func (*ExerciseImage) Descriptor() ([]byte, []int) {
+// Deprecated: Use TempoPlan.ProtoReflect.Descriptor instead.
+func (*TempoPlan) Descriptor() ([]byte, []int) {
 	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ExerciseImage) GetId() int32 {
+func (x *TempoPlan) GetStartBpm() int32 {
 	if x != nil {
-		return x.Id
+		return x.StartBpm
 	}
 	return 0
 }
 
-func (x *ExerciseImage) GetExerciseId() int32 {
+func (x *TempoPlan) GetEndBpm() int32 {
 	if x != nil {
-		return x.ExerciseId
+		return x.EndBpm
 	}
 	return 0
 }
 
-func (x *ExerciseImage) GetImageData() []byte {
+func (x *TempoPlan) GetIncrement() int32 {
 	if x != nil {
-		return x.ImageData
+		return x.Increment
 	}
-	return nil
+	return 0
 }
 
-func (x *ExerciseImage) GetFilename() string {
+func (x *TempoPlan) GetBarsPerStep() int32 {
 	if x != nil {
-		return x.Filename
+		return x.BarsPerStep
 	}
-	return ""
+	return 0
 }
 
-func (x *ExerciseImage) GetMimeType() string {
+func (x *TempoPlan) GetTimeSignature() string {
 	if x != nil {
-		return x.MimeType
+		return x.TimeSignature
 	}
 	return ""
 }
 
-func (x *ExerciseImage) GetDescription() string {
+func (x *TempoPlan) GetSubdivision() Subdivision {
 	if x != nil {
-		return x.Description
+		return x.Subdivision
 	}
-	return ""
+	return Subdivision_SUBDIVISION_UNSPECIFIED
 }
 
-func (x *ExerciseImage) GetCreatedAt() *timestamppb.Timestamp {
+func (x *TempoPlan) GetAccentPattern() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.AccentPattern
 	}
-	return nil
+	return ""
 }
 
-// ExerciseLink represents an external link for an exercise
-type ExerciseLink struct {
+// ExerciseNotation is sheet music attached to an exercise. Listings leave out
+// the notation data, an SVG preview is served at the SVG URL.
+type ExerciseNotation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	ExerciseId    int32                  `protobuf:"varint,2,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Format        NotationFormat         `protobuf:"varint,3,opt,name=format,proto3,enum=drummer.v1.NotationFormat" json:"format,omitempty"`
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Filename      string                 `protobuf:"bytes,5,opt,name=filename,proto3" json:"filename,omitempty"`
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`                                      // Output only
+	TimeSignature string                 `protobuf:"bytes,7,opt,name=time_signature,json=timeSignature,proto3" json:"time_signature,omitempty"` // Output only: of the first measure, if given
+	MeasureCount  int32                  `protobuf:"varint,8,opt,name=measure_count,json=measureCount,proto3" json:"measure_count,omitempty"`   // Output only
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SvgUrl        string                 `protobuf:"bytes,10,opt,name=svg_url,json=svgUrl,proto3" json:"svg_url,omitempty"` // Output only
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExerciseLink) Reset() {
-	*x = ExerciseLink{}
+func (x *ExerciseNotation) Reset() {
+	*x = ExerciseNotation{}
 	mi := &file_api_v1_tempus_tempus_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExerciseLink) String() string {
+func (x *ExerciseNotation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExerciseLink) ProtoMessage() {}
+func (*ExerciseNotation) ProtoMessage() {}
 
-func (x *ExerciseLink) ProtoReflect() protoreflect.Message {
+func (x *ExerciseNotation) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_tempus_tempus_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -434,76 +876,7232 @@ func (x *ExerciseLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExerciseLink.ProtoReflect.Descriptor instead.
-func (*ExerciseLink) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExerciseNotation.ProtoReflect.Descriptor instead.
+func (*ExerciseNotation) Descriptor() ([]byte, []int) {
 	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ExerciseLink) GetId() int32 {
+func (x *ExerciseNotation) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ExerciseLink) GetExerciseId() int32 {
+func (x *ExerciseNotation) GetExerciseId() int32 {
 	if x != nil {
 		return x.ExerciseId
 	}
 	return 0
 }
 
-func (x *ExerciseLink) GetUrl() string {
+func (x *ExerciseNotation) GetFormat() NotationFormat {
 	if x != nil {
-		return x.Url
+		return x.Format
+	}
+	return NotationFormat_NOTATION_FORMAT_UNSPECIFIED
+}
+
+func (x *ExerciseNotation) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExerciseNotation) GetFilename() string {
+	if x != nil {
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *ExerciseLink) GetDescription() string {
+func (x *ExerciseNotation) GetTitle() string {
 	if x != nil {
-		return x.Description
+		return x.Title
 	}
 	return ""
 }
 
-func (x *ExerciseLink) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ExerciseNotation) GetTimeSignature() string {
+	if x != nil {
+		return x.TimeSignature
+	}
+	return ""
+}
+
+func (x *ExerciseNotation) GetMeasureCount() int32 {
+	if x != nil {
+		return x.MeasureCount
+	}
+	return 0
+}
+
+func (x *ExerciseNotation) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-// PracticeSession represents a drumming practice session
-type PracticeSession struct {
+func (x *ExerciseNotation) GetSvgUrl() string {
+	if x != nil {
+		return x.SvgUrl
+	}
+	return ""
+}
+
+// ExerciseImage represents an image associated with an exercise. Listings
+// leave out the image data, the image is served at its URL.
+type ExerciseImage struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Id            int32                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExerciseId    int32                     `protobuf:"varint,2,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	ImageData     []byte                    `protobuf:"bytes,3,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`
+	Filename      string                    `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType      string                    `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Description   string                    `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp    `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ContentHash   string                    `protobuf:"bytes,8,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"` // Output only: SHA-256 of the image data
+	SizeBytes     int64                     `protobuf:"varint,9,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`      // Output only
+	Url           string                    `protobuf:"bytes,10,opt,name=url,proto3" json:"url,omitempty"`                                   // Output only
+	Width         int32                     `protobuf:"varint,11,opt,name=width,proto3" json:"width,omitempty"`                              // Output only
+	Height        int32                     `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`                            // Output only
+	Thumbnails    []*ExerciseImageThumbnail `protobuf:"bytes,13,rep,name=thumbnails,proto3" json:"thumbnails,omitempty"`                     // Output only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExerciseImage) Reset() {
+	*x = ExerciseImage{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExerciseImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExerciseImage) ProtoMessage() {}
+
+func (x *ExerciseImage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExerciseImage.ProtoReflect.Descriptor instead.
+func (*ExerciseImage) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExerciseImage) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExerciseImage) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *ExerciseImage) GetImageData() []byte {
+	if x != nil {
+		return x.ImageData
+	}
+	return nil
+}
+
+func (x *ExerciseImage) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExerciseImage) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ExerciseImage) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExerciseImage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExerciseImage) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *ExerciseImage) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ExerciseImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ExerciseImage) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ExerciseImage) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ExerciseImage) GetThumbnails() []*ExerciseImageThumbnail {
+	if x != nil {
+		return x.Thumbnails
+	}
+	return nil
+}
+
+// ExerciseImageThumbnail is a scaled down copy of an exercise image for
+// previews, all fields are output only
+type ExerciseImageThumbnail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          string                 `protobuf:"bytes,1,opt,name=size,proto3" json:"size,omitempty"` // "small" or "medium"
+	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Width         int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	ContentHash   string                 `protobuf:"bytes,5,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExerciseImageThumbnail) Reset() {
+	*x = ExerciseImageThumbnail{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExerciseImageThumbnail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExerciseImageThumbnail) ProtoMessage() {}
+
+func (x *ExerciseImageThumbnail) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExerciseImageThumbnail.ProtoReflect.Descriptor instead.
+func (*ExerciseImageThumbnail) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExerciseImageThumbnail) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *ExerciseImageThumbnail) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ExerciseImageThumbnail) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ExerciseImageThumbnail) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ExerciseImageThumbnail) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *ExerciseImageThumbnail) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// ExerciseLink represents an external link for an exercise
+type ExerciseLink struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExerciseId    int32                  `protobuf:"varint,2,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Exercises     []*ExerciseHistory     `protobuf:"bytes,7,rep,name=exercises,proto3" json:"exercises,omitempty"`
-	Active        bool                   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PracticeSession) Reset() {
-	*x = PracticeSession{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[5]
+func (x *ExerciseLink) Reset() {
+	*x = ExerciseLink{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExerciseLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExerciseLink) ProtoMessage() {}
+
+func (x *ExerciseLink) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExerciseLink.ProtoReflect.Descriptor instead.
+func (*ExerciseLink) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExerciseLink) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExerciseLink) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *ExerciseLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ExerciseLink) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExerciseLink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// PracticeSession represents a drumming practice session
+type PracticeSession struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartTime        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Notes            string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Exercises        []*ExerciseHistory     `protobuf:"bytes,7,rep,name=exercises,proto3" json:"exercises,omitempty"`
+	Active           bool                   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	DurationSeconds  int32                  `protobuf:"varint,9,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`       // Output only: time practiced without pauses, from the segments
+	Paused           bool                   `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty"`                                               // Output only
+	CurrentHistoryId int32                  `protobuf:"varint,11,opt,name=current_history_id,json=currentHistoryId,proto3" json:"current_history_id,omitempty"` // Output only: exercise history entry in progress
+	Segments         []*SessionSegment      `protobuf:"bytes,12,rep,name=segments,proto3" json:"segments,omitempty"`                                            // Output only: not filled in when listing
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PracticeSession) Reset() {
+	*x = PracticeSession{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PracticeSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PracticeSession) ProtoMessage() {}
+
+func (x *PracticeSession) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PracticeSession.ProtoReflect.Descriptor instead.
+func (*PracticeSession) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PracticeSession) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PracticeSession) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *PracticeSession) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *PracticeSession) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *PracticeSession) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PracticeSession) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PracticeSession) GetExercises() []*ExerciseHistory {
+	if x != nil {
+		return x.Exercises
+	}
+	return nil
+}
+
+func (x *PracticeSession) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *PracticeSession) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *PracticeSession) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *PracticeSession) GetCurrentHistoryId() int32 {
+	if x != nil {
+		return x.CurrentHistoryId
+	}
+	return 0
+}
+
+func (x *PracticeSession) GetSegments() []*SessionSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+// SessionSegment is a stretch of time a practice session was running,
+// spent on an exercise when it has an exercise history entry
+type SessionSegment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HistoryId     int32                  `protobuf:"varint,1,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"` // Optional
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"` // Unset while running
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionSegment) Reset() {
+	*x = SessionSegment{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionSegment) ProtoMessage() {}
+
+func (x *SessionSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionSegment.ProtoReflect.Descriptor instead.
+func (*SessionSegment) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SessionSegment) GetHistoryId() int32 {
+	if x != nil {
+		return x.HistoryId
+	}
+	return 0
+}
+
+func (x *SessionSegment) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SessionSegment) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// ExerciseHistory represents a historical record of exercise performance
+type ExerciseHistory struct {
+	state           protoimpl.MessageState      `protogen:"open.v1"`
+	Id              int32                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExerciseId      int32                       `protobuf:"varint,2,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	StartTime       *timestamppb.Timestamp      `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime         *timestamppb.Timestamp      `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Bpms            []int32                     `protobuf:"varint,5,rep,packed,name=bpms,proto3" json:"bpms,omitempty"`
+	TimeSignature   string                      `protobuf:"bytes,6,opt,name=time_signature,json=timeSignature,proto3" json:"time_signature,omitempty"`
+	Notes           string                      `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	Rating          int32                       `protobuf:"varint,8,opt,name=rating,proto3" json:"rating,omitempty"`    // User rating (1-5)
+	Exercise        *Exercise                   `protobuf:"bytes,9,opt,name=exercise,proto3" json:"exercise,omitempty"` // Full exercise details
+	SessionId       int32                       `protobuf:"varint,10,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	DurationSeconds int32                       `protobuf:"varint,11,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // Optional manual duration override
+	SuggestedBpm    int32                       `protobuf:"varint,12,opt,name=suggested_bpm,json=suggestedBpm,proto3" json:"suggested_bpm,omitempty"`          // Output only: tempo plan rung suggested when the entry was added
+	Recordings      []*ExerciseHistoryRecording `protobuf:"bytes,13,rep,name=recordings,proto3" json:"recordings,omitempty"`                                   // Output only: without their audio
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExerciseHistory) Reset() {
+	*x = ExerciseHistory{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExerciseHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExerciseHistory) ProtoMessage() {}
+
+func (x *ExerciseHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExerciseHistory.ProtoReflect.Descriptor instead.
+func (*ExerciseHistory) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExerciseHistory) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExerciseHistory) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *ExerciseHistory) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ExerciseHistory) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ExerciseHistory) GetBpms() []int32 {
+	if x != nil {
+		return x.Bpms
+	}
+	return nil
+}
+
+func (x *ExerciseHistory) GetTimeSignature() string {
+	if x != nil {
+		return x.TimeSignature
+	}
+	return ""
+}
+
+func (x *ExerciseHistory) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ExerciseHistory) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ExerciseHistory) GetExercise() *Exercise {
+	if x != nil {
+		return x.Exercise
+	}
+	return nil
+}
+
+func (x *ExerciseHistory) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *ExerciseHistory) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ExerciseHistory) GetSuggestedBpm() int32 {
+	if x != nil {
+		return x.SuggestedBpm
+	}
+	return 0
+}
+
+func (x *ExerciseHistory) GetRecordings() []*ExerciseHistoryRecording {
+	if x != nil {
+		return x.Recordings
+	}
+	return nil
+}
+
+// ExerciseHistoryRecording is an audio recording of a practice attempt. The
+// audio is downloaded from the download URL, which supports range requests.
+type ExerciseHistoryRecording struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HistoryId       int32                  `protobuf:"varint,2,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`
+	Filename        string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType        string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	SizeBytes       int64                  `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DownloadUrl     string                 `protobuf:"bytes,8,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"` // Output only
+	AudioData       []byte                 `protobuf:"bytes,9,opt,name=audio_data,json=audioData,proto3" json:"audio_data,omitempty"`       // Only set in data archives
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExerciseHistoryRecording) Reset() {
+	*x = ExerciseHistoryRecording{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExerciseHistoryRecording) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExerciseHistoryRecording) ProtoMessage() {}
+
+func (x *ExerciseHistoryRecording) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExerciseHistoryRecording.ProtoReflect.Descriptor instead.
+func (*ExerciseHistoryRecording) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ExerciseHistoryRecording) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExerciseHistoryRecording) GetHistoryId() int32 {
+	if x != nil {
+		return x.HistoryId
+	}
+	return 0
+}
+
+func (x *ExerciseHistoryRecording) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExerciseHistoryRecording) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ExerciseHistoryRecording) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ExerciseHistoryRecording) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ExerciseHistoryRecording) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExerciseHistoryRecording) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ExerciseHistoryRecording) GetAudioData() []byte {
+	if x != nil {
+		return x.AudioData
+	}
+	return nil
+}
+
+// Goal is a target BPM to reach for an exercise
+type Goal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExerciseId    int32                  `protobuf:"varint,2,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	TargetBpm     int32                  `protobuf:"varint,3,opt,name=target_bpm,json=targetBpm,proto3" json:"target_bpm,omitempty"`
+	TargetDate    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=target_date,json=targetDate,proto3" json:"target_date,omitempty"`          // Optional
+	TimeSignature string                 `protobuf:"bytes,5,opt,name=time_signature,json=timeSignature,proto3" json:"time_signature,omitempty"` // Optional: only count entries in this time signature
+	AchievedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=achieved_at,json=achievedAt,proto3" json:"achieved_at,omitempty"`          // Set once the target BPM is reached
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Goal) Reset() {
+	*x = Goal{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Goal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goal) ProtoMessage() {}
+
+func (x *Goal) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Goal.ProtoReflect.Descriptor instead.
+func (*Goal) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Goal) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Goal) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *Goal) GetTargetBpm() int32 {
+	if x != nil {
+		return x.TargetBpm
+	}
+	return 0
+}
+
+func (x *Goal) GetTargetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TargetDate
+	}
+	return nil
+}
+
+func (x *Goal) GetTimeSignature() string {
+	if x != nil {
+		return x.TimeSignature
+	}
+	return ""
+}
+
+func (x *Goal) GetAchievedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AchievedAt
+	}
+	return nil
+}
+
+func (x *Goal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Goal) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Routine is a reusable session plan, an ordered list of exercise steps
+type Routine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Steps         []*RoutineStep         `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"` // In practice order
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Routine) Reset() {
+	*x = Routine{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Routine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Routine) ProtoMessage() {}
+
+func (x *Routine) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Routine.ProtoReflect.Descriptor instead.
+func (*Routine) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Routine) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Routine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Routine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Routine) GetSteps() []*RoutineStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *Routine) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Routine) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// RoutineStep is a planned exercise within a routine
+type RoutineStep struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseId             int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	ExerciseName           string                 `protobuf:"bytes,2,opt,name=exercise_name,json=exerciseName,proto3" json:"exercise_name,omitempty"` // Output only
+	PlannedDurationSeconds int32                  `protobuf:"varint,3,opt,name=planned_duration_seconds,json=plannedDurationSeconds,proto3" json:"planned_duration_seconds,omitempty"`
+	StartBpm               int32                  `protobuf:"varint,4,opt,name=start_bpm,json=startBpm,proto3" json:"start_bpm,omitempty"`               // Optional
+	TimeSignature          string                 `protobuf:"bytes,5,opt,name=time_signature,json=timeSignature,proto3" json:"time_signature,omitempty"` // Optional
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RoutineStep) Reset() {
+	*x = RoutineStep{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutineStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutineStep) ProtoMessage() {}
+
+func (x *RoutineStep) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutineStep.ProtoReflect.Descriptor instead.
+func (*RoutineStep) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RoutineStep) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *RoutineStep) GetExerciseName() string {
+	if x != nil {
+		return x.ExerciseName
+	}
+	return ""
+}
+
+func (x *RoutineStep) GetPlannedDurationSeconds() int32 {
+	if x != nil {
+		return x.PlannedDurationSeconds
+	}
+	return 0
+}
+
+func (x *RoutineStep) GetStartBpm() int32 {
+	if x != nil {
+		return x.StartBpm
+	}
+	return 0
+}
+
+func (x *RoutineStep) GetTimeSignature() string {
+	if x != nil {
+		return x.TimeSignature
+	}
+	return ""
+}
+
+// Settings are the user preferences
+type Settings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeZone      string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // IANA time zone that days and weeks are bucketed in
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Settings) Reset() {
+	*x = Settings{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settings) ProtoMessage() {}
+
+func (x *Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
+func (*Settings) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Settings) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Settings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateCategoryRequest is used to create a new category
+type CreateCategoryRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description         string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	WeeklyTargetMinutes int32                  `protobuf:"varint,3,opt,name=weekly_target_minutes,json=weeklyTargetMinutes,proto3" json:"weekly_target_minutes,omitempty"` // Optional
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetWeeklyTargetMinutes() int32 {
+	if x != nil {
+		return x.WeeklyTargetMinutes
+	}
+	return 0
+}
+
+// GetCategoryRequest is used to retrieve a specific category
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetCategoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ListCategoriesRequest is used to list categories with pagination
+type ListCategoriesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageSize          int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken         string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	IncludeTotalCount bool                   `protobuf:"varint,3,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Count the whole listing in total_count, an extra query
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListCategoriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+// ListCategoriesResponse contains a list of categories and pagination info
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Only set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListCategoriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCategoriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// UpdateCategoryRequest is used to update a category
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Category      *Category              `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateCategoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteCategoryRequest is used to delete a category
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteCategoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// CreateTagRequest is used to create a new tag
+type CreateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryIds   []int32                `protobuf:"varint,2,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"` // Related category IDs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagRequest) ProtoMessage() {}
+
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTagRequest) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+// GetTagRequest is used to retrieve a specific tag
+type GetTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagRequest) Reset() {
+	*x = GetTagRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagRequest) ProtoMessage() {}
+
+func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagRequest.ProtoReflect.Descriptor instead.
+func (*GetTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTagRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ListTagsRequest is used to list tags with pagination
+type ListTagsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageSize          int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken         string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	CategoryId        int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                        // Optional: filter by category
+	IncludeTotalCount bool                   `protobuf:"varint,4,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Count the whole listing in total_count, an extra query
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListTagsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTagsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTagsRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListTagsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+// ListTagsResponse contains a list of tags and pagination info
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Only set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTagsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTagsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// UpdateTagRequest is used to update a tag
+type UpdateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tag           *Tag                   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagRequest) Reset() {
+	*x = UpdateTagRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagRequest) ProtoMessage() {}
+
+func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateTagRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTagRequest) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *UpdateTagRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteTagRequest is used to delete a tag
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteTagRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// CreateExerciseRequest is used to create a new exercise
+type CreateExerciseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	TagIds        []int32                `protobuf:"varint,3,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	Images        []*ExerciseImage       `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
+	Links         []*ExerciseLink        `protobuf:"bytes,5,rep,name=links,proto3" json:"links,omitempty"`
+	TempoPlan     *TempoPlan             `protobuf:"bytes,6,opt,name=tempo_plan,json=tempoPlan,proto3" json:"tempo_plan,omitempty"` // Optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExerciseRequest) Reset() {
+	*x = CreateExerciseRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExerciseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExerciseRequest) ProtoMessage() {}
+
+func (x *CreateExerciseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExerciseRequest.ProtoReflect.Descriptor instead.
+func (*CreateExerciseRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateExerciseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateExerciseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateExerciseRequest) GetTagIds() []int32 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *CreateExerciseRequest) GetImages() []*ExerciseImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *CreateExerciseRequest) GetLinks() []*ExerciseLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *CreateExerciseRequest) GetTempoPlan() *TempoPlan {
+	if x != nil {
+		return x.TempoPlan
+	}
+	return nil
+}
+
+// GetExerciseRequest is used to retrieve a specific exercise
+type GetExerciseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExerciseRequest) Reset() {
+	*x = GetExerciseRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExerciseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExerciseRequest) ProtoMessage() {}
+
+func (x *GetExerciseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExerciseRequest.ProtoReflect.Descriptor instead.
+func (*GetExerciseRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetExerciseRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ListExercisesRequest is used to list exercises with pagination and filters.
+// The filters combine, an exercise must pass all of them. The last BPM and
+// rating are those of the latest practice of an exercise.
+type ListExercisesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageSize          int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken         string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	CategoryId        int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                       // Optional: filter by category
+	TagId             int32                  `protobuf:"varint,4,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`                                      // Optional: filter by tag, combined with tag_ids
+	TagIds            []int32                `protobuf:"varint,5,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`                            // Optional: filter by tags
+	TagMatch          TagMatch               `protobuf:"varint,6,opt,name=tag_match,json=tagMatch,proto3,enum=drummer.v1.TagMatch" json:"tag_match,omitempty"`    // How tag_ids combine, any by default
+	ExcludeTagIds     []int32                `protobuf:"varint,7,rep,packed,name=exclude_tag_ids,json=excludeTagIds,proto3" json:"exclude_tag_ids,omitempty"`     // Optional: leave out exercises with any of these tags
+	NotPracticedSince *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=not_practiced_since,json=notPracticedSince,proto3" json:"not_practiced_since,omitempty"` // Optional: last practiced before this time or never
+	MinLastBpm        int32                  `protobuf:"varint,9,opt,name=min_last_bpm,json=minLastBpm,proto3" json:"min_last_bpm,omitempty"`                     // Optional: highest BPM of the latest practice at least this
+	MaxLastBpm        int32                  `protobuf:"varint,10,opt,name=max_last_bpm,json=maxLastBpm,proto3" json:"max_last_bpm,omitempty"`                    // Optional: highest BPM of the latest practice at most this
+	MinRating         int32                  `protobuf:"varint,11,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`                         // Optional: 1-5, unrated exercises never match
+	MaxRating         int32                  `protobuf:"varint,12,opt,name=max_rating,json=maxRating,proto3" json:"max_rating,omitempty"`                         // Optional: 1-5, unrated exercises never match
+	Sort              ExerciseSort           `protobuf:"varint,13,opt,name=sort,proto3,enum=drummer.v1.ExerciseSort" json:"sort,omitempty"`
+	Descending        bool                   `protobuf:"varint,14,opt,name=descending,proto3" json:"descending,omitempty"`                                          // Reverse the sort order
+	IncludeTotalCount bool                   `protobuf:"varint,15,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Count the whole listing in total_count, an extra query
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListExercisesRequest) Reset() {
+	*x = ListExercisesRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExercisesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExercisesRequest) ProtoMessage() {}
+
+func (x *ListExercisesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExercisesRequest.ProtoReflect.Descriptor instead.
+func (*ListExercisesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListExercisesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExercisesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListExercisesRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListExercisesRequest) GetTagId() int32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+func (x *ListExercisesRequest) GetTagIds() []int32 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *ListExercisesRequest) GetTagMatch() TagMatch {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatch_TAG_MATCH_UNSPECIFIED
+}
+
+func (x *ListExercisesRequest) GetExcludeTagIds() []int32 {
+	if x != nil {
+		return x.ExcludeTagIds
+	}
+	return nil
+}
+
+func (x *ListExercisesRequest) GetNotPracticedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotPracticedSince
+	}
+	return nil
+}
+
+func (x *ListExercisesRequest) GetMinLastBpm() int32 {
+	if x != nil {
+		return x.MinLastBpm
+	}
+	return 0
+}
+
+func (x *ListExercisesRequest) GetMaxLastBpm() int32 {
+	if x != nil {
+		return x.MaxLastBpm
+	}
+	return 0
+}
+
+func (x *ListExercisesRequest) GetMinRating() int32 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *ListExercisesRequest) GetMaxRating() int32 {
+	if x != nil {
+		return x.MaxRating
+	}
+	return 0
+}
+
+func (x *ListExercisesRequest) GetSort() ExerciseSort {
+	if x != nil {
+		return x.Sort
+	}
+	return ExerciseSort_EXERCISE_SORT_UNSPECIFIED
+}
+
+func (x *ListExercisesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListExercisesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+// ListExercisesResponse contains a list of exercises and pagination info
+type ListExercisesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exercises     []*Exercise            `protobuf:"bytes,1,rep,name=exercises,proto3" json:"exercises,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Only set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExercisesResponse) Reset() {
+	*x = ListExercisesResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExercisesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExercisesResponse) ProtoMessage() {}
+
+func (x *ListExercisesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExercisesResponse.ProtoReflect.Descriptor instead.
+func (*ListExercisesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListExercisesResponse) GetExercises() []*Exercise {
+	if x != nil {
+		return x.Exercises
+	}
+	return nil
+}
+
+func (x *ListExercisesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListExercisesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// UpdateExerciseRequest is used to update an exercise
+type UpdateExerciseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exercise      *Exercise              `protobuf:"bytes,2,opt,name=exercise,proto3" json:"exercise,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExerciseRequest) Reset() {
+	*x = UpdateExerciseRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExerciseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExerciseRequest) ProtoMessage() {}
+
+func (x *UpdateExerciseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExerciseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExerciseRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateExerciseRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateExerciseRequest) GetExercise() *Exercise {
+	if x != nil {
+		return x.Exercise
+	}
+	return nil
+}
+
+func (x *UpdateExerciseRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteExerciseRequest is used to delete an exercise
+type DeleteExerciseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExerciseRequest) Reset() {
+	*x = DeleteExerciseRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExerciseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExerciseRequest) ProtoMessage() {}
+
+func (x *DeleteExerciseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExerciseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExerciseRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteExerciseRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// AddExerciseImageRequest is used to add an image to an exercise
+type AddExerciseImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseId    int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	ImageData     []byte                 `protobuf:"bytes,2,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"` // A PNG, JPEG, GIF or WebP image of at most 10 MB
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType      string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"` // Ignored, the type is detected from the data
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExerciseImageRequest) Reset() {
+	*x = AddExerciseImageRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExerciseImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExerciseImageRequest) ProtoMessage() {}
+
+func (x *AddExerciseImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExerciseImageRequest.ProtoReflect.Descriptor instead.
+func (*AddExerciseImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AddExerciseImageRequest) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *AddExerciseImageRequest) GetImageData() []byte {
+	if x != nil {
+		return x.ImageData
+	}
+	return nil
+}
+
+func (x *AddExerciseImageRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *AddExerciseImageRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *AddExerciseImageRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// GetExerciseImageRequest is used to get an image
+type GetExerciseImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseId    int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	ImageId       int32                  `protobuf:"varint,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExerciseImageRequest) Reset() {
+	*x = GetExerciseImageRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExerciseImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExerciseImageRequest) ProtoMessage() {}
+
+func (x *GetExerciseImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExerciseImageRequest.ProtoReflect.Descriptor instead.
+func (*GetExerciseImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetExerciseImageRequest) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *GetExerciseImageRequest) GetImageId() int32 {
+	if x != nil {
+		return x.ImageId
+	}
+	return 0
+}
+
+// DeleteExerciseImageRequest is used to delete an image from an exercise
+type DeleteExerciseImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExerciseImageRequest) Reset() {
+	*x = DeleteExerciseImageRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExerciseImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExerciseImageRequest) ProtoMessage() {}
+
+func (x *DeleteExerciseImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExerciseImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExerciseImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteExerciseImageRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// AddExerciseNotationRequest is used to attach notation to an exercise
+type AddExerciseNotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseId    int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	Format        NotationFormat         `protobuf:"varint,2,opt,name=format,proto3,enum=drummer.v1.NotationFormat" json:"format,omitempty"` // Detected from the data when unspecified
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                                     // At most 1 MB
+	Filename      string                 `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExerciseNotationRequest) Reset() {
+	*x = AddExerciseNotationRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExerciseNotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExerciseNotationRequest) ProtoMessage() {}
+
+func (x *AddExerciseNotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExerciseNotationRequest.ProtoReflect.Descriptor instead.
+func (*AddExerciseNotationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AddExerciseNotationRequest) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *AddExerciseNotationRequest) GetFormat() NotationFormat {
+	if x != nil {
+		return x.Format
+	}
+	return NotationFormat_NOTATION_FORMAT_UNSPECIFIED
+}
+
+func (x *AddExerciseNotationRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AddExerciseNotationRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// GetExerciseNotationRequest is used to get notation with its data
+type GetExerciseNotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExerciseNotationRequest) Reset() {
+	*x = GetExerciseNotationRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExerciseNotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExerciseNotationRequest) ProtoMessage() {}
+
+func (x *GetExerciseNotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExerciseNotationRequest.ProtoReflect.Descriptor instead.
+func (*GetExerciseNotationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetExerciseNotationRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeleteExerciseNotationRequest is used to delete notation from an exercise
+type DeleteExerciseNotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExerciseNotationRequest) Reset() {
+	*x = DeleteExerciseNotationRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExerciseNotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExerciseNotationRequest) ProtoMessage() {}
+
+func (x *DeleteExerciseNotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExerciseNotationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExerciseNotationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteExerciseNotationRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// AddExerciseLinkRequest is used to add a link to an exercise
+type AddExerciseLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseId    int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExerciseLinkRequest) Reset() {
+	*x = AddExerciseLinkRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExerciseLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExerciseLinkRequest) ProtoMessage() {}
+
+func (x *AddExerciseLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExerciseLinkRequest.ProtoReflect.Descriptor instead.
+func (*AddExerciseLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AddExerciseLinkRequest) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *AddExerciseLinkRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddExerciseLinkRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// DeleteExerciseLinkRequest is used to delete a link from an exercise
+type DeleteExerciseLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExerciseLinkRequest) Reset() {
+	*x = DeleteExerciseLinkRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExerciseLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExerciseLinkRequest) ProtoMessage() {}
+
+func (x *DeleteExerciseLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExerciseLinkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExerciseLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteExerciseLinkRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// CreatePracticeSessionRequest is used to create a new practice session
+type CreatePracticeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePracticeSessionRequest) Reset() {
+	*x = CreatePracticeSessionRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePracticeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePracticeSessionRequest) ProtoMessage() {}
+
+func (x *CreatePracticeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePracticeSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePracticeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreatePracticeSessionRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreatePracticeSessionRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreatePracticeSessionRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+// GetPracticeSessionRequest is used to retrieve a specific practice session
+type GetPracticeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPracticeSessionRequest) Reset() {
+	*x = GetPracticeSessionRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPracticeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPracticeSessionRequest) ProtoMessage() {}
+
+func (x *GetPracticeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPracticeSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetPracticeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetPracticeSessionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ListPracticeSessionsRequest is used to list practice sessions with pagination
+type ListPracticeSessionsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageSize          int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken         string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                            // Optional: filter by date range
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                  // Optional: filter by date range
+	ExerciseId        int32                  `protobuf:"varint,5,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`                        // Optional: filter by exercise
+	Active            bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`                                                  // Optional: filter for active
+	TimeZone          string                 `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`                               // Optional: widen the date range to whole days in this IANA time zone
+	IncludeTotalCount bool                   `protobuf:"varint,8,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Count the whole listing in total_count, an extra query
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListPracticeSessionsRequest) Reset() {
+	*x = ListPracticeSessionsRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPracticeSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPracticeSessionsRequest) ProtoMessage() {}
+
+func (x *ListPracticeSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPracticeSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPracticeSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListPracticeSessionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPracticeSessionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPracticeSessionsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListPracticeSessionsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListPracticeSessionsRequest) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *ListPracticeSessionsRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *ListPracticeSessionsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *ListPracticeSessionsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+// ListPracticeSessionsResponse contains a list of practice sessions and
+// pagination info
+type ListPracticeSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*PracticeSession     `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Only set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPracticeSessionsResponse) Reset() {
+	*x = ListPracticeSessionsResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPracticeSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPracticeSessionsResponse) ProtoMessage() {}
+
+func (x *ListPracticeSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPracticeSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPracticeSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListPracticeSessionsResponse) GetSessions() []*PracticeSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListPracticeSessionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListPracticeSessionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// UpdatePracticeSessionRequest is used to update a practice session
+type UpdatePracticeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Session       *PracticeSession       `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePracticeSessionRequest) Reset() {
+	*x = UpdatePracticeSessionRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePracticeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePracticeSessionRequest) ProtoMessage() {}
+
+func (x *UpdatePracticeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePracticeSessionRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePracticeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdatePracticeSessionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePracticeSessionRequest) GetSession() *PracticeSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *UpdatePracticeSessionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeletePracticeSessionRequest is used to delete a practice session
+type DeletePracticeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePracticeSessionRequest) Reset() {
+	*x = DeletePracticeSessionRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePracticeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePracticeSessionRequest) ProtoMessage() {}
+
+func (x *DeletePracticeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePracticeSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeletePracticeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeletePracticeSessionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// PauseSessionRequest is used to pause the timer of an active practice session
+type PauseSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSessionRequest) Reset() {
+	*x = PauseSessionRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSessionRequest) ProtoMessage() {}
+
+func (x *PauseSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSessionRequest.ProtoReflect.Descriptor instead.
+func (*PauseSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PauseSessionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ResumeSessionRequest is used to resume a paused practice session
+type ResumeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSessionRequest) Reset() {
+	*x = ResumeSessionRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSessionRequest) ProtoMessage() {}
+
+func (x *ResumeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSessionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ResumeSessionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// StartExerciseRequest is used to start timing an exercise in an active
+// practice session, stopping the exercise in progress
+type StartExerciseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     int32                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ExerciseId    int32                  `protobuf:"varint,2,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	Bpms          []int32                `protobuf:"varint,3,rep,packed,name=bpms,proto3" json:"bpms,omitempty"`                                // Optional: defaults to the suggested BPM
+	TimeSignature string                 `protobuf:"bytes,4,opt,name=time_signature,json=timeSignature,proto3" json:"time_signature,omitempty"` // Optional: defaults to that of the exercise notation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartExerciseRequest) Reset() {
+	*x = StartExerciseRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartExerciseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartExerciseRequest) ProtoMessage() {}
+
+func (x *StartExerciseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartExerciseRequest.ProtoReflect.Descriptor instead.
+func (*StartExerciseRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *StartExerciseRequest) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *StartExerciseRequest) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *StartExerciseRequest) GetBpms() []int32 {
+	if x != nil {
+		return x.Bpms
+	}
+	return nil
+}
+
+func (x *StartExerciseRequest) GetTimeSignature() string {
+	if x != nil {
+		return x.TimeSignature
+	}
+	return ""
+}
+
+// StopExerciseRequest is used to stop timing the exercise in progress
+type StopExerciseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     int32                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopExerciseRequest) Reset() {
+	*x = StopExerciseRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopExerciseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopExerciseRequest) ProtoMessage() {}
+
+func (x *StopExerciseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopExerciseRequest.ProtoReflect.Descriptor instead.
+func (*StopExerciseRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *StopExerciseRequest) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+// WatchSessionRequest is used to follow the changes to practice sessions
+type WatchSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     int32                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Optional: only follow this session
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSessionRequest) Reset() {
+	*x = WatchSessionRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSessionRequest) ProtoMessage() {}
+
+func (x *WatchSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSessionRequest.ProtoReflect.Descriptor instead.
+func (*WatchSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *WatchSessionRequest) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+// SessionEvent reports a change to a practice session or its exercise history
+type SessionEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          SessionEventType       `protobuf:"varint,1,opt,name=type,proto3,enum=drummer.v1.SessionEventType" json:"type,omitempty"`
+	SessionId     int32                  `protobuf:"varint,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Session       *PracticeSession       `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`   // Set for session changes, except deletion
+	Exercise      *ExerciseHistory       `protobuf:"bytes,4,opt,name=exercise,proto3" json:"exercise,omitempty"` // Set for exercise changes
+	Time          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionEvent) Reset() {
+	*x = SessionEvent{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent) ProtoMessage() {}
+
+func (x *SessionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent.ProtoReflect.Descriptor instead.
+func (*SessionEvent) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SessionEvent) GetType() SessionEventType {
+	if x != nil {
+		return x.Type
+	}
+	return SessionEventType_SESSION_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *SessionEvent) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *SessionEvent) GetSession() *PracticeSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetExercise() *ExerciseHistory {
+	if x != nil {
+		return x.Exercise
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// CreateExerciseHistoryRequest is used to create a new exercise history entry
+type CreateExerciseHistoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseId      int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Bpms            []int32                `protobuf:"varint,4,rep,packed,name=bpms,proto3" json:"bpms,omitempty"`
+	TimeSignature   string                 `protobuf:"bytes,5,opt,name=time_signature,json=timeSignature,proto3" json:"time_signature,omitempty"` // Optional: defaults to that of the exercise notation
+	Notes           string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Rating          int32                  `protobuf:"varint,7,opt,name=rating,proto3" json:"rating,omitempty"`
+	SessionId       int32                  `protobuf:"varint,8,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,9,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateExerciseHistoryRequest) Reset() {
+	*x = CreateExerciseHistoryRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExerciseHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExerciseHistoryRequest) ProtoMessage() {}
+
+func (x *CreateExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExerciseHistoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateExerciseHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateExerciseHistoryRequest) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *CreateExerciseHistoryRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateExerciseHistoryRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateExerciseHistoryRequest) GetBpms() []int32 {
+	if x != nil {
+		return x.Bpms
+	}
+	return nil
+}
+
+func (x *CreateExerciseHistoryRequest) GetTimeSignature() string {
+	if x != nil {
+		return x.TimeSignature
+	}
+	return ""
+}
+
+func (x *CreateExerciseHistoryRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateExerciseHistoryRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateExerciseHistoryRequest) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *CreateExerciseHistoryRequest) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// GetExerciseHistoryRequest is used to retrieve a specific exercise history
+// entry
+type GetExerciseHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExerciseHistoryRequest) Reset() {
+	*x = GetExerciseHistoryRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExerciseHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExerciseHistoryRequest) ProtoMessage() {}
+
+func (x *GetExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExerciseHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetExerciseHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetExerciseHistoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ListExerciseHistoryRequest is used to list exercise history entries with
+// pagination
+type ListExerciseHistoryRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageSize          int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken         string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	ExerciseId        int32                  `protobuf:"varint,3,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`                        // Optional: filter by exercise
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                            // Optional: filter by date range
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                  // Optional: filter by date range
+	SessionId         int32                  `protobuf:"varint,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`                           // Optional: filter by session
+	TimeZone          string                 `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`                               // Optional: widen the date range to whole days in this IANA time zone
+	IncludeTotalCount bool                   `protobuf:"varint,8,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Count the whole listing in total_count, an extra query
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListExerciseHistoryRequest) Reset() {
+	*x = ListExerciseHistoryRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExerciseHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExerciseHistoryRequest) ProtoMessage() {}
+
+func (x *ListExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExerciseHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListExerciseHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListExerciseHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExerciseHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListExerciseHistoryRequest) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *ListExerciseHistoryRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListExerciseHistoryRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListExerciseHistoryRequest) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *ListExerciseHistoryRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *ListExerciseHistoryRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+// ListExerciseHistoryResponse contains a list of exercise history entries and
+// pagination info
+type ListExerciseHistoryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HistoryEntries []*ExerciseHistory     `protobuf:"bytes,1,rep,name=history_entries,json=historyEntries,proto3" json:"history_entries,omitempty"`
+	NextPageToken  string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount     int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Only set with include_total_count
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListExerciseHistoryResponse) Reset() {
+	*x = ListExerciseHistoryResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExerciseHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExerciseHistoryResponse) ProtoMessage() {}
+
+func (x *ListExerciseHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExerciseHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListExerciseHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListExerciseHistoryResponse) GetHistoryEntries() []*ExerciseHistory {
+	if x != nil {
+		return x.HistoryEntries
+	}
+	return nil
+}
+
+func (x *ListExerciseHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListExerciseHistoryResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// UpdateExerciseHistoryRequest is used to update an exercise history entry
+type UpdateExerciseHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	History       *ExerciseHistory       `protobuf:"bytes,2,opt,name=history,proto3" json:"history,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExerciseHistoryRequest) Reset() {
+	*x = UpdateExerciseHistoryRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExerciseHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExerciseHistoryRequest) ProtoMessage() {}
+
+func (x *UpdateExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExerciseHistoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExerciseHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateExerciseHistoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateExerciseHistoryRequest) GetHistory() *ExerciseHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *UpdateExerciseHistoryRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteExerciseHistoryRequest is used to delete an exercise history entry
+type DeleteExerciseHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExerciseHistoryRequest) Reset() {
+	*x = DeleteExerciseHistoryRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExerciseHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExerciseHistoryRequest) ProtoMessage() {}
+
+func (x *DeleteExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExerciseHistoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExerciseHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteExerciseHistoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// UploadRecordingRequest is a message of a recording upload, the first one
+// carries the metadata and the following ones the audio in order
+type UploadRecordingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadRecordingRequest_Metadata
+	//	*UploadRecordingRequest_Chunk
+	Payload       isUploadRecordingRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadRecordingRequest) Reset() {
+	*x = UploadRecordingRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadRecordingRequest) ProtoMessage() {}
+
+func (x *UploadRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadRecordingRequest.ProtoReflect.Descriptor instead.
+func (*UploadRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UploadRecordingRequest) GetPayload() isUploadRecordingRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadRecordingRequest) GetMetadata() *RecordingMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadRecordingRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadRecordingRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadRecordingRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadRecordingRequest_Payload interface {
+	isUploadRecordingRequest_Payload()
+}
+
+type UploadRecordingRequest_Metadata struct {
+	Metadata *RecordingMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadRecordingRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadRecordingRequest_Metadata) isUploadRecordingRequest_Payload() {}
+
+func (*UploadRecordingRequest_Chunk) isUploadRecordingRequest_Payload() {}
+
+// RecordingMetadata describes a recording being uploaded
+type RecordingMetadata struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HistoryId       int32                  `protobuf:"varint,1,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`
+	Filename        string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType        string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"` // An audio type such as audio/webm
+	DurationSeconds int32                  `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecordingMetadata) Reset() {
+	*x = RecordingMetadata{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordingMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordingMetadata) ProtoMessage() {}
+
+func (x *RecordingMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordingMetadata.ProtoReflect.Descriptor instead.
+func (*RecordingMetadata) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RecordingMetadata) GetHistoryId() int32 {
+	if x != nil {
+		return x.HistoryId
+	}
+	return 0
+}
+
+func (x *RecordingMetadata) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *RecordingMetadata) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *RecordingMetadata) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// GetRecordingRequest is used to get the details of a recording
+type GetRecordingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecordingRequest) Reset() {
+	*x = GetRecordingRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecordingRequest) ProtoMessage() {}
+
+func (x *GetRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecordingRequest.ProtoReflect.Descriptor instead.
+func (*GetRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetRecordingRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeleteRecordingRequest is used to delete a recording
+type DeleteRecordingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecordingRequest) Reset() {
+	*x = DeleteRecordingRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecordingRequest) ProtoMessage() {}
+
+func (x *DeleteRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecordingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteRecordingRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetExerciseStatsRequest is used to get statistics for an exercise
+type GetExerciseStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseId    int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Optional: filter by date range
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // Optional: filter by date range
+	TimeZone      string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`    // Optional: IANA time zone of the days, defaults to the settings
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExerciseStatsRequest) Reset() {
+	*x = GetExerciseStatsRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExerciseStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExerciseStatsRequest) ProtoMessage() {}
+
+func (x *GetExerciseStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExerciseStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetExerciseStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetExerciseStatsRequest) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *GetExerciseStatsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetExerciseStatsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetExerciseStatsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// ExerciseStats contains statistics for an exercise
+type ExerciseStats struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseId                   int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	ExerciseName                 string                 `protobuf:"bytes,2,opt,name=exercise_name,json=exerciseName,proto3" json:"exercise_name,omitempty"`
+	PracticeCount                int32                  `protobuf:"varint,3,opt,name=practice_count,json=practiceCount,proto3" json:"practice_count,omitempty"`
+	TotalPracticeDurationSeconds int32                  `protobuf:"varint,4,opt,name=total_practice_duration_seconds,json=totalPracticeDurationSeconds,proto3" json:"total_practice_duration_seconds,omitempty"`
+	AvgRating                    float64                `protobuf:"fixed64,5,opt,name=avg_rating,json=avgRating,proto3" json:"avg_rating,omitempty"`
+	MaxBpm                       int32                  `protobuf:"varint,6,opt,name=max_bpm,json=maxBpm,proto3" json:"max_bpm,omitempty"`
+	MinBpm                       int32                  `protobuf:"varint,7,opt,name=min_bpm,json=minBpm,proto3" json:"min_bpm,omitempty"`
+	AvgBpm                       float64                `protobuf:"fixed64,8,opt,name=avg_bpm,json=avgBpm,proto3" json:"avg_bpm,omitempty"`
+	BpmProgress                  []*BpmProgressPoint    `protobuf:"bytes,9,rep,name=bpm_progress,json=bpmProgress,proto3" json:"bpm_progress,omitempty"`
+	Goals                        []*GoalProgress        `protobuf:"bytes,10,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *ExerciseStats) Reset() {
+	*x = ExerciseStats{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExerciseStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExerciseStats) ProtoMessage() {}
+
+func (x *ExerciseStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExerciseStats.ProtoReflect.Descriptor instead.
+func (*ExerciseStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ExerciseStats) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *ExerciseStats) GetExerciseName() string {
+	if x != nil {
+		return x.ExerciseName
+	}
+	return ""
+}
+
+func (x *ExerciseStats) GetPracticeCount() int32 {
+	if x != nil {
+		return x.PracticeCount
+	}
+	return 0
+}
+
+func (x *ExerciseStats) GetTotalPracticeDurationSeconds() int32 {
+	if x != nil {
+		return x.TotalPracticeDurationSeconds
+	}
+	return 0
+}
+
+func (x *ExerciseStats) GetAvgRating() float64 {
+	if x != nil {
+		return x.AvgRating
+	}
+	return 0
+}
+
+func (x *ExerciseStats) GetMaxBpm() int32 {
+	if x != nil {
+		return x.MaxBpm
+	}
+	return 0
+}
+
+func (x *ExerciseStats) GetMinBpm() int32 {
+	if x != nil {
+		return x.MinBpm
+	}
+	return 0
+}
+
+func (x *ExerciseStats) GetAvgBpm() float64 {
+	if x != nil {
+		return x.AvgBpm
+	}
+	return 0
+}
+
+func (x *ExerciseStats) GetBpmProgress() []*BpmProgressPoint {
+	if x != nil {
+		return x.BpmProgress
+	}
+	return nil
+}
+
+func (x *ExerciseStats) GetGoals() []*GoalProgress {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+// ExportMidiRequest selects the exercises rendered into a MIDI click track
+type ExportMidiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseIds   []int32                `protobuf:"varint,1,rep,packed,name=exercise_ids,json=exerciseIds,proto3" json:"exercise_ids,omitempty"` // Played in the given order
+	BarsPerStep   int32                  `protobuf:"varint,2,opt,name=bars_per_step,json=barsPerStep,proto3" json:"bars_per_step,omitempty"`      // Optional: bars per tempo, defaults to the tempo plan or 4
+	Ladder        bool                   `protobuf:"varint,3,opt,name=ladder,proto3" json:"ladder,omitempty"`                                     // Play the tempo plan rungs instead of the last-used BPMs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMidiRequest) Reset() {
+	*x = ExportMidiRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMidiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMidiRequest) ProtoMessage() {}
+
+func (x *ExportMidiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMidiRequest.ProtoReflect.Descriptor instead.
+func (*ExportMidiRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ExportMidiRequest) GetExerciseIds() []int32 {
+	if x != nil {
+		return x.ExerciseIds
+	}
+	return nil
+}
+
+func (x *ExportMidiRequest) GetBarsPerStep() int32 {
+	if x != nil {
+		return x.BarsPerStep
+	}
+	return 0
+}
+
+func (x *ExportMidiRequest) GetLadder() bool {
+	if x != nil {
+		return x.Ladder
+	}
+	return false
+}
+
+// ExercisePack is the manifest of an exercise pack, a zip archive sharing
+// exercises between instances without any practice data. The manifest is
+// stored as manifest.json next to the image files it refers to. Tags and
+// categories are referred to by name, so that importing merges them with
+// those of the same name.
+type ExercisePack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ExportedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	Categories    []*PackCategory        `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	Tags          []*PackTag             `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Exercises     []*PackExercise        `protobuf:"bytes,7,rep,name=exercises,proto3" json:"exercises,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExercisePack) Reset() {
+	*x = ExercisePack{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExercisePack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExercisePack) ProtoMessage() {}
+
+func (x *ExercisePack) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExercisePack.ProtoReflect.Descriptor instead.
+func (*ExercisePack) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ExercisePack) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ExercisePack) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExercisePack) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExercisePack) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+func (x *ExercisePack) GetCategories() []*PackCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ExercisePack) GetTags() []*PackTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ExercisePack) GetExercises() []*PackExercise {
+	if x != nil {
+		return x.Exercises
+	}
+	return nil
+}
+
+// PackCategory is a category of the tags of an exercise pack
+type PackCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackCategory) Reset() {
+	*x = PackCategory{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackCategory) ProtoMessage() {}
+
+func (x *PackCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackCategory.ProtoReflect.Descriptor instead.
+func (*PackCategory) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PackCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PackCategory) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// PackTag is a tag of the exercises of an exercise pack
+type PackTag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Categories    []string               `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"` // Category names
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackTag) Reset() {
+	*x = PackTag{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackTag) ProtoMessage() {}
+
+func (x *PackTag) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackTag.ProtoReflect.Descriptor instead.
+func (*PackTag) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PackTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PackTag) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// PackExercise is an exercise of an exercise pack
+type PackExercise struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the exercise across instances, importing a pack again
+	// updates the exercises imported from it before
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TempoPlan     *TempoPlan             `protobuf:"bytes,5,opt,name=tempo_plan,json=tempoPlan,proto3" json:"tempo_plan,omitempty"` // Optional
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                            // Tag names
+	Links         []*PackLink            `protobuf:"bytes,7,rep,name=links,proto3" json:"links,omitempty"`
+	Images        []*PackImage           `protobuf:"bytes,8,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackExercise) Reset() {
+	*x = PackExercise{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackExercise) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackExercise) ProtoMessage() {}
+
+func (x *PackExercise) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackExercise.ProtoReflect.Descriptor instead.
+func (*PackExercise) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PackExercise) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *PackExercise) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PackExercise) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PackExercise) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PackExercise) GetTempoPlan() *TempoPlan {
+	if x != nil {
+		return x.TempoPlan
+	}
+	return nil
+}
+
+func (x *PackExercise) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PackExercise) GetLinks() []*PackLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *PackExercise) GetImages() []*PackImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// PackLink is an external link of an exercise in an exercise pack
+type PackLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackLink) Reset() {
+	*x = PackLink{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackLink) ProtoMessage() {}
+
+func (x *PackLink) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackLink.ProtoReflect.Descriptor instead.
+func (*PackLink) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PackLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PackLink) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// PackImage is an image of an exercise in an exercise pack
+type PackImage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // Of the image data in the archive
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackImage) Reset() {
+	*x = PackImage{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackImage) ProtoMessage() {}
+
+func (x *PackImage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackImage.ProtoReflect.Descriptor instead.
+func (*PackImage) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PackImage) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PackImage) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *PackImage) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *PackImage) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ExportExercisePackRequest selects the exercises of an exercise pack
+type ExportExercisePackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseIds   []int32                `protobuf:"varint,1,rep,packed,name=exercise_ids,json=exerciseIds,proto3" json:"exercise_ids,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // Optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportExercisePackRequest) Reset() {
+	*x = ExportExercisePackRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportExercisePackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportExercisePackRequest) ProtoMessage() {}
+
+func (x *ExportExercisePackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportExercisePackRequest.ProtoReflect.Descriptor instead.
+func (*ExportExercisePackRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ExportExercisePackRequest) GetExerciseIds() []int32 {
+	if x != nil {
+		return x.ExerciseIds
+	}
+	return nil
+}
+
+func (x *ExportExercisePackRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportExercisePackRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ImportExercisePackRequest is used to import an exercise pack
+type ImportExercisePackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pack          []byte                 `protobuf:"bytes,1,opt,name=pack,proto3" json:"pack,omitempty"` // The zip archive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExercisePackRequest) Reset() {
+	*x = ImportExercisePackRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExercisePackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExercisePackRequest) ProtoMessage() {}
+
+func (x *ImportExercisePackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExercisePackRequest.ProtoReflect.Descriptor instead.
+func (*ImportExercisePackRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ImportExercisePackRequest) GetPack() []byte {
+	if x != nil {
+		return x.Pack
+	}
+	return nil
+}
+
+// ImportExercisePackResponse contains the number of exercises imported and of
+// the tags and categories created for them
+type ImportExercisePackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`     // Exercises new to the user
+	Updated       int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`     // Exercises updated from a newer version of the pack
+	Unchanged     int32                  `protobuf:"varint,3,opt,name=unchanged,proto3" json:"unchanged,omitempty"` // Exercises at least as recent as those of the pack
+	Tags          int32                  `protobuf:"varint,4,opt,name=tags,proto3" json:"tags,omitempty"`
+	Categories    int32                  `protobuf:"varint,5,opt,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExercisePackResponse) Reset() {
+	*x = ImportExercisePackResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExercisePackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExercisePackResponse) ProtoMessage() {}
+
+func (x *ImportExercisePackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExercisePackResponse.ProtoReflect.Descriptor instead.
+func (*ImportExercisePackResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ImportExercisePackResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportExercisePackResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportExercisePackResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ImportExercisePackResponse) GetTags() int32 {
+	if x != nil {
+		return x.Tags
+	}
+	return 0
+}
+
+func (x *ImportExercisePackResponse) GetCategories() int32 {
+	if x != nil {
+		return x.Categories
+	}
+	return 0
+}
+
+// GoalProgress shows how close an exercise is to reaching a goal
+type GoalProgress struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Goal               *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	CurrentBpm         int32                  `protobuf:"varint,2,opt,name=current_bpm,json=currentBpm,proto3" json:"current_bpm,omitempty"` // Best BPM recorded so far
+	ProgressPercentage float64                `protobuf:"fixed64,3,opt,name=progress_percentage,json=progressPercentage,proto3" json:"progress_percentage,omitempty"`
+	// Projected from the BPM trend, unset when the trend is not increasing
+	ProjectedCompletionDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=projected_completion_date,json=projectedCompletionDate,proto3" json:"projected_completion_date,omitempty"`
+	OnTrack                 bool                   `protobuf:"varint,5,opt,name=on_track,json=onTrack,proto3" json:"on_track,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GoalProgress) Reset() {
+	*x = GoalProgress{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoalProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalProgress) ProtoMessage() {}
+
+func (x *GoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalProgress.ProtoReflect.Descriptor instead.
+func (*GoalProgress) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GoalProgress) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+func (x *GoalProgress) GetCurrentBpm() int32 {
+	if x != nil {
+		return x.CurrentBpm
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetProgressPercentage() float64 {
+	if x != nil {
+		return x.ProgressPercentage
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetProjectedCompletionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProjectedCompletionDate
+	}
+	return nil
+}
+
+func (x *GoalProgress) GetOnTrack() bool {
+	if x != nil {
+		return x.OnTrack
+	}
+	return false
+}
+
+// BpmProgressPoint represents a point in the BPM progress chart
+type BpmProgressPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Bpm           int32                  `protobuf:"varint,2,opt,name=bpm,proto3" json:"bpm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BpmProgressPoint) Reset() {
+	*x = BpmProgressPoint{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BpmProgressPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BpmProgressPoint) ProtoMessage() {}
+
+func (x *BpmProgressPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BpmProgressPoint.ProtoReflect.Descriptor instead.
+func (*BpmProgressPoint) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *BpmProgressPoint) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *BpmProgressPoint) GetBpm() int32 {
+	if x != nil {
+		return x.Bpm
+	}
+	return 0
+}
+
+// GetPracticeStatsRequest is used to get statistics for practice sessions
+type GetPracticeStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`     // Optional: filter by date range
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`           // Optional: filter by date range
+	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Optional: filter by category
+	TimeZone      string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`        // Optional: IANA time zone of the days, defaults to the settings
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPracticeStatsRequest) Reset() {
+	*x = GetPracticeStatsRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPracticeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPracticeStatsRequest) ProtoMessage() {}
+
+func (x *GetPracticeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPracticeStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPracticeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetPracticeStatsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetPracticeStatsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetPracticeStatsRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetPracticeStatsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// PracticeStats contains statistics for practice sessions
+type PracticeStats struct {
+	state                     protoimpl.MessageState      `protogen:"open.v1"`
+	TotalSessions             int32                       `protobuf:"varint,1,opt,name=total_sessions,json=totalSessions,proto3" json:"total_sessions,omitempty"`
+	TotalDurationSeconds      int32                       `protobuf:"varint,2,opt,name=total_duration_seconds,json=totalDurationSeconds,proto3" json:"total_duration_seconds,omitempty"`
+	AvgSessionDurationSeconds float64                     `protobuf:"fixed64,3,opt,name=avg_session_duration_seconds,json=avgSessionDurationSeconds,proto3" json:"avg_session_duration_seconds,omitempty"`
+	ExerciseDistribution      []*ExerciseTimeDistribution `protobuf:"bytes,4,rep,name=exercise_distribution,json=exerciseDistribution,proto3" json:"exercise_distribution,omitempty"`
+	CategoryDistribution      []*CategoryTimeDistribution `protobuf:"bytes,5,rep,name=category_distribution,json=categoryDistribution,proto3" json:"category_distribution,omitempty"`
+	PracticeFrequency         []*PracticeTimePoint        `protobuf:"bytes,6,rep,name=practice_frequency,json=practiceFrequency,proto3" json:"practice_frequency,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *PracticeStats) Reset() {
+	*x = PracticeStats{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PracticeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PracticeStats) ProtoMessage() {}
+
+func (x *PracticeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PracticeStats.ProtoReflect.Descriptor instead.
+func (*PracticeStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PracticeStats) GetTotalSessions() int32 {
+	if x != nil {
+		return x.TotalSessions
+	}
+	return 0
+}
+
+func (x *PracticeStats) GetTotalDurationSeconds() int32 {
+	if x != nil {
+		return x.TotalDurationSeconds
+	}
+	return 0
+}
+
+func (x *PracticeStats) GetAvgSessionDurationSeconds() float64 {
+	if x != nil {
+		return x.AvgSessionDurationSeconds
+	}
+	return 0
+}
+
+func (x *PracticeStats) GetExerciseDistribution() []*ExerciseTimeDistribution {
+	if x != nil {
+		return x.ExerciseDistribution
+	}
+	return nil
+}
+
+func (x *PracticeStats) GetCategoryDistribution() []*CategoryTimeDistribution {
+	if x != nil {
+		return x.CategoryDistribution
+	}
+	return nil
+}
+
+func (x *PracticeStats) GetPracticeFrequency() []*PracticeTimePoint {
+	if x != nil {
+		return x.PracticeFrequency
+	}
+	return nil
+}
+
+// ExerciseTimeDistribution shows how much time was spent on each exercise
+type ExerciseTimeDistribution struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseId      int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	ExerciseName    string                 `protobuf:"bytes,2,opt,name=exercise_name,json=exerciseName,proto3" json:"exercise_name,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Percentage      float64                `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExerciseTimeDistribution) Reset() {
+	*x = ExerciseTimeDistribution{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExerciseTimeDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExerciseTimeDistribution) ProtoMessage() {}
+
+func (x *ExerciseTimeDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExerciseTimeDistribution.ProtoReflect.Descriptor instead.
+func (*ExerciseTimeDistribution) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ExerciseTimeDistribution) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *ExerciseTimeDistribution) GetExerciseName() string {
+	if x != nil {
+		return x.ExerciseName
+	}
+	return ""
+}
+
+func (x *ExerciseTimeDistribution) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ExerciseTimeDistribution) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+// CategoryTimeDistribution shows how much time was spent on each category
+type CategoryTimeDistribution struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId        int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName      string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	DurationSeconds   int32                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Percentage        float64                `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	PracticeFrequency []*PracticeTimePoint   `protobuf:"bytes,5,rep,name=practice_frequency,json=practiceFrequency,proto3" json:"practice_frequency,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CategoryTimeDistribution) Reset() {
+	*x = CategoryTimeDistribution{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTimeDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTimeDistribution) ProtoMessage() {}
+
+func (x *CategoryTimeDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTimeDistribution.ProtoReflect.Descriptor instead.
+func (*CategoryTimeDistribution) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CategoryTimeDistribution) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryTimeDistribution) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryTimeDistribution) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CategoryTimeDistribution) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *CategoryTimeDistribution) GetPracticeFrequency() []*PracticeTimePoint {
+	if x != nil {
+		return x.PracticeFrequency
+	}
+	return nil
+}
+
+// PracticeTimePoint represents a point in the practice frequency chart
+type PracticeTimePoint struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Date            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PracticeTimePoint) Reset() {
+	*x = PracticeTimePoint{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PracticeTimePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PracticeTimePoint) ProtoMessage() {}
+
+func (x *PracticeTimePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PracticeTimePoint.ProtoReflect.Descriptor instead.
+func (*PracticeTimePoint) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PracticeTimePoint) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *PracticeTimePoint) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// GetTargetProgressRequest is used to compare weekly practice time against
+// the category targets
+type GetTargetProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`     // Optional: defaults to 12 weeks ago
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`           // Optional: defaults to now
+	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Optional: filter by category
+	TimeZone      string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`        // Optional: IANA time zone of the weeks, defaults to the settings
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTargetProgressRequest) Reset() {
+	*x = GetTargetProgressRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTargetProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTargetProgressRequest) ProtoMessage() {}
+
+func (x *GetTargetProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTargetProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetTargetProgressRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetTargetProgressRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetTargetProgressRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetTargetProgressRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetTargetProgressRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// TargetProgress contains the weekly target progress of each category with a
+// target
+type TargetProgress struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Categories    []*CategoryTargetProgress `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetProgress) Reset() {
+	*x = TargetProgress{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetProgress) ProtoMessage() {}
+
+func (x *TargetProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetProgress.ProtoReflect.Descriptor instead.
+func (*TargetProgress) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *TargetProgress) GetCategories() []*CategoryTargetProgress {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// CategoryTargetProgress shows how a category did against its weekly target
+type CategoryTargetProgress struct {
+	state               protoimpl.MessageState  `protogen:"open.v1"`
+	CategoryId          int32                   `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName        string                  `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	WeeklyTargetMinutes int32                   `protobuf:"varint,3,opt,name=weekly_target_minutes,json=weeklyTargetMinutes,proto3" json:"weekly_target_minutes,omitempty"`
+	Weeks               []*WeeklyTargetProgress `protobuf:"bytes,4,rep,name=weeks,proto3" json:"weeks,omitempty"`                                       // Oldest first
+	CurrentStreak       int32                   `protobuf:"varint,5,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"` // Consecutive weeks met up to the latest week
+	LongestStreak       int32                   `protobuf:"varint,6,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CategoryTargetProgress) Reset() {
+	*x = CategoryTargetProgress{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTargetProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTargetProgress) ProtoMessage() {}
+
+func (x *CategoryTargetProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTargetProgress.ProtoReflect.Descriptor instead.
+func (*CategoryTargetProgress) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CategoryTargetProgress) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryTargetProgress) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryTargetProgress) GetWeeklyTargetMinutes() int32 {
+	if x != nil {
+		return x.WeeklyTargetMinutes
+	}
+	return 0
+}
+
+func (x *CategoryTargetProgress) GetWeeks() []*WeeklyTargetProgress {
+	if x != nil {
+		return x.Weeks
+	}
+	return nil
+}
+
+func (x *CategoryTargetProgress) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *CategoryTargetProgress) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+// WeeklyTargetProgress compares the time spent in a week against the target
+type WeeklyTargetProgress struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WeekStart      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"` // Monday 00:00 in the time zone
+	ActualMinutes  int32                  `protobuf:"varint,2,opt,name=actual_minutes,json=actualMinutes,proto3" json:"actual_minutes,omitempty"`
+	TargetMinutes  int32                  `protobuf:"varint,3,opt,name=target_minutes,json=targetMinutes,proto3" json:"target_minutes,omitempty"`
+	DeficitMinutes int32                  `protobuf:"varint,4,opt,name=deficit_minutes,json=deficitMinutes,proto3" json:"deficit_minutes,omitempty"` // Minutes short of the target, zero once met
+	Met            bool                   `protobuf:"varint,5,opt,name=met,proto3" json:"met,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WeeklyTargetProgress) Reset() {
+	*x = WeeklyTargetProgress{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeeklyTargetProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeeklyTargetProgress) ProtoMessage() {}
+
+func (x *WeeklyTargetProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeeklyTargetProgress.ProtoReflect.Descriptor instead.
+func (*WeeklyTargetProgress) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *WeeklyTargetProgress) GetWeekStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekStart
+	}
+	return nil
+}
+
+func (x *WeeklyTargetProgress) GetActualMinutes() int32 {
+	if x != nil {
+		return x.ActualMinutes
+	}
+	return 0
+}
+
+func (x *WeeklyTargetProgress) GetTargetMinutes() int32 {
+	if x != nil {
+		return x.TargetMinutes
+	}
+	return 0
+}
+
+func (x *WeeklyTargetProgress) GetDeficitMinutes() int32 {
+	if x != nil {
+		return x.DeficitMinutes
+	}
+	return 0
+}
+
+func (x *WeeklyTargetProgress) GetMet() bool {
+	if x != nil {
+		return x.Met
+	}
+	return false
+}
+
+// GetConsistencyStatsRequest is used to get practice streaks and calendar
+// statistics
+type GetConsistencyStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeZone      string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // Optional: IANA time zone of the calendar days, defaults to the settings
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`    // Optional: last day of the heatmap, defaults to today
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsistencyStatsRequest) Reset() {
+	*x = GetConsistencyStatsRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsistencyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsistencyStatsRequest) ProtoMessage() {}
+
+func (x *GetConsistencyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsistencyStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetConsistencyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetConsistencyStatsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *GetConsistencyStatsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+// ConsistencyStats describes how regularly practice happens. Days, weeks and
+// hours are those of the requested time zone, weeks start on Monday.
+type ConsistencyStats struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	TimeZone              string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	CurrentStreak         int32                  `protobuf:"varint,2,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"` // Consecutive days practiced up to today, or yesterday while today has no practice yet
+	LongestStreak         int32                  `protobuf:"varint,3,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	Weekly                []*PracticePeriod      `protobuf:"bytes,4,rep,name=weekly,proto3" json:"weekly,omitempty"`                                                                // Weeks of the heatmap, oldest first
+	Monthly               []*PracticePeriod      `protobuf:"bytes,5,rep,name=monthly,proto3" json:"monthly,omitempty"`                                                              // Months of the heatmap, oldest first
+	Heatmap               []*HeatmapDay          `protobuf:"bytes,6,rep,name=heatmap,proto3" json:"heatmap,omitempty"`                                                              // A year of days, oldest first
+	DayOfWeekDistribution []*DayOfWeekTime       `protobuf:"bytes,7,rep,name=day_of_week_distribution,json=dayOfWeekDistribution,proto3" json:"day_of_week_distribution,omitempty"` // Within the heatmap
+	HourOfDayDistribution []*HourOfDayTime       `protobuf:"bytes,8,rep,name=hour_of_day_distribution,json=hourOfDayDistribution,proto3" json:"hour_of_day_distribution,omitempty"` // Within the heatmap
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ConsistencyStats) Reset() {
+	*x = ConsistencyStats{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsistencyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyStats) ProtoMessage() {}
+
+func (x *ConsistencyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyStats.ProtoReflect.Descriptor instead.
+func (*ConsistencyStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ConsistencyStats) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *ConsistencyStats) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *ConsistencyStats) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+func (x *ConsistencyStats) GetWeekly() []*PracticePeriod {
+	if x != nil {
+		return x.Weekly
+	}
+	return nil
+}
+
+func (x *ConsistencyStats) GetMonthly() []*PracticePeriod {
+	if x != nil {
+		return x.Monthly
+	}
+	return nil
+}
+
+func (x *ConsistencyStats) GetHeatmap() []*HeatmapDay {
+	if x != nil {
+		return x.Heatmap
+	}
+	return nil
+}
+
+func (x *ConsistencyStats) GetDayOfWeekDistribution() []*DayOfWeekTime {
+	if x != nil {
+		return x.DayOfWeekDistribution
+	}
+	return nil
+}
+
+func (x *ConsistencyStats) GetHourOfDayDistribution() []*HourOfDayTime {
+	if x != nil {
+		return x.HourOfDayDistribution
+	}
+	return nil
+}
+
+// PracticePeriod counts the days practiced in a week or month
+type PracticePeriod struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	DaysPracticed   int32                  `protobuf:"varint,2,opt,name=days_practiced,json=daysPracticed,proto3" json:"days_practiced,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PracticePeriod) Reset() {
+	*x = PracticePeriod{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PracticePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PracticePeriod) ProtoMessage() {}
+
+func (x *PracticePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PracticePeriod.ProtoReflect.Descriptor instead.
+func (*PracticePeriod) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PracticePeriod) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *PracticePeriod) GetDaysPracticed() int32 {
+	if x != nil {
+		return x.DaysPracticed
+	}
+	return 0
+}
+
+func (x *PracticePeriod) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// HeatmapDay is a cell of the calendar heatmap grid
+type HeatmapDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`        // Midnight in the requested time zone
+	Week          int32                  `protobuf:"varint,2,opt,name=week,proto3" json:"week,omitempty"`       // Grid column, 0 is the oldest week
+	Weekday       int32                  `protobuf:"varint,3,opt,name=weekday,proto3" json:"weekday,omitempty"` // Grid row, 0 is Monday
+	Minutes       int32                  `protobuf:"varint,4,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeatmapDay) Reset() {
+	*x = HeatmapDay{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeatmapDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapDay) ProtoMessage() {}
+
+func (x *HeatmapDay) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapDay.ProtoReflect.Descriptor instead.
+func (*HeatmapDay) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *HeatmapDay) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *HeatmapDay) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *HeatmapDay) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *HeatmapDay) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+// DayOfWeekTime is the practice time on a day of the week
+type DayOfWeekTime struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DayOfWeek       int32                  `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"` // 0 is Sunday
+	DurationSeconds int32                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DayOfWeekTime) Reset() {
+	*x = DayOfWeekTime{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayOfWeekTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayOfWeekTime) ProtoMessage() {}
+
+func (x *DayOfWeekTime) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayOfWeekTime.ProtoReflect.Descriptor instead.
+func (*DayOfWeekTime) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DayOfWeekTime) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *DayOfWeekTime) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// HourOfDayTime is the practice time within an hour of the day
+type HourOfDayTime struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Hour            int32                  `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"` // 0-23
+	DurationSeconds int32                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HourOfDayTime) Reset() {
+	*x = HourOfDayTime{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HourOfDayTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourOfDayTime) ProtoMessage() {}
+
+func (x *HourOfDayTime) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourOfDayTime.ProtoReflect.Descriptor instead.
+func (*HourOfDayTime) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *HourOfDayTime) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *HourOfDayTime) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// CreateGoalRequest is used to create a new goal
+type CreateGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseId    int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	TargetBpm     int32                  `protobuf:"varint,2,opt,name=target_bpm,json=targetBpm,proto3" json:"target_bpm,omitempty"`
+	TargetDate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=target_date,json=targetDate,proto3" json:"target_date,omitempty"`          // Optional
+	TimeSignature string                 `protobuf:"bytes,4,opt,name=time_signature,json=timeSignature,proto3" json:"time_signature,omitempty"` // Optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGoalRequest) Reset() {
+	*x = CreateGoalRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoalRequest) ProtoMessage() {}
+
+func (x *CreateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoalRequest.ProtoReflect.Descriptor instead.
+func (*CreateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CreateGoalRequest) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *CreateGoalRequest) GetTargetBpm() int32 {
+	if x != nil {
+		return x.TargetBpm
+	}
+	return 0
+}
+
+func (x *CreateGoalRequest) GetTargetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TargetDate
+	}
+	return nil
+}
+
+func (x *CreateGoalRequest) GetTimeSignature() string {
+	if x != nil {
+		return x.TimeSignature
+	}
+	return ""
+}
+
+// GetGoalRequest is used to retrieve a specific goal
+type GetGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGoalRequest) Reset() {
+	*x = GetGoalRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalRequest) ProtoMessage() {}
+
+func (x *GetGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetGoalRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ListGoalsRequest is used to list goals with pagination
+type ListGoalsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageSize          int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken         string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	ExerciseId        int32                  `protobuf:"varint,3,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`                        // Optional: filter by exercise
+	IncludeTotalCount bool                   `protobuf:"varint,4,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Count the whole listing in total_count, an extra query
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListGoalsRequest) Reset() {
+	*x = ListGoalsRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGoalsRequest) ProtoMessage() {}
+
+func (x *ListGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGoalsRequest.ProtoReflect.Descriptor instead.
+func (*ListGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListGoalsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGoalsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListGoalsRequest) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *ListGoalsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+// ListGoalsResponse contains a list of goals and pagination info
+type ListGoalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goals         []*Goal                `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Only set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGoalsResponse) Reset() {
+	*x = ListGoalsResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGoalsResponse) ProtoMessage() {}
+
+func (x *ListGoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGoalsResponse.ProtoReflect.Descriptor instead.
+func (*ListGoalsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListGoalsResponse) GetGoals() []*Goal {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+func (x *ListGoalsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListGoalsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// UpdateGoalRequest is used to update a goal
+type UpdateGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Goal          *Goal                  `protobuf:"bytes,2,opt,name=goal,proto3" json:"goal,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGoalRequest) Reset() {
+	*x = UpdateGoalRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoalRequest) ProtoMessage() {}
+
+func (x *UpdateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UpdateGoalRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateGoalRequest) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+func (x *UpdateGoalRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteGoalRequest is used to delete a goal
+type DeleteGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGoalRequest) Reset() {
+	*x = DeleteGoalRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGoalRequest) ProtoMessage() {}
+
+func (x *DeleteGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGoalRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DeleteGoalRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// CreateRoutineRequest is used to create a new routine
+type CreateRoutineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Steps         []*RoutineStep         `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoutineRequest) Reset() {
+	*x = CreateRoutineRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoutineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoutineRequest) ProtoMessage() {}
+
+func (x *CreateRoutineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoutineRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoutineRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CreateRoutineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoutineRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRoutineRequest) GetSteps() []*RoutineStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// GetRoutineRequest is used to retrieve a specific routine
+type GetRoutineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoutineRequest) Reset() {
+	*x = GetRoutineRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoutineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoutineRequest) ProtoMessage() {}
+
+func (x *GetRoutineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoutineRequest.ProtoReflect.Descriptor instead.
+func (*GetRoutineRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetRoutineRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ListRoutinesRequest is used to list routines with pagination
+type ListRoutinesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageSize          int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken         string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	IncludeTotalCount bool                   `protobuf:"varint,3,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Count the whole listing in total_count, an extra query
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListRoutinesRequest) Reset() {
+	*x = ListRoutinesRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoutinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoutinesRequest) ProtoMessage() {}
+
+func (x *ListRoutinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoutinesRequest.ProtoReflect.Descriptor instead.
+func (*ListRoutinesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListRoutinesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRoutinesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRoutinesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+// ListRoutinesResponse contains a list of routines and pagination info
+type ListRoutinesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Routines      []*Routine             `protobuf:"bytes,1,rep,name=routines,proto3" json:"routines,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Only set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoutinesResponse) Reset() {
+	*x = ListRoutinesResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoutinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoutinesResponse) ProtoMessage() {}
+
+func (x *ListRoutinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoutinesResponse.ProtoReflect.Descriptor instead.
+func (*ListRoutinesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListRoutinesResponse) GetRoutines() []*Routine {
+	if x != nil {
+		return x.Routines
+	}
+	return nil
+}
+
+func (x *ListRoutinesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListRoutinesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// UpdateRoutineRequest is used to update a routine, updating steps replaces
+// all of them
+type UpdateRoutineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Routine       *Routine               `protobuf:"bytes,2,opt,name=routine,proto3" json:"routine,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoutineRequest) Reset() {
+	*x = UpdateRoutineRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoutineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoutineRequest) ProtoMessage() {}
+
+func (x *UpdateRoutineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoutineRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoutineRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdateRoutineRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRoutineRequest) GetRoutine() *Routine {
+	if x != nil {
+		return x.Routine
+	}
+	return nil
+}
+
+func (x *UpdateRoutineRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteRoutineRequest is used to delete a routine
+type DeleteRoutineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoutineRequest) Reset() {
+	*x = DeleteRoutineRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoutineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoutineRequest) ProtoMessage() {}
+
+func (x *DeleteRoutineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoutineRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoutineRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DeleteRoutineRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// StartSessionFromRoutineRequest is used to start a practice session that
+// follows a routine
+type StartSessionFromRoutineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoutineId     int32                  `protobuf:"varint,1,opt,name=routine_id,json=routineId,proto3" json:"routine_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // Optional: defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartSessionFromRoutineRequest) Reset() {
+	*x = StartSessionFromRoutineRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSessionFromRoutineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSessionFromRoutineRequest) ProtoMessage() {}
+
+func (x *StartSessionFromRoutineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSessionFromRoutineRequest.ProtoReflect.Descriptor instead.
+func (*StartSessionFromRoutineRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *StartSessionFromRoutineRequest) GetRoutineId() int32 {
+	if x != nil {
+		return x.RoutineId
+	}
+	return 0
+}
+
+func (x *StartSessionFromRoutineRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+// StartSessionFromRoutineResponse contains the started session and the steps
+// to walk through
+type StartSessionFromRoutineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *PracticeSession       `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Steps         []*PlannedStep         `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartSessionFromRoutineResponse) Reset() {
+	*x = StartSessionFromRoutineResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSessionFromRoutineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSessionFromRoutineResponse) ProtoMessage() {}
+
+func (x *StartSessionFromRoutineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSessionFromRoutineResponse.ProtoReflect.Descriptor instead.
+func (*StartSessionFromRoutineResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *StartSessionFromRoutineResponse) GetSession() *PracticeSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *StartSessionFromRoutineResponse) GetSteps() []*PlannedStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// PlannedStep is a routine step with a history entry pre-filled from it, the
+// client sets the times once the step is practiced
+type PlannedStep struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Step          *RoutineStep                  `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	Entry         *CreateExerciseHistoryRequest `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedStep) Reset() {
+	*x = PlannedStep{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedStep) ProtoMessage() {}
+
+func (x *PlannedStep) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedStep.ProtoReflect.Descriptor instead.
+func (*PlannedStep) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *PlannedStep) GetStep() *RoutineStep {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *PlannedStep) GetEntry() *CreateExerciseHistoryRequest {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// GetPracticePlanRequest is used to get a ranked plan of exercises to practice
+type GetPracticePlanRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AvailableMinutes int32                  `protobuf:"varint,1,opt,name=available_minutes,json=availableMinutes,proto3" json:"available_minutes,omitempty"`
+	CategoryId       int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Optional: only recommend exercises in this category
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetPracticePlanRequest) Reset() {
+	*x = GetPracticePlanRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPracticePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPracticePlanRequest) ProtoMessage() {}
+
+func (x *GetPracticePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPracticePlanRequest.ProtoReflect.Descriptor instead.
+func (*GetPracticePlanRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetPracticePlanRequest) GetAvailableMinutes() int32 {
+	if x != nil {
+		return x.AvailableMinutes
+	}
+	return 0
+}
+
+func (x *GetPracticePlanRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+// PracticePlan is a ranked list of exercises fitting the available time
+type PracticePlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PlanItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Highest score first
+	TotalMinutes  int32                  `protobuf:"varint,2,opt,name=total_minutes,json=totalMinutes,proto3" json:"total_minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PracticePlan) Reset() {
+	*x = PracticePlan{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PracticePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PracticePlan) ProtoMessage() {}
+
+func (x *PracticePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PracticePlan.ProtoReflect.Descriptor instead.
+func (*PracticePlan) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *PracticePlan) GetItems() []*PlanItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PracticePlan) GetTotalMinutes() int32 {
+	if x != nil {
+		return x.TotalMinutes
+	}
+	return 0
+}
+
+// PlanItem is a recommended exercise with the time to spend on it
+type PlanItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ExerciseId       int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
+	ExerciseName     string                 `protobuf:"bytes,2,opt,name=exercise_name,json=exerciseName,proto3" json:"exercise_name,omitempty"`
+	SuggestedMinutes int32                  `protobuf:"varint,3,opt,name=suggested_minutes,json=suggestedMinutes,proto3" json:"suggested_minutes,omitempty"`
+	Score            float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"` // 0-100
+	Breakdown        *ScoreBreakdown        `protobuf:"bytes,5,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	Reasons          []string               `protobuf:"bytes,6,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	LastPractice     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_practice,json=lastPractice,proto3" json:"last_practice,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PlanItem) Reset() {
+	*x = PlanItem{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanItem) ProtoMessage() {}
+
+func (x *PlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanItem.ProtoReflect.Descriptor instead.
+func (*PlanItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *PlanItem) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *PlanItem) GetExerciseName() string {
+	if x != nil {
+		return x.ExerciseName
+	}
+	return ""
+}
+
+func (x *PlanItem) GetSuggestedMinutes() int32 {
+	if x != nil {
+		return x.SuggestedMinutes
+	}
+	return 0
+}
+
+func (x *PlanItem) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PlanItem) GetBreakdown() *ScoreBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *PlanItem) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *PlanItem) GetLastPractice() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPractice
+	}
+	return nil
+}
+
+// ScoreBreakdown holds the score components, each between 0 and 1
+type ScoreBreakdown struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Recency         float64                `protobuf:"fixed64,1,opt,name=recency,proto3" json:"recency,omitempty"`                                        // Time since last practice
+	LowRating       float64                `protobuf:"fixed64,2,opt,name=low_rating,json=lowRating,proto3" json:"low_rating,omitempty"`                   // Low recent ratings
+	StalledProgress float64                `protobuf:"fixed64,3,opt,name=stalled_progress,json=stalledProgress,proto3" json:"stalled_progress,omitempty"` // No recent BPM improvement
+	CategoryBalance float64                `protobuf:"fixed64,4,opt,name=category_balance,json=categoryBalance,proto3" json:"category_balance,omitempty"` // Categories behind their weekly targets
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScoreBreakdown) Reset() {
+	*x = ScoreBreakdown{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreBreakdown) ProtoMessage() {}
+
+func (x *ScoreBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreBreakdown.ProtoReflect.Descriptor instead.
+func (*ScoreBreakdown) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ScoreBreakdown) GetRecency() float64 {
+	if x != nil {
+		return x.Recency
+	}
+	return 0
+}
+
+func (x *ScoreBreakdown) GetLowRating() float64 {
+	if x != nil {
+		return x.LowRating
+	}
+	return 0
+}
+
+func (x *ScoreBreakdown) GetStalledProgress() float64 {
+	if x != nil {
+		return x.StalledProgress
+	}
+	return 0
+}
+
+func (x *ScoreBreakdown) GetCategoryBalance() float64 {
+	if x != nil {
+		return x.CategoryBalance
+	}
+	return 0
+}
+
+// GetSettingsRequest is used to retrieve the settings
+type GetSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsRequest) Reset() {
+	*x = GetSettingsRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PracticeSession) String() string {
+func (x *GetSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PracticeSession) ProtoMessage() {}
+func (*GetSettingsRequest) ProtoMessage() {}
 
-func (x *PracticeSession) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[5]
+func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,100 +8112,97 @@ func (x *PracticeSession) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PracticeSession.ProtoReflect.Descriptor instead.
-func (*PracticeSession) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *PracticeSession) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// UpdateSettingsRequest is used to update the settings
+type UpdateSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *Settings              `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PracticeSession) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PracticeSession) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
+func (x *UpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PracticeSession) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
-}
+func (*UpdateSettingsRequest) ProtoMessage() {}
 
-func (x *PracticeSession) GetCreatedAt() *timestamppb.Timestamp {
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[113]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PracticeSession) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *PracticeSession) GetExercises() []*ExerciseHistory {
+func (x *UpdateSettingsRequest) GetSettings() *Settings {
 	if x != nil {
-		return x.Exercises
+		return x.Settings
 	}
 	return nil
 }
 
-func (x *PracticeSession) GetActive() bool {
+func (x *UpdateSettingsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Active
+		return x.UpdateMask
 	}
-	return false
+	return nil
 }
 
-// ExerciseHistory represents a historical record of exercise performance
-type ExerciseHistory struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ExerciseId      int32                  `protobuf:"varint,2,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
-	StartTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Bpms            []int32                `protobuf:"varint,5,rep,packed,name=bpms,proto3" json:"bpms,omitempty"`
-	TimeSignature   string                 `protobuf:"bytes,6,opt,name=time_signature,json=timeSignature,proto3" json:"time_signature,omitempty"`
-	Notes           string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
-	Rating          int32                  `protobuf:"varint,8,opt,name=rating,proto3" json:"rating,omitempty"`    // User rating (1-5)
-	Exercise        *Exercise              `protobuf:"bytes,9,opt,name=exercise,proto3" json:"exercise,omitempty"` // Full exercise details
-	SessionId       int32                  `protobuf:"varint,10,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	DurationSeconds int32                  `protobuf:"varint,11,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // Optional manual duration override
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// DataArchive is a versioned snapshot of all practice data. Relations between
+// the entities are expressed through their IDs.
+type DataArchive struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ExportedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	Categories    []*Category            `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	Tags          []*Tag                 `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`           // Includes category IDs
+	Exercises     []*Exercise            `protobuf:"bytes,5,rep,name=exercises,proto3" json:"exercises,omitempty"` // Includes tag IDs, images, notations and links
+	Sessions      []*PracticeSession     `protobuf:"bytes,6,rep,name=sessions,proto3" json:"sessions,omitempty"`   // Includes segments, without exercise history
+	History       []*ExerciseHistory     `protobuf:"bytes,7,rep,name=history,proto3" json:"history,omitempty"`     // Includes recordings with their audio
+	Goals         []*Goal                `protobuf:"bytes,8,rep,name=goals,proto3" json:"goals,omitempty"`
+	Routines      []*Routine             `protobuf:"bytes,9,rep,name=routines,proto3" json:"routines,omitempty"`
+	Settings      *Settings              `protobuf:"bytes,10,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExerciseHistory) Reset() {
-	*x = ExerciseHistory{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[6]
+func (x *DataArchive) Reset() {
+	*x = DataArchive{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExerciseHistory) String() string {
+func (x *DataArchive) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExerciseHistory) ProtoMessage() {}
+func (*DataArchive) ProtoMessage() {}
 
-func (x *ExerciseHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[6]
+func (x *DataArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,112 +8213,103 @@ func (x *ExerciseHistory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExerciseHistory.ProtoReflect.Descriptor instead.
-func (*ExerciseHistory) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *ExerciseHistory) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use DataArchive.ProtoReflect.Descriptor instead.
+func (*DataArchive) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *ExerciseHistory) GetExerciseId() int32 {
+func (x *DataArchive) GetVersion() int32 {
 	if x != nil {
-		return x.ExerciseId
+		return x.Version
 	}
 	return 0
 }
 
-func (x *ExerciseHistory) GetStartTime() *timestamppb.Timestamp {
+func (x *DataArchive) GetExportedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StartTime
+		return x.ExportedAt
 	}
 	return nil
 }
 
-func (x *ExerciseHistory) GetEndTime() *timestamppb.Timestamp {
+func (x *DataArchive) GetCategories() []*Category {
 	if x != nil {
-		return x.EndTime
+		return x.Categories
 	}
 	return nil
 }
 
-func (x *ExerciseHistory) GetBpms() []int32 {
+func (x *DataArchive) GetTags() []*Tag {
 	if x != nil {
-		return x.Bpms
+		return x.Tags
 	}
 	return nil
 }
 
-func (x *ExerciseHistory) GetTimeSignature() string {
+func (x *DataArchive) GetExercises() []*Exercise {
 	if x != nil {
-		return x.TimeSignature
+		return x.Exercises
 	}
-	return ""
+	return nil
 }
 
-func (x *ExerciseHistory) GetNotes() string {
+func (x *DataArchive) GetSessions() []*PracticeSession {
 	if x != nil {
-		return x.Notes
+		return x.Sessions
 	}
-	return ""
+	return nil
 }
 
-func (x *ExerciseHistory) GetRating() int32 {
+func (x *DataArchive) GetHistory() []*ExerciseHistory {
 	if x != nil {
-		return x.Rating
+		return x.History
 	}
-	return 0
+	return nil
 }
 
-func (x *ExerciseHistory) GetExercise() *Exercise {
+func (x *DataArchive) GetGoals() []*Goal {
 	if x != nil {
-		return x.Exercise
+		return x.Goals
 	}
 	return nil
 }
 
-func (x *ExerciseHistory) GetSessionId() int32 {
+func (x *DataArchive) GetRoutines() []*Routine {
 	if x != nil {
-		return x.SessionId
+		return x.Routines
 	}
-	return 0
+	return nil
 }
 
-func (x *ExerciseHistory) GetDurationSeconds() int32 {
+func (x *DataArchive) GetSettings() *Settings {
 	if x != nil {
-		return x.DurationSeconds
+		return x.Settings
 	}
-	return 0
+	return nil
 }
 
-// CreateCategoryRequest is used to create a new category
-type CreateCategoryRequest struct {
+// ExportAllRequest is used to export all data of the authenticated user
+type ExportAllRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryRequest) Reset() {
-	*x = CreateCategoryRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[7]
+func (x *ExportAllRequest) Reset() {
+	*x = ExportAllRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryRequest) String() string {
+func (x *ExportAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryRequest) ProtoMessage() {}
+func (*ExportAllRequest) ProtoMessage() {}
 
-func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[7]
+func (x *ExportAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,48 +8320,38 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *CreateCategoryRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateCategoryRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use ExportAllRequest.ProtoReflect.Descriptor instead.
+func (*ExportAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{115}
 }
 
-// GetCategoryRequest is used to retrieve a specific category
-type GetCategoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+// ImportAllRequest is used to import a data archive
+type ImportAllRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Archive *DataArchive           `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	// Assign new IDs to the imported entities instead of preserving the
+	// archived ones, required when the user already has data. IDs are
+	// remapped anyway when other users have data.
+	RemapIds      bool `protobuf:"varint,2,opt,name=remap_ids,json=remapIds,proto3" json:"remap_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoryRequest) Reset() {
-	*x = GetCategoryRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[8]
+func (x *ImportAllRequest) Reset() {
+	*x = ImportAllRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoryRequest) String() string {
+func (x *ImportAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoryRequest) ProtoMessage() {}
+func (*ImportAllRequest) ProtoMessage() {}
 
-func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[8]
+func (x *ImportAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,42 +8362,55 @@ func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
-func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ImportAllRequest.ProtoReflect.Descriptor instead.
+func (*ImportAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GetCategoryRequest) GetId() int32 {
+func (x *ImportAllRequest) GetArchive() *DataArchive {
 	if x != nil {
-		return x.Id
+		return x.Archive
 	}
-	return 0
+	return nil
 }
 
-// ListCategoriesRequest is used to list categories with pagination
-type ListCategoriesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ImportAllRequest) GetRemapIds() bool {
+	if x != nil {
+		return x.RemapIds
+	}
+	return false
 }
 
-func (x *ListCategoriesRequest) Reset() {
-	*x = ListCategoriesRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[9]
+// ImportAllResponse contains the number of imported entities of each kind
+type ImportAllResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Categories     int32                  `protobuf:"varint,1,opt,name=categories,proto3" json:"categories,omitempty"`
+	Tags           int32                  `protobuf:"varint,2,opt,name=tags,proto3" json:"tags,omitempty"`
+	Exercises      int32                  `protobuf:"varint,3,opt,name=exercises,proto3" json:"exercises,omitempty"`
+	Sessions       int32                  `protobuf:"varint,4,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	HistoryEntries int32                  `protobuf:"varint,5,opt,name=history_entries,json=historyEntries,proto3" json:"history_entries,omitempty"`
+	Goals          int32                  `protobuf:"varint,6,opt,name=goals,proto3" json:"goals,omitempty"`
+	Routines       int32                  `protobuf:"varint,7,opt,name=routines,proto3" json:"routines,omitempty"`
+	Recordings     int32                  `protobuf:"varint,8,opt,name=recordings,proto3" json:"recordings,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportAllResponse) Reset() {
+	*x = ImportAllResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesRequest) String() string {
+func (x *ImportAllResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesRequest) ProtoMessage() {}
+func (*ImportAllResponse) ProtoMessage() {}
 
-func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[9]
+func (x *ImportAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,111 +8421,92 @@ func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ImportAllResponse.ProtoReflect.Descriptor instead.
+func (*ImportAllResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *ListCategoriesRequest) GetPageSize() int32 {
+func (x *ImportAllResponse) GetCategories() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.Categories
 	}
 	return 0
 }
 
-func (x *ListCategoriesRequest) GetPageToken() string {
+func (x *ImportAllResponse) GetTags() int32 {
 	if x != nil {
-		return x.PageToken
+		return x.Tags
 	}
-	return ""
-}
-
-// ListCategoriesResponse contains a list of categories and pagination info
-type ListCategoriesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListCategoriesResponse) Reset() {
-	*x = ListCategoriesResponse{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *ListCategoriesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ImportAllResponse) GetExercises() int32 {
+	if x != nil {
+		return x.Exercises
+	}
+	return 0
 }
 
-func (*ListCategoriesResponse) ProtoMessage() {}
-
-func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[10]
+func (x *ImportAllResponse) GetSessions() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Sessions
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{10}
+func (x *ImportAllResponse) GetHistoryEntries() int32 {
+	if x != nil {
+		return x.HistoryEntries
+	}
+	return 0
 }
 
-func (x *ListCategoriesResponse) GetCategories() []*Category {
+func (x *ImportAllResponse) GetGoals() int32 {
 	if x != nil {
-		return x.Categories
+		return x.Goals
 	}
-	return nil
+	return 0
 }
 
-func (x *ListCategoriesResponse) GetNextPageToken() string {
+func (x *ImportAllResponse) GetRoutines() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.Routines
 	}
-	return ""
+	return 0
 }
 
-func (x *ListCategoriesResponse) GetTotalCount() int32 {
+func (x *ImportAllResponse) GetRecordings() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.Recordings
 	}
 	return 0
 }
 
-// UpdateCategoryRequest is used to update a category
-type UpdateCategoryRequest struct {
+// Backup describes a database snapshot
+type Backup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Category      *Category              `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCategoryRequest) Reset() {
-	*x = UpdateCategoryRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[11]
+func (x *Backup) Reset() {
+	*x = Backup{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCategoryRequest) String() string {
+func (x *Backup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCategoryRequest) ProtoMessage() {}
+func (*Backup) ProtoMessage() {}
 
-func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[11]
+func (x *Backup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -947,55 +8517,54 @@ func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Backup.ProtoReflect.Descriptor instead.
+func (*Backup) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *UpdateCategoryRequest) GetId() int32 {
+func (x *Backup) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateCategoryRequest) GetCategory() *Category {
+func (x *Backup) GetSizeBytes() int64 {
 	if x != nil {
-		return x.Category
+		return x.SizeBytes
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateCategoryRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *Backup) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateMask
+		return x.CreatedAt
 	}
 	return nil
 }
 
-// DeleteCategoryRequest is used to delete a category
-type DeleteCategoryRequest struct {
+// CreateBackupRequest is used to take a database snapshot
+type CreateBackupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCategoryRequest) Reset() {
-	*x = DeleteCategoryRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[12]
+func (x *CreateBackupRequest) Reset() {
+	*x = CreateBackupRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCategoryRequest) String() string {
+func (x *CreateBackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCategoryRequest) ProtoMessage() {}
+func (*CreateBackupRequest) ProtoMessage() {}
 
-func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[12]
+func (x *CreateBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1006,42 +8575,33 @@ func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *DeleteCategoryRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use CreateBackupRequest.ProtoReflect.Descriptor instead.
+func (*CreateBackupRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{119}
 }
 
-// CreateTagRequest is used to create a new tag
-type CreateTagRequest struct {
+// ListBackupsRequest is used to list the database snapshots
+type ListBackupsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CategoryIds   []int32                `protobuf:"varint,2,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"` // Related category IDs
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTagRequest) Reset() {
-	*x = CreateTagRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[13]
+func (x *ListBackupsRequest) Reset() {
+	*x = ListBackupsRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTagRequest) String() string {
+func (x *ListBackupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTagRequest) ProtoMessage() {}
+func (*ListBackupsRequest) ProtoMessage() {}
 
-func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[13]
+func (x *ListBackupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,48 +8612,34 @@ func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
-func (*CreateTagRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *CreateTagRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateTagRequest) GetCategoryIds() []int32 {
-	if x != nil {
-		return x.CategoryIds
-	}
-	return nil
+// Deprecated: Use ListBackupsRequest.ProtoReflect.Descriptor instead.
+func (*ListBackupsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{120}
 }
 
-// GetTagRequest is used to retrieve a specific tag
-type GetTagRequest struct {
+// ListBackupsResponse contains the database snapshots, most recent first
+type ListBackupsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Backups       []*Backup              `protobuf:"bytes,1,rep,name=backups,proto3" json:"backups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTagRequest) Reset() {
-	*x = GetTagRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[14]
+func (x *ListBackupsResponse) Reset() {
+	*x = ListBackupsResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTagRequest) String() string {
+func (x *ListBackupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTagRequest) ProtoMessage() {}
+func (*ListBackupsResponse) ProtoMessage() {}
 
-func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[14]
+func (x *ListBackupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1104,43 +8650,45 @@ func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTagRequest.ProtoReflect.Descriptor instead.
-func (*GetTagRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListBackupsResponse.ProtoReflect.Descriptor instead.
+func (*ListBackupsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *GetTagRequest) GetId() int32 {
+func (x *ListBackupsResponse) GetBackups() []*Backup {
 	if x != nil {
-		return x.Id
+		return x.Backups
 	}
-	return 0
+	return nil
 }
 
-// ListTagsRequest is used to list tags with pagination
-type ListTagsRequest struct {
+// SearchRequest is used to search the text of exercises, links, sessions and
+// exercise history. Every word of the query must match, the last one as a
+// prefix.
+type SearchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Optional: filter by category
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Types         []SearchEntityType     `protobuf:"varint,2,rep,packed,name=types,proto3,enum=drummer.v1.SearchEntityType" json:"types,omitempty"` // Optional: only search these, all by default
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                         // Results per type, 10 by default and at most 50
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTagsRequest) Reset() {
-	*x = ListTagsRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[15]
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTagsRequest) String() string {
+func (x *SearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTagsRequest) ProtoMessage() {}
+func (*SearchRequest) ProtoMessage() {}
 
-func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[15]
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1151,57 +8699,56 @@ func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
-func (*ListTagsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *ListTagsRequest) GetPageSize() int32 {
+func (x *SearchRequest) GetQuery() string {
 	if x != nil {
-		return x.PageSize
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *ListTagsRequest) GetPageToken() string {
+func (x *SearchRequest) GetTypes() []SearchEntityType {
 	if x != nil {
-		return x.PageToken
+		return x.Types
 	}
-	return ""
+	return nil
 }
 
-func (x *ListTagsRequest) GetCategoryId() int32 {
+func (x *SearchRequest) GetLimit() int32 {
 	if x != nil {
-		return x.CategoryId
+		return x.Limit
 	}
 	return 0
 }
 
-// ListTagsResponse contains a list of tags and pagination info
-type ListTagsResponse struct {
+// SearchResponse contains the results grouped by type, in the order of
+// SearchEntityType, leaving out types without results
+type SearchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Groups        []*SearchResultGroup   `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTagsResponse) Reset() {
-	*x = ListTagsResponse{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[16]
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTagsResponse) String() string {
+func (x *SearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTagsResponse) ProtoMessage() {}
+func (*SearchResponse) ProtoMessage() {}
 
-func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[16]
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,57 +8759,101 @@ func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
-func (*ListTagsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *ListTagsResponse) GetTags() []*Tag {
+func (x *SearchResponse) GetGroups() []*SearchResultGroup {
 	if x != nil {
-		return x.Tags
+		return x.Groups
 	}
 	return nil
 }
 
-func (x *ListTagsResponse) GetNextPageToken() string {
+// SearchResultGroup holds the results of a type, best match first
+type SearchResultGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          SearchEntityType       `protobuf:"varint,1,opt,name=type,proto3,enum=drummer.v1.SearchEntityType" json:"type,omitempty"`
+	Results       []*SearchResult        `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResultGroup) Reset() {
+	*x = SearchResultGroup{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResultGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResultGroup) ProtoMessage() {}
+
+func (x *SearchResultGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[124]
 	if x != nil {
-		return x.NextPageToken
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListTagsResponse) GetTotalCount() int32 {
+// Deprecated: Use SearchResultGroup.ProtoReflect.Descriptor instead.
+func (*SearchResultGroup) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SearchResultGroup) GetType() SearchEntityType {
 	if x != nil {
-		return x.TotalCount
+		return x.Type
 	}
-	return 0
+	return SearchEntityType_SEARCH_ENTITY_TYPE_UNSPECIFIED
 }
 
-// UpdateTagRequest is used to update a tag
-type UpdateTagRequest struct {
+func (x *SearchResultGroup) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// SearchResult is a record matching a search
+type SearchResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Tag           *Tag                   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Type          SearchEntityType       `protobuf:"varint,1,opt,name=type,proto3,enum=drummer.v1.SearchEntityType" json:"type,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`                                   // ID of the record of the type
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                              // Exercise name, empty for sessions
+	Snippet       string                 `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`                          // HTML escaped text with the matches in <mark> elements
+	Score         float64                `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`                            // Relevance within the type, higher is better
+	ExerciseId    int32                  `protobuf:"varint,6,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"` // Set for exercises, links and history
+	SessionId     int32                  `protobuf:"varint,7,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`    // Set for sessions and history
+	Time          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`                                // Start of sessions and history, creation otherwise
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTagRequest) Reset() {
-	*x = UpdateTagRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[17]
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTagRequest) String() string {
+func (x *SearchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTagRequest) ProtoMessage() {}
+func (*SearchResult) ProtoMessage() {}
 
-func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[17]
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1273,55 +8864,95 @@ func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *UpdateTagRequest) GetId() int32 {
+func (x *SearchResult) GetType() SearchEntityType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchEntityType_SEARCH_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *SearchResult) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateTagRequest) GetTag() *Tag {
+func (x *SearchResult) GetTitle() string {
 	if x != nil {
-		return x.Tag
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateTagRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *SearchResult) GetSnippet() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetExerciseId() int32 {
+	if x != nil {
+		return x.ExerciseId
+	}
+	return 0
+}
+
+func (x *SearchResult) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *SearchResult) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
 	}
 	return nil
 }
 
-// DeleteTagRequest is used to delete a tag
-type DeleteTagRequest struct {
+// User is an account that signs in to the app
+type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Admin         bool                   `protobuf:"varint,3,opt,name=admin,proto3" json:"admin,omitempty"` // Manages users and backups
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Teacher       bool                   `protobuf:"varint,6,opt,name=teacher,proto3" json:"teacher,omitempty"` // Assigns exercises to their students
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTagRequest) Reset() {
-	*x = DeleteTagRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[18]
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTagRequest) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTagRequest) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[18]
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1332,45 +8963,81 @@ func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *DeleteTagRequest) GetId() int32 {
+func (x *User) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// CreateExerciseRequest is used to create a new exercise
-type CreateExerciseRequest struct {
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *User) GetTeacher() bool {
+	if x != nil {
+		return x.Teacher
+	}
+	return false
+}
+
+// ApiToken is a credential of a user for scripts and direct gRPC clients,
+// sent as a bearer token in the authorization header
+type ApiToken struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	TagIds        []int32                `protobuf:"varint,3,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
-	Images        []*ExerciseImage       `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
-	Links         []*ExerciseLink        `protobuf:"bytes,5,rep,name=links,proto3" json:"links,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // Unset when the token does not expire
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // Unset until the token is used
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateExerciseRequest) Reset() {
-	*x = CreateExerciseRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[19]
+func (x *ApiToken) Reset() {
+	*x = ApiToken{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateExerciseRequest) String() string {
+func (x *ApiToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExerciseRequest) ProtoMessage() {}
+func (*ApiToken) ProtoMessage() {}
 
-func (x *CreateExerciseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[19]
+func (x *ApiToken) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,69 +9048,70 @@ func (x *CreateExerciseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExerciseRequest.ProtoReflect.Descriptor instead.
-func (*CreateExerciseRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ApiToken.ProtoReflect.Descriptor instead.
+func (*ApiToken) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *CreateExerciseRequest) GetName() string {
+func (x *ApiToken) GetId() int32 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateExerciseRequest) GetDescription() string {
+func (x *ApiToken) GetName() string {
 	if x != nil {
-		return x.Description
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateExerciseRequest) GetTagIds() []int32 {
+func (x *ApiToken) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TagIds
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *CreateExerciseRequest) GetImages() []*ExerciseImage {
+func (x *ApiToken) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Images
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *CreateExerciseRequest) GetLinks() []*ExerciseLink {
+func (x *ApiToken) GetLastUsedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Links
+		return x.LastUsedAt
 	}
 	return nil
 }
 
-// GetExerciseRequest is used to retrieve a specific exercise
-type GetExerciseRequest struct {
+// LoginRequest is used to sign in with a password
+type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExerciseRequest) Reset() {
-	*x = GetExerciseRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[20]
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExerciseRequest) String() string {
+func (x *LoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExerciseRequest) ProtoMessage() {}
+func (*LoginRequest) ProtoMessage() {}
 
-func (x *GetExerciseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[20]
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1454,44 +9122,51 @@ func (x *GetExerciseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExerciseRequest.ProtoReflect.Descriptor instead.
-func (*GetExerciseRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GetExerciseRequest) GetId() int32 {
+func (x *LoginRequest) GetUsername() string {
 	if x != nil {
-		return x.Id
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-// ListExercisesRequest is used to list exercises with pagination and filters
-type ListExercisesRequest struct {
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// LoginResponse contains the signed in user and the token of their session.
+// Over HTTP the token is set as a cookie instead of being returned.
+type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Optional: filter by category
-	TagId         int32                  `protobuf:"varint,4,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`                // Optional: filter by tag
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExercisesRequest) Reset() {
-	*x = ListExercisesRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[21]
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExercisesRequest) String() string {
+func (x *LoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExercisesRequest) ProtoMessage() {}
+func (*LoginResponse) ProtoMessage() {}
 
-func (x *ListExercisesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[21]
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1502,64 +9177,54 @@ func (x *ListExercisesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExercisesRequest.ProtoReflect.Descriptor instead.
-func (*ListExercisesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *ListExercisesRequest) GetPageSize() int32 {
+func (x *LoginResponse) GetUser() *User {
 	if x != nil {
-		return x.PageSize
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-func (x *ListExercisesRequest) GetPageToken() string {
+func (x *LoginResponse) GetSessionToken() string {
 	if x != nil {
-		return x.PageToken
+		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *ListExercisesRequest) GetCategoryId() int32 {
-	if x != nil {
-		return x.CategoryId
-	}
-	return 0
-}
-
-func (x *ListExercisesRequest) GetTagId() int32 {
+func (x *LoginResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TagId
+		return x.ExpiresAt
 	}
-	return 0
+	return nil
 }
 
-// ListExercisesResponse contains a list of exercises and pagination info
-type ListExercisesResponse struct {
+// LogoutRequest is used to end the session the request is authenticated with
+type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exercises     []*Exercise            `protobuf:"bytes,1,rep,name=exercises,proto3" json:"exercises,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExercisesResponse) Reset() {
-	*x = ListExercisesResponse{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[22]
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExercisesResponse) String() string {
+func (x *LogoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExercisesResponse) ProtoMessage() {}
+func (*LogoutRequest) ProtoMessage() {}
 
-func (x *ListExercisesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[22]
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1570,57 +9235,70 @@ func (x *ListExercisesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExercisesResponse.ProtoReflect.Descriptor instead.
-func (*ListExercisesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *ListExercisesResponse) GetExercises() []*Exercise {
-	if x != nil {
-		return x.Exercises
-	}
-	return nil
+// LogoutResponse confirms the session ended, over HTTP its cookie is cleared
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExercisesResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExercisesResponse) GetTotalCount() int32 {
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[131]
 	if x != nil {
-		return x.TotalCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// UpdateExerciseRequest is used to update an exercise
-type UpdateExerciseRequest struct {
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{131}
+}
+
+// GetCurrentUserRequest is used to retrieve the authenticated user
+type GetCurrentUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Exercise      *Exercise              `protobuf:"bytes,2,opt,name=exercise,proto3" json:"exercise,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExerciseRequest) Reset() {
-	*x = UpdateExerciseRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[23]
+func (x *GetCurrentUserRequest) Reset() {
+	*x = GetCurrentUserRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExerciseRequest) String() string {
+func (x *GetCurrentUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExerciseRequest) ProtoMessage() {}
+func (*GetCurrentUserRequest) ProtoMessage() {}
 
-func (x *UpdateExerciseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[23]
+func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1631,55 +9309,90 @@ func (x *UpdateExerciseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExerciseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExerciseRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetCurrentUserRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *UpdateExerciseRequest) GetId() int32 {
+// ChangePasswordRequest is used to change the password of the authenticated
+// user, which ends their other sessions
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[133]
 	if x != nil {
-		return x.Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateExerciseRequest) GetExercise() *Exercise {
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
 	if x != nil {
-		return x.Exercise
+		return x.CurrentPassword
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateExerciseRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *ChangePasswordRequest) GetNewPassword() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.NewPassword
 	}
-	return nil
+	return ""
 }
 
-// DeleteExerciseRequest is used to delete an exercise
-type DeleteExerciseRequest struct {
+// CreateApiTokenRequest is used to create an API token for the authenticated
+// user
+type CreateApiTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExerciseRequest) Reset() {
-	*x = DeleteExerciseRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[24]
+func (x *CreateApiTokenRequest) Reset() {
+	*x = CreateApiTokenRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExerciseRequest) String() string {
+func (x *CreateApiTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExerciseRequest) ProtoMessage() {}
+func (*CreateApiTokenRequest) ProtoMessage() {}
 
-func (x *DeleteExerciseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[24]
+func (x *CreateApiTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1690,45 +9403,50 @@ func (x *DeleteExerciseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExerciseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteExerciseRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CreateApiTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *DeleteExerciseRequest) GetId() int32 {
+func (x *CreateApiTokenRequest) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-// AddExerciseImageRequest is used to add an image to an exercise
-type AddExerciseImageRequest struct {
+func (x *CreateApiTokenRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// CreateApiTokenResponse contains the created token and its secret, which
+// cannot be retrieved again
+type CreateApiTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExerciseId    int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
-	ImageData     []byte                 `protobuf:"bytes,2,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`
-	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
-	MimeType      string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Token         *ApiToken              `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddExerciseImageRequest) Reset() {
-	*x = AddExerciseImageRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[25]
+func (x *CreateApiTokenResponse) Reset() {
+	*x = CreateApiTokenResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddExerciseImageRequest) String() string {
+func (x *CreateApiTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddExerciseImageRequest) ProtoMessage() {}
+func (*CreateApiTokenResponse) ProtoMessage() {}
 
-func (x *AddExerciseImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[25]
+func (x *CreateApiTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1739,70 +9457,86 @@ func (x *AddExerciseImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddExerciseImageRequest.ProtoReflect.Descriptor instead.
-func (*AddExerciseImageRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *AddExerciseImageRequest) GetExerciseId() int32 {
-	if x != nil {
-		return x.ExerciseId
-	}
-	return 0
+// Deprecated: Use CreateApiTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *AddExerciseImageRequest) GetImageData() []byte {
+func (x *CreateApiTokenResponse) GetToken() *ApiToken {
 	if x != nil {
-		return x.ImageData
+		return x.Token
 	}
 	return nil
 }
 
-func (x *AddExerciseImageRequest) GetFilename() string {
+func (x *CreateApiTokenResponse) GetSecret() string {
 	if x != nil {
-		return x.Filename
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *AddExerciseImageRequest) GetMimeType() string {
-	if x != nil {
-		return x.MimeType
-	}
-	return ""
+// ListApiTokensRequest is used to list the API tokens of the authenticated
+// user
+type ListApiTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddExerciseImageRequest) GetDescription() string {
+func (x *ListApiTokensRequest) Reset() {
+	*x = ListApiTokensRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiTokensRequest) ProtoMessage() {}
+
+func (x *ListApiTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[136]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// GetExerciseImageRequest is used to get an image
-type GetExerciseImageRequest struct {
+// Deprecated: Use ListApiTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListApiTokensRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{136}
+}
+
+// ListApiTokensResponse contains the API tokens, most recent first
+type ListApiTokensResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExerciseId    int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
-	ImageId       int32                  `protobuf:"varint,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	Tokens        []*ApiToken            `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExerciseImageRequest) Reset() {
-	*x = GetExerciseImageRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[26]
+func (x *ListApiTokensResponse) Reset() {
+	*x = ListApiTokensResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExerciseImageRequest) String() string {
+func (x *ListApiTokensResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExerciseImageRequest) ProtoMessage() {}
+func (*ListApiTokensResponse) ProtoMessage() {}
 
-func (x *GetExerciseImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[26]
+func (x *ListApiTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1813,48 +9547,41 @@ func (x *GetExerciseImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExerciseImageRequest.ProtoReflect.Descriptor instead.
-func (*GetExerciseImageRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *GetExerciseImageRequest) GetExerciseId() int32 {
-	if x != nil {
-		return x.ExerciseId
-	}
-	return 0
+// Deprecated: Use ListApiTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListApiTokensResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *GetExerciseImageRequest) GetImageId() int32 {
+func (x *ListApiTokensResponse) GetTokens() []*ApiToken {
 	if x != nil {
-		return x.ImageId
+		return x.Tokens
 	}
-	return 0
+	return nil
 }
 
-// DeleteExerciseImageRequest is used to delete an image from an exercise
-type DeleteExerciseImageRequest struct {
+// DeleteApiTokenRequest is used to revoke an API token
+type DeleteApiTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExerciseImageRequest) Reset() {
-	*x = DeleteExerciseImageRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[27]
+func (x *DeleteApiTokenRequest) Reset() {
+	*x = DeleteApiTokenRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExerciseImageRequest) String() string {
+func (x *DeleteApiTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExerciseImageRequest) ProtoMessage() {}
+func (*DeleteApiTokenRequest) ProtoMessage() {}
 
-func (x *DeleteExerciseImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[27]
+func (x *DeleteApiTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1865,43 +9592,44 @@ func (x *DeleteExerciseImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExerciseImageRequest.ProtoReflect.Descriptor instead.
-func (*DeleteExerciseImageRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use DeleteApiTokenRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApiTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *DeleteExerciseImageRequest) GetId() int32 {
+func (x *DeleteApiTokenRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// AddExerciseLinkRequest is used to add a link to an exercise
-type AddExerciseLinkRequest struct {
+// CreateUserRequest is used to create a user
+type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExerciseId    int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Admin         bool                   `protobuf:"varint,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	Teacher       bool                   `protobuf:"varint,4,opt,name=teacher,proto3" json:"teacher,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddExerciseLinkRequest) Reset() {
-	*x = AddExerciseLinkRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[28]
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddExerciseLinkRequest) String() string {
+func (x *CreateUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddExerciseLinkRequest) ProtoMessage() {}
+func (*CreateUserRequest) ProtoMessage() {}
 
-func (x *AddExerciseLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[28]
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,55 +9640,61 @@ func (x *AddExerciseLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddExerciseLinkRequest.ProtoReflect.Descriptor instead.
-func (*AddExerciseLinkRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *AddExerciseLinkRequest) GetExerciseId() int32 {
+func (x *CreateUserRequest) GetUsername() string {
 	if x != nil {
-		return x.ExerciseId
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *AddExerciseLinkRequest) GetUrl() string {
+func (x *CreateUserRequest) GetPassword() string {
 	if x != nil {
-		return x.Url
+		return x.Password
 	}
 	return ""
 }
 
-func (x *AddExerciseLinkRequest) GetDescription() string {
+func (x *CreateUserRequest) GetAdmin() bool {
 	if x != nil {
-		return x.Description
+		return x.Admin
 	}
-	return ""
+	return false
 }
 
-// DeleteExerciseLinkRequest is used to delete a link from an exercise
-type DeleteExerciseLinkRequest struct {
+func (x *CreateUserRequest) GetTeacher() bool {
+	if x != nil {
+		return x.Teacher
+	}
+	return false
+}
+
+// ListUsersRequest is used to list the users
+type ListUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExerciseLinkRequest) Reset() {
-	*x = DeleteExerciseLinkRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[29]
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExerciseLinkRequest) String() string {
+func (x *ListUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExerciseLinkRequest) ProtoMessage() {}
+func (*ListUsersRequest) ProtoMessage() {}
 
-func (x *DeleteExerciseLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[29]
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1971,43 +9705,34 @@ func (x *DeleteExerciseLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExerciseLinkRequest.ProtoReflect.Descriptor instead.
-func (*DeleteExerciseLinkRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *DeleteExerciseLinkRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{140}
 }
 
-// CreatePracticeSessionRequest is used to create a new practice session
-type CreatePracticeSessionRequest struct {
+// ListUsersResponse contains the users ordered by username
+type ListUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePracticeSessionRequest) Reset() {
-	*x = CreatePracticeSessionRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[30]
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePracticeSessionRequest) String() string {
+func (x *ListUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePracticeSessionRequest) ProtoMessage() {}
+func (*ListUsersResponse) ProtoMessage() {}
 
-func (x *CreatePracticeSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[30]
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,55 +9743,44 @@ func (x *CreatePracticeSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePracticeSessionRequest.ProtoReflect.Descriptor instead.
-func (*CreatePracticeSessionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *CreatePracticeSessionRequest) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *CreatePracticeSessionRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *ListUsersResponse) GetUsers() []*User {
 	if x != nil {
-		return x.EndTime
+		return x.Users
 	}
 	return nil
 }
 
-func (x *CreatePracticeSessionRequest) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
-}
-
-// GetPracticeSessionRequest is used to retrieve a specific practice session
-type GetPracticeSessionRequest struct {
+// UpdateUserRequest is used to change the roles of a user, only the teacher
+// flag can be updated
+type UpdateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPracticeSessionRequest) Reset() {
-	*x = GetPracticeSessionRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[31]
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPracticeSessionRequest) String() string {
+func (x *UpdateUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPracticeSessionRequest) ProtoMessage() {}
+func (*UpdateUserRequest) ProtoMessage() {}
 
-func (x *GetPracticeSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[31]
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2077,46 +9791,55 @@ func (x *GetPracticeSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPracticeSessionRequest.ProtoReflect.Descriptor instead.
-func (*GetPracticeSessionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *GetPracticeSessionRequest) GetId() int32 {
+func (x *UpdateUserRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// ListPracticeSessionsRequest is used to list practice sessions with pagination
-type ListPracticeSessionsRequest struct {
+func (x *UpdateUserRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteUserRequest is used to delete a user and their tokens
+type DeleteUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`     // Optional: filter by date range
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`           // Optional: filter by date range
-	ExerciseId    int32                  `protobuf:"varint,5,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"` // Optional: filter by exercise
-	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`                           // Optional: filter for active
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPracticeSessionsRequest) Reset() {
-	*x = ListPracticeSessionsRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[32]
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPracticeSessionsRequest) String() string {
+func (x *DeleteUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPracticeSessionsRequest) ProtoMessage() {}
+func (*DeleteUserRequest) ProtoMessage() {}
 
-func (x *ListPracticeSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[32]
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2127,79 +9850,42 @@ func (x *ListPracticeSessionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPracticeSessionsRequest.ProtoReflect.Descriptor instead.
-func (*ListPracticeSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *ListPracticeSessionsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListPracticeSessionsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-func (x *ListPracticeSessionsRequest) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
-}
-
-func (x *ListPracticeSessionsRequest) GetEndDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndDate
-	}
-	return nil
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *ListPracticeSessionsRequest) GetExerciseId() int32 {
+func (x *DeleteUserRequest) GetId() int32 {
 	if x != nil {
-		return x.ExerciseId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListPracticeSessionsRequest) GetActive() bool {
-	if x != nil {
-		return x.Active
-	}
-	return false
-}
-
-// ListPracticeSessionsResponse contains a list of practice sessions and
-// pagination info
-type ListPracticeSessionsResponse struct {
+// AddStudentRequest is used to make a user a student of a teacher
+type AddStudentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sessions      []*PracticeSession     `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	TeacherId     int32                  `protobuf:"varint,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	StudentId     int32                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPracticeSessionsResponse) Reset() {
-	*x = ListPracticeSessionsResponse{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[33]
+func (x *AddStudentRequest) Reset() {
+	*x = AddStudentRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPracticeSessionsResponse) String() string {
+func (x *AddStudentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPracticeSessionsResponse) ProtoMessage() {}
+func (*AddStudentRequest) ProtoMessage() {}
 
-func (x *ListPracticeSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[33]
+func (x *AddStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2210,57 +9896,50 @@ func (x *ListPracticeSessionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPracticeSessionsResponse.ProtoReflect.Descriptor instead.
-func (*ListPracticeSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *ListPracticeSessionsResponse) GetSessions() []*PracticeSession {
-	if x != nil {
-		return x.Sessions
-	}
-	return nil
+// Deprecated: Use AddStudentRequest.ProtoReflect.Descriptor instead.
+func (*AddStudentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *ListPracticeSessionsResponse) GetNextPageToken() string {
+func (x *AddStudentRequest) GetTeacherId() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.TeacherId
 	}
-	return ""
+	return 0
 }
 
-func (x *ListPracticeSessionsResponse) GetTotalCount() int32 {
+func (x *AddStudentRequest) GetStudentId() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.StudentId
 	}
 	return 0
 }
 
-// UpdatePracticeSessionRequest is used to update a practice session
-type UpdatePracticeSessionRequest struct {
+// RemoveStudentRequest is used to end the teaching of a student, along with
+// the assignments the teacher gave them
+type RemoveStudentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Session       *PracticeSession       `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	TeacherId     int32                  `protobuf:"varint,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	StudentId     int32                  `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePracticeSessionRequest) Reset() {
-	*x = UpdatePracticeSessionRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[34]
+func (x *RemoveStudentRequest) Reset() {
+	*x = RemoveStudentRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePracticeSessionRequest) String() string {
+func (x *RemoveStudentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePracticeSessionRequest) ProtoMessage() {}
+func (*RemoveStudentRequest) ProtoMessage() {}
 
-func (x *UpdatePracticeSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[34]
+func (x *RemoveStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2271,55 +9950,63 @@ func (x *UpdatePracticeSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePracticeSessionRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePracticeSessionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use RemoveStudentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStudentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *UpdatePracticeSessionRequest) GetId() int32 {
+func (x *RemoveStudentRequest) GetTeacherId() int32 {
 	if x != nil {
-		return x.Id
+		return x.TeacherId
 	}
 	return 0
 }
 
-func (x *UpdatePracticeSessionRequest) GetSession() *PracticeSession {
-	if x != nil {
-		return x.Session
-	}
-	return nil
-}
-
-func (x *UpdatePracticeSessionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *RemoveStudentRequest) GetStudentId() int32 {
 	if x != nil {
-		return x.UpdateMask
+		return x.StudentId
 	}
-	return nil
+	return 0
 }
 
-// DeletePracticeSessionRequest is used to delete a practice session
-type DeletePracticeSessionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Assignment is an exercise of a student that their teacher set a target BPM
+// for. Only practice after the assignment was given counts towards it.
+type Assignment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeacherId       int32                  `protobuf:"varint,2,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	TeacherUsername string                 `protobuf:"bytes,3,opt,name=teacher_username,json=teacherUsername,proto3" json:"teacher_username,omitempty"`
+	StudentId       int32                  `protobuf:"varint,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentUsername string                 `protobuf:"bytes,5,opt,name=student_username,json=studentUsername,proto3" json:"student_username,omitempty"`
+	ExerciseId      int32                  `protobuf:"varint,6,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"` // An exercise of the student
+	ExerciseName    string                 `protobuf:"bytes,7,opt,name=exercise_name,json=exerciseName,proto3" json:"exercise_name,omitempty"`
+	TargetBpm       int32                  `protobuf:"varint,8,opt,name=target_bpm,json=targetBpm,proto3" json:"target_bpm,omitempty"`
+	DueDate         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"` // Optional
+	Notes           string                 `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status          AssignmentStatus       `protobuf:"varint,13,opt,name=status,proto3,enum=drummer.v1.AssignmentStatus" json:"status,omitempty"` // Output only
+	BestBpm         int32                  `protobuf:"varint,14,opt,name=best_bpm,json=bestBpm,proto3" json:"best_bpm,omitempty"`                 // Output only: highest BPM practiced since assigned
+	LastPractice    *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=last_practice,json=lastPractice,proto3" json:"last_practice,omitempty"`   // Output only: latest practice since assigned
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
-
-func (x *DeletePracticeSessionRequest) Reset() {
-	*x = DeletePracticeSessionRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[35]
+
+func (x *Assignment) Reset() {
+	*x = Assignment{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePracticeSessionRequest) String() string {
+func (x *Assignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePracticeSessionRequest) ProtoMessage() {}
+func (*Assignment) ProtoMessage() {}
 
-func (x *DeletePracticeSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[35]
+func (x *Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2330,151 +10017,145 @@ func (x *DeletePracticeSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePracticeSessionRequest.ProtoReflect.Descriptor instead.
-func (*DeletePracticeSessionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
+func (*Assignment) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *DeletePracticeSessionRequest) GetId() int32 {
+func (x *Assignment) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// CreateExerciseHistoryRequest is used to create a new exercise history entry
-type CreateExerciseHistoryRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ExerciseId      int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
-	StartTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Bpms            []int32                `protobuf:"varint,4,rep,packed,name=bpms,proto3" json:"bpms,omitempty"`
-	TimeSignature   string                 `protobuf:"bytes,5,opt,name=time_signature,json=timeSignature,proto3" json:"time_signature,omitempty"`
-	Notes           string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
-	Rating          int32                  `protobuf:"varint,7,opt,name=rating,proto3" json:"rating,omitempty"`
-	SessionId       int32                  `protobuf:"varint,8,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	DurationSeconds int32                  `protobuf:"varint,9,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *CreateExerciseHistoryRequest) Reset() {
-	*x = CreateExerciseHistoryRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Assignment) GetTeacherId() int32 {
+	if x != nil {
+		return x.TeacherId
+	}
+	return 0
 }
 
-func (x *CreateExerciseHistoryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Assignment) GetTeacherUsername() string {
+	if x != nil {
+		return x.TeacherUsername
+	}
+	return ""
 }
 
-func (*CreateExerciseHistoryRequest) ProtoMessage() {}
-
-func (x *CreateExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[36]
+func (x *Assignment) GetStudentId() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StudentId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateExerciseHistoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateExerciseHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{36}
+func (x *Assignment) GetStudentUsername() string {
+	if x != nil {
+		return x.StudentUsername
+	}
+	return ""
 }
 
-func (x *CreateExerciseHistoryRequest) GetExerciseId() int32 {
+func (x *Assignment) GetExerciseId() int32 {
 	if x != nil {
 		return x.ExerciseId
 	}
 	return 0
 }
 
-func (x *CreateExerciseHistoryRequest) GetStartTime() *timestamppb.Timestamp {
+func (x *Assignment) GetExerciseName() string {
 	if x != nil {
-		return x.StartTime
+		return x.ExerciseName
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateExerciseHistoryRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *Assignment) GetTargetBpm() int32 {
 	if x != nil {
-		return x.EndTime
+		return x.TargetBpm
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateExerciseHistoryRequest) GetBpms() []int32 {
+func (x *Assignment) GetDueDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Bpms
+		return x.DueDate
 	}
 	return nil
 }
 
-func (x *CreateExerciseHistoryRequest) GetTimeSignature() string {
+func (x *Assignment) GetNotes() string {
 	if x != nil {
-		return x.TimeSignature
+		return x.Notes
 	}
 	return ""
 }
 
-func (x *CreateExerciseHistoryRequest) GetNotes() string {
+func (x *Assignment) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Notes
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateExerciseHistoryRequest) GetRating() int32 {
+func (x *Assignment) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Rating
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateExerciseHistoryRequest) GetSessionId() int32 {
+func (x *Assignment) GetStatus() AssignmentStatus {
 	if x != nil {
-		return x.SessionId
+		return x.Status
 	}
-	return 0
+	return AssignmentStatus_ASSIGNMENT_STATUS_UNSPECIFIED
 }
 
-func (x *CreateExerciseHistoryRequest) GetDurationSeconds() int32 {
+func (x *Assignment) GetBestBpm() int32 {
 	if x != nil {
-		return x.DurationSeconds
+		return x.BestBpm
 	}
 	return 0
 }
 
-// GetExerciseHistoryRequest is used to retrieve a specific exercise history
-// entry
-type GetExerciseHistoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Assignment) GetLastPractice() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPractice
+	}
+	return nil
 }
 
-func (x *GetExerciseHistoryRequest) Reset() {
-	*x = GetExerciseHistoryRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[37]
+// HistoryComment is a comment on an exercise history entry, by the student
+// who practiced or by a teacher who assigned them the exercise
+type HistoryComment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HistoryId      int32                  `protobuf:"varint,2,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`
+	AuthorId       int32                  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorUsername string                 `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	Text           string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HistoryComment) Reset() {
+	*x = HistoryComment{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExerciseHistoryRequest) String() string {
+func (x *HistoryComment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExerciseHistoryRequest) ProtoMessage() {}
+func (*HistoryComment) ProtoMessage() {}
 
-func (x *GetExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[37]
+func (x *HistoryComment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2485,47 +10166,81 @@ func (x *GetExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExerciseHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetExerciseHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use HistoryComment.ProtoReflect.Descriptor instead.
+func (*HistoryComment) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *GetExerciseHistoryRequest) GetId() int32 {
+func (x *HistoryComment) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// ListExerciseHistoryRequest is used to list exercise history entries with
-// pagination
-type ListExerciseHistoryRequest struct {
+func (x *HistoryComment) GetHistoryId() int32 {
+	if x != nil {
+		return x.HistoryId
+	}
+	return 0
+}
+
+func (x *HistoryComment) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *HistoryComment) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *HistoryComment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *HistoryComment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateAssignmentRequest is used by a teacher to assign an exercise to one
+// of their students
+type CreateAssignmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	ExerciseId    int32                  `protobuf:"varint,3,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"` // Optional: filter by exercise
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`     // Optional: filter by date range
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`           // Optional: filter by date range
-	SessionId     int32                  `protobuf:"varint,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`    // Optional: filter by session
+	StudentId     int32                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	ExerciseId    int32                  `protobuf:"varint,2,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"` // An exercise of the student
+	TargetBpm     int32                  `protobuf:"varint,3,opt,name=target_bpm,json=targetBpm,proto3" json:"target_bpm,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"` // Optional
+	Notes         string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExerciseHistoryRequest) Reset() {
-	*x = ListExerciseHistoryRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[38]
+func (x *CreateAssignmentRequest) Reset() {
+	*x = CreateAssignmentRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExerciseHistoryRequest) String() string {
+func (x *CreateAssignmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExerciseHistoryRequest) ProtoMessage() {}
+func (*CreateAssignmentRequest) ProtoMessage() {}
 
-func (x *ListExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[38]
+func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2536,79 +10251,70 @@ func (x *ListExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExerciseHistoryRequest.ProtoReflect.Descriptor instead.
-func (*ListExerciseHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use CreateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *ListExerciseHistoryRequest) GetPageSize() int32 {
+func (x *CreateAssignmentRequest) GetStudentId() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.StudentId
 	}
 	return 0
 }
 
-func (x *ListExerciseHistoryRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-func (x *ListExerciseHistoryRequest) GetExerciseId() int32 {
+func (x *CreateAssignmentRequest) GetExerciseId() int32 {
 	if x != nil {
 		return x.ExerciseId
 	}
 	return 0
 }
 
-func (x *ListExerciseHistoryRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *CreateAssignmentRequest) GetTargetBpm() int32 {
 	if x != nil {
-		return x.StartDate
+		return x.TargetBpm
 	}
-	return nil
+	return 0
 }
 
-func (x *ListExerciseHistoryRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *CreateAssignmentRequest) GetDueDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EndDate
+		return x.DueDate
 	}
 	return nil
 }
 
-func (x *ListExerciseHistoryRequest) GetSessionId() int32 {
+func (x *CreateAssignmentRequest) GetNotes() string {
 	if x != nil {
-		return x.SessionId
+		return x.Notes
 	}
-	return 0
+	return ""
 }
 
-// ListExerciseHistoryResponse contains a list of exercise history entries and
-// pagination info
-type ListExerciseHistoryResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	HistoryEntries []*ExerciseHistory     `protobuf:"bytes,1,rep,name=history_entries,json=historyEntries,proto3" json:"history_entries,omitempty"`
-	NextPageToken  string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount     int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// GetAssignmentRequest is used to get an assignment given to or by the
+// authenticated user
+type GetAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExerciseHistoryResponse) Reset() {
-	*x = ListExerciseHistoryResponse{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[39]
+func (x *GetAssignmentRequest) Reset() {
+	*x = GetAssignmentRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExerciseHistoryResponse) String() string {
+func (x *GetAssignmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExerciseHistoryResponse) ProtoMessage() {}
+func (*GetAssignmentRequest) ProtoMessage() {}
 
-func (x *ListExerciseHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[39]
+func (x *GetAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2619,57 +10325,48 @@ func (x *ListExerciseHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExerciseHistoryResponse.ProtoReflect.Descriptor instead.
-func (*ListExerciseHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *ListExerciseHistoryResponse) GetHistoryEntries() []*ExerciseHistory {
-	if x != nil {
-		return x.HistoryEntries
-	}
-	return nil
-}
-
-func (x *ListExerciseHistoryResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
+// Deprecated: Use GetAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *ListExerciseHistoryResponse) GetTotalCount() int32 {
+func (x *GetAssignmentRequest) GetId() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.Id
 	}
 	return 0
 }
 
-// UpdateExerciseHistoryRequest is used to update an exercise history entry
-type UpdateExerciseHistoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	History       *ExerciseHistory       `protobuf:"bytes,2,opt,name=history,proto3" json:"history,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+// ListAssignmentsRequest is used to list assignments, most recent first
+type ListAssignmentsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PageSize          int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken         string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	IncludeTotalCount bool                   `protobuf:"varint,3,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Count the whole listing in total_count, an extra query
+	// List the assignments the authenticated teacher gave instead of those
+	// given to the authenticated user
+	Given         bool             `protobuf:"varint,4,opt,name=given,proto3" json:"given,omitempty"`
+	StudentId     int32            `protobuf:"varint,5,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`           // Optional with given: filter by student
+	Status        AssignmentStatus `protobuf:"varint,6,opt,name=status,proto3,enum=drummer.v1.AssignmentStatus" json:"status,omitempty"` // Optional: filter by status
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExerciseHistoryRequest) Reset() {
-	*x = UpdateExerciseHistoryRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[40]
+func (x *ListAssignmentsRequest) Reset() {
+	*x = ListAssignmentsRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExerciseHistoryRequest) String() string {
+func (x *ListAssignmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExerciseHistoryRequest) ProtoMessage() {}
+func (*ListAssignmentsRequest) ProtoMessage() {}
 
-func (x *UpdateExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[40]
+func (x *ListAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2680,55 +10377,78 @@ func (x *UpdateExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExerciseHistoryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExerciseHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ListAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *UpdateExerciseHistoryRequest) GetId() int32 {
+func (x *ListAssignmentsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Id
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *UpdateExerciseHistoryRequest) GetHistory() *ExerciseHistory {
+func (x *ListAssignmentsRequest) GetPageToken() string {
 	if x != nil {
-		return x.History
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateExerciseHistoryRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *ListAssignmentsRequest) GetIncludeTotalCount() bool {
 	if x != nil {
-		return x.UpdateMask
+		return x.IncludeTotalCount
 	}
-	return nil
+	return false
 }
 
-// DeleteExerciseHistoryRequest is used to delete an exercise history entry
-type DeleteExerciseHistoryRequest struct {
+func (x *ListAssignmentsRequest) GetGiven() bool {
+	if x != nil {
+		return x.Given
+	}
+	return false
+}
+
+func (x *ListAssignmentsRequest) GetStudentId() int32 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *ListAssignmentsRequest) GetStatus() AssignmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AssignmentStatus_ASSIGNMENT_STATUS_UNSPECIFIED
+}
+
+// ListAssignmentsResponse contains a list of assignments and pagination info
+type ListAssignmentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Assignments   []*Assignment          `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Only set with include_total_count
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExerciseHistoryRequest) Reset() {
-	*x = DeleteExerciseHistoryRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[41]
+func (x *ListAssignmentsResponse) Reset() {
+	*x = ListAssignmentsResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExerciseHistoryRequest) String() string {
+func (x *ListAssignmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExerciseHistoryRequest) ProtoMessage() {}
+func (*ListAssignmentsResponse) ProtoMessage() {}
 
-func (x *DeleteExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[41]
+func (x *ListAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2739,43 +10459,58 @@ func (x *DeleteExerciseHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExerciseHistoryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteExerciseHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ListAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *DeleteExerciseHistoryRequest) GetId() int32 {
+func (x *ListAssignmentsResponse) GetAssignments() []*Assignment {
 	if x != nil {
-		return x.Id
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *ListAssignmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAssignmentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
 	}
 	return 0
 }
 
-// GetExerciseStatsRequest is used to get statistics for an exercise
-type GetExerciseStatsRequest struct {
+// UpdateAssignmentRequest is used by a teacher to update an assignment they
+// gave
+type UpdateAssignmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExerciseId    int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Optional: filter by date range
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // Optional: filter by date range
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Assignment    *Assignment            `protobuf:"bytes,2,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExerciseStatsRequest) Reset() {
-	*x = GetExerciseStatsRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[42]
+func (x *UpdateAssignmentRequest) Reset() {
+	*x = UpdateAssignmentRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExerciseStatsRequest) String() string {
+func (x *UpdateAssignmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExerciseStatsRequest) ProtoMessage() {}
+func (*UpdateAssignmentRequest) ProtoMessage() {}
 
-func (x *GetExerciseStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[42]
+func (x *UpdateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2786,63 +10521,56 @@ func (x *GetExerciseStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExerciseStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetExerciseStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use UpdateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *GetExerciseStatsRequest) GetExerciseId() int32 {
+func (x *UpdateAssignmentRequest) GetId() int32 {
 	if x != nil {
-		return x.ExerciseId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetExerciseStatsRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *UpdateAssignmentRequest) GetAssignment() *Assignment {
 	if x != nil {
-		return x.StartDate
+		return x.Assignment
 	}
 	return nil
 }
 
-func (x *GetExerciseStatsRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *UpdateAssignmentRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.EndDate
+		return x.UpdateMask
 	}
 	return nil
 }
 
-// ExerciseStats contains statistics for an exercise
-type ExerciseStats struct {
-	state                        protoimpl.MessageState `protogen:"open.v1"`
-	ExerciseId                   int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
-	ExerciseName                 string                 `protobuf:"bytes,2,opt,name=exercise_name,json=exerciseName,proto3" json:"exercise_name,omitempty"`
-	PracticeCount                int32                  `protobuf:"varint,3,opt,name=practice_count,json=practiceCount,proto3" json:"practice_count,omitempty"`
-	TotalPracticeDurationSeconds int32                  `protobuf:"varint,4,opt,name=total_practice_duration_seconds,json=totalPracticeDurationSeconds,proto3" json:"total_practice_duration_seconds,omitempty"`
-	AvgRating                    float64                `protobuf:"fixed64,5,opt,name=avg_rating,json=avgRating,proto3" json:"avg_rating,omitempty"`
-	MaxBpm                       int32                  `protobuf:"varint,6,opt,name=max_bpm,json=maxBpm,proto3" json:"max_bpm,omitempty"`
-	MinBpm                       int32                  `protobuf:"varint,7,opt,name=min_bpm,json=minBpm,proto3" json:"min_bpm,omitempty"`
-	AvgBpm                       float64                `protobuf:"fixed64,8,opt,name=avg_bpm,json=avgBpm,proto3" json:"avg_bpm,omitempty"`
-	BpmProgress                  []*BpmProgressPoint    `protobuf:"bytes,9,rep,name=bpm_progress,json=bpmProgress,proto3" json:"bpm_progress,omitempty"`
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+// DeleteAssignmentRequest is used by a teacher to delete an assignment they
+// gave
+type DeleteAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExerciseStats) Reset() {
-	*x = ExerciseStats{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[43]
+func (x *DeleteAssignmentRequest) Reset() {
+	*x = DeleteAssignmentRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExerciseStats) String() string {
+func (x *DeleteAssignmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExerciseStats) ProtoMessage() {}
+func (*DeleteAssignmentRequest) ProtoMessage() {}
 
-func (x *ExerciseStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[43]
+func (x *DeleteAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2853,98 +10581,89 @@ func (x *ExerciseStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExerciseStats.ProtoReflect.Descriptor instead.
-func (*ExerciseStats) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use DeleteAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *ExerciseStats) GetExerciseId() int32 {
+func (x *DeleteAssignmentRequest) GetId() int32 {
 	if x != nil {
-		return x.ExerciseId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ExerciseStats) GetExerciseName() string {
-	if x != nil {
-		return x.ExerciseName
-	}
-	return ""
+// ListAssignmentHistoryRequest is used to review the practice of an
+// assignment
+type ListAssignmentHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  int32                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExerciseStats) GetPracticeCount() int32 {
-	if x != nil {
-		return x.PracticeCount
-	}
-	return 0
+func (x *ListAssignmentHistoryRequest) Reset() {
+	*x = ListAssignmentHistoryRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExerciseStats) GetTotalPracticeDurationSeconds() int32 {
-	if x != nil {
-		return x.TotalPracticeDurationSeconds
-	}
-	return 0
+func (x *ListAssignmentHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ExerciseStats) GetAvgRating() float64 {
-	if x != nil {
-		return x.AvgRating
-	}
-	return 0
-}
+func (*ListAssignmentHistoryRequest) ProtoMessage() {}
 
-func (x *ExerciseStats) GetMaxBpm() int32 {
+func (x *ListAssignmentHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[154]
 	if x != nil {
-		return x.MaxBpm
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ExerciseStats) GetMinBpm() int32 {
-	if x != nil {
-		return x.MinBpm
-	}
-	return 0
+// Deprecated: Use ListAssignmentHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *ExerciseStats) GetAvgBpm() float64 {
+func (x *ListAssignmentHistoryRequest) GetAssignmentId() int32 {
 	if x != nil {
-		return x.AvgBpm
+		return x.AssignmentId
 	}
 	return 0
 }
 
-func (x *ExerciseStats) GetBpmProgress() []*BpmProgressPoint {
-	if x != nil {
-		return x.BpmProgress
-	}
-	return nil
-}
-
-// BpmProgressPoint represents a point in the BPM progress chart
-type BpmProgressPoint struct {
+// ListAssignmentHistoryResponse contains all the history of the student for
+// the assigned exercise, most recent first, and the comments on it
+type ListAssignmentHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Bpm           int32                  `protobuf:"varint,2,opt,name=bpm,proto3" json:"bpm,omitempty"`
+	Entries       []*ExerciseHistory     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Comments      []*HistoryComment      `protobuf:"bytes,2,rep,name=comments,proto3" json:"comments,omitempty"` // Oldest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BpmProgressPoint) Reset() {
-	*x = BpmProgressPoint{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[44]
+func (x *ListAssignmentHistoryResponse) Reset() {
+	*x = ListAssignmentHistoryResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BpmProgressPoint) String() string {
+func (x *ListAssignmentHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BpmProgressPoint) ProtoMessage() {}
+func (*ListAssignmentHistoryResponse) ProtoMessage() {}
 
-func (x *BpmProgressPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[44]
+func (x *ListAssignmentHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2955,50 +10674,49 @@ func (x *BpmProgressPoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BpmProgressPoint.ProtoReflect.Descriptor instead.
-func (*BpmProgressPoint) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ListAssignmentHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListAssignmentHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *BpmProgressPoint) GetDate() *timestamppb.Timestamp {
+func (x *ListAssignmentHistoryResponse) GetEntries() []*ExerciseHistory {
 	if x != nil {
-		return x.Date
+		return x.Entries
 	}
 	return nil
 }
 
-func (x *BpmProgressPoint) GetBpm() int32 {
+func (x *ListAssignmentHistoryResponse) GetComments() []*HistoryComment {
 	if x != nil {
-		return x.Bpm
+		return x.Comments
 	}
-	return 0
+	return nil
 }
 
-// GetPracticeStatsRequest is used to get statistics for practice sessions
-type GetPracticeStatsRequest struct {
+// AddHistoryCommentRequest is used to comment on an exercise history entry
+type AddHistoryCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`     // Optional: filter by date range
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`           // Optional: filter by date range
-	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Optional: filter by category
+	HistoryId     int32                  `protobuf:"varint,1,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPracticeStatsRequest) Reset() {
-	*x = GetPracticeStatsRequest{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[45]
+func (x *AddHistoryCommentRequest) Reset() {
+	*x = AddHistoryCommentRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPracticeStatsRequest) String() string {
+func (x *AddHistoryCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPracticeStatsRequest) ProtoMessage() {}
+func (*AddHistoryCommentRequest) ProtoMessage() {}
 
-func (x *GetPracticeStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[45]
+func (x *AddHistoryCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3009,60 +10727,49 @@ func (x *GetPracticeStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPracticeStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetPracticeStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *GetPracticeStatsRequest) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
+// Deprecated: Use AddHistoryCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddHistoryCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *GetPracticeStatsRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *AddHistoryCommentRequest) GetHistoryId() int32 {
 	if x != nil {
-		return x.EndDate
+		return x.HistoryId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetPracticeStatsRequest) GetCategoryId() int32 {
+func (x *AddHistoryCommentRequest) GetText() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-// PracticeStats contains statistics for practice sessions
-type PracticeStats struct {
-	state                     protoimpl.MessageState      `protogen:"open.v1"`
-	TotalSessions             int32                       `protobuf:"varint,1,opt,name=total_sessions,json=totalSessions,proto3" json:"total_sessions,omitempty"`
-	TotalDurationSeconds      int32                       `protobuf:"varint,2,opt,name=total_duration_seconds,json=totalDurationSeconds,proto3" json:"total_duration_seconds,omitempty"`
-	AvgSessionDurationSeconds float64                     `protobuf:"fixed64,3,opt,name=avg_session_duration_seconds,json=avgSessionDurationSeconds,proto3" json:"avg_session_duration_seconds,omitempty"`
-	ExerciseDistribution      []*ExerciseTimeDistribution `protobuf:"bytes,4,rep,name=exercise_distribution,json=exerciseDistribution,proto3" json:"exercise_distribution,omitempty"`
-	CategoryDistribution      []*CategoryTimeDistribution `protobuf:"bytes,5,rep,name=category_distribution,json=categoryDistribution,proto3" json:"category_distribution,omitempty"`
-	PracticeFrequency         []*PracticeTimePoint        `protobuf:"bytes,6,rep,name=practice_frequency,json=practiceFrequency,proto3" json:"practice_frequency,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+// DeleteHistoryCommentRequest is used to delete a comment of the
+// authenticated user
+type DeleteHistoryCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PracticeStats) Reset() {
-	*x = PracticeStats{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[46]
+func (x *DeleteHistoryCommentRequest) Reset() {
+	*x = DeleteHistoryCommentRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PracticeStats) String() string {
+func (x *DeleteHistoryCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PracticeStats) ProtoMessage() {}
+func (*DeleteHistoryCommentRequest) ProtoMessage() {}
 
-func (x *PracticeStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[46]
+func (x *DeleteHistoryCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3073,79 +10780,41 @@ func (x *PracticeStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PracticeStats.ProtoReflect.Descriptor instead.
-func (*PracticeStats) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *PracticeStats) GetTotalSessions() int32 {
-	if x != nil {
-		return x.TotalSessions
-	}
-	return 0
-}
-
-func (x *PracticeStats) GetTotalDurationSeconds() int32 {
-	if x != nil {
-		return x.TotalDurationSeconds
-	}
-	return 0
+// Deprecated: Use DeleteHistoryCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHistoryCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *PracticeStats) GetAvgSessionDurationSeconds() float64 {
+func (x *DeleteHistoryCommentRequest) GetId() int32 {
 	if x != nil {
-		return x.AvgSessionDurationSeconds
+		return x.Id
 	}
 	return 0
 }
 
-func (x *PracticeStats) GetExerciseDistribution() []*ExerciseTimeDistribution {
-	if x != nil {
-		return x.ExerciseDistribution
-	}
-	return nil
-}
-
-func (x *PracticeStats) GetCategoryDistribution() []*CategoryTimeDistribution {
-	if x != nil {
-		return x.CategoryDistribution
-	}
-	return nil
-}
-
-func (x *PracticeStats) GetPracticeFrequency() []*PracticeTimePoint {
-	if x != nil {
-		return x.PracticeFrequency
-	}
-	return nil
-}
-
-// ExerciseTimeDistribution shows how much time was spent on each exercise
-type ExerciseTimeDistribution struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ExerciseId      int32                  `protobuf:"varint,1,opt,name=exercise_id,json=exerciseId,proto3" json:"exercise_id,omitempty"`
-	ExerciseName    string                 `protobuf:"bytes,2,opt,name=exercise_name,json=exerciseName,proto3" json:"exercise_name,omitempty"`
-	DurationSeconds int32                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
-	Percentage      float64                `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// ListStudentsRequest is used to list the students of the authenticated
+// teacher
+type ListStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExerciseTimeDistribution) Reset() {
-	*x = ExerciseTimeDistribution{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[47]
+func (x *ListStudentsRequest) Reset() {
+	*x = ListStudentsRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExerciseTimeDistribution) String() string {
+func (x *ListStudentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExerciseTimeDistribution) ProtoMessage() {}
+func (*ListStudentsRequest) ProtoMessage() {}
 
-func (x *ExerciseTimeDistribution) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[47]
+func (x *ListStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3156,66 +10825,83 @@ func (x *ExerciseTimeDistribution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExerciseTimeDistribution.ProtoReflect.Descriptor instead.
-func (*ExerciseTimeDistribution) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListStudentsRequest.ProtoReflect.Descriptor instead.
+func (*ListStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *ExerciseTimeDistribution) GetExerciseId() int32 {
-	if x != nil {
-		return x.ExerciseId
-	}
-	return 0
+// ListStudentsResponse contains the students ordered by username
+type ListStudentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*User                `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExerciseTimeDistribution) GetExerciseName() string {
-	if x != nil {
-		return x.ExerciseName
-	}
-	return ""
+func (x *ListStudentsResponse) Reset() {
+	*x = ListStudentsResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExerciseTimeDistribution) GetDurationSeconds() int32 {
+func (x *ListStudentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudentsResponse) ProtoMessage() {}
+
+func (x *ListStudentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[159]
 	if x != nil {
-		return x.DurationSeconds
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ExerciseTimeDistribution) GetPercentage() float64 {
+// Deprecated: Use ListStudentsResponse.ProtoReflect.Descriptor instead.
+func (*ListStudentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *ListStudentsResponse) GetStudents() []*User {
 	if x != nil {
-		return x.Percentage
+		return x.Students
 	}
-	return 0
+	return nil
 }
 
-// CategoryTimeDistribution shows how much time was spent on each category
-type CategoryTimeDistribution struct {
+// ListStudentExercisesRequest is used by a teacher to list the exercises of a
+// student, to assign one of them
+type ListStudentExercisesRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId        int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	CategoryName      string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	DurationSeconds   int32                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
-	Percentage        float64                `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	PracticeFrequency []*PracticeTimePoint   `protobuf:"bytes,5,rep,name=practice_frequency,json=practiceFrequency,proto3" json:"practice_frequency,omitempty"`
+	StudentId         int32                  `protobuf:"varint,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	PageSize          int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken         string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	IncludeTotalCount bool                   `protobuf:"varint,4,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Count the whole listing in total_count, an extra query
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *CategoryTimeDistribution) Reset() {
-	*x = CategoryTimeDistribution{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[48]
+func (x *ListStudentExercisesRequest) Reset() {
+	*x = ListStudentExercisesRequest{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryTimeDistribution) String() string {
+func (x *ListStudentExercisesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryTimeDistribution) ProtoMessage() {}
+func (*ListStudentExercisesRequest) ProtoMessage() {}
 
-func (x *CategoryTimeDistribution) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[48]
+func (x *ListStudentExercisesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3226,70 +10912,65 @@ func (x *CategoryTimeDistribution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryTimeDistribution.ProtoReflect.Descriptor instead.
-func (*CategoryTimeDistribution) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ListStudentExercisesRequest.ProtoReflect.Descriptor instead.
+func (*ListStudentExercisesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *CategoryTimeDistribution) GetCategoryId() int32 {
+func (x *ListStudentExercisesRequest) GetStudentId() int32 {
 	if x != nil {
-		return x.CategoryId
+		return x.StudentId
 	}
 	return 0
 }
 
-func (x *CategoryTimeDistribution) GetCategoryName() string {
-	if x != nil {
-		return x.CategoryName
-	}
-	return ""
-}
-
-func (x *CategoryTimeDistribution) GetDurationSeconds() int32 {
+func (x *ListStudentExercisesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.DurationSeconds
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *CategoryTimeDistribution) GetPercentage() float64 {
+func (x *ListStudentExercisesRequest) GetPageToken() string {
 	if x != nil {
-		return x.Percentage
+		return x.PageToken
 	}
-	return 0
+	return ""
 }
 
-func (x *CategoryTimeDistribution) GetPracticeFrequency() []*PracticeTimePoint {
+func (x *ListStudentExercisesRequest) GetIncludeTotalCount() bool {
 	if x != nil {
-		return x.PracticeFrequency
+		return x.IncludeTotalCount
 	}
-	return nil
+	return false
 }
 
-// PracticeTimePoint represents a point in the practice frequency chart
-type PracticeTimePoint struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Date            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	DurationSeconds int32                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// ListStudentExercisesResponse contains the exercises of the student ordered
+// by name
+type ListStudentExercisesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exercises     []*Exercise            `protobuf:"bytes,1,rep,name=exercises,proto3" json:"exercises,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Only set with include_total_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PracticeTimePoint) Reset() {
-	*x = PracticeTimePoint{}
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[49]
+func (x *ListStudentExercisesResponse) Reset() {
+	*x = ListStudentExercisesResponse{}
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PracticeTimePoint) String() string {
+func (x *ListStudentExercisesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PracticeTimePoint) ProtoMessage() {}
+func (*ListStudentExercisesResponse) ProtoMessage() {}
 
-func (x *PracticeTimePoint) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_tempus_tempus_proto_msgTypes[49]
+func (x *ListStudentExercisesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tempus_tempus_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3300,21 +10981,28 @@ func (x *PracticeTimePoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PracticeTimePoint.ProtoReflect.Descriptor instead.
-func (*PracticeTimePoint) Descriptor() ([]byte, []int) {
-	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ListStudentExercisesResponse.ProtoReflect.Descriptor instead.
+func (*ListStudentExercisesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tempus_tempus_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *PracticeTimePoint) GetDate() *timestamppb.Timestamp {
+func (x *ListStudentExercisesResponse) GetExercises() []*Exercise {
 	if x != nil {
-		return x.Date
+		return x.Exercises
 	}
 	return nil
 }
 
-func (x *PracticeTimePoint) GetDurationSeconds() int32 {
+func (x *ListStudentExercisesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.DurationSeconds
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListStudentExercisesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
 	}
 	return 0
 }
@@ -3324,7 +11012,7 @@ var File_api_v1_tempus_tempus_proto protoreflect.FileDescriptor
 const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\n" +
 	"\x1aapi/v1/tempus/tempus.proto\x12\n" +
-	"drummer.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xc6\x01\n" +
+	"drummer.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xfa\x01\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -3332,13 +11020,14 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x87\x01\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x122\n" +
+	"\x15weekly_target_minutes\x18\x06 \x01(\x05R\x13weeklyTargetMinutes\"\x87\x01\n" +
 	"\x03Tag\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
-	"\fcategory_ids\x18\x04 \x03(\x05R\vcategoryIds\"\xe2\x03\n" +
+	"\fcategory_ids\x18\x04 \x03(\x05R\vcategoryIds\"\x96\x05\n" +
 	"\bExercise\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -3355,7 +11044,34 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\flastPractice\x12\x1b\n" +
 	"\tlast_bpms\x18\v \x03(\x05R\blastBpms\x12\x1d\n" +
 	"\n" +
-	"last_notes\x18\f \x01(\tR\tlastNotes\"\xf5\x01\n" +
+	"last_notes\x18\f \x01(\tR\tlastNotes\x124\n" +
+	"\n" +
+	"tempo_plan\x18\r \x01(\v2\x15.drummer.v1.TempoPlanR\ttempoPlan\x12#\n" +
+	"\rsuggested_bpm\x18\x0e \x01(\x05R\fsuggestedBpm\x12:\n" +
+	"\tnotations\x18\x0f \x03(\v2\x1c.drummer.v1.ExerciseNotationR\tnotations\x12\x1b\n" +
+	"\tpack_name\x18\x10 \x01(\tR\bpackName\"\x8c\x02\n" +
+	"\tTempoPlan\x12\x1b\n" +
+	"\tstart_bpm\x18\x01 \x01(\x05R\bstartBpm\x12\x17\n" +
+	"\aend_bpm\x18\x02 \x01(\x05R\x06endBpm\x12\x1c\n" +
+	"\tincrement\x18\x03 \x01(\x05R\tincrement\x12\"\n" +
+	"\rbars_per_step\x18\x04 \x01(\x05R\vbarsPerStep\x12%\n" +
+	"\x0etime_signature\x18\x05 \x01(\tR\rtimeSignature\x129\n" +
+	"\vsubdivision\x18\x06 \x01(\x0e2\x17.drummer.v1.SubdivisionR\vsubdivision\x12%\n" +
+	"\x0eaccent_pattern\x18\a \x01(\tR\raccentPattern\"\xdd\x02\n" +
+	"\x10ExerciseNotation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vexercise_id\x18\x02 \x01(\x05R\n" +
+	"exerciseId\x122\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x1a.drummer.v1.NotationFormatR\x06format\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12\x1a\n" +
+	"\bfilename\x18\x05 \x01(\tR\bfilename\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12%\n" +
+	"\x0etime_signature\x18\a \x01(\tR\rtimeSignature\x12#\n" +
+	"\rmeasure_count\x18\b \x01(\x05R\fmeasureCount\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x17\n" +
+	"\asvg_url\x18\n" +
+	" \x01(\tR\x06svgUrl\"\xbb\x03\n" +
 	"\rExerciseImage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vexercise_id\x18\x02 \x01(\x05R\n" +
@@ -3366,7 +11082,24 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\tmime_type\x18\x05 \x01(\tR\bmimeType\x12 \n" +
 	"\vdescription\x18\x06 \x01(\tR\vdescription\x129\n" +
 	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xae\x01\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fcontent_hash\x18\b \x01(\tR\vcontentHash\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\t \x01(\x03R\tsizeBytes\x12\x10\n" +
+	"\x03url\x18\n" +
+	" \x01(\tR\x03url\x12\x14\n" +
+	"\x05width\x18\v \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\f \x01(\x05R\x06height\x12B\n" +
+	"\n" +
+	"thumbnails\x18\r \x03(\v2\".drummer.v1.ExerciseImageThumbnailR\n" +
+	"thumbnails\"\xac\x01\n" +
+	"\x16ExerciseImageThumbnail\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\tR\x04size\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\x12!\n" +
+	"\fcontent_hash\x18\x05 \x01(\tR\vcontentHash\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\"\xae\x01\n" +
 	"\fExerciseLink\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vexercise_id\x18\x02 \x01(\x05R\n" +
@@ -3374,7 +11107,7 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\x03url\x18\x03 \x01(\tR\x03url\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf2\x02\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9b\x04\n" +
 	"\x0fPracticeSession\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x129\n" +
 	"\n" +
@@ -3386,7 +11119,18 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
 	"\texercises\x18\a \x03(\v2\x1b.drummer.v1.ExerciseHistoryR\texercises\x12\x16\n" +
-	"\x06active\x18\b \x01(\bR\x06active\"\x99\x03\n" +
+	"\x06active\x18\b \x01(\bR\x06active\x12)\n" +
+	"\x10duration_seconds\x18\t \x01(\x05R\x0fdurationSeconds\x12\x16\n" +
+	"\x06paused\x18\n" +
+	" \x01(\bR\x06paused\x12,\n" +
+	"\x12current_history_id\x18\v \x01(\x05R\x10currentHistoryId\x126\n" +
+	"\bsegments\x18\f \x03(\v2\x1a.drummer.v1.SessionSegmentR\bsegments\"\xa1\x01\n" +
+	"\x0eSessionSegment\x12\x1d\n" +
+	"\n" +
+	"history_id\x18\x01 \x01(\x05R\thistoryId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\x84\x04\n" +
 	"\x0fExerciseHistory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vexercise_id\x18\x02 \x01(\x05R\n" +
@@ -3402,16 +11146,71 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\n" +
 	"session_id\x18\n" +
 	" \x01(\x05R\tsessionId\x12)\n" +
-	"\x10duration_seconds\x18\v \x01(\x05R\x0fdurationSeconds\"M\n" +
+	"\x10duration_seconds\x18\v \x01(\x05R\x0fdurationSeconds\x12#\n" +
+	"\rsuggested_bpm\x18\f \x01(\x05R\fsuggestedBpm\x12D\n" +
+	"\n" +
+	"recordings\x18\r \x03(\v2$.drummer.v1.ExerciseHistoryRecordingR\n" +
+	"recordings\"\xc9\x02\n" +
+	"\x18ExerciseHistoryRecording\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"history_id\x18\x02 \x01(\x05R\thistoryId\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x1b\n" +
+	"\tmime_type\x18\x04 \x01(\tR\bmimeType\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x05R\x0fdurationSeconds\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x03R\tsizeBytes\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fdownload_url\x18\b \x01(\tR\vdownloadUrl\x12\x1d\n" +
+	"\n" +
+	"audio_data\x18\t \x01(\fR\taudioData\"\xed\x02\n" +
+	"\x04Goal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vexercise_id\x18\x02 \x01(\x05R\n" +
+	"exerciseId\x12\x1d\n" +
+	"\n" +
+	"target_bpm\x18\x03 \x01(\x05R\ttargetBpm\x12;\n" +
+	"\vtarget_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"targetDate\x12%\n" +
+	"\x0etime_signature\x18\x05 \x01(\tR\rtimeSignature\x12;\n" +
+	"\vachieved_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"achievedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf4\x01\n" +
+	"\aRoutine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12-\n" +
+	"\x05steps\x18\x04 \x03(\v2\x17.drummer.v1.RoutineStepR\x05steps\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd1\x01\n" +
+	"\vRoutineStep\x12\x1f\n" +
+	"\vexercise_id\x18\x01 \x01(\x05R\n" +
+	"exerciseId\x12#\n" +
+	"\rexercise_name\x18\x02 \x01(\tR\fexerciseName\x128\n" +
+	"\x18planned_duration_seconds\x18\x03 \x01(\x05R\x16plannedDurationSeconds\x12\x1b\n" +
+	"\tstart_bpm\x18\x04 \x01(\x05R\bstartBpm\x12%\n" +
+	"\x0etime_signature\x18\x05 \x01(\tR\rtimeSignature\"b\n" +
+	"\bSettings\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\x129\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x81\x01\n" +
 	"\x15CreateCategoryRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"$\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x122\n" +
+	"\x15weekly_target_minutes\x18\x03 \x01(\x05R\x13weeklyTargetMinutes\"$\n" +
 	"\x12GetCategoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"S\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x83\x01\n" +
 	"\x15ListCategoriesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"\x97\x01\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\x03 \x01(\bR\x11includeTotalCount\"\x97\x01\n" +
 	"\x16ListCategoriesResponse\x124\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x14.drummer.v1.CategoryR\n" +
@@ -3430,13 +11229,14 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\fcategory_ids\x18\x02 \x03(\x05R\vcategoryIds\"\x1f\n" +
 	"\rGetTagRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"n\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x9e\x01\n" +
 	"\x0fListTagsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1f\n" +
 	"\vcategory_id\x18\x03 \x01(\x05R\n" +
-	"categoryId\"\x80\x01\n" +
+	"categoryId\x12.\n" +
+	"\x13include_total_count\x18\x04 \x01(\bR\x11includeTotalCount\"\x80\x01\n" +
 	"\x10ListTagsResponse\x12#\n" +
 	"\x04tags\x18\x01 \x03(\v2\x0f.drummer.v1.TagR\x04tags\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
@@ -3448,22 +11248,42 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\"\"\n" +
 	"\x10DeleteTagRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xc9\x01\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xff\x01\n" +
 	"\x15CreateExerciseRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x17\n" +
 	"\atag_ids\x18\x03 \x03(\x05R\x06tagIds\x121\n" +
 	"\x06images\x18\x04 \x03(\v2\x19.drummer.v1.ExerciseImageR\x06images\x12.\n" +
-	"\x05links\x18\x05 \x03(\v2\x18.drummer.v1.ExerciseLinkR\x05links\"$\n" +
+	"\x05links\x18\x05 \x03(\v2\x18.drummer.v1.ExerciseLinkR\x05links\x124\n" +
+	"\n" +
+	"tempo_plan\x18\x06 \x01(\v2\x15.drummer.v1.TempoPlanR\ttempoPlan\"$\n" +
 	"\x12GetExerciseRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\x8a\x01\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xca\x04\n" +
 	"\x14ListExercisesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1f\n" +
 	"\vcategory_id\x18\x03 \x01(\x05R\n" +
 	"categoryId\x12\x15\n" +
-	"\x06tag_id\x18\x04 \x01(\x05R\x05tagId\"\x94\x01\n" +
+	"\x06tag_id\x18\x04 \x01(\x05R\x05tagId\x12\x17\n" +
+	"\atag_ids\x18\x05 \x03(\x05R\x06tagIds\x121\n" +
+	"\ttag_match\x18\x06 \x01(\x0e2\x14.drummer.v1.TagMatchR\btagMatch\x12&\n" +
+	"\x0fexclude_tag_ids\x18\a \x03(\x05R\rexcludeTagIds\x12J\n" +
+	"\x13not_practiced_since\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x11notPracticedSince\x12 \n" +
+	"\fmin_last_bpm\x18\t \x01(\x05R\n" +
+	"minLastBpm\x12 \n" +
+	"\fmax_last_bpm\x18\n" +
+	" \x01(\x05R\n" +
+	"maxLastBpm\x12\x1d\n" +
+	"\n" +
+	"min_rating\x18\v \x01(\x05R\tminRating\x12\x1d\n" +
+	"\n" +
+	"max_rating\x18\f \x01(\x05R\tmaxRating\x12,\n" +
+	"\x04sort\x18\r \x01(\x0e2\x18.drummer.v1.ExerciseSortR\x04sort\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x0e \x01(\bR\n" +
+	"descending\x12.\n" +
+	"\x13include_total_count\x18\x0f \x01(\bR\x11includeTotalCount\"\x94\x01\n" +
 	"\x15ListExercisesResponse\x122\n" +
 	"\texercises\x18\x01 \x03(\v2\x14.drummer.v1.ExerciseR\texercises\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
@@ -3489,6 +11309,16 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"exerciseId\x12\x19\n" +
 	"\bimage_id\x18\x02 \x01(\x05R\aimageId\",\n" +
 	"\x1aDeleteExerciseImageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xa1\x01\n" +
+	"\x1aAddExerciseNotationRequest\x12\x1f\n" +
+	"\vexercise_id\x18\x01 \x01(\x05R\n" +
+	"exerciseId\x122\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1a.drummer.v1.NotationFormatR\x06format\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x1a\n" +
+	"\bfilename\x18\x04 \x01(\tR\bfilename\",\n" +
+	"\x1aGetExerciseNotationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"/\n" +
+	"\x1dDeleteExerciseNotationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"m\n" +
 	"\x16AddExerciseLinkRequest\x12\x1f\n" +
 	"\vexercise_id\x18\x01 \x01(\x05R\n" +
@@ -3503,7 +11333,7 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
 	"\x05notes\x18\x03 \x01(\tR\x05notes\"+\n" +
 	"\x19GetPracticeSessionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\x84\x02\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xd1\x02\n" +
 	"\x1bListPracticeSessionsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -3513,7 +11343,9 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1f\n" +
 	"\vexercise_id\x18\x05 \x01(\x05R\n" +
 	"exerciseId\x12\x16\n" +
-	"\x06active\x18\x06 \x01(\bR\x06active\"\xa0\x01\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\x12\x1b\n" +
+	"\ttime_zone\x18\a \x01(\tR\btimeZone\x12.\n" +
+	"\x13include_total_count\x18\b \x01(\bR\x11includeTotalCount\"\xa0\x01\n" +
 	"\x1cListPracticeSessionsResponse\x127\n" +
 	"\bsessions\x18\x01 \x03(\v2\x1b.drummer.v1.PracticeSessionR\bsessions\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
@@ -3525,7 +11357,31 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\".\n" +
 	"\x1cDeletePracticeSessionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xe4\x02\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"%\n" +
+	"\x13PauseSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"&\n" +
+	"\x14ResumeSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x91\x01\n" +
+	"\x14StartExerciseRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x05R\tsessionId\x12\x1f\n" +
+	"\vexercise_id\x18\x02 \x01(\x05R\n" +
+	"exerciseId\x12\x12\n" +
+	"\x04bpms\x18\x03 \x03(\x05R\x04bpms\x12%\n" +
+	"\x0etime_signature\x18\x04 \x01(\tR\rtimeSignature\"4\n" +
+	"\x13StopExerciseRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x05R\tsessionId\"4\n" +
+	"\x13WatchSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x05R\tsessionId\"\xff\x01\n" +
+	"\fSessionEvent\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1c.drummer.v1.SessionEventTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\x05R\tsessionId\x125\n" +
+	"\asession\x18\x03 \x01(\v2\x1b.drummer.v1.PracticeSessionR\asession\x127\n" +
+	"\bexercise\x18\x04 \x01(\v2\x1b.drummer.v1.ExerciseHistoryR\bexercise\x12.\n" +
+	"\x04time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"\xe4\x02\n" +
 	"\x1cCreateExerciseHistoryRequest\x12\x1f\n" +
 	"\vexercise_id\x18\x01 \x01(\x05R\n" +
 	"exerciseId\x129\n" +
@@ -3540,7 +11396,7 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"session_id\x18\b \x01(\x05R\tsessionId\x12)\n" +
 	"\x10duration_seconds\x18\t \x01(\x05R\x0fdurationSeconds\"+\n" +
 	"\x19GetExerciseHistoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\x8a\x02\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xd7\x02\n" +
 	"\x1aListExerciseHistoryRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -3551,7 +11407,9 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
 	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1d\n" +
 	"\n" +
-	"session_id\x18\x06 \x01(\x05R\tsessionId\"\xac\x01\n" +
+	"session_id\x18\x06 \x01(\x05R\tsessionId\x12\x1b\n" +
+	"\ttime_zone\x18\a \x01(\tR\btimeZone\x12.\n" +
+	"\x13include_total_count\x18\b \x01(\bR\x11includeTotalCount\"\xac\x01\n" +
 	"\x1bListExerciseHistoryResponse\x12D\n" +
 	"\x0fhistory_entries\x18\x01 \x03(\v2\x1b.drummer.v1.ExerciseHistoryR\x0ehistoryEntries\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
@@ -3563,13 +11421,28 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\".\n" +
 	"\x1cDeleteExerciseHistoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xac\x01\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"x\n" +
+	"\x16UploadRecordingRequest\x12;\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1d.drummer.v1.RecordingMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\x96\x01\n" +
+	"\x11RecordingMetadata\x12\x1d\n" +
+	"\n" +
+	"history_id\x18\x01 \x01(\x05R\thistoryId\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x05R\x0fdurationSeconds\"%\n" +
+	"\x13GetRecordingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"(\n" +
+	"\x16DeleteRecordingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xc9\x01\n" +
 	"\x17GetExerciseStatsRequest\x12\x1f\n" +
 	"\vexercise_id\x18\x01 \x01(\x05R\n" +
 	"exerciseId\x129\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\xee\x02\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\"\x9e\x03\n" +
 	"\rExerciseStats\x12\x1f\n" +
 	"\vexercise_id\x18\x01 \x01(\x05R\n" +
 	"exerciseId\x12#\n" +
@@ -3581,16 +11454,82 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\amax_bpm\x18\x06 \x01(\x05R\x06maxBpm\x12\x17\n" +
 	"\amin_bpm\x18\a \x01(\x05R\x06minBpm\x12\x17\n" +
 	"\aavg_bpm\x18\b \x01(\x01R\x06avgBpm\x12?\n" +
-	"\fbpm_progress\x18\t \x03(\v2\x1c.drummer.v1.BpmProgressPointR\vbpmProgress\"T\n" +
+	"\fbpm_progress\x18\t \x03(\v2\x1c.drummer.v1.BpmProgressPointR\vbpmProgress\x12.\n" +
+	"\x05goals\x18\n" +
+	" \x03(\v2\x18.drummer.v1.GoalProgressR\x05goals\"r\n" +
+	"\x11ExportMidiRequest\x12!\n" +
+	"\fexercise_ids\x18\x01 \x03(\x05R\vexerciseIds\x12\"\n" +
+	"\rbars_per_step\x18\x02 \x01(\x05R\vbarsPerStep\x12\x16\n" +
+	"\x06ladder\x18\x03 \x01(\bR\x06ladder\"\xb6\x02\n" +
+	"\fExercisePack\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12;\n" +
+	"\vexported_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\x128\n" +
+	"\n" +
+	"categories\x18\x05 \x03(\v2\x18.drummer.v1.PackCategoryR\n" +
+	"categories\x12'\n" +
+	"\x04tags\x18\x06 \x03(\v2\x13.drummer.v1.PackTagR\x04tags\x126\n" +
+	"\texercises\x18\a \x03(\v2\x18.drummer.v1.PackExerciseR\texercises\"D\n" +
+	"\fPackCategory\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"=\n" +
+	"\aPackTag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\tR\n" +
+	"categories\"\xb6\x02\n" +
+	"\fPackExercise\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x124\n" +
+	"\n" +
+	"tempo_plan\x18\x05 \x01(\v2\x15.drummer.v1.TempoPlanR\ttempoPlan\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12*\n" +
+	"\x05links\x18\a \x03(\v2\x14.drummer.v1.PackLinkR\x05links\x12-\n" +
+	"\x06images\x18\b \x03(\v2\x15.drummer.v1.PackImageR\x06images\">\n" +
+	"\bPackLink\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"z\n" +
+	"\tPackImage\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"t\n" +
+	"\x19ExportExercisePackRequest\x12!\n" +
+	"\fexercise_ids\x18\x01 \x03(\x05R\vexerciseIds\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"/\n" +
+	"\x19ImportExercisePackRequest\x12\x12\n" +
+	"\x04pack\x18\x01 \x01(\fR\x04pack\"\xa2\x01\n" +
+	"\x1aImportExercisePackResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x03 \x01(\x05R\tunchanged\x12\x12\n" +
+	"\x04tags\x18\x04 \x01(\x05R\x04tags\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x05 \x01(\x05R\n" +
+	"categories\"\xf9\x01\n" +
+	"\fGoalProgress\x12$\n" +
+	"\x04goal\x18\x01 \x01(\v2\x10.drummer.v1.GoalR\x04goal\x12\x1f\n" +
+	"\vcurrent_bpm\x18\x02 \x01(\x05R\n" +
+	"currentBpm\x12/\n" +
+	"\x13progress_percentage\x18\x03 \x01(\x01R\x12progressPercentage\x12V\n" +
+	"\x19projected_completion_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x17projectedCompletionDate\x12\x19\n" +
+	"\bon_track\x18\x05 \x01(\bR\aonTrack\"T\n" +
 	"\x10BpmProgressPoint\x12.\n" +
 	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x10\n" +
-	"\x03bpm\x18\x02 \x01(\x05R\x03bpm\"\xac\x01\n" +
+	"\x03bpm\x18\x02 \x01(\x05R\x03bpm\"\xc9\x01\n" +
 	"\x17GetPracticeStatsRequest\x129\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
 	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1f\n" +
 	"\vcategory_id\x18\x03 \x01(\x05R\n" +
-	"categoryId\"\xb1\x03\n" +
+	"categoryId\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\"\xb1\x03\n" +
 	"\rPracticeStats\x12%\n" +
 	"\x0etotal_sessions\x18\x01 \x01(\x05R\rtotalSessions\x124\n" +
 	"\x16total_duration_seconds\x18\x02 \x01(\x05R\x14totalDurationSeconds\x12?\n" +
@@ -3617,7 +11556,418 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\x12practice_frequency\x18\x05 \x03(\v2\x1d.drummer.v1.PracticeTimePointR\x11practiceFrequency\"n\n" +
 	"\x11PracticeTimePoint\x12.\n" +
 	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12)\n" +
-	"\x10duration_seconds\x18\x02 \x01(\x05R\x0fdurationSeconds2\x9f\x04\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x05R\x0fdurationSeconds\"\xca\x01\n" +
+	"\x18GetTargetProgressRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x05R\n" +
+	"categoryId\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\"T\n" +
+	"\x0eTargetProgress\x12B\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\".drummer.v1.CategoryTargetProgressR\n" +
+	"categories\"\x98\x02\n" +
+	"\x16CategoryTargetProgress\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x122\n" +
+	"\x15weekly_target_minutes\x18\x03 \x01(\x05R\x13weeklyTargetMinutes\x126\n" +
+	"\x05weeks\x18\x04 \x03(\v2 .drummer.v1.WeeklyTargetProgressR\x05weeks\x12%\n" +
+	"\x0ecurrent_streak\x18\x05 \x01(\x05R\rcurrentStreak\x12%\n" +
+	"\x0elongest_streak\x18\x06 \x01(\x05R\rlongestStreak\"\xda\x01\n" +
+	"\x14WeeklyTargetProgress\x129\n" +
+	"\n" +
+	"week_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tweekStart\x12%\n" +
+	"\x0eactual_minutes\x18\x02 \x01(\x05R\ractualMinutes\x12%\n" +
+	"\x0etarget_minutes\x18\x03 \x01(\x05R\rtargetMinutes\x12'\n" +
+	"\x0fdeficit_minutes\x18\x04 \x01(\x05R\x0edeficitMinutes\x12\x10\n" +
+	"\x03met\x18\x05 \x01(\bR\x03met\"p\n" +
+	"\x1aGetConsistencyStatsRequest\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\xc1\x03\n" +
+	"\x10ConsistencyStats\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\x12%\n" +
+	"\x0ecurrent_streak\x18\x02 \x01(\x05R\rcurrentStreak\x12%\n" +
+	"\x0elongest_streak\x18\x03 \x01(\x05R\rlongestStreak\x122\n" +
+	"\x06weekly\x18\x04 \x03(\v2\x1a.drummer.v1.PracticePeriodR\x06weekly\x124\n" +
+	"\amonthly\x18\x05 \x03(\v2\x1a.drummer.v1.PracticePeriodR\amonthly\x120\n" +
+	"\aheatmap\x18\x06 \x03(\v2\x16.drummer.v1.HeatmapDayR\aheatmap\x12R\n" +
+	"\x18day_of_week_distribution\x18\a \x03(\v2\x19.drummer.v1.DayOfWeekTimeR\x15dayOfWeekDistribution\x12R\n" +
+	"\x18hour_of_day_distribution\x18\b \x03(\v2\x19.drummer.v1.HourOfDayTimeR\x15hourOfDayDistribution\"\xa1\x01\n" +
+	"\x0ePracticePeriod\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12%\n" +
+	"\x0edays_practiced\x18\x02 \x01(\x05R\rdaysPracticed\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x05R\x0fdurationSeconds\"\x84\x01\n" +
+	"\n" +
+	"HeatmapDay\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x12\n" +
+	"\x04week\x18\x02 \x01(\x05R\x04week\x12\x18\n" +
+	"\aweekday\x18\x03 \x01(\x05R\aweekday\x12\x18\n" +
+	"\aminutes\x18\x04 \x01(\x05R\aminutes\"Z\n" +
+	"\rDayOfWeekTime\x12\x1e\n" +
+	"\vday_of_week\x18\x01 \x01(\x05R\tdayOfWeek\x12)\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x05R\x0fdurationSeconds\"N\n" +
+	"\rHourOfDayTime\x12\x12\n" +
+	"\x04hour\x18\x01 \x01(\x05R\x04hour\x12)\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x05R\x0fdurationSeconds\"\xb7\x01\n" +
+	"\x11CreateGoalRequest\x12\x1f\n" +
+	"\vexercise_id\x18\x01 \x01(\x05R\n" +
+	"exerciseId\x12\x1d\n" +
+	"\n" +
+	"target_bpm\x18\x02 \x01(\x05R\ttargetBpm\x12;\n" +
+	"\vtarget_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"targetDate\x12%\n" +
+	"\x0etime_signature\x18\x04 \x01(\tR\rtimeSignature\" \n" +
+	"\x0eGetGoalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x9f\x01\n" +
+	"\x10ListGoalsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vexercise_id\x18\x03 \x01(\x05R\n" +
+	"exerciseId\x12.\n" +
+	"\x13include_total_count\x18\x04 \x01(\bR\x11includeTotalCount\"\x84\x01\n" +
+	"\x11ListGoalsResponse\x12&\n" +
+	"\x05goals\x18\x01 \x03(\v2\x10.drummer.v1.GoalR\x05goals\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x86\x01\n" +
+	"\x11UpdateGoalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12$\n" +
+	"\x04goal\x18\x02 \x01(\v2\x10.drummer.v1.GoalR\x04goal\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"#\n" +
+	"\x11DeleteGoalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"{\n" +
+	"\x14CreateRoutineRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12-\n" +
+	"\x05steps\x18\x03 \x03(\v2\x17.drummer.v1.RoutineStepR\x05steps\"#\n" +
+	"\x11GetRoutineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x81\x01\n" +
+	"\x13ListRoutinesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\x03 \x01(\bR\x11includeTotalCount\"\x90\x01\n" +
+	"\x14ListRoutinesResponse\x12/\n" +
+	"\broutines\x18\x01 \x03(\v2\x13.drummer.v1.RoutineR\broutines\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x92\x01\n" +
+	"\x14UpdateRoutineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12-\n" +
+	"\aroutine\x18\x02 \x01(\v2\x13.drummer.v1.RoutineR\aroutine\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"&\n" +
+	"\x14DeleteRoutineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"z\n" +
+	"\x1eStartSessionFromRoutineRequest\x12\x1d\n" +
+	"\n" +
+	"routine_id\x18\x01 \x01(\x05R\troutineId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\"\x87\x01\n" +
+	"\x1fStartSessionFromRoutineResponse\x125\n" +
+	"\asession\x18\x01 \x01(\v2\x1b.drummer.v1.PracticeSessionR\asession\x12-\n" +
+	"\x05steps\x18\x02 \x03(\v2\x17.drummer.v1.PlannedStepR\x05steps\"z\n" +
+	"\vPlannedStep\x12+\n" +
+	"\x04step\x18\x01 \x01(\v2\x17.drummer.v1.RoutineStepR\x04step\x12>\n" +
+	"\x05entry\x18\x02 \x01(\v2(.drummer.v1.CreateExerciseHistoryRequestR\x05entry\"f\n" +
+	"\x16GetPracticePlanRequest\x12+\n" +
+	"\x11available_minutes\x18\x01 \x01(\x05R\x10availableMinutes\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\"_\n" +
+	"\fPracticePlan\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.drummer.v1.PlanItemR\x05items\x12#\n" +
+	"\rtotal_minutes\x18\x02 \x01(\x05R\ftotalMinutes\"\xa8\x02\n" +
+	"\bPlanItem\x12\x1f\n" +
+	"\vexercise_id\x18\x01 \x01(\x05R\n" +
+	"exerciseId\x12#\n" +
+	"\rexercise_name\x18\x02 \x01(\tR\fexerciseName\x12+\n" +
+	"\x11suggested_minutes\x18\x03 \x01(\x05R\x10suggestedMinutes\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x128\n" +
+	"\tbreakdown\x18\x05 \x01(\v2\x1a.drummer.v1.ScoreBreakdownR\tbreakdown\x12\x18\n" +
+	"\areasons\x18\x06 \x03(\tR\areasons\x12?\n" +
+	"\rlast_practice\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\flastPractice\"\x9f\x01\n" +
+	"\x0eScoreBreakdown\x12\x18\n" +
+	"\arecency\x18\x01 \x01(\x01R\arecency\x12\x1d\n" +
+	"\n" +
+	"low_rating\x18\x02 \x01(\x01R\tlowRating\x12)\n" +
+	"\x10stalled_progress\x18\x03 \x01(\x01R\x0fstalledProgress\x12)\n" +
+	"\x10category_balance\x18\x04 \x01(\x01R\x0fcategoryBalance\"\x14\n" +
+	"\x12GetSettingsRequest\"\x86\x01\n" +
+	"\x15UpdateSettingsRequest\x120\n" +
+	"\bsettings\x18\x01 \x01(\v2\x14.drummer.v1.SettingsR\bsettings\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\xee\x03\n" +
+	"\vDataArchive\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12;\n" +
+	"\vexported_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\x124\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\v2\x14.drummer.v1.CategoryR\n" +
+	"categories\x12#\n" +
+	"\x04tags\x18\x04 \x03(\v2\x0f.drummer.v1.TagR\x04tags\x122\n" +
+	"\texercises\x18\x05 \x03(\v2\x14.drummer.v1.ExerciseR\texercises\x127\n" +
+	"\bsessions\x18\x06 \x03(\v2\x1b.drummer.v1.PracticeSessionR\bsessions\x125\n" +
+	"\ahistory\x18\a \x03(\v2\x1b.drummer.v1.ExerciseHistoryR\ahistory\x12&\n" +
+	"\x05goals\x18\b \x03(\v2\x10.drummer.v1.GoalR\x05goals\x12/\n" +
+	"\broutines\x18\t \x03(\v2\x13.drummer.v1.RoutineR\broutines\x120\n" +
+	"\bsettings\x18\n" +
+	" \x01(\v2\x14.drummer.v1.SettingsR\bsettings\"\x12\n" +
+	"\x10ExportAllRequest\"b\n" +
+	"\x10ImportAllRequest\x121\n" +
+	"\aarchive\x18\x01 \x01(\v2\x17.drummer.v1.DataArchiveR\aarchive\x12\x1b\n" +
+	"\tremap_ids\x18\x02 \x01(\bR\bremapIds\"\xfc\x01\n" +
+	"\x11ImportAllResponse\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x01 \x01(\x05R\n" +
+	"categories\x12\x12\n" +
+	"\x04tags\x18\x02 \x01(\x05R\x04tags\x12\x1c\n" +
+	"\texercises\x18\x03 \x01(\x05R\texercises\x12\x1a\n" +
+	"\bsessions\x18\x04 \x01(\x05R\bsessions\x12'\n" +
+	"\x0fhistory_entries\x18\x05 \x01(\x05R\x0ehistoryEntries\x12\x14\n" +
+	"\x05goals\x18\x06 \x01(\x05R\x05goals\x12\x1a\n" +
+	"\broutines\x18\a \x01(\x05R\broutines\x12\x1e\n" +
+	"\n" +
+	"recordings\x18\b \x01(\x05R\n" +
+	"recordings\"v\n" +
+	"\x06Backup\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03R\tsizeBytes\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x15\n" +
+	"\x13CreateBackupRequest\"\x14\n" +
+	"\x12ListBackupsRequest\"C\n" +
+	"\x13ListBackupsResponse\x12,\n" +
+	"\abackups\x18\x01 \x03(\v2\x12.drummer.v1.BackupR\abackups\"o\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x122\n" +
+	"\x05types\x18\x02 \x03(\x0e2\x1c.drummer.v1.SearchEntityTypeR\x05types\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"G\n" +
+	"\x0eSearchResponse\x125\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1d.drummer.v1.SearchResultGroupR\x06groups\"y\n" +
+	"\x11SearchResultGroup\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1c.drummer.v1.SearchEntityTypeR\x04type\x122\n" +
+	"\aresults\x18\x02 \x03(\v2\x18.drummer.v1.SearchResultR\aresults\"\x86\x02\n" +
+	"\fSearchResult\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1c.drummer.v1.SearchEntityTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x01R\x05score\x12\x1f\n" +
+	"\vexercise_id\x18\x06 \x01(\x05R\n" +
+	"exerciseId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\a \x01(\x05R\tsessionId\x12.\n" +
+	"\x04time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"\xd8\x01\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05admin\x18\x03 \x01(\bR\x05admin\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\ateacher\x18\x06 \x01(\bR\ateacher\"\xe2\x01\n" +
+	"\bApiToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"F\n" +
+	"\fLoginRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x95\x01\n" +
+	"\rLoginResponse\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.drummer.v1.UserR\x04user\x12#\n" +
+	"\rsession_token\x18\x02 \x01(\tR\fsessionToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x0f\n" +
+	"\rLogoutRequest\"\x10\n" +
+	"\x0eLogoutResponse\"\x17\n" +
+	"\x15GetCurrentUserRequest\"e\n" +
+	"\x15ChangePasswordRequest\x12)\n" +
+	"\x10current_password\x18\x01 \x01(\tR\x0fcurrentPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"f\n" +
+	"\x15CreateApiTokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\\\n" +
+	"\x16CreateApiTokenResponse\x12*\n" +
+	"\x05token\x18\x01 \x01(\v2\x14.drummer.v1.ApiTokenR\x05token\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\x16\n" +
+	"\x14ListApiTokensRequest\"E\n" +
+	"\x15ListApiTokensResponse\x12,\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x14.drummer.v1.ApiTokenR\x06tokens\"'\n" +
+	"\x15DeleteApiTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"{\n" +
+	"\x11CreateUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x14\n" +
+	"\x05admin\x18\x03 \x01(\bR\x05admin\x12\x18\n" +
+	"\ateacher\x18\x04 \x01(\bR\ateacher\"\x12\n" +
+	"\x10ListUsersRequest\";\n" +
+	"\x11ListUsersResponse\x12&\n" +
+	"\x05users\x18\x01 \x03(\v2\x10.drummer.v1.UserR\x05users\"\x86\x01\n" +
+	"\x11UpdateUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12$\n" +
+	"\x04user\x18\x02 \x01(\v2\x10.drummer.v1.UserR\x04user\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"#\n" +
+	"\x11DeleteUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"Q\n" +
+	"\x11AddStudentRequest\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\x05R\tteacherId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\x05R\tstudentId\"T\n" +
+	"\x14RemoveStudentRequest\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\x05R\tteacherId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\x05R\tstudentId\"\xea\x04\n" +
+	"\n" +
+	"Assignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x02 \x01(\x05R\tteacherId\x12)\n" +
+	"\x10teacher_username\x18\x03 \x01(\tR\x0fteacherUsername\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x04 \x01(\x05R\tstudentId\x12)\n" +
+	"\x10student_username\x18\x05 \x01(\tR\x0fstudentUsername\x12\x1f\n" +
+	"\vexercise_id\x18\x06 \x01(\x05R\n" +
+	"exerciseId\x12#\n" +
+	"\rexercise_name\x18\a \x01(\tR\fexerciseName\x12\x1d\n" +
+	"\n" +
+	"target_bpm\x18\b \x01(\x05R\ttargetBpm\x125\n" +
+	"\bdue_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x14\n" +
+	"\x05notes\x18\n" +
+	" \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x124\n" +
+	"\x06status\x18\r \x01(\x0e2\x1c.drummer.v1.AssignmentStatusR\x06status\x12\x19\n" +
+	"\bbest_bpm\x18\x0e \x01(\x05R\abestBpm\x12?\n" +
+	"\rlast_practice\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\flastPractice\"\xd4\x01\n" +
+	"\x0eHistoryComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"history_id\x18\x02 \x01(\x05R\thistoryId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\x05R\bauthorId\x12'\n" +
+	"\x0fauthor_username\x18\x04 \x01(\tR\x0eauthorUsername\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc5\x01\n" +
+	"\x17CreateAssignmentRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\x05R\tstudentId\x12\x1f\n" +
+	"\vexercise_id\x18\x02 \x01(\x05R\n" +
+	"exerciseId\x12\x1d\n" +
+	"\n" +
+	"target_bpm\x18\x03 \x01(\x05R\ttargetBpm\x125\n" +
+	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\"&\n" +
+	"\x14GetAssignmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xef\x01\n" +
+	"\x16ListAssignmentsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\x03 \x01(\bR\x11includeTotalCount\x12\x14\n" +
+	"\x05given\x18\x04 \x01(\bR\x05given\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x05 \x01(\x05R\tstudentId\x124\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1c.drummer.v1.AssignmentStatusR\x06status\"\x9c\x01\n" +
+	"\x17ListAssignmentsResponse\x128\n" +
+	"\vassignments\x18\x01 \x03(\v2\x16.drummer.v1.AssignmentR\vassignments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x9e\x01\n" +
+	"\x17UpdateAssignmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x126\n" +
+	"\n" +
+	"assignment\x18\x02 \x01(\v2\x16.drummer.v1.AssignmentR\n" +
+	"assignment\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\")\n" +
+	"\x17DeleteAssignmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"C\n" +
+	"\x1cListAssignmentHistoryRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\x05R\fassignmentId\"\x8e\x01\n" +
+	"\x1dListAssignmentHistoryResponse\x125\n" +
+	"\aentries\x18\x01 \x03(\v2\x1b.drummer.v1.ExerciseHistoryR\aentries\x126\n" +
+	"\bcomments\x18\x02 \x03(\v2\x1a.drummer.v1.HistoryCommentR\bcomments\"M\n" +
+	"\x18AddHistoryCommentRequest\x12\x1d\n" +
+	"\n" +
+	"history_id\x18\x01 \x01(\x05R\thistoryId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"-\n" +
+	"\x1bDeleteHistoryCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x15\n" +
+	"\x13ListStudentsRequest\"D\n" +
+	"\x14ListStudentsResponse\x12,\n" +
+	"\bstudents\x18\x01 \x03(\v2\x10.drummer.v1.UserR\bstudents\"\xa8\x01\n" +
+	"\x1bListStudentExercisesRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\x05R\tstudentId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\x04 \x01(\bR\x11includeTotalCount\"\x9b\x01\n" +
+	"\x1cListStudentExercisesResponse\x122\n" +
+	"\texercises\x18\x01 \x03(\v2\x14.drummer.v1.ExerciseR\texercises\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount*\xd8\x01\n" +
+	"\vSubdivision\x12\x1b\n" +
+	"\x17SUBDIVISION_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SUBDIVISION_QUARTER\x10\x01\x12\x16\n" +
+	"\x12SUBDIVISION_EIGHTH\x10\x02\x12\x1e\n" +
+	"\x1aSUBDIVISION_EIGHTH_TRIPLET\x10\x03\x12\x19\n" +
+	"\x15SUBDIVISION_SIXTEENTH\x10\x04\x12!\n" +
+	"\x1dSUBDIVISION_SIXTEENTH_TRIPLET\x10\x05\x12\x1d\n" +
+	"\x19SUBDIVISION_THIRTY_SECOND\x10\x06*m\n" +
+	"\x0eNotationFormat\x12\x1f\n" +
+	"\x1bNOTATION_FORMAT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18NOTATION_FORMAT_MUSICXML\x10\x01\x12\x1c\n" +
+	"\x18NOTATION_FORMAT_DRUM_TAB\x10\x02*K\n" +
+	"\bTagMatch\x12\x19\n" +
+	"\x15TAG_MATCH_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rTAG_MATCH_ANY\x10\x01\x12\x11\n" +
+	"\rTAG_MATCH_ALL\x10\x02*\xab\x01\n" +
+	"\fExerciseSort\x12\x1d\n" +
+	"\x19EXERCISE_SORT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12EXERCISE_SORT_NAME\x10\x01\x12\x1c\n" +
+	"\x18EXERCISE_SORT_CREATED_AT\x10\x02\x12\x1f\n" +
+	"\x1bEXERCISE_SORT_LAST_PRACTICE\x10\x03\x12%\n" +
+	"!EXERCISE_SORT_TOTAL_PRACTICE_TIME\x10\x04*\xad\x02\n" +
+	"\x10SessionEventType\x12\"\n" +
+	"\x1eSESSION_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSESSION_EVENT_TYPE_STARTED\x10\x01\x12\x1e\n" +
+	"\x1aSESSION_EVENT_TYPE_UPDATED\x10\x02\x12\x1c\n" +
+	"\x18SESSION_EVENT_TYPE_ENDED\x10\x03\x12\x1e\n" +
+	"\x1aSESSION_EVENT_TYPE_DELETED\x10\x04\x12%\n" +
+	"!SESSION_EVENT_TYPE_EXERCISE_ADDED\x10\x05\x12'\n" +
+	"#SESSION_EVENT_TYPE_EXERCISE_UPDATED\x10\x06\x12'\n" +
+	"#SESSION_EVENT_TYPE_EXERCISE_REMOVED\x10\a*\xcf\x01\n" +
+	"\x10SearchEntityType\x12\"\n" +
+	"\x1eSEARCH_ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSEARCH_ENTITY_TYPE_EXERCISE\x10\x01\x12$\n" +
+	" SEARCH_ENTITY_TYPE_EXERCISE_LINK\x10\x02\x12'\n" +
+	"#SEARCH_ENTITY_TYPE_PRACTICE_SESSION\x10\x03\x12'\n" +
+	"#SEARCH_ENTITY_TYPE_EXERCISE_HISTORY\x10\x04*\xbc\x01\n" +
+	"\x10AssignmentStatus\x12!\n" +
+	"\x1dASSIGNMENT_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dASSIGNMENT_STATUS_NOT_STARTED\x10\x01\x12!\n" +
+	"\x1dASSIGNMENT_STATUS_IN_PROGRESS\x10\x02\x12 \n" +
+	"\x1cASSIGNMENT_STATUS_MET_TARGET\x10\x03\x12\x1d\n" +
+	"\x19ASSIGNMENT_STATUS_OVERDUE\x10\x042\x9f\x04\n" +
 	"\x0fCategoryService\x12d\n" +
 	"\x0eCreateCategory\x12!.drummer.v1.CreateCategoryRequest\x1a\x14.drummer.v1.Category\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/categories\x12`\n" +
 	"\vGetCategory\x12\x1e.drummer.v1.GetCategoryRequest\x1a\x14.drummer.v1.Category\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/categories/{id}\x12o\n" +
@@ -3631,8 +11981,7 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\bListTags\x12\x1b.drummer.v1.ListTagsRequest\x1a\x1c.drummer.v1.ListTagsResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
 	"\x12\b/v1/tags\x12T\n" +
 	"\tUpdateTag\x12\x1c.drummer.v1.UpdateTagRequest\x1a\x0f.drummer.v1.Tag\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*2\r/v1/tags/{id}\x12X\n" +
-	"\tDeleteTag\x12\x1c.drummer.v1.DeleteTagRequest\x1a\x16.google.protobuf.Empty\"\x15\x82\xd3\xe4\x93\x02\x0f*\r/v1/tags/{id}2\x94\n" +
-	"\n" +
+	"\tDeleteTag\x12\x1c.drummer.v1.DeleteTagRequest\x1a\x16.google.protobuf.Empty\"\x15\x82\xd3\xe4\x93\x02\x0f*\r/v1/tags/{id}2\xfd\x0f\n" +
 	"\x0fExerciseService\x12c\n" +
 	"\x0eCreateExercise\x12!.drummer.v1.CreateExerciseRequest\x1a\x14.drummer.v1.Exercise\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/exercises\x12_\n" +
 	"\vGetExercise\x12\x1e.drummer.v1.GetExerciseRequest\x1a\x14.drummer.v1.Exercise\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/exercises/{id}\x12k\n" +
@@ -3641,23 +11990,101 @@ const file_api_v1_tempus_tempus_proto_rawDesc = "" +
 	"\x0eDeleteExercise\x12!.drummer.v1.DeleteExerciseRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/exercises/{id}\x12\x81\x01\n" +
 	"\x10AddExerciseImage\x12#.drummer.v1.AddExerciseImageRequest\x1a\x19.drummer.v1.ExerciseImage\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/exercises/{exercise_id}/images\x12\x89\x01\n" +
 	"\x10GetExerciseImage\x12#.drummer.v1.GetExerciseImageRequest\x1a\x19.drummer.v1.ExerciseImage\"5\x82\xd3\xe4\x93\x02/\x12-/v1/exercises/{exercise_id}/images/{image_id}\x12w\n" +
-	"\x13DeleteExerciseImage\x12&.drummer.v1.DeleteExerciseImageRequest\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a*\x18/v1/exercise-images/{id}\x12}\n" +
+	"\x13DeleteExerciseImage\x12&.drummer.v1.DeleteExerciseImageRequest\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a*\x18/v1/exercise-images/{id}\x12\x8d\x01\n" +
+	"\x13AddExerciseNotation\x12&.drummer.v1.AddExerciseNotationRequest\x1a\x1c.drummer.v1.ExerciseNotation\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/exercises/{exercise_id}/notations\x12\x80\x01\n" +
+	"\x13GetExerciseNotation\x12&.drummer.v1.GetExerciseNotationRequest\x1a\x1c.drummer.v1.ExerciseNotation\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/exercise-notations/{id}\x12\x80\x01\n" +
+	"\x16DeleteExerciseNotation\x12).drummer.v1.DeleteExerciseNotationRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/exercise-notations/{id}\x12}\n" +
 	"\x0fAddExerciseLink\x12\".drummer.v1.AddExerciseLinkRequest\x1a\x18.drummer.v1.ExerciseLink\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/exercises/{exercise_id}/links\x12t\n" +
 	"\x12DeleteExerciseLink\x12%.drummer.v1.DeleteExerciseLinkRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/exercise-links/{id}\x12}\n" +
-	"\x10GetExerciseStats\x12#.drummer.v1.GetExerciseStatsRequest\x1a\x19.drummer.v1.ExerciseStats\")\x82\xd3\xe4\x93\x02#\x12!/v1/exercises/{exercise_id}/stats2\xeb\x05\n" +
+	"\x10GetExerciseStats\x12#.drummer.v1.GetExerciseStatsRequest\x1a\x19.drummer.v1.ExerciseStats\")\x82\xd3\xe4\x93\x02#\x12!/v1/exercises/{exercise_id}/stats\x12]\n" +
+	"\n" +
+	"ExportMidi\x12\x1d.drummer.v1.ExportMidiRequest\x1a\x14.google.api.HttpBody\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/exercises/midi\x12m\n" +
+	"\x12ExportExercisePack\x12%.drummer.v1.ExportExercisePackRequest\x1a\x14.google.api.HttpBody\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/exercises/pack\x12\x82\x01\n" +
+	"\x12ImportExercisePack\x12%.drummer.v1.ImportExercisePackRequest\x1a&.drummer.v1.ImportExercisePackResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/exercises/pack2\xba\f\n" +
 	"\x16PracticeSessionService\x12w\n" +
 	"\x15CreatePracticeSession\x12(.drummer.v1.CreatePracticeSessionRequest\x1a\x1b.drummer.v1.PracticeSession\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/sessions\x12s\n" +
 	"\x12GetPracticeSession\x12%.drummer.v1.GetPracticeSessionRequest\x1a\x1b.drummer.v1.PracticeSession\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/sessions/{id}\x12\x7f\n" +
 	"\x14ListPracticeSessions\x12'.drummer.v1.ListPracticeSessionsRequest\x1a(.drummer.v1.ListPracticeSessionsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/sessions\x12|\n" +
 	"\x15UpdatePracticeSession\x12(.drummer.v1.UpdatePracticeSessionRequest\x1a\x1b.drummer.v1.PracticeSession\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/sessions/{id}\x12t\n" +
 	"\x15DeletePracticeSession\x12(.drummer.v1.DeletePracticeSessionRequest\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/sessions/{id}\x12n\n" +
-	"\x10GetPracticeStats\x12#.drummer.v1.GetPracticeStatsRequest\x1a\x19.drummer.v1.PracticeStats\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/sessions/stats2\xf3\x04\n" +
+	"\x10GetPracticeStats\x12#.drummer.v1.GetPracticeStatsRequest\x1a\x19.drummer.v1.PracticeStats\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/sessions/stats\x12s\n" +
+	"\x11GetTargetProgress\x12$.drummer.v1.GetTargetProgressRequest\x1a\x1a.drummer.v1.TargetProgress\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/sessions/targets\x12}\n" +
+	"\x13GetConsistencyStats\x12&.drummer.v1.GetConsistencyStatsRequest\x1a\x1c.drummer.v1.ConsistencyStats\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/sessions/consistency\x12p\n" +
+	"\fPauseSession\x12\x1f.drummer.v1.PauseSessionRequest\x1a\x1b.drummer.v1.PracticeSession\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/sessions/{id}/pause\x12s\n" +
+	"\rResumeSession\x12 .drummer.v1.ResumeSessionRequest\x1a\x1b.drummer.v1.PracticeSession\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/sessions/{id}/resume\x12\x84\x01\n" +
+	"\rStartExercise\x12 .drummer.v1.StartExerciseRequest\x1a\x1b.drummer.v1.PracticeSession\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/sessions/{session_id}/exercises/start\x12\x81\x01\n" +
+	"\fStopExercise\x12\x1f.drummer.v1.StopExerciseRequest\x1a\x1b.drummer.v1.PracticeSession\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/sessions/{session_id}/exercises/stop\x12g\n" +
+	"\fWatchSession\x12\x1f.drummer.v1.WatchSessionRequest\x1a\x18.drummer.v1.SessionEvent\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/sessions/watch0\x012\xcd\a\n" +
 	"\x16ExerciseHistoryService\x12v\n" +
 	"\x15CreateExerciseHistory\x12(.drummer.v1.CreateExerciseHistoryRequest\x1a\x1b.drummer.v1.ExerciseHistory\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/history\x12r\n" +
 	"\x12GetExerciseHistory\x12%.drummer.v1.GetExerciseHistoryRequest\x1a\x1b.drummer.v1.ExerciseHistory\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/history/{id}\x12{\n" +
 	"\x13ListExerciseHistory\x12&.drummer.v1.ListExerciseHistoryRequest\x1a'.drummer.v1.ListExerciseHistoryResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/history\x12{\n" +
 	"\x15UpdateExerciseHistory\x12(.drummer.v1.UpdateExerciseHistoryRequest\x1a\x1b.drummer.v1.ExerciseHistory\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/history/{id}\x12s\n" +
-	"\x15DeleteExerciseHistory\x12(.drummer.v1.DeleteExerciseHistoryRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/history/{id}B\xa6\x01\n" +
+	"\x15DeleteExerciseHistory\x12(.drummer.v1.DeleteExerciseHistoryRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/history/{id}\x12x\n" +
+	"\x0fUploadRecording\x12\".drummer.v1.UploadRecordingRequest\x1a$.drummer.v1.ExerciseHistoryRecording\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/recordings(\x01\x12r\n" +
+	"\fGetRecording\x12\x1f.drummer.v1.GetRecordingRequest\x1a$.drummer.v1.ExerciseHistoryRecording\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/recordings/{id}\x12j\n" +
+	"\x0fDeleteRecording\x12\".drummer.v1.DeleteRecordingRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/recordings/{id}2\xc7\x03\n" +
+	"\vGoalService\x12S\n" +
+	"\n" +
+	"CreateGoal\x12\x1d.drummer.v1.CreateGoalRequest\x1a\x10.drummer.v1.Goal\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/goals\x12O\n" +
+	"\aGetGoal\x12\x1a.drummer.v1.GetGoalRequest\x1a\x10.drummer.v1.Goal\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/goals/{id}\x12[\n" +
+	"\tListGoals\x12\x1c.drummer.v1.ListGoalsRequest\x1a\x1d.drummer.v1.ListGoalsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/goals\x12X\n" +
+	"\n" +
+	"UpdateGoal\x12\x1d.drummer.v1.UpdateGoalRequest\x1a\x10.drummer.v1.Goal\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*2\x0e/v1/goals/{id}\x12[\n" +
+	"\n" +
+	"DeleteGoal\x12\x1d.drummer.v1.DeleteGoalRequest\x1a\x16.google.protobuf.Empty\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/goals/{id}2\xa4\x05\n" +
+	"\x0eRoutineService\x12_\n" +
+	"\rCreateRoutine\x12 .drummer.v1.CreateRoutineRequest\x1a\x13.drummer.v1.Routine\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/routines\x12[\n" +
+	"\n" +
+	"GetRoutine\x12\x1d.drummer.v1.GetRoutineRequest\x1a\x13.drummer.v1.Routine\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/routines/{id}\x12g\n" +
+	"\fListRoutines\x12\x1f.drummer.v1.ListRoutinesRequest\x1a .drummer.v1.ListRoutinesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/routines\x12d\n" +
+	"\rUpdateRoutine\x12 .drummer.v1.UpdateRoutineRequest\x1a\x13.drummer.v1.Routine\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/routines/{id}\x12d\n" +
+	"\rDeleteRoutine\x12 .drummer.v1.DeleteRoutineRequest\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/routines/{id}\x12\x9e\x01\n" +
+	"\x17StartSessionFromRoutine\x12*.drummer.v1.StartSessionFromRoutineRequest\x1a+.drummer.v1.StartSessionFromRoutineResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/routines/{routine_id}/start2\x8a\x01\n" +
+	"\x15RecommendationService\x12q\n" +
+	"\x0fGetPracticePlan\x12\".drummer.v1.GetPracticePlanRequest\x1a\x18.drummer.v1.PracticePlan\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/recommendations/plan2d\n" +
+	"\rSearchService\x12S\n" +
+	"\x06Search\x12\x19.drummer.v1.SearchRequest\x1a\x1a.drummer.v1.SearchResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/search2\xd0\x01\n" +
+	"\x0fSettingsService\x12Y\n" +
+	"\vGetSettings\x12\x1e.drummer.v1.GetSettingsRequest\x1a\x14.drummer.v1.Settings\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/settings\x12b\n" +
+	"\x0eUpdateSettings\x12!.drummer.v1.UpdateSettingsRequest\x1a\x14.drummer.v1.Settings\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*2\f/v1/settings2\xd6\x01\n" +
+	"\vDataService\x12[\n" +
+	"\tExportAll\x12\x1c.drummer.v1.ExportAllRequest\x1a\x17.drummer.v1.DataArchive\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/data/export\x12j\n" +
+	"\tImportAll\x12\x1c.drummer.v1.ImportAllRequest\x1a\x1d.drummer.v1.ImportAllResponse\" \x82\xd3\xe4\x93\x02\x1a:\aarchive\"\x0f/v1/data/import2\xdc\x01\n" +
+	"\fAdminService\x12a\n" +
+	"\fCreateBackup\x12\x1f.drummer.v1.CreateBackupRequest\x1a\x12.drummer.v1.Backup\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/admin/backups\x12i\n" +
+	"\vListBackups\x12\x1e.drummer.v1.ListBackupsRequest\x1a\x1f.drummer.v1.ListBackupsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/admin/backups2\xdb\x05\n" +
+	"\vAuthService\x12W\n" +
+	"\x05Login\x12\x18.drummer.v1.LoginRequest\x1a\x19.drummer.v1.LoginResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12[\n" +
+	"\x06Logout\x12\x19.drummer.v1.LogoutRequest\x1a\x1a.drummer.v1.LogoutResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logout\x12\\\n" +
+	"\x0eGetCurrentUser\x12!.drummer.v1.GetCurrentUserRequest\x1a\x10.drummer.v1.User\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/auth/user\x12i\n" +
+	"\x0eChangePassword\x12!.drummer.v1.ChangePasswordRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/password\x12s\n" +
+	"\x0eCreateApiToken\x12!.drummer.v1.CreateApiTokenRequest\x1a\".drummer.v1.CreateApiTokenResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/tokens\x12m\n" +
+	"\rListApiTokens\x12 .drummer.v1.ListApiTokensRequest\x1a!.drummer.v1.ListApiTokensResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/auth/tokens\x12i\n" +
+	"\x0eDeleteApiToken\x12!.drummer.v1.DeleteApiTokenRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/auth/tokens/{id}2\xe8\x04\n" +
+	"\vUserService\x12S\n" +
+	"\n" +
+	"CreateUser\x12\x1d.drummer.v1.CreateUserRequest\x1a\x10.drummer.v1.User\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/users\x12[\n" +
+	"\tListUsers\x12\x1c.drummer.v1.ListUsersRequest\x1a\x1d.drummer.v1.ListUsersResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x12X\n" +
+	"\n" +
+	"UpdateUser\x12\x1d.drummer.v1.UpdateUserRequest\x1a\x10.drummer.v1.User\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*2\x0e/v1/users/{id}\x12[\n" +
+	"\n" +
+	"DeleteUser\x12\x1d.drummer.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/users/{id}\x12o\n" +
+	"\n" +
+	"AddStudent\x12\x1d.drummer.v1.AddStudentRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/users/{teacher_id}/students\x12\x7f\n" +
+	"\rRemoveStudent\x12 .drummer.v1.RemoveStudentRequest\x1a\x16.google.protobuf.Empty\"4\x82\xd3\xe4\x93\x02.*,/v1/users/{teacher_id}/students/{student_id}2\xe3\t\n" +
+	"\x11AssignmentService\x12k\n" +
+	"\x10CreateAssignment\x12#.drummer.v1.CreateAssignmentRequest\x1a\x16.drummer.v1.Assignment\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/assignments\x12g\n" +
+	"\rGetAssignment\x12 .drummer.v1.GetAssignmentRequest\x1a\x16.drummer.v1.Assignment\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/assignments/{id}\x12s\n" +
+	"\x0fListAssignments\x12\".drummer.v1.ListAssignmentsRequest\x1a#.drummer.v1.ListAssignmentsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/assignments\x12p\n" +
+	"\x10UpdateAssignment\x12#.drummer.v1.UpdateAssignmentRequest\x1a\x16.drummer.v1.Assignment\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*2\x14/v1/assignments/{id}\x12m\n" +
+	"\x10DeleteAssignment\x12#.drummer.v1.DeleteAssignmentRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/assignments/{id}\x12\x9d\x01\n" +
+	"\x15ListAssignmentHistory\x12(.drummer.v1.ListAssignmentHistoryRequest\x1a).drummer.v1.ListAssignmentHistoryResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v1/assignments/{assignment_id}/history\x12\x83\x01\n" +
+	"\x11AddHistoryComment\x12$.drummer.v1.AddHistoryCommentRequest\x1a\x1a.drummer.v1.HistoryComment\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/history/{history_id}/comments\x12z\n" +
+	"\x14DeleteHistoryComment\x12'.drummer.v1.DeleteHistoryCommentRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/history-comments/{id}\x12g\n" +
+	"\fListStudents\x12\x1f.drummer.v1.ListStudentsRequest\x1a .drummer.v1.ListStudentsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/students\x12\x96\x01\n" +
+	"\x14ListStudentExercises\x12'.drummer.v1.ListStudentExercisesRequest\x1a(.drummer.v1.ListStudentExercisesResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/students/{student_id}/exercisesB\xa6\x01\n" +
 	"\x0ecom.drummer.v1B\vTempusProtoP\x01Z>github.com/Zach-Johnson/drum-practice/proto/tempus/v1;tempusv1\xa2\x02\x03DXX\xaa\x02\n" +
 	"Drummer.V1\xca\x02\n" +
 	"Drummer\\V1\xe2\x02\x16Drummer\\V1\\GPBMetadata\xea\x02\vDrummer::V1b\x06proto3"
@@ -3674,186 +12101,540 @@ func file_api_v1_tempus_tempus_proto_rawDescGZIP() []byte {
 	return file_api_v1_tempus_tempus_proto_rawDescData
 }
 
-var file_api_v1_tempus_tempus_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_api_v1_tempus_tempus_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_api_v1_tempus_tempus_proto_msgTypes = make([]protoimpl.MessageInfo, 162)
 var file_api_v1_tempus_tempus_proto_goTypes = []any{
-	(*Category)(nil),                     // 0: drummer.v1.Category
-	(*Tag)(nil),                          // 1: drummer.v1.Tag
-	(*Exercise)(nil),                     // 2: drummer.v1.Exercise
-	(*ExerciseImage)(nil),                // 3: drummer.v1.ExerciseImage
-	(*ExerciseLink)(nil),                 // 4: drummer.v1.ExerciseLink
-	(*PracticeSession)(nil),              // 5: drummer.v1.PracticeSession
-	(*ExerciseHistory)(nil),              // 6: drummer.v1.ExerciseHistory
-	(*CreateCategoryRequest)(nil),        // 7: drummer.v1.CreateCategoryRequest
-	(*GetCategoryRequest)(nil),           // 8: drummer.v1.GetCategoryRequest
-	(*ListCategoriesRequest)(nil),        // 9: drummer.v1.ListCategoriesRequest
-	(*ListCategoriesResponse)(nil),       // 10: drummer.v1.ListCategoriesResponse
-	(*UpdateCategoryRequest)(nil),        // 11: drummer.v1.UpdateCategoryRequest
-	(*DeleteCategoryRequest)(nil),        // 12: drummer.v1.DeleteCategoryRequest
-	(*CreateTagRequest)(nil),             // 13: drummer.v1.CreateTagRequest
-	(*GetTagRequest)(nil),                // 14: drummer.v1.GetTagRequest
-	(*ListTagsRequest)(nil),              // 15: drummer.v1.ListTagsRequest
-	(*ListTagsResponse)(nil),             // 16: drummer.v1.ListTagsResponse
-	(*UpdateTagRequest)(nil),             // 17: drummer.v1.UpdateTagRequest
-	(*DeleteTagRequest)(nil),             // 18: drummer.v1.DeleteTagRequest
-	(*CreateExerciseRequest)(nil),        // 19: drummer.v1.CreateExerciseRequest
-	(*GetExerciseRequest)(nil),           // 20: drummer.v1.GetExerciseRequest
-	(*ListExercisesRequest)(nil),         // 21: drummer.v1.ListExercisesRequest
-	(*ListExercisesResponse)(nil),        // 22: drummer.v1.ListExercisesResponse
-	(*UpdateExerciseRequest)(nil),        // 23: drummer.v1.UpdateExerciseRequest
-	(*DeleteExerciseRequest)(nil),        // 24: drummer.v1.DeleteExerciseRequest
-	(*AddExerciseImageRequest)(nil),      // 25: drummer.v1.AddExerciseImageRequest
-	(*GetExerciseImageRequest)(nil),      // 26: drummer.v1.GetExerciseImageRequest
-	(*DeleteExerciseImageRequest)(nil),   // 27: drummer.v1.DeleteExerciseImageRequest
-	(*AddExerciseLinkRequest)(nil),       // 28: drummer.v1.AddExerciseLinkRequest
-	(*DeleteExerciseLinkRequest)(nil),    // 29: drummer.v1.DeleteExerciseLinkRequest
-	(*CreatePracticeSessionRequest)(nil), // 30: drummer.v1.CreatePracticeSessionRequest
-	(*GetPracticeSessionRequest)(nil),    // 31: drummer.v1.GetPracticeSessionRequest
-	(*ListPracticeSessionsRequest)(nil),  // 32: drummer.v1.ListPracticeSessionsRequest
-	(*ListPracticeSessionsResponse)(nil), // 33: drummer.v1.ListPracticeSessionsResponse
-	(*UpdatePracticeSessionRequest)(nil), // 34: drummer.v1.UpdatePracticeSessionRequest
-	(*DeletePracticeSessionRequest)(nil), // 35: drummer.v1.DeletePracticeSessionRequest
-	(*CreateExerciseHistoryRequest)(nil), // 36: drummer.v1.CreateExerciseHistoryRequest
-	(*GetExerciseHistoryRequest)(nil),    // 37: drummer.v1.GetExerciseHistoryRequest
-	(*ListExerciseHistoryRequest)(nil),   // 38: drummer.v1.ListExerciseHistoryRequest
-	(*ListExerciseHistoryResponse)(nil),  // 39: drummer.v1.ListExerciseHistoryResponse
-	(*UpdateExerciseHistoryRequest)(nil), // 40: drummer.v1.UpdateExerciseHistoryRequest
-	(*DeleteExerciseHistoryRequest)(nil), // 41: drummer.v1.DeleteExerciseHistoryRequest
-	(*GetExerciseStatsRequest)(nil),      // 42: drummer.v1.GetExerciseStatsRequest
-	(*ExerciseStats)(nil),                // 43: drummer.v1.ExerciseStats
-	(*BpmProgressPoint)(nil),             // 44: drummer.v1.BpmProgressPoint
-	(*GetPracticeStatsRequest)(nil),      // 45: drummer.v1.GetPracticeStatsRequest
-	(*PracticeStats)(nil),                // 46: drummer.v1.PracticeStats
-	(*ExerciseTimeDistribution)(nil),     // 47: drummer.v1.ExerciseTimeDistribution
-	(*CategoryTimeDistribution)(nil),     // 48: drummer.v1.CategoryTimeDistribution
-	(*PracticeTimePoint)(nil),            // 49: drummer.v1.PracticeTimePoint
-	(*timestamppb.Timestamp)(nil),        // 50: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),        // 51: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                // 52: google.protobuf.Empty
+	(Subdivision)(0),                        // 0: drummer.v1.Subdivision
+	(NotationFormat)(0),                     // 1: drummer.v1.NotationFormat
+	(TagMatch)(0),                           // 2: drummer.v1.TagMatch
+	(ExerciseSort)(0),                       // 3: drummer.v1.ExerciseSort
+	(SessionEventType)(0),                   // 4: drummer.v1.SessionEventType
+	(SearchEntityType)(0),                   // 5: drummer.v1.SearchEntityType
+	(AssignmentStatus)(0),                   // 6: drummer.v1.AssignmentStatus
+	(*Category)(nil),                        // 7: drummer.v1.Category
+	(*Tag)(nil),                             // 8: drummer.v1.Tag
+	(*Exercise)(nil),                        // 9: drummer.v1.Exercise
+	(*TempoPlan)(nil),                       // 10: drummer.v1.TempoPlan
+	(*ExerciseNotation)(nil),                // 11: drummer.v1.ExerciseNotation
+	(*ExerciseImage)(nil),                   // 12: drummer.v1.ExerciseImage
+	(*ExerciseImageThumbnail)(nil),          // 13: drummer.v1.ExerciseImageThumbnail
+	(*ExerciseLink)(nil),                    // 14: drummer.v1.ExerciseLink
+	(*PracticeSession)(nil),                 // 15: drummer.v1.PracticeSession
+	(*SessionSegment)(nil),                  // 16: drummer.v1.SessionSegment
+	(*ExerciseHistory)(nil),                 // 17: drummer.v1.ExerciseHistory
+	(*ExerciseHistoryRecording)(nil),        // 18: drummer.v1.ExerciseHistoryRecording
+	(*Goal)(nil),                            // 19: drummer.v1.Goal
+	(*Routine)(nil),                         // 20: drummer.v1.Routine
+	(*RoutineStep)(nil),                     // 21: drummer.v1.RoutineStep
+	(*Settings)(nil),                        // 22: drummer.v1.Settings
+	(*CreateCategoryRequest)(nil),           // 23: drummer.v1.CreateCategoryRequest
+	(*GetCategoryRequest)(nil),              // 24: drummer.v1.GetCategoryRequest
+	(*ListCategoriesRequest)(nil),           // 25: drummer.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),          // 26: drummer.v1.ListCategoriesResponse
+	(*UpdateCategoryRequest)(nil),           // 27: drummer.v1.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),           // 28: drummer.v1.DeleteCategoryRequest
+	(*CreateTagRequest)(nil),                // 29: drummer.v1.CreateTagRequest
+	(*GetTagRequest)(nil),                   // 30: drummer.v1.GetTagRequest
+	(*ListTagsRequest)(nil),                 // 31: drummer.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),                // 32: drummer.v1.ListTagsResponse
+	(*UpdateTagRequest)(nil),                // 33: drummer.v1.UpdateTagRequest
+	(*DeleteTagRequest)(nil),                // 34: drummer.v1.DeleteTagRequest
+	(*CreateExerciseRequest)(nil),           // 35: drummer.v1.CreateExerciseRequest
+	(*GetExerciseRequest)(nil),              // 36: drummer.v1.GetExerciseRequest
+	(*ListExercisesRequest)(nil),            // 37: drummer.v1.ListExercisesRequest
+	(*ListExercisesResponse)(nil),           // 38: drummer.v1.ListExercisesResponse
+	(*UpdateExerciseRequest)(nil),           // 39: drummer.v1.UpdateExerciseRequest
+	(*DeleteExerciseRequest)(nil),           // 40: drummer.v1.DeleteExerciseRequest
+	(*AddExerciseImageRequest)(nil),         // 41: drummer.v1.AddExerciseImageRequest
+	(*GetExerciseImageRequest)(nil),         // 42: drummer.v1.GetExerciseImageRequest
+	(*DeleteExerciseImageRequest)(nil),      // 43: drummer.v1.DeleteExerciseImageRequest
+	(*AddExerciseNotationRequest)(nil),      // 44: drummer.v1.AddExerciseNotationRequest
+	(*GetExerciseNotationRequest)(nil),      // 45: drummer.v1.GetExerciseNotationRequest
+	(*DeleteExerciseNotationRequest)(nil),   // 46: drummer.v1.DeleteExerciseNotationRequest
+	(*AddExerciseLinkRequest)(nil),          // 47: drummer.v1.AddExerciseLinkRequest
+	(*DeleteExerciseLinkRequest)(nil),       // 48: drummer.v1.DeleteExerciseLinkRequest
+	(*CreatePracticeSessionRequest)(nil),    // 49: drummer.v1.CreatePracticeSessionRequest
+	(*GetPracticeSessionRequest)(nil),       // 50: drummer.v1.GetPracticeSessionRequest
+	(*ListPracticeSessionsRequest)(nil),     // 51: drummer.v1.ListPracticeSessionsRequest
+	(*ListPracticeSessionsResponse)(nil),    // 52: drummer.v1.ListPracticeSessionsResponse
+	(*UpdatePracticeSessionRequest)(nil),    // 53: drummer.v1.UpdatePracticeSessionRequest
+	(*DeletePracticeSessionRequest)(nil),    // 54: drummer.v1.DeletePracticeSessionRequest
+	(*PauseSessionRequest)(nil),             // 55: drummer.v1.PauseSessionRequest
+	(*ResumeSessionRequest)(nil),            // 56: drummer.v1.ResumeSessionRequest
+	(*StartExerciseRequest)(nil),            // 57: drummer.v1.StartExerciseRequest
+	(*StopExerciseRequest)(nil),             // 58: drummer.v1.StopExerciseRequest
+	(*WatchSessionRequest)(nil),             // 59: drummer.v1.WatchSessionRequest
+	(*SessionEvent)(nil),                    // 60: drummer.v1.SessionEvent
+	(*CreateExerciseHistoryRequest)(nil),    // 61: drummer.v1.CreateExerciseHistoryRequest
+	(*GetExerciseHistoryRequest)(nil),       // 62: drummer.v1.GetExerciseHistoryRequest
+	(*ListExerciseHistoryRequest)(nil),      // 63: drummer.v1.ListExerciseHistoryRequest
+	(*ListExerciseHistoryResponse)(nil),     // 64: drummer.v1.ListExerciseHistoryResponse
+	(*UpdateExerciseHistoryRequest)(nil),    // 65: drummer.v1.UpdateExerciseHistoryRequest
+	(*DeleteExerciseHistoryRequest)(nil),    // 66: drummer.v1.DeleteExerciseHistoryRequest
+	(*UploadRecordingRequest)(nil),          // 67: drummer.v1.UploadRecordingRequest
+	(*RecordingMetadata)(nil),               // 68: drummer.v1.RecordingMetadata
+	(*GetRecordingRequest)(nil),             // 69: drummer.v1.GetRecordingRequest
+	(*DeleteRecordingRequest)(nil),          // 70: drummer.v1.DeleteRecordingRequest
+	(*GetExerciseStatsRequest)(nil),         // 71: drummer.v1.GetExerciseStatsRequest
+	(*ExerciseStats)(nil),                   // 72: drummer.v1.ExerciseStats
+	(*ExportMidiRequest)(nil),               // 73: drummer.v1.ExportMidiRequest
+	(*ExercisePack)(nil),                    // 74: drummer.v1.ExercisePack
+	(*PackCategory)(nil),                    // 75: drummer.v1.PackCategory
+	(*PackTag)(nil),                         // 76: drummer.v1.PackTag
+	(*PackExercise)(nil),                    // 77: drummer.v1.PackExercise
+	(*PackLink)(nil),                        // 78: drummer.v1.PackLink
+	(*PackImage)(nil),                       // 79: drummer.v1.PackImage
+	(*ExportExercisePackRequest)(nil),       // 80: drummer.v1.ExportExercisePackRequest
+	(*ImportExercisePackRequest)(nil),       // 81: drummer.v1.ImportExercisePackRequest
+	(*ImportExercisePackResponse)(nil),      // 82: drummer.v1.ImportExercisePackResponse
+	(*GoalProgress)(nil),                    // 83: drummer.v1.GoalProgress
+	(*BpmProgressPoint)(nil),                // 84: drummer.v1.BpmProgressPoint
+	(*GetPracticeStatsRequest)(nil),         // 85: drummer.v1.GetPracticeStatsRequest
+	(*PracticeStats)(nil),                   // 86: drummer.v1.PracticeStats
+	(*ExerciseTimeDistribution)(nil),        // 87: drummer.v1.ExerciseTimeDistribution
+	(*CategoryTimeDistribution)(nil),        // 88: drummer.v1.CategoryTimeDistribution
+	(*PracticeTimePoint)(nil),               // 89: drummer.v1.PracticeTimePoint
+	(*GetTargetProgressRequest)(nil),        // 90: drummer.v1.GetTargetProgressRequest
+	(*TargetProgress)(nil),                  // 91: drummer.v1.TargetProgress
+	(*CategoryTargetProgress)(nil),          // 92: drummer.v1.CategoryTargetProgress
+	(*WeeklyTargetProgress)(nil),            // 93: drummer.v1.WeeklyTargetProgress
+	(*GetConsistencyStatsRequest)(nil),      // 94: drummer.v1.GetConsistencyStatsRequest
+	(*ConsistencyStats)(nil),                // 95: drummer.v1.ConsistencyStats
+	(*PracticePeriod)(nil),                  // 96: drummer.v1.PracticePeriod
+	(*HeatmapDay)(nil),                      // 97: drummer.v1.HeatmapDay
+	(*DayOfWeekTime)(nil),                   // 98: drummer.v1.DayOfWeekTime
+	(*HourOfDayTime)(nil),                   // 99: drummer.v1.HourOfDayTime
+	(*CreateGoalRequest)(nil),               // 100: drummer.v1.CreateGoalRequest
+	(*GetGoalRequest)(nil),                  // 101: drummer.v1.GetGoalRequest
+	(*ListGoalsRequest)(nil),                // 102: drummer.v1.ListGoalsRequest
+	(*ListGoalsResponse)(nil),               // 103: drummer.v1.ListGoalsResponse
+	(*UpdateGoalRequest)(nil),               // 104: drummer.v1.UpdateGoalRequest
+	(*DeleteGoalRequest)(nil),               // 105: drummer.v1.DeleteGoalRequest
+	(*CreateRoutineRequest)(nil),            // 106: drummer.v1.CreateRoutineRequest
+	(*GetRoutineRequest)(nil),               // 107: drummer.v1.GetRoutineRequest
+	(*ListRoutinesRequest)(nil),             // 108: drummer.v1.ListRoutinesRequest
+	(*ListRoutinesResponse)(nil),            // 109: drummer.v1.ListRoutinesResponse
+	(*UpdateRoutineRequest)(nil),            // 110: drummer.v1.UpdateRoutineRequest
+	(*DeleteRoutineRequest)(nil),            // 111: drummer.v1.DeleteRoutineRequest
+	(*StartSessionFromRoutineRequest)(nil),  // 112: drummer.v1.StartSessionFromRoutineRequest
+	(*StartSessionFromRoutineResponse)(nil), // 113: drummer.v1.StartSessionFromRoutineResponse
+	(*PlannedStep)(nil),                     // 114: drummer.v1.PlannedStep
+	(*GetPracticePlanRequest)(nil),          // 115: drummer.v1.GetPracticePlanRequest
+	(*PracticePlan)(nil),                    // 116: drummer.v1.PracticePlan
+	(*PlanItem)(nil),                        // 117: drummer.v1.PlanItem
+	(*ScoreBreakdown)(nil),                  // 118: drummer.v1.ScoreBreakdown
+	(*GetSettingsRequest)(nil),              // 119: drummer.v1.GetSettingsRequest
+	(*UpdateSettingsRequest)(nil),           // 120: drummer.v1.UpdateSettingsRequest
+	(*DataArchive)(nil),                     // 121: drummer.v1.DataArchive
+	(*ExportAllRequest)(nil),                // 122: drummer.v1.ExportAllRequest
+	(*ImportAllRequest)(nil),                // 123: drummer.v1.ImportAllRequest
+	(*ImportAllResponse)(nil),               // 124: drummer.v1.ImportAllResponse
+	(*Backup)(nil),                          // 125: drummer.v1.Backup
+	(*CreateBackupRequest)(nil),             // 126: drummer.v1.CreateBackupRequest
+	(*ListBackupsRequest)(nil),              // 127: drummer.v1.ListBackupsRequest
+	(*ListBackupsResponse)(nil),             // 128: drummer.v1.ListBackupsResponse
+	(*SearchRequest)(nil),                   // 129: drummer.v1.SearchRequest
+	(*SearchResponse)(nil),                  // 130: drummer.v1.SearchResponse
+	(*SearchResultGroup)(nil),               // 131: drummer.v1.SearchResultGroup
+	(*SearchResult)(nil),                    // 132: drummer.v1.SearchResult
+	(*User)(nil),                            // 133: drummer.v1.User
+	(*ApiToken)(nil),                        // 134: drummer.v1.ApiToken
+	(*LoginRequest)(nil),                    // 135: drummer.v1.LoginRequest
+	(*LoginResponse)(nil),                   // 136: drummer.v1.LoginResponse
+	(*LogoutRequest)(nil),                   // 137: drummer.v1.LogoutRequest
+	(*LogoutResponse)(nil),                  // 138: drummer.v1.LogoutResponse
+	(*GetCurrentUserRequest)(nil),           // 139: drummer.v1.GetCurrentUserRequest
+	(*ChangePasswordRequest)(nil),           // 140: drummer.v1.ChangePasswordRequest
+	(*CreateApiTokenRequest)(nil),           // 141: drummer.v1.CreateApiTokenRequest
+	(*CreateApiTokenResponse)(nil),          // 142: drummer.v1.CreateApiTokenResponse
+	(*ListApiTokensRequest)(nil),            // 143: drummer.v1.ListApiTokensRequest
+	(*ListApiTokensResponse)(nil),           // 144: drummer.v1.ListApiTokensResponse
+	(*DeleteApiTokenRequest)(nil),           // 145: drummer.v1.DeleteApiTokenRequest
+	(*CreateUserRequest)(nil),               // 146: drummer.v1.CreateUserRequest
+	(*ListUsersRequest)(nil),                // 147: drummer.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),               // 148: drummer.v1.ListUsersResponse
+	(*UpdateUserRequest)(nil),               // 149: drummer.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),               // 150: drummer.v1.DeleteUserRequest
+	(*AddStudentRequest)(nil),               // 151: drummer.v1.AddStudentRequest
+	(*RemoveStudentRequest)(nil),            // 152: drummer.v1.RemoveStudentRequest
+	(*Assignment)(nil),                      // 153: drummer.v1.Assignment
+	(*HistoryComment)(nil),                  // 154: drummer.v1.HistoryComment
+	(*CreateAssignmentRequest)(nil),         // 155: drummer.v1.CreateAssignmentRequest
+	(*GetAssignmentRequest)(nil),            // 156: drummer.v1.GetAssignmentRequest
+	(*ListAssignmentsRequest)(nil),          // 157: drummer.v1.ListAssignmentsRequest
+	(*ListAssignmentsResponse)(nil),         // 158: drummer.v1.ListAssignmentsResponse
+	(*UpdateAssignmentRequest)(nil),         // 159: drummer.v1.UpdateAssignmentRequest
+	(*DeleteAssignmentRequest)(nil),         // 160: drummer.v1.DeleteAssignmentRequest
+	(*ListAssignmentHistoryRequest)(nil),    // 161: drummer.v1.ListAssignmentHistoryRequest
+	(*ListAssignmentHistoryResponse)(nil),   // 162: drummer.v1.ListAssignmentHistoryResponse
+	(*AddHistoryCommentRequest)(nil),        // 163: drummer.v1.AddHistoryCommentRequest
+	(*DeleteHistoryCommentRequest)(nil),     // 164: drummer.v1.DeleteHistoryCommentRequest
+	(*ListStudentsRequest)(nil),             // 165: drummer.v1.ListStudentsRequest
+	(*ListStudentsResponse)(nil),            // 166: drummer.v1.ListStudentsResponse
+	(*ListStudentExercisesRequest)(nil),     // 167: drummer.v1.ListStudentExercisesRequest
+	(*ListStudentExercisesResponse)(nil),    // 168: drummer.v1.ListStudentExercisesResponse
+	(*timestamppb.Timestamp)(nil),           // 169: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),           // 170: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                   // 171: google.protobuf.Empty
+	(*httpbody.HttpBody)(nil),               // 172: google.api.HttpBody
 }
 var file_api_v1_tempus_tempus_proto_depIdxs = []int32{
-	50, // 0: drummer.v1.Category.created_at:type_name -> google.protobuf.Timestamp
-	50, // 1: drummer.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
-	50, // 2: drummer.v1.Tag.created_at:type_name -> google.protobuf.Timestamp
-	50, // 3: drummer.v1.Exercise.created_at:type_name -> google.protobuf.Timestamp
-	50, // 4: drummer.v1.Exercise.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 5: drummer.v1.Exercise.images:type_name -> drummer.v1.ExerciseImage
-	4,  // 6: drummer.v1.Exercise.links:type_name -> drummer.v1.ExerciseLink
-	50, // 7: drummer.v1.Exercise.last_practice:type_name -> google.protobuf.Timestamp
-	50, // 8: drummer.v1.ExerciseImage.created_at:type_name -> google.protobuf.Timestamp
-	50, // 9: drummer.v1.ExerciseLink.created_at:type_name -> google.protobuf.Timestamp
-	50, // 10: drummer.v1.PracticeSession.start_time:type_name -> google.protobuf.Timestamp
-	50, // 11: drummer.v1.PracticeSession.end_time:type_name -> google.protobuf.Timestamp
-	50, // 12: drummer.v1.PracticeSession.created_at:type_name -> google.protobuf.Timestamp
-	50, // 13: drummer.v1.PracticeSession.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 14: drummer.v1.PracticeSession.exercises:type_name -> drummer.v1.ExerciseHistory
-	50, // 15: drummer.v1.ExerciseHistory.start_time:type_name -> google.protobuf.Timestamp
-	50, // 16: drummer.v1.ExerciseHistory.end_time:type_name -> google.protobuf.Timestamp
-	2,  // 17: drummer.v1.ExerciseHistory.exercise:type_name -> drummer.v1.Exercise
-	0,  // 18: drummer.v1.ListCategoriesResponse.categories:type_name -> drummer.v1.Category
-	0,  // 19: drummer.v1.UpdateCategoryRequest.category:type_name -> drummer.v1.Category
-	51, // 20: drummer.v1.UpdateCategoryRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 21: drummer.v1.ListTagsResponse.tags:type_name -> drummer.v1.Tag
-	1,  // 22: drummer.v1.UpdateTagRequest.tag:type_name -> drummer.v1.Tag
-	51, // 23: drummer.v1.UpdateTagRequest.update_mask:type_name -> google.protobuf.FieldMask
-	3,  // 24: drummer.v1.CreateExerciseRequest.images:type_name -> drummer.v1.ExerciseImage
-	4,  // 25: drummer.v1.CreateExerciseRequest.links:type_name -> drummer.v1.ExerciseLink
-	2,  // 26: drummer.v1.ListExercisesResponse.exercises:type_name -> drummer.v1.Exercise
-	2,  // 27: drummer.v1.UpdateExerciseRequest.exercise:type_name -> drummer.v1.Exercise
-	51, // 28: drummer.v1.UpdateExerciseRequest.update_mask:type_name -> google.protobuf.FieldMask
-	50, // 29: drummer.v1.CreatePracticeSessionRequest.start_time:type_name -> google.protobuf.Timestamp
-	50, // 30: drummer.v1.CreatePracticeSessionRequest.end_time:type_name -> google.protobuf.Timestamp
-	50, // 31: drummer.v1.ListPracticeSessionsRequest.start_date:type_name -> google.protobuf.Timestamp
-	50, // 32: drummer.v1.ListPracticeSessionsRequest.end_date:type_name -> google.protobuf.Timestamp
-	5,  // 33: drummer.v1.ListPracticeSessionsResponse.sessions:type_name -> drummer.v1.PracticeSession
-	5,  // 34: drummer.v1.UpdatePracticeSessionRequest.session:type_name -> drummer.v1.PracticeSession
-	51, // 35: drummer.v1.UpdatePracticeSessionRequest.update_mask:type_name -> google.protobuf.FieldMask
-	50, // 36: drummer.v1.CreateExerciseHistoryRequest.start_time:type_name -> google.protobuf.Timestamp
-	50, // 37: drummer.v1.CreateExerciseHistoryRequest.end_time:type_name -> google.protobuf.Timestamp
-	50, // 38: drummer.v1.ListExerciseHistoryRequest.start_date:type_name -> google.protobuf.Timestamp
-	50, // 39: drummer.v1.ListExerciseHistoryRequest.end_date:type_name -> google.protobuf.Timestamp
-	6,  // 40: drummer.v1.ListExerciseHistoryResponse.history_entries:type_name -> drummer.v1.ExerciseHistory
-	6,  // 41: drummer.v1.UpdateExerciseHistoryRequest.history:type_name -> drummer.v1.ExerciseHistory
-	51, // 42: drummer.v1.UpdateExerciseHistoryRequest.update_mask:type_name -> google.protobuf.FieldMask
-	50, // 43: drummer.v1.GetExerciseStatsRequest.start_date:type_name -> google.protobuf.Timestamp
-	50, // 44: drummer.v1.GetExerciseStatsRequest.end_date:type_name -> google.protobuf.Timestamp
-	44, // 45: drummer.v1.ExerciseStats.bpm_progress:type_name -> drummer.v1.BpmProgressPoint
-	50, // 46: drummer.v1.BpmProgressPoint.date:type_name -> google.protobuf.Timestamp
-	50, // 47: drummer.v1.GetPracticeStatsRequest.start_date:type_name -> google.protobuf.Timestamp
-	50, // 48: drummer.v1.GetPracticeStatsRequest.end_date:type_name -> google.protobuf.Timestamp
-	47, // 49: drummer.v1.PracticeStats.exercise_distribution:type_name -> drummer.v1.ExerciseTimeDistribution
-	48, // 50: drummer.v1.PracticeStats.category_distribution:type_name -> drummer.v1.CategoryTimeDistribution
-	49, // 51: drummer.v1.PracticeStats.practice_frequency:type_name -> drummer.v1.PracticeTimePoint
-	49, // 52: drummer.v1.CategoryTimeDistribution.practice_frequency:type_name -> drummer.v1.PracticeTimePoint
-	50, // 53: drummer.v1.PracticeTimePoint.date:type_name -> google.protobuf.Timestamp
-	7,  // 54: drummer.v1.CategoryService.CreateCategory:input_type -> drummer.v1.CreateCategoryRequest
-	8,  // 55: drummer.v1.CategoryService.GetCategory:input_type -> drummer.v1.GetCategoryRequest
-	9,  // 56: drummer.v1.CategoryService.ListCategories:input_type -> drummer.v1.ListCategoriesRequest
-	11, // 57: drummer.v1.CategoryService.UpdateCategory:input_type -> drummer.v1.UpdateCategoryRequest
-	12, // 58: drummer.v1.CategoryService.DeleteCategory:input_type -> drummer.v1.DeleteCategoryRequest
-	13, // 59: drummer.v1.TagService.CreateTag:input_type -> drummer.v1.CreateTagRequest
-	14, // 60: drummer.v1.TagService.GetTag:input_type -> drummer.v1.GetTagRequest
-	15, // 61: drummer.v1.TagService.ListTags:input_type -> drummer.v1.ListTagsRequest
-	17, // 62: drummer.v1.TagService.UpdateTag:input_type -> drummer.v1.UpdateTagRequest
-	18, // 63: drummer.v1.TagService.DeleteTag:input_type -> drummer.v1.DeleteTagRequest
-	19, // 64: drummer.v1.ExerciseService.CreateExercise:input_type -> drummer.v1.CreateExerciseRequest
-	20, // 65: drummer.v1.ExerciseService.GetExercise:input_type -> drummer.v1.GetExerciseRequest
-	21, // 66: drummer.v1.ExerciseService.ListExercises:input_type -> drummer.v1.ListExercisesRequest
-	23, // 67: drummer.v1.ExerciseService.UpdateExercise:input_type -> drummer.v1.UpdateExerciseRequest
-	24, // 68: drummer.v1.ExerciseService.DeleteExercise:input_type -> drummer.v1.DeleteExerciseRequest
-	25, // 69: drummer.v1.ExerciseService.AddExerciseImage:input_type -> drummer.v1.AddExerciseImageRequest
-	26, // 70: drummer.v1.ExerciseService.GetExerciseImage:input_type -> drummer.v1.GetExerciseImageRequest
-	27, // 71: drummer.v1.ExerciseService.DeleteExerciseImage:input_type -> drummer.v1.DeleteExerciseImageRequest
-	28, // 72: drummer.v1.ExerciseService.AddExerciseLink:input_type -> drummer.v1.AddExerciseLinkRequest
-	29, // 73: drummer.v1.ExerciseService.DeleteExerciseLink:input_type -> drummer.v1.DeleteExerciseLinkRequest
-	42, // 74: drummer.v1.ExerciseService.GetExerciseStats:input_type -> drummer.v1.GetExerciseStatsRequest
-	30, // 75: drummer.v1.PracticeSessionService.CreatePracticeSession:input_type -> drummer.v1.CreatePracticeSessionRequest
-	31, // 76: drummer.v1.PracticeSessionService.GetPracticeSession:input_type -> drummer.v1.GetPracticeSessionRequest
-	32, // 77: drummer.v1.PracticeSessionService.ListPracticeSessions:input_type -> drummer.v1.ListPracticeSessionsRequest
-	34, // 78: drummer.v1.PracticeSessionService.UpdatePracticeSession:input_type -> drummer.v1.UpdatePracticeSessionRequest
-	35, // 79: drummer.v1.PracticeSessionService.DeletePracticeSession:input_type -> drummer.v1.DeletePracticeSessionRequest
-	45, // 80: drummer.v1.PracticeSessionService.GetPracticeStats:input_type -> drummer.v1.GetPracticeStatsRequest
-	36, // 81: drummer.v1.ExerciseHistoryService.CreateExerciseHistory:input_type -> drummer.v1.CreateExerciseHistoryRequest
-	37, // 82: drummer.v1.ExerciseHistoryService.GetExerciseHistory:input_type -> drummer.v1.GetExerciseHistoryRequest
-	38, // 83: drummer.v1.ExerciseHistoryService.ListExerciseHistory:input_type -> drummer.v1.ListExerciseHistoryRequest
-	40, // 84: drummer.v1.ExerciseHistoryService.UpdateExerciseHistory:input_type -> drummer.v1.UpdateExerciseHistoryRequest
-	41, // 85: drummer.v1.ExerciseHistoryService.DeleteExerciseHistory:input_type -> drummer.v1.DeleteExerciseHistoryRequest
-	0,  // 86: drummer.v1.CategoryService.CreateCategory:output_type -> drummer.v1.Category
-	0,  // 87: drummer.v1.CategoryService.GetCategory:output_type -> drummer.v1.Category
-	10, // 88: drummer.v1.CategoryService.ListCategories:output_type -> drummer.v1.ListCategoriesResponse
-	0,  // 89: drummer.v1.CategoryService.UpdateCategory:output_type -> drummer.v1.Category
-	52, // 90: drummer.v1.CategoryService.DeleteCategory:output_type -> google.protobuf.Empty
-	1,  // 91: drummer.v1.TagService.CreateTag:output_type -> drummer.v1.Tag
-	1,  // 92: drummer.v1.TagService.GetTag:output_type -> drummer.v1.Tag
-	16, // 93: drummer.v1.TagService.ListTags:output_type -> drummer.v1.ListTagsResponse
-	1,  // 94: drummer.v1.TagService.UpdateTag:output_type -> drummer.v1.Tag
-	52, // 95: drummer.v1.TagService.DeleteTag:output_type -> google.protobuf.Empty
-	2,  // 96: drummer.v1.ExerciseService.CreateExercise:output_type -> drummer.v1.Exercise
-	2,  // 97: drummer.v1.ExerciseService.GetExercise:output_type -> drummer.v1.Exercise
-	22, // 98: drummer.v1.ExerciseService.ListExercises:output_type -> drummer.v1.ListExercisesResponse
-	2,  // 99: drummer.v1.ExerciseService.UpdateExercise:output_type -> drummer.v1.Exercise
-	52, // 100: drummer.v1.ExerciseService.DeleteExercise:output_type -> google.protobuf.Empty
-	3,  // 101: drummer.v1.ExerciseService.AddExerciseImage:output_type -> drummer.v1.ExerciseImage
-	3,  // 102: drummer.v1.ExerciseService.GetExerciseImage:output_type -> drummer.v1.ExerciseImage
-	52, // 103: drummer.v1.ExerciseService.DeleteExerciseImage:output_type -> google.protobuf.Empty
-	4,  // 104: drummer.v1.ExerciseService.AddExerciseLink:output_type -> drummer.v1.ExerciseLink
-	52, // 105: drummer.v1.ExerciseService.DeleteExerciseLink:output_type -> google.protobuf.Empty
-	43, // 106: drummer.v1.ExerciseService.GetExerciseStats:output_type -> drummer.v1.ExerciseStats
-	5,  // 107: drummer.v1.PracticeSessionService.CreatePracticeSession:output_type -> drummer.v1.PracticeSession
-	5,  // 108: drummer.v1.PracticeSessionService.GetPracticeSession:output_type -> drummer.v1.PracticeSession
-	33, // 109: drummer.v1.PracticeSessionService.ListPracticeSessions:output_type -> drummer.v1.ListPracticeSessionsResponse
-	5,  // 110: drummer.v1.PracticeSessionService.UpdatePracticeSession:output_type -> drummer.v1.PracticeSession
-	52, // 111: drummer.v1.PracticeSessionService.DeletePracticeSession:output_type -> google.protobuf.Empty
-	46, // 112: drummer.v1.PracticeSessionService.GetPracticeStats:output_type -> drummer.v1.PracticeStats
-	6,  // 113: drummer.v1.ExerciseHistoryService.CreateExerciseHistory:output_type -> drummer.v1.ExerciseHistory
-	6,  // 114: drummer.v1.ExerciseHistoryService.GetExerciseHistory:output_type -> drummer.v1.ExerciseHistory
-	39, // 115: drummer.v1.ExerciseHistoryService.ListExerciseHistory:output_type -> drummer.v1.ListExerciseHistoryResponse
-	6,  // 116: drummer.v1.ExerciseHistoryService.UpdateExerciseHistory:output_type -> drummer.v1.ExerciseHistory
-	52, // 117: drummer.v1.ExerciseHistoryService.DeleteExerciseHistory:output_type -> google.protobuf.Empty
-	86, // [86:118] is the sub-list for method output_type
-	54, // [54:86] is the sub-list for method input_type
-	54, // [54:54] is the sub-list for extension type_name
-	54, // [54:54] is the sub-list for extension extendee
-	0,  // [0:54] is the sub-list for field type_name
+	169, // 0: drummer.v1.Category.created_at:type_name -> google.protobuf.Timestamp
+	169, // 1: drummer.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
+	169, // 2: drummer.v1.Tag.created_at:type_name -> google.protobuf.Timestamp
+	169, // 3: drummer.v1.Exercise.created_at:type_name -> google.protobuf.Timestamp
+	169, // 4: drummer.v1.Exercise.updated_at:type_name -> google.protobuf.Timestamp
+	12,  // 5: drummer.v1.Exercise.images:type_name -> drummer.v1.ExerciseImage
+	14,  // 6: drummer.v1.Exercise.links:type_name -> drummer.v1.ExerciseLink
+	169, // 7: drummer.v1.Exercise.last_practice:type_name -> google.protobuf.Timestamp
+	10,  // 8: drummer.v1.Exercise.tempo_plan:type_name -> drummer.v1.TempoPlan
+	11,  // 9: drummer.v1.Exercise.notations:type_name -> drummer.v1.ExerciseNotation
+	0,   // 10: drummer.v1.TempoPlan.subdivision:type_name -> drummer.v1.Subdivision
+	1,   // 11: drummer.v1.ExerciseNotation.format:type_name -> drummer.v1.NotationFormat
+	169, // 12: drummer.v1.ExerciseNotation.created_at:type_name -> google.protobuf.Timestamp
+	169, // 13: drummer.v1.ExerciseImage.created_at:type_name -> google.protobuf.Timestamp
+	13,  // 14: drummer.v1.ExerciseImage.thumbnails:type_name -> drummer.v1.ExerciseImageThumbnail
+	169, // 15: drummer.v1.ExerciseLink.created_at:type_name -> google.protobuf.Timestamp
+	169, // 16: drummer.v1.PracticeSession.start_time:type_name -> google.protobuf.Timestamp
+	169, // 17: drummer.v1.PracticeSession.end_time:type_name -> google.protobuf.Timestamp
+	169, // 18: drummer.v1.PracticeSession.created_at:type_name -> google.protobuf.Timestamp
+	169, // 19: drummer.v1.PracticeSession.updated_at:type_name -> google.protobuf.Timestamp
+	17,  // 20: drummer.v1.PracticeSession.exercises:type_name -> drummer.v1.ExerciseHistory
+	16,  // 21: drummer.v1.PracticeSession.segments:type_name -> drummer.v1.SessionSegment
+	169, // 22: drummer.v1.SessionSegment.start_time:type_name -> google.protobuf.Timestamp
+	169, // 23: drummer.v1.SessionSegment.end_time:type_name -> google.protobuf.Timestamp
+	169, // 24: drummer.v1.ExerciseHistory.start_time:type_name -> google.protobuf.Timestamp
+	169, // 25: drummer.v1.ExerciseHistory.end_time:type_name -> google.protobuf.Timestamp
+	9,   // 26: drummer.v1.ExerciseHistory.exercise:type_name -> drummer.v1.Exercise
+	18,  // 27: drummer.v1.ExerciseHistory.recordings:type_name -> drummer.v1.ExerciseHistoryRecording
+	169, // 28: drummer.v1.ExerciseHistoryRecording.created_at:type_name -> google.protobuf.Timestamp
+	169, // 29: drummer.v1.Goal.target_date:type_name -> google.protobuf.Timestamp
+	169, // 30: drummer.v1.Goal.achieved_at:type_name -> google.protobuf.Timestamp
+	169, // 31: drummer.v1.Goal.created_at:type_name -> google.protobuf.Timestamp
+	169, // 32: drummer.v1.Goal.updated_at:type_name -> google.protobuf.Timestamp
+	21,  // 33: drummer.v1.Routine.steps:type_name -> drummer.v1.RoutineStep
+	169, // 34: drummer.v1.Routine.created_at:type_name -> google.protobuf.Timestamp
+	169, // 35: drummer.v1.Routine.updated_at:type_name -> google.protobuf.Timestamp
+	169, // 36: drummer.v1.Settings.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 37: drummer.v1.ListCategoriesResponse.categories:type_name -> drummer.v1.Category
+	7,   // 38: drummer.v1.UpdateCategoryRequest.category:type_name -> drummer.v1.Category
+	170, // 39: drummer.v1.UpdateCategoryRequest.update_mask:type_name -> google.protobuf.FieldMask
+	8,   // 40: drummer.v1.ListTagsResponse.tags:type_name -> drummer.v1.Tag
+	8,   // 41: drummer.v1.UpdateTagRequest.tag:type_name -> drummer.v1.Tag
+	170, // 42: drummer.v1.UpdateTagRequest.update_mask:type_name -> google.protobuf.FieldMask
+	12,  // 43: drummer.v1.CreateExerciseRequest.images:type_name -> drummer.v1.ExerciseImage
+	14,  // 44: drummer.v1.CreateExerciseRequest.links:type_name -> drummer.v1.ExerciseLink
+	10,  // 45: drummer.v1.CreateExerciseRequest.tempo_plan:type_name -> drummer.v1.TempoPlan
+	2,   // 46: drummer.v1.ListExercisesRequest.tag_match:type_name -> drummer.v1.TagMatch
+	169, // 47: drummer.v1.ListExercisesRequest.not_practiced_since:type_name -> google.protobuf.Timestamp
+	3,   // 48: drummer.v1.ListExercisesRequest.sort:type_name -> drummer.v1.ExerciseSort
+	9,   // 49: drummer.v1.ListExercisesResponse.exercises:type_name -> drummer.v1.Exercise
+	9,   // 50: drummer.v1.UpdateExerciseRequest.exercise:type_name -> drummer.v1.Exercise
+	170, // 51: drummer.v1.UpdateExerciseRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,   // 52: drummer.v1.AddExerciseNotationRequest.format:type_name -> drummer.v1.NotationFormat
+	169, // 53: drummer.v1.CreatePracticeSessionRequest.start_time:type_name -> google.protobuf.Timestamp
+	169, // 54: drummer.v1.CreatePracticeSessionRequest.end_time:type_name -> google.protobuf.Timestamp
+	169, // 55: drummer.v1.ListPracticeSessionsRequest.start_date:type_name -> google.protobuf.Timestamp
+	169, // 56: drummer.v1.ListPracticeSessionsRequest.end_date:type_name -> google.protobuf.Timestamp
+	15,  // 57: drummer.v1.ListPracticeSessionsResponse.sessions:type_name -> drummer.v1.PracticeSession
+	15,  // 58: drummer.v1.UpdatePracticeSessionRequest.session:type_name -> drummer.v1.PracticeSession
+	170, // 59: drummer.v1.UpdatePracticeSessionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,   // 60: drummer.v1.SessionEvent.type:type_name -> drummer.v1.SessionEventType
+	15,  // 61: drummer.v1.SessionEvent.session:type_name -> drummer.v1.PracticeSession
+	17,  // 62: drummer.v1.SessionEvent.exercise:type_name -> drummer.v1.ExerciseHistory
+	169, // 63: drummer.v1.SessionEvent.time:type_name -> google.protobuf.Timestamp
+	169, // 64: drummer.v1.CreateExerciseHistoryRequest.start_time:type_name -> google.protobuf.Timestamp
+	169, // 65: drummer.v1.CreateExerciseHistoryRequest.end_time:type_name -> google.protobuf.Timestamp
+	169, // 66: drummer.v1.ListExerciseHistoryRequest.start_date:type_name -> google.protobuf.Timestamp
+	169, // 67: drummer.v1.ListExerciseHistoryRequest.end_date:type_name -> google.protobuf.Timestamp
+	17,  // 68: drummer.v1.ListExerciseHistoryResponse.history_entries:type_name -> drummer.v1.ExerciseHistory
+	17,  // 69: drummer.v1.UpdateExerciseHistoryRequest.history:type_name -> drummer.v1.ExerciseHistory
+	170, // 70: drummer.v1.UpdateExerciseHistoryRequest.update_mask:type_name -> google.protobuf.FieldMask
+	68,  // 71: drummer.v1.UploadRecordingRequest.metadata:type_name -> drummer.v1.RecordingMetadata
+	169, // 72: drummer.v1.GetExerciseStatsRequest.start_date:type_name -> google.protobuf.Timestamp
+	169, // 73: drummer.v1.GetExerciseStatsRequest.end_date:type_name -> google.protobuf.Timestamp
+	84,  // 74: drummer.v1.ExerciseStats.bpm_progress:type_name -> drummer.v1.BpmProgressPoint
+	83,  // 75: drummer.v1.ExerciseStats.goals:type_name -> drummer.v1.GoalProgress
+	169, // 76: drummer.v1.ExercisePack.exported_at:type_name -> google.protobuf.Timestamp
+	75,  // 77: drummer.v1.ExercisePack.categories:type_name -> drummer.v1.PackCategory
+	76,  // 78: drummer.v1.ExercisePack.tags:type_name -> drummer.v1.PackTag
+	77,  // 79: drummer.v1.ExercisePack.exercises:type_name -> drummer.v1.PackExercise
+	169, // 80: drummer.v1.PackExercise.updated_at:type_name -> google.protobuf.Timestamp
+	10,  // 81: drummer.v1.PackExercise.tempo_plan:type_name -> drummer.v1.TempoPlan
+	78,  // 82: drummer.v1.PackExercise.links:type_name -> drummer.v1.PackLink
+	79,  // 83: drummer.v1.PackExercise.images:type_name -> drummer.v1.PackImage
+	19,  // 84: drummer.v1.GoalProgress.goal:type_name -> drummer.v1.Goal
+	169, // 85: drummer.v1.GoalProgress.projected_completion_date:type_name -> google.protobuf.Timestamp
+	169, // 86: drummer.v1.BpmProgressPoint.date:type_name -> google.protobuf.Timestamp
+	169, // 87: drummer.v1.GetPracticeStatsRequest.start_date:type_name -> google.protobuf.Timestamp
+	169, // 88: drummer.v1.GetPracticeStatsRequest.end_date:type_name -> google.protobuf.Timestamp
+	87,  // 89: drummer.v1.PracticeStats.exercise_distribution:type_name -> drummer.v1.ExerciseTimeDistribution
+	88,  // 90: drummer.v1.PracticeStats.category_distribution:type_name -> drummer.v1.CategoryTimeDistribution
+	89,  // 91: drummer.v1.PracticeStats.practice_frequency:type_name -> drummer.v1.PracticeTimePoint
+	89,  // 92: drummer.v1.CategoryTimeDistribution.practice_frequency:type_name -> drummer.v1.PracticeTimePoint
+	169, // 93: drummer.v1.PracticeTimePoint.date:type_name -> google.protobuf.Timestamp
+	169, // 94: drummer.v1.GetTargetProgressRequest.start_date:type_name -> google.protobuf.Timestamp
+	169, // 95: drummer.v1.GetTargetProgressRequest.end_date:type_name -> google.protobuf.Timestamp
+	92,  // 96: drummer.v1.TargetProgress.categories:type_name -> drummer.v1.CategoryTargetProgress
+	93,  // 97: drummer.v1.CategoryTargetProgress.weeks:type_name -> drummer.v1.WeeklyTargetProgress
+	169, // 98: drummer.v1.WeeklyTargetProgress.week_start:type_name -> google.protobuf.Timestamp
+	169, // 99: drummer.v1.GetConsistencyStatsRequest.end_date:type_name -> google.protobuf.Timestamp
+	96,  // 100: drummer.v1.ConsistencyStats.weekly:type_name -> drummer.v1.PracticePeriod
+	96,  // 101: drummer.v1.ConsistencyStats.monthly:type_name -> drummer.v1.PracticePeriod
+	97,  // 102: drummer.v1.ConsistencyStats.heatmap:type_name -> drummer.v1.HeatmapDay
+	98,  // 103: drummer.v1.ConsistencyStats.day_of_week_distribution:type_name -> drummer.v1.DayOfWeekTime
+	99,  // 104: drummer.v1.ConsistencyStats.hour_of_day_distribution:type_name -> drummer.v1.HourOfDayTime
+	169, // 105: drummer.v1.PracticePeriod.period_start:type_name -> google.protobuf.Timestamp
+	169, // 106: drummer.v1.HeatmapDay.date:type_name -> google.protobuf.Timestamp
+	169, // 107: drummer.v1.CreateGoalRequest.target_date:type_name -> google.protobuf.Timestamp
+	19,  // 108: drummer.v1.ListGoalsResponse.goals:type_name -> drummer.v1.Goal
+	19,  // 109: drummer.v1.UpdateGoalRequest.goal:type_name -> drummer.v1.Goal
+	170, // 110: drummer.v1.UpdateGoalRequest.update_mask:type_name -> google.protobuf.FieldMask
+	21,  // 111: drummer.v1.CreateRoutineRequest.steps:type_name -> drummer.v1.RoutineStep
+	20,  // 112: drummer.v1.ListRoutinesResponse.routines:type_name -> drummer.v1.Routine
+	20,  // 113: drummer.v1.UpdateRoutineRequest.routine:type_name -> drummer.v1.Routine
+	170, // 114: drummer.v1.UpdateRoutineRequest.update_mask:type_name -> google.protobuf.FieldMask
+	169, // 115: drummer.v1.StartSessionFromRoutineRequest.start_time:type_name -> google.protobuf.Timestamp
+	15,  // 116: drummer.v1.StartSessionFromRoutineResponse.session:type_name -> drummer.v1.PracticeSession
+	114, // 117: drummer.v1.StartSessionFromRoutineResponse.steps:type_name -> drummer.v1.PlannedStep
+	21,  // 118: drummer.v1.PlannedStep.step:type_name -> drummer.v1.RoutineStep
+	61,  // 119: drummer.v1.PlannedStep.entry:type_name -> drummer.v1.CreateExerciseHistoryRequest
+	117, // 120: drummer.v1.PracticePlan.items:type_name -> drummer.v1.PlanItem
+	118, // 121: drummer.v1.PlanItem.breakdown:type_name -> drummer.v1.ScoreBreakdown
+	169, // 122: drummer.v1.PlanItem.last_practice:type_name -> google.protobuf.Timestamp
+	22,  // 123: drummer.v1.UpdateSettingsRequest.settings:type_name -> drummer.v1.Settings
+	170, // 124: drummer.v1.UpdateSettingsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	169, // 125: drummer.v1.DataArchive.exported_at:type_name -> google.protobuf.Timestamp
+	7,   // 126: drummer.v1.DataArchive.categories:type_name -> drummer.v1.Category
+	8,   // 127: drummer.v1.DataArchive.tags:type_name -> drummer.v1.Tag
+	9,   // 128: drummer.v1.DataArchive.exercises:type_name -> drummer.v1.Exercise
+	15,  // 129: drummer.v1.DataArchive.sessions:type_name -> drummer.v1.PracticeSession
+	17,  // 130: drummer.v1.DataArchive.history:type_name -> drummer.v1.ExerciseHistory
+	19,  // 131: drummer.v1.DataArchive.goals:type_name -> drummer.v1.Goal
+	20,  // 132: drummer.v1.DataArchive.routines:type_name -> drummer.v1.Routine
+	22,  // 133: drummer.v1.DataArchive.settings:type_name -> drummer.v1.Settings
+	121, // 134: drummer.v1.ImportAllRequest.archive:type_name -> drummer.v1.DataArchive
+	169, // 135: drummer.v1.Backup.created_at:type_name -> google.protobuf.Timestamp
+	125, // 136: drummer.v1.ListBackupsResponse.backups:type_name -> drummer.v1.Backup
+	5,   // 137: drummer.v1.SearchRequest.types:type_name -> drummer.v1.SearchEntityType
+	131, // 138: drummer.v1.SearchResponse.groups:type_name -> drummer.v1.SearchResultGroup
+	5,   // 139: drummer.v1.SearchResultGroup.type:type_name -> drummer.v1.SearchEntityType
+	132, // 140: drummer.v1.SearchResultGroup.results:type_name -> drummer.v1.SearchResult
+	5,   // 141: drummer.v1.SearchResult.type:type_name -> drummer.v1.SearchEntityType
+	169, // 142: drummer.v1.SearchResult.time:type_name -> google.protobuf.Timestamp
+	169, // 143: drummer.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	169, // 144: drummer.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	169, // 145: drummer.v1.ApiToken.created_at:type_name -> google.protobuf.Timestamp
+	169, // 146: drummer.v1.ApiToken.expires_at:type_name -> google.protobuf.Timestamp
+	169, // 147: drummer.v1.ApiToken.last_used_at:type_name -> google.protobuf.Timestamp
+	133, // 148: drummer.v1.LoginResponse.user:type_name -> drummer.v1.User
+	169, // 149: drummer.v1.LoginResponse.expires_at:type_name -> google.protobuf.Timestamp
+	169, // 150: drummer.v1.CreateApiTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	134, // 151: drummer.v1.CreateApiTokenResponse.token:type_name -> drummer.v1.ApiToken
+	134, // 152: drummer.v1.ListApiTokensResponse.tokens:type_name -> drummer.v1.ApiToken
+	133, // 153: drummer.v1.ListUsersResponse.users:type_name -> drummer.v1.User
+	133, // 154: drummer.v1.UpdateUserRequest.user:type_name -> drummer.v1.User
+	170, // 155: drummer.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	169, // 156: drummer.v1.Assignment.due_date:type_name -> google.protobuf.Timestamp
+	169, // 157: drummer.v1.Assignment.created_at:type_name -> google.protobuf.Timestamp
+	169, // 158: drummer.v1.Assignment.updated_at:type_name -> google.protobuf.Timestamp
+	6,   // 159: drummer.v1.Assignment.status:type_name -> drummer.v1.AssignmentStatus
+	169, // 160: drummer.v1.Assignment.last_practice:type_name -> google.protobuf.Timestamp
+	169, // 161: drummer.v1.HistoryComment.created_at:type_name -> google.protobuf.Timestamp
+	169, // 162: drummer.v1.CreateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
+	6,   // 163: drummer.v1.ListAssignmentsRequest.status:type_name -> drummer.v1.AssignmentStatus
+	153, // 164: drummer.v1.ListAssignmentsResponse.assignments:type_name -> drummer.v1.Assignment
+	153, // 165: drummer.v1.UpdateAssignmentRequest.assignment:type_name -> drummer.v1.Assignment
+	170, // 166: drummer.v1.UpdateAssignmentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	17,  // 167: drummer.v1.ListAssignmentHistoryResponse.entries:type_name -> drummer.v1.ExerciseHistory
+	154, // 168: drummer.v1.ListAssignmentHistoryResponse.comments:type_name -> drummer.v1.HistoryComment
+	133, // 169: drummer.v1.ListStudentsResponse.students:type_name -> drummer.v1.User
+	9,   // 170: drummer.v1.ListStudentExercisesResponse.exercises:type_name -> drummer.v1.Exercise
+	23,  // 171: drummer.v1.CategoryService.CreateCategory:input_type -> drummer.v1.CreateCategoryRequest
+	24,  // 172: drummer.v1.CategoryService.GetCategory:input_type -> drummer.v1.GetCategoryRequest
+	25,  // 173: drummer.v1.CategoryService.ListCategories:input_type -> drummer.v1.ListCategoriesRequest
+	27,  // 174: drummer.v1.CategoryService.UpdateCategory:input_type -> drummer.v1.UpdateCategoryRequest
+	28,  // 175: drummer.v1.CategoryService.DeleteCategory:input_type -> drummer.v1.DeleteCategoryRequest
+	29,  // 176: drummer.v1.TagService.CreateTag:input_type -> drummer.v1.CreateTagRequest
+	30,  // 177: drummer.v1.TagService.GetTag:input_type -> drummer.v1.GetTagRequest
+	31,  // 178: drummer.v1.TagService.ListTags:input_type -> drummer.v1.ListTagsRequest
+	33,  // 179: drummer.v1.TagService.UpdateTag:input_type -> drummer.v1.UpdateTagRequest
+	34,  // 180: drummer.v1.TagService.DeleteTag:input_type -> drummer.v1.DeleteTagRequest
+	35,  // 181: drummer.v1.ExerciseService.CreateExercise:input_type -> drummer.v1.CreateExerciseRequest
+	36,  // 182: drummer.v1.ExerciseService.GetExercise:input_type -> drummer.v1.GetExerciseRequest
+	37,  // 183: drummer.v1.ExerciseService.ListExercises:input_type -> drummer.v1.ListExercisesRequest
+	39,  // 184: drummer.v1.ExerciseService.UpdateExercise:input_type -> drummer.v1.UpdateExerciseRequest
+	40,  // 185: drummer.v1.ExerciseService.DeleteExercise:input_type -> drummer.v1.DeleteExerciseRequest
+	41,  // 186: drummer.v1.ExerciseService.AddExerciseImage:input_type -> drummer.v1.AddExerciseImageRequest
+	42,  // 187: drummer.v1.ExerciseService.GetExerciseImage:input_type -> drummer.v1.GetExerciseImageRequest
+	43,  // 188: drummer.v1.ExerciseService.DeleteExerciseImage:input_type -> drummer.v1.DeleteExerciseImageRequest
+	44,  // 189: drummer.v1.ExerciseService.AddExerciseNotation:input_type -> drummer.v1.AddExerciseNotationRequest
+	45,  // 190: drummer.v1.ExerciseService.GetExerciseNotation:input_type -> drummer.v1.GetExerciseNotationRequest
+	46,  // 191: drummer.v1.ExerciseService.DeleteExerciseNotation:input_type -> drummer.v1.DeleteExerciseNotationRequest
+	47,  // 192: drummer.v1.ExerciseService.AddExerciseLink:input_type -> drummer.v1.AddExerciseLinkRequest
+	48,  // 193: drummer.v1.ExerciseService.DeleteExerciseLink:input_type -> drummer.v1.DeleteExerciseLinkRequest
+	71,  // 194: drummer.v1.ExerciseService.GetExerciseStats:input_type -> drummer.v1.GetExerciseStatsRequest
+	73,  // 195: drummer.v1.ExerciseService.ExportMidi:input_type -> drummer.v1.ExportMidiRequest
+	80,  // 196: drummer.v1.ExerciseService.ExportExercisePack:input_type -> drummer.v1.ExportExercisePackRequest
+	81,  // 197: drummer.v1.ExerciseService.ImportExercisePack:input_type -> drummer.v1.ImportExercisePackRequest
+	49,  // 198: drummer.v1.PracticeSessionService.CreatePracticeSession:input_type -> drummer.v1.CreatePracticeSessionRequest
+	50,  // 199: drummer.v1.PracticeSessionService.GetPracticeSession:input_type -> drummer.v1.GetPracticeSessionRequest
+	51,  // 200: drummer.v1.PracticeSessionService.ListPracticeSessions:input_type -> drummer.v1.ListPracticeSessionsRequest
+	53,  // 201: drummer.v1.PracticeSessionService.UpdatePracticeSession:input_type -> drummer.v1.UpdatePracticeSessionRequest
+	54,  // 202: drummer.v1.PracticeSessionService.DeletePracticeSession:input_type -> drummer.v1.DeletePracticeSessionRequest
+	85,  // 203: drummer.v1.PracticeSessionService.GetPracticeStats:input_type -> drummer.v1.GetPracticeStatsRequest
+	90,  // 204: drummer.v1.PracticeSessionService.GetTargetProgress:input_type -> drummer.v1.GetTargetProgressRequest
+	94,  // 205: drummer.v1.PracticeSessionService.GetConsistencyStats:input_type -> drummer.v1.GetConsistencyStatsRequest
+	55,  // 206: drummer.v1.PracticeSessionService.PauseSession:input_type -> drummer.v1.PauseSessionRequest
+	56,  // 207: drummer.v1.PracticeSessionService.ResumeSession:input_type -> drummer.v1.ResumeSessionRequest
+	57,  // 208: drummer.v1.PracticeSessionService.StartExercise:input_type -> drummer.v1.StartExerciseRequest
+	58,  // 209: drummer.v1.PracticeSessionService.StopExercise:input_type -> drummer.v1.StopExerciseRequest
+	59,  // 210: drummer.v1.PracticeSessionService.WatchSession:input_type -> drummer.v1.WatchSessionRequest
+	61,  // 211: drummer.v1.ExerciseHistoryService.CreateExerciseHistory:input_type -> drummer.v1.CreateExerciseHistoryRequest
+	62,  // 212: drummer.v1.ExerciseHistoryService.GetExerciseHistory:input_type -> drummer.v1.GetExerciseHistoryRequest
+	63,  // 213: drummer.v1.ExerciseHistoryService.ListExerciseHistory:input_type -> drummer.v1.ListExerciseHistoryRequest
+	65,  // 214: drummer.v1.ExerciseHistoryService.UpdateExerciseHistory:input_type -> drummer.v1.UpdateExerciseHistoryRequest
+	66,  // 215: drummer.v1.ExerciseHistoryService.DeleteExerciseHistory:input_type -> drummer.v1.DeleteExerciseHistoryRequest
+	67,  // 216: drummer.v1.ExerciseHistoryService.UploadRecording:input_type -> drummer.v1.UploadRecordingRequest
+	69,  // 217: drummer.v1.ExerciseHistoryService.GetRecording:input_type -> drummer.v1.GetRecordingRequest
+	70,  // 218: drummer.v1.ExerciseHistoryService.DeleteRecording:input_type -> drummer.v1.DeleteRecordingRequest
+	100, // 219: drummer.v1.GoalService.CreateGoal:input_type -> drummer.v1.CreateGoalRequest
+	101, // 220: drummer.v1.GoalService.GetGoal:input_type -> drummer.v1.GetGoalRequest
+	102, // 221: drummer.v1.GoalService.ListGoals:input_type -> drummer.v1.ListGoalsRequest
+	104, // 222: drummer.v1.GoalService.UpdateGoal:input_type -> drummer.v1.UpdateGoalRequest
+	105, // 223: drummer.v1.GoalService.DeleteGoal:input_type -> drummer.v1.DeleteGoalRequest
+	106, // 224: drummer.v1.RoutineService.CreateRoutine:input_type -> drummer.v1.CreateRoutineRequest
+	107, // 225: drummer.v1.RoutineService.GetRoutine:input_type -> drummer.v1.GetRoutineRequest
+	108, // 226: drummer.v1.RoutineService.ListRoutines:input_type -> drummer.v1.ListRoutinesRequest
+	110, // 227: drummer.v1.RoutineService.UpdateRoutine:input_type -> drummer.v1.UpdateRoutineRequest
+	111, // 228: drummer.v1.RoutineService.DeleteRoutine:input_type -> drummer.v1.DeleteRoutineRequest
+	112, // 229: drummer.v1.RoutineService.StartSessionFromRoutine:input_type -> drummer.v1.StartSessionFromRoutineRequest
+	115, // 230: drummer.v1.RecommendationService.GetPracticePlan:input_type -> drummer.v1.GetPracticePlanRequest
+	129, // 231: drummer.v1.SearchService.Search:input_type -> drummer.v1.SearchRequest
+	119, // 232: drummer.v1.SettingsService.GetSettings:input_type -> drummer.v1.GetSettingsRequest
+	120, // 233: drummer.v1.SettingsService.UpdateSettings:input_type -> drummer.v1.UpdateSettingsRequest
+	122, // 234: drummer.v1.DataService.ExportAll:input_type -> drummer.v1.ExportAllRequest
+	123, // 235: drummer.v1.DataService.ImportAll:input_type -> drummer.v1.ImportAllRequest
+	126, // 236: drummer.v1.AdminService.CreateBackup:input_type -> drummer.v1.CreateBackupRequest
+	127, // 237: drummer.v1.AdminService.ListBackups:input_type -> drummer.v1.ListBackupsRequest
+	135, // 238: drummer.v1.AuthService.Login:input_type -> drummer.v1.LoginRequest
+	137, // 239: drummer.v1.AuthService.Logout:input_type -> drummer.v1.LogoutRequest
+	139, // 240: drummer.v1.AuthService.GetCurrentUser:input_type -> drummer.v1.GetCurrentUserRequest
+	140, // 241: drummer.v1.AuthService.ChangePassword:input_type -> drummer.v1.ChangePasswordRequest
+	141, // 242: drummer.v1.AuthService.CreateApiToken:input_type -> drummer.v1.CreateApiTokenRequest
+	143, // 243: drummer.v1.AuthService.ListApiTokens:input_type -> drummer.v1.ListApiTokensRequest
+	145, // 244: drummer.v1.AuthService.DeleteApiToken:input_type -> drummer.v1.DeleteApiTokenRequest
+	146, // 245: drummer.v1.UserService.CreateUser:input_type -> drummer.v1.CreateUserRequest
+	147, // 246: drummer.v1.UserService.ListUsers:input_type -> drummer.v1.ListUsersRequest
+	149, // 247: drummer.v1.UserService.UpdateUser:input_type -> drummer.v1.UpdateUserRequest
+	150, // 248: drummer.v1.UserService.DeleteUser:input_type -> drummer.v1.DeleteUserRequest
+	151, // 249: drummer.v1.UserService.AddStudent:input_type -> drummer.v1.AddStudentRequest
+	152, // 250: drummer.v1.UserService.RemoveStudent:input_type -> drummer.v1.RemoveStudentRequest
+	155, // 251: drummer.v1.AssignmentService.CreateAssignment:input_type -> drummer.v1.CreateAssignmentRequest
+	156, // 252: drummer.v1.AssignmentService.GetAssignment:input_type -> drummer.v1.GetAssignmentRequest
+	157, // 253: drummer.v1.AssignmentService.ListAssignments:input_type -> drummer.v1.ListAssignmentsRequest
+	159, // 254: drummer.v1.AssignmentService.UpdateAssignment:input_type -> drummer.v1.UpdateAssignmentRequest
+	160, // 255: drummer.v1.AssignmentService.DeleteAssignment:input_type -> drummer.v1.DeleteAssignmentRequest
+	161, // 256: drummer.v1.AssignmentService.ListAssignmentHistory:input_type -> drummer.v1.ListAssignmentHistoryRequest
+	163, // 257: drummer.v1.AssignmentService.AddHistoryComment:input_type -> drummer.v1.AddHistoryCommentRequest
+	164, // 258: drummer.v1.AssignmentService.DeleteHistoryComment:input_type -> drummer.v1.DeleteHistoryCommentRequest
+	165, // 259: drummer.v1.AssignmentService.ListStudents:input_type -> drummer.v1.ListStudentsRequest
+	167, // 260: drummer.v1.AssignmentService.ListStudentExercises:input_type -> drummer.v1.ListStudentExercisesRequest
+	7,   // 261: drummer.v1.CategoryService.CreateCategory:output_type -> drummer.v1.Category
+	7,   // 262: drummer.v1.CategoryService.GetCategory:output_type -> drummer.v1.Category
+	26,  // 263: drummer.v1.CategoryService.ListCategories:output_type -> drummer.v1.ListCategoriesResponse
+	7,   // 264: drummer.v1.CategoryService.UpdateCategory:output_type -> drummer.v1.Category
+	171, // 265: drummer.v1.CategoryService.DeleteCategory:output_type -> google.protobuf.Empty
+	8,   // 266: drummer.v1.TagService.CreateTag:output_type -> drummer.v1.Tag
+	8,   // 267: drummer.v1.TagService.GetTag:output_type -> drummer.v1.Tag
+	32,  // 268: drummer.v1.TagService.ListTags:output_type -> drummer.v1.ListTagsResponse
+	8,   // 269: drummer.v1.TagService.UpdateTag:output_type -> drummer.v1.Tag
+	171, // 270: drummer.v1.TagService.DeleteTag:output_type -> google.protobuf.Empty
+	9,   // 271: drummer.v1.ExerciseService.CreateExercise:output_type -> drummer.v1.Exercise
+	9,   // 272: drummer.v1.ExerciseService.GetExercise:output_type -> drummer.v1.Exercise
+	38,  // 273: drummer.v1.ExerciseService.ListExercises:output_type -> drummer.v1.ListExercisesResponse
+	9,   // 274: drummer.v1.ExerciseService.UpdateExercise:output_type -> drummer.v1.Exercise
+	171, // 275: drummer.v1.ExerciseService.DeleteExercise:output_type -> google.protobuf.Empty
+	12,  // 276: drummer.v1.ExerciseService.AddExerciseImage:output_type -> drummer.v1.ExerciseImage
+	12,  // 277: drummer.v1.ExerciseService.GetExerciseImage:output_type -> drummer.v1.ExerciseImage
+	171, // 278: drummer.v1.ExerciseService.DeleteExerciseImage:output_type -> google.protobuf.Empty
+	11,  // 279: drummer.v1.ExerciseService.AddExerciseNotation:output_type -> drummer.v1.ExerciseNotation
+	11,  // 280: drummer.v1.ExerciseService.GetExerciseNotation:output_type -> drummer.v1.ExerciseNotation
+	171, // 281: drummer.v1.ExerciseService.DeleteExerciseNotation:output_type -> google.protobuf.Empty
+	14,  // 282: drummer.v1.ExerciseService.AddExerciseLink:output_type -> drummer.v1.ExerciseLink
+	171, // 283: drummer.v1.ExerciseService.DeleteExerciseLink:output_type -> google.protobuf.Empty
+	72,  // 284: drummer.v1.ExerciseService.GetExerciseStats:output_type -> drummer.v1.ExerciseStats
+	172, // 285: drummer.v1.ExerciseService.ExportMidi:output_type -> google.api.HttpBody
+	172, // 286: drummer.v1.ExerciseService.ExportExercisePack:output_type -> google.api.HttpBody
+	82,  // 287: drummer.v1.ExerciseService.ImportExercisePack:output_type -> drummer.v1.ImportExercisePackResponse
+	15,  // 288: drummer.v1.PracticeSessionService.CreatePracticeSession:output_type -> drummer.v1.PracticeSession
+	15,  // 289: drummer.v1.PracticeSessionService.GetPracticeSession:output_type -> drummer.v1.PracticeSession
+	52,  // 290: drummer.v1.PracticeSessionService.ListPracticeSessions:output_type -> drummer.v1.ListPracticeSessionsResponse
+	15,  // 291: drummer.v1.PracticeSessionService.UpdatePracticeSession:output_type -> drummer.v1.PracticeSession
+	171, // 292: drummer.v1.PracticeSessionService.DeletePracticeSession:output_type -> google.protobuf.Empty
+	86,  // 293: drummer.v1.PracticeSessionService.GetPracticeStats:output_type -> drummer.v1.PracticeStats
+	91,  // 294: drummer.v1.PracticeSessionService.GetTargetProgress:output_type -> drummer.v1.TargetProgress
+	95,  // 295: drummer.v1.PracticeSessionService.GetConsistencyStats:output_type -> drummer.v1.ConsistencyStats
+	15,  // 296: drummer.v1.PracticeSessionService.PauseSession:output_type -> drummer.v1.PracticeSession
+	15,  // 297: drummer.v1.PracticeSessionService.ResumeSession:output_type -> drummer.v1.PracticeSession
+	15,  // 298: drummer.v1.PracticeSessionService.StartExercise:output_type -> drummer.v1.PracticeSession
+	15,  // 299: drummer.v1.PracticeSessionService.StopExercise:output_type -> drummer.v1.PracticeSession
+	60,  // 300: drummer.v1.PracticeSessionService.WatchSession:output_type -> drummer.v1.SessionEvent
+	17,  // 301: drummer.v1.ExerciseHistoryService.CreateExerciseHistory:output_type -> drummer.v1.ExerciseHistory
+	17,  // 302: drummer.v1.ExerciseHistoryService.GetExerciseHistory:output_type -> drummer.v1.ExerciseHistory
+	64,  // 303: drummer.v1.ExerciseHistoryService.ListExerciseHistory:output_type -> drummer.v1.ListExerciseHistoryResponse
+	17,  // 304: drummer.v1.ExerciseHistoryService.UpdateExerciseHistory:output_type -> drummer.v1.ExerciseHistory
+	171, // 305: drummer.v1.ExerciseHistoryService.DeleteExerciseHistory:output_type -> google.protobuf.Empty
+	18,  // 306: drummer.v1.ExerciseHistoryService.UploadRecording:output_type -> drummer.v1.ExerciseHistoryRecording
+	18,  // 307: drummer.v1.ExerciseHistoryService.GetRecording:output_type -> drummer.v1.ExerciseHistoryRecording
+	171, // 308: drummer.v1.ExerciseHistoryService.DeleteRecording:output_type -> google.protobuf.Empty
+	19,  // 309: drummer.v1.GoalService.CreateGoal:output_type -> drummer.v1.Goal
+	19,  // 310: drummer.v1.GoalService.GetGoal:output_type -> drummer.v1.Goal
+	103, // 311: drummer.v1.GoalService.ListGoals:output_type -> drummer.v1.ListGoalsResponse
+	19,  // 312: drummer.v1.GoalService.UpdateGoal:output_type -> drummer.v1.Goal
+	171, // 313: drummer.v1.GoalService.DeleteGoal:output_type -> google.protobuf.Empty
+	20,  // 314: drummer.v1.RoutineService.CreateRoutine:output_type -> drummer.v1.Routine
+	20,  // 315: drummer.v1.RoutineService.GetRoutine:output_type -> drummer.v1.Routine
+	109, // 316: drummer.v1.RoutineService.ListRoutines:output_type -> drummer.v1.ListRoutinesResponse
+	20,  // 317: drummer.v1.RoutineService.UpdateRoutine:output_type -> drummer.v1.Routine
+	171, // 318: drummer.v1.RoutineService.DeleteRoutine:output_type -> google.protobuf.Empty
+	113, // 319: drummer.v1.RoutineService.StartSessionFromRoutine:output_type -> drummer.v1.StartSessionFromRoutineResponse
+	116, // 320: drummer.v1.RecommendationService.GetPracticePlan:output_type -> drummer.v1.PracticePlan
+	130, // 321: drummer.v1.SearchService.Search:output_type -> drummer.v1.SearchResponse
+	22,  // 322: drummer.v1.SettingsService.GetSettings:output_type -> drummer.v1.Settings
+	22,  // 323: drummer.v1.SettingsService.UpdateSettings:output_type -> drummer.v1.Settings
+	121, // 324: drummer.v1.DataService.ExportAll:output_type -> drummer.v1.DataArchive
+	124, // 325: drummer.v1.DataService.ImportAll:output_type -> drummer.v1.ImportAllResponse
+	125, // 326: drummer.v1.AdminService.CreateBackup:output_type -> drummer.v1.Backup
+	128, // 327: drummer.v1.AdminService.ListBackups:output_type -> drummer.v1.ListBackupsResponse
+	136, // 328: drummer.v1.AuthService.Login:output_type -> drummer.v1.LoginResponse
+	138, // 329: drummer.v1.AuthService.Logout:output_type -> drummer.v1.LogoutResponse
+	133, // 330: drummer.v1.AuthService.GetCurrentUser:output_type -> drummer.v1.User
+	171, // 331: drummer.v1.AuthService.ChangePassword:output_type -> google.protobuf.Empty
+	142, // 332: drummer.v1.AuthService.CreateApiToken:output_type -> drummer.v1.CreateApiTokenResponse
+	144, // 333: drummer.v1.AuthService.ListApiTokens:output_type -> drummer.v1.ListApiTokensResponse
+	171, // 334: drummer.v1.AuthService.DeleteApiToken:output_type -> google.protobuf.Empty
+	133, // 335: drummer.v1.UserService.CreateUser:output_type -> drummer.v1.User
+	148, // 336: drummer.v1.UserService.ListUsers:output_type -> drummer.v1.ListUsersResponse
+	133, // 337: drummer.v1.UserService.UpdateUser:output_type -> drummer.v1.User
+	171, // 338: drummer.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	171, // 339: drummer.v1.UserService.AddStudent:output_type -> google.protobuf.Empty
+	171, // 340: drummer.v1.UserService.RemoveStudent:output_type -> google.protobuf.Empty
+	153, // 341: drummer.v1.AssignmentService.CreateAssignment:output_type -> drummer.v1.Assignment
+	153, // 342: drummer.v1.AssignmentService.GetAssignment:output_type -> drummer.v1.Assignment
+	158, // 343: drummer.v1.AssignmentService.ListAssignments:output_type -> drummer.v1.ListAssignmentsResponse
+	153, // 344: drummer.v1.AssignmentService.UpdateAssignment:output_type -> drummer.v1.Assignment
+	171, // 345: drummer.v1.AssignmentService.DeleteAssignment:output_type -> google.protobuf.Empty
+	162, // 346: drummer.v1.AssignmentService.ListAssignmentHistory:output_type -> drummer.v1.ListAssignmentHistoryResponse
+	154, // 347: drummer.v1.AssignmentService.AddHistoryComment:output_type -> drummer.v1.HistoryComment
+	171, // 348: drummer.v1.AssignmentService.DeleteHistoryComment:output_type -> google.protobuf.Empty
+	166, // 349: drummer.v1.AssignmentService.ListStudents:output_type -> drummer.v1.ListStudentsResponse
+	168, // 350: drummer.v1.AssignmentService.ListStudentExercises:output_type -> drummer.v1.ListStudentExercisesResponse
+	261, // [261:351] is the sub-list for method output_type
+	171, // [171:261] is the sub-list for method input_type
+	171, // [171:171] is the sub-list for extension type_name
+	171, // [171:171] is the sub-list for extension extendee
+	0,   // [0:171] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_tempus_tempus_proto_init() }
@@ -3861,18 +12642,23 @@ func file_api_v1_tempus_tempus_proto_init() {
 	if File_api_v1_tempus_tempus_proto != nil {
 		return
 	}
+	file_api_v1_tempus_tempus_proto_msgTypes[60].OneofWrappers = []any{
+		(*UploadRecordingRequest_Metadata)(nil),
+		(*UploadRecordingRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_tempus_tempus_proto_rawDesc), len(file_api_v1_tempus_tempus_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   50,
+			NumEnums:      7,
+			NumMessages:   162,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   15,
 		},
 		GoTypes:           file_api_v1_tempus_tempus_proto_goTypes,
 		DependencyIndexes: file_api_v1_tempus_tempus_proto_depIdxs,
+		EnumInfos:         file_api_v1_tempus_tempus_proto_enumTypes,
 		MessageInfos:      file_api_v1_tempus_tempus_proto_msgTypes,
 	}.Build()
 	File_api_v1_tempus_tempus_proto = out.File
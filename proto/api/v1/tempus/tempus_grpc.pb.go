@@ -8,6 +8,7 @@ package tempusv1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -544,17 +545,23 @@ var TagService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	ExerciseService_CreateExercise_FullMethodName      = "/drummer.v1.ExerciseService/CreateExercise"
-	ExerciseService_GetExercise_FullMethodName         = "/drummer.v1.ExerciseService/GetExercise"
-	ExerciseService_ListExercises_FullMethodName       = "/drummer.v1.ExerciseService/ListExercises"
-	ExerciseService_UpdateExercise_FullMethodName      = "/drummer.v1.ExerciseService/UpdateExercise"
-	ExerciseService_DeleteExercise_FullMethodName      = "/drummer.v1.ExerciseService/DeleteExercise"
-	ExerciseService_AddExerciseImage_FullMethodName    = "/drummer.v1.ExerciseService/AddExerciseImage"
-	ExerciseService_GetExerciseImage_FullMethodName    = "/drummer.v1.ExerciseService/GetExerciseImage"
-	ExerciseService_DeleteExerciseImage_FullMethodName = "/drummer.v1.ExerciseService/DeleteExerciseImage"
-	ExerciseService_AddExerciseLink_FullMethodName     = "/drummer.v1.ExerciseService/AddExerciseLink"
-	ExerciseService_DeleteExerciseLink_FullMethodName  = "/drummer.v1.ExerciseService/DeleteExerciseLink"
-	ExerciseService_GetExerciseStats_FullMethodName    = "/drummer.v1.ExerciseService/GetExerciseStats"
+	ExerciseService_CreateExercise_FullMethodName         = "/drummer.v1.ExerciseService/CreateExercise"
+	ExerciseService_GetExercise_FullMethodName            = "/drummer.v1.ExerciseService/GetExercise"
+	ExerciseService_ListExercises_FullMethodName          = "/drummer.v1.ExerciseService/ListExercises"
+	ExerciseService_UpdateExercise_FullMethodName         = "/drummer.v1.ExerciseService/UpdateExercise"
+	ExerciseService_DeleteExercise_FullMethodName         = "/drummer.v1.ExerciseService/DeleteExercise"
+	ExerciseService_AddExerciseImage_FullMethodName       = "/drummer.v1.ExerciseService/AddExerciseImage"
+	ExerciseService_GetExerciseImage_FullMethodName       = "/drummer.v1.ExerciseService/GetExerciseImage"
+	ExerciseService_DeleteExerciseImage_FullMethodName    = "/drummer.v1.ExerciseService/DeleteExerciseImage"
+	ExerciseService_AddExerciseNotation_FullMethodName    = "/drummer.v1.ExerciseService/AddExerciseNotation"
+	ExerciseService_GetExerciseNotation_FullMethodName    = "/drummer.v1.ExerciseService/GetExerciseNotation"
+	ExerciseService_DeleteExerciseNotation_FullMethodName = "/drummer.v1.ExerciseService/DeleteExerciseNotation"
+	ExerciseService_AddExerciseLink_FullMethodName        = "/drummer.v1.ExerciseService/AddExerciseLink"
+	ExerciseService_DeleteExerciseLink_FullMethodName     = "/drummer.v1.ExerciseService/DeleteExerciseLink"
+	ExerciseService_GetExerciseStats_FullMethodName       = "/drummer.v1.ExerciseService/GetExerciseStats"
+	ExerciseService_ExportMidi_FullMethodName             = "/drummer.v1.ExerciseService/ExportMidi"
+	ExerciseService_ExportExercisePack_FullMethodName     = "/drummer.v1.ExerciseService/ExportExercisePack"
+	ExerciseService_ImportExercisePack_FullMethodName     = "/drummer.v1.ExerciseService/ImportExercisePack"
 )
 
 // ExerciseServiceClient is the client API for ExerciseService service.
@@ -576,12 +583,25 @@ type ExerciseServiceClient interface {
 	GetExerciseImage(ctx context.Context, in *GetExerciseImageRequest, opts ...grpc.CallOption) (*ExerciseImage, error)
 	// Delete an image from an exercise
 	DeleteExerciseImage(ctx context.Context, in *DeleteExerciseImageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Attach notation to an exercise, its time signature becomes the default
+	// of new history entries of the exercise
+	AddExerciseNotation(ctx context.Context, in *AddExerciseNotationRequest, opts ...grpc.CallOption) (*ExerciseNotation, error)
+	GetExerciseNotation(ctx context.Context, in *GetExerciseNotationRequest, opts ...grpc.CallOption) (*ExerciseNotation, error)
+	// Delete notation from an exercise
+	DeleteExerciseNotation(ctx context.Context, in *DeleteExerciseNotationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Add a link to an exercise
 	AddExerciseLink(ctx context.Context, in *AddExerciseLinkRequest, opts ...grpc.CallOption) (*ExerciseLink, error)
 	// Delete a link from an exercise
 	DeleteExerciseLink(ctx context.Context, in *DeleteExerciseLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Get statistics for an exercise
 	GetExerciseStats(ctx context.Context, in *GetExerciseStatsRequest, opts ...grpc.CallOption) (*ExerciseStats, error)
+	// Export a Standard MIDI File click track for one or more exercises
+	ExportMidi(ctx context.Context, in *ExportMidiRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Export exercises with their images, links and tags as an exercise pack
+	ExportExercisePack(ctx context.Context, in *ExportExercisePackRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Import an exercise pack, updating the exercises imported from an older
+	// version of it
+	ImportExercisePack(ctx context.Context, in *ImportExercisePackRequest, opts ...grpc.CallOption) (*ImportExercisePackResponse, error)
 }
 
 type exerciseServiceClient struct {
@@ -672,6 +692,36 @@ func (c *exerciseServiceClient) DeleteExerciseImage(ctx context.Context, in *Del
 	return out, nil
 }
 
+func (c *exerciseServiceClient) AddExerciseNotation(ctx context.Context, in *AddExerciseNotationRequest, opts ...grpc.CallOption) (*ExerciseNotation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseNotation)
+	err := c.cc.Invoke(ctx, ExerciseService_AddExerciseNotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) GetExerciseNotation(ctx context.Context, in *GetExerciseNotationRequest, opts ...grpc.CallOption) (*ExerciseNotation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseNotation)
+	err := c.cc.Invoke(ctx, ExerciseService_GetExerciseNotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) DeleteExerciseNotation(ctx context.Context, in *DeleteExerciseNotationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ExerciseService_DeleteExerciseNotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *exerciseServiceClient) AddExerciseLink(ctx context.Context, in *AddExerciseLinkRequest, opts ...grpc.CallOption) (*ExerciseLink, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExerciseLink)
@@ -702,6 +752,36 @@ func (c *exerciseServiceClient) GetExerciseStats(ctx context.Context, in *GetExe
 	return out, nil
 }
 
+func (c *exerciseServiceClient) ExportMidi(ctx context.Context, in *ExportMidiRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, ExerciseService_ExportMidi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) ExportExercisePack(ctx context.Context, in *ExportExercisePackRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, ExerciseService_ExportExercisePack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseServiceClient) ImportExercisePack(ctx context.Context, in *ImportExercisePackRequest, opts ...grpc.CallOption) (*ImportExercisePackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportExercisePackResponse)
+	err := c.cc.Invoke(ctx, ExerciseService_ImportExercisePack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExerciseServiceServer is the server API for ExerciseService service.
 // All implementations should embed UnimplementedExerciseServiceServer
 // for forward compatibility.
@@ -721,12 +801,25 @@ type ExerciseServiceServer interface {
 	GetExerciseImage(context.Context, *GetExerciseImageRequest) (*ExerciseImage, error)
 	// Delete an image from an exercise
 	DeleteExerciseImage(context.Context, *DeleteExerciseImageRequest) (*emptypb.Empty, error)
+	// Attach notation to an exercise, its time signature becomes the default
+	// of new history entries of the exercise
+	AddExerciseNotation(context.Context, *AddExerciseNotationRequest) (*ExerciseNotation, error)
+	GetExerciseNotation(context.Context, *GetExerciseNotationRequest) (*ExerciseNotation, error)
+	// Delete notation from an exercise
+	DeleteExerciseNotation(context.Context, *DeleteExerciseNotationRequest) (*emptypb.Empty, error)
 	// Add a link to an exercise
 	AddExerciseLink(context.Context, *AddExerciseLinkRequest) (*ExerciseLink, error)
 	// Delete a link from an exercise
 	DeleteExerciseLink(context.Context, *DeleteExerciseLinkRequest) (*emptypb.Empty, error)
 	// Get statistics for an exercise
 	GetExerciseStats(context.Context, *GetExerciseStatsRequest) (*ExerciseStats, error)
+	// Export a Standard MIDI File click track for one or more exercises
+	ExportMidi(context.Context, *ExportMidiRequest) (*httpbody.HttpBody, error)
+	// Export exercises with their images, links and tags as an exercise pack
+	ExportExercisePack(context.Context, *ExportExercisePackRequest) (*httpbody.HttpBody, error)
+	// Import an exercise pack, updating the exercises imported from an older
+	// version of it
+	ImportExercisePack(context.Context, *ImportExercisePackRequest) (*ImportExercisePackResponse, error)
 }
 
 // UnimplementedExerciseServiceServer should be embedded to have
@@ -760,6 +853,15 @@ func (UnimplementedExerciseServiceServer) GetExerciseImage(context.Context, *Get
 func (UnimplementedExerciseServiceServer) DeleteExerciseImage(context.Context, *DeleteExerciseImageRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteExerciseImage not implemented")
 }
+func (UnimplementedExerciseServiceServer) AddExerciseNotation(context.Context, *AddExerciseNotationRequest) (*ExerciseNotation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExerciseNotation not implemented")
+}
+func (UnimplementedExerciseServiceServer) GetExerciseNotation(context.Context, *GetExerciseNotationRequest) (*ExerciseNotation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExerciseNotation not implemented")
+}
+func (UnimplementedExerciseServiceServer) DeleteExerciseNotation(context.Context, *DeleteExerciseNotationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExerciseNotation not implemented")
+}
 func (UnimplementedExerciseServiceServer) AddExerciseLink(context.Context, *AddExerciseLinkRequest) (*ExerciseLink, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddExerciseLink not implemented")
 }
@@ -769,6 +871,15 @@ func (UnimplementedExerciseServiceServer) DeleteExerciseLink(context.Context, *D
 func (UnimplementedExerciseServiceServer) GetExerciseStats(context.Context, *GetExerciseStatsRequest) (*ExerciseStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExerciseStats not implemented")
 }
+func (UnimplementedExerciseServiceServer) ExportMidi(context.Context, *ExportMidiRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMidi not implemented")
+}
+func (UnimplementedExerciseServiceServer) ExportExercisePack(context.Context, *ExportExercisePackRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportExercisePack not implemented")
+}
+func (UnimplementedExerciseServiceServer) ImportExercisePack(context.Context, *ImportExercisePackRequest) (*ImportExercisePackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExercisePack not implemented")
+}
 func (UnimplementedExerciseServiceServer) testEmbeddedByValue() {}
 
 // UnsafeExerciseServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -933,6 +1044,60 @@ func _ExerciseService_DeleteExerciseImage_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExerciseService_AddExerciseNotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExerciseNotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExerciseServiceServer).AddExerciseNotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExerciseService_AddExerciseNotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExerciseServiceServer).AddExerciseNotation(ctx, req.(*AddExerciseNotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExerciseService_GetExerciseNotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExerciseNotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExerciseServiceServer).GetExerciseNotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExerciseService_GetExerciseNotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExerciseServiceServer).GetExerciseNotation(ctx, req.(*GetExerciseNotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExerciseService_DeleteExerciseNotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExerciseNotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExerciseServiceServer).DeleteExerciseNotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExerciseService_DeleteExerciseNotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExerciseServiceServer).DeleteExerciseNotation(ctx, req.(*DeleteExerciseNotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExerciseService_AddExerciseLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddExerciseLinkRequest)
 	if err := dec(in); err != nil {
@@ -987,6 +1152,60 @@ func _ExerciseService_GetExerciseStats_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExerciseService_ExportMidi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMidiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExerciseServiceServer).ExportMidi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExerciseService_ExportMidi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExerciseServiceServer).ExportMidi(ctx, req.(*ExportMidiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExerciseService_ExportExercisePack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportExercisePackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExerciseServiceServer).ExportExercisePack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExerciseService_ExportExercisePack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExerciseServiceServer).ExportExercisePack(ctx, req.(*ExportExercisePackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExerciseService_ImportExercisePack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExercisePackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExerciseServiceServer).ImportExercisePack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExerciseService_ImportExercisePack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExerciseServiceServer).ImportExercisePack(ctx, req.(*ImportExercisePackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExerciseService_ServiceDesc is the grpc.ServiceDesc for ExerciseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1026,6 +1245,18 @@ var ExerciseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteExerciseImage",
 			Handler:    _ExerciseService_DeleteExerciseImage_Handler,
 		},
+		{
+			MethodName: "AddExerciseNotation",
+			Handler:    _ExerciseService_AddExerciseNotation_Handler,
+		},
+		{
+			MethodName: "GetExerciseNotation",
+			Handler:    _ExerciseService_GetExerciseNotation_Handler,
+		},
+		{
+			MethodName: "DeleteExerciseNotation",
+			Handler:    _ExerciseService_DeleteExerciseNotation_Handler,
+		},
 		{
 			MethodName: "AddExerciseLink",
 			Handler:    _ExerciseService_AddExerciseLink_Handler,
@@ -1038,6 +1269,18 @@ var ExerciseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExerciseStats",
 			Handler:    _ExerciseService_GetExerciseStats_Handler,
 		},
+		{
+			MethodName: "ExportMidi",
+			Handler:    _ExerciseService_ExportMidi_Handler,
+		},
+		{
+			MethodName: "ExportExercisePack",
+			Handler:    _ExerciseService_ExportExercisePack_Handler,
+		},
+		{
+			MethodName: "ImportExercisePack",
+			Handler:    _ExerciseService_ImportExercisePack_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/tempus/tempus.proto",
@@ -1050,6 +1293,13 @@ const (
 	PracticeSessionService_UpdatePracticeSession_FullMethodName = "/drummer.v1.PracticeSessionService/UpdatePracticeSession"
 	PracticeSessionService_DeletePracticeSession_FullMethodName = "/drummer.v1.PracticeSessionService/DeletePracticeSession"
 	PracticeSessionService_GetPracticeStats_FullMethodName      = "/drummer.v1.PracticeSessionService/GetPracticeStats"
+	PracticeSessionService_GetTargetProgress_FullMethodName     = "/drummer.v1.PracticeSessionService/GetTargetProgress"
+	PracticeSessionService_GetConsistencyStats_FullMethodName   = "/drummer.v1.PracticeSessionService/GetConsistencyStats"
+	PracticeSessionService_PauseSession_FullMethodName          = "/drummer.v1.PracticeSessionService/PauseSession"
+	PracticeSessionService_ResumeSession_FullMethodName         = "/drummer.v1.PracticeSessionService/ResumeSession"
+	PracticeSessionService_StartExercise_FullMethodName         = "/drummer.v1.PracticeSessionService/StartExercise"
+	PracticeSessionService_StopExercise_FullMethodName          = "/drummer.v1.PracticeSessionService/StopExercise"
+	PracticeSessionService_WatchSession_FullMethodName          = "/drummer.v1.PracticeSessionService/WatchSession"
 )
 
 // PracticeSessionServiceClient is the client API for PracticeSessionService service.
@@ -1068,6 +1318,22 @@ type PracticeSessionServiceClient interface {
 	DeletePracticeSession(ctx context.Context, in *DeletePracticeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Get practice statistics
 	GetPracticeStats(ctx context.Context, in *GetPracticeStatsRequest, opts ...grpc.CallOption) (*PracticeStats, error)
+	// Get weekly practice time against the category targets
+	GetTargetProgress(ctx context.Context, in *GetTargetProgressRequest, opts ...grpc.CallOption) (*TargetProgress, error)
+	// Get practice streaks, a calendar heatmap and time distributions
+	GetConsistencyStats(ctx context.Context, in *GetConsistencyStatsRequest, opts ...grpc.CallOption) (*ConsistencyStats, error)
+	// Pause the timer of an active practice session
+	PauseSession(ctx context.Context, in *PauseSessionRequest, opts ...grpc.CallOption) (*PracticeSession, error)
+	// Resume the timer of a paused practice session
+	ResumeSession(ctx context.Context, in *ResumeSessionRequest, opts ...grpc.CallOption) (*PracticeSession, error)
+	// Start timing an exercise, switching from the one in progress
+	StartExercise(ctx context.Context, in *StartExerciseRequest, opts ...grpc.CallOption) (*PracticeSession, error)
+	// Stop timing the exercise in progress
+	StopExercise(ctx context.Context, in *StopExerciseRequest, opts ...grpc.CallOption) (*PracticeSession, error)
+	// Follow the changes to practice sessions as they happen, over HTTP as
+	// newline delimited JSON or as server-sent events with
+	// Accept: text/event-stream
+	WatchSession(ctx context.Context, in *WatchSessionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SessionEvent], error)
 }
 
 type practiceSessionServiceClient struct {
@@ -1138,6 +1404,85 @@ func (c *practiceSessionServiceClient) GetPracticeStats(ctx context.Context, in
 	return out, nil
 }
 
+func (c *practiceSessionServiceClient) GetTargetProgress(ctx context.Context, in *GetTargetProgressRequest, opts ...grpc.CallOption) (*TargetProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TargetProgress)
+	err := c.cc.Invoke(ctx, PracticeSessionService_GetTargetProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *practiceSessionServiceClient) GetConsistencyStats(ctx context.Context, in *GetConsistencyStatsRequest, opts ...grpc.CallOption) (*ConsistencyStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsistencyStats)
+	err := c.cc.Invoke(ctx, PracticeSessionService_GetConsistencyStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *practiceSessionServiceClient) PauseSession(ctx context.Context, in *PauseSessionRequest, opts ...grpc.CallOption) (*PracticeSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PracticeSession)
+	err := c.cc.Invoke(ctx, PracticeSessionService_PauseSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *practiceSessionServiceClient) ResumeSession(ctx context.Context, in *ResumeSessionRequest, opts ...grpc.CallOption) (*PracticeSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PracticeSession)
+	err := c.cc.Invoke(ctx, PracticeSessionService_ResumeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *practiceSessionServiceClient) StartExercise(ctx context.Context, in *StartExerciseRequest, opts ...grpc.CallOption) (*PracticeSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PracticeSession)
+	err := c.cc.Invoke(ctx, PracticeSessionService_StartExercise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *practiceSessionServiceClient) StopExercise(ctx context.Context, in *StopExerciseRequest, opts ...grpc.CallOption) (*PracticeSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PracticeSession)
+	err := c.cc.Invoke(ctx, PracticeSessionService_StopExercise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *practiceSessionServiceClient) WatchSession(ctx context.Context, in *WatchSessionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SessionEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PracticeSessionService_ServiceDesc.Streams[0], PracticeSessionService_WatchSession_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSessionRequest, SessionEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PracticeSessionService_WatchSessionClient = grpc.ServerStreamingClient[SessionEvent]
+
 // PracticeSessionServiceServer is the server API for PracticeSessionService service.
 // All implementations should embed UnimplementedPracticeSessionServiceServer
 // for forward compatibility.
@@ -1154,6 +1499,22 @@ type PracticeSessionServiceServer interface {
 	DeletePracticeSession(context.Context, *DeletePracticeSessionRequest) (*emptypb.Empty, error)
 	// Get practice statistics
 	GetPracticeStats(context.Context, *GetPracticeStatsRequest) (*PracticeStats, error)
+	// Get weekly practice time against the category targets
+	GetTargetProgress(context.Context, *GetTargetProgressRequest) (*TargetProgress, error)
+	// Get practice streaks, a calendar heatmap and time distributions
+	GetConsistencyStats(context.Context, *GetConsistencyStatsRequest) (*ConsistencyStats, error)
+	// Pause the timer of an active practice session
+	PauseSession(context.Context, *PauseSessionRequest) (*PracticeSession, error)
+	// Resume the timer of a paused practice session
+	ResumeSession(context.Context, *ResumeSessionRequest) (*PracticeSession, error)
+	// Start timing an exercise, switching from the one in progress
+	StartExercise(context.Context, *StartExerciseRequest) (*PracticeSession, error)
+	// Stop timing the exercise in progress
+	StopExercise(context.Context, *StopExerciseRequest) (*PracticeSession, error)
+	// Follow the changes to practice sessions as they happen, over HTTP as
+	// newline delimited JSON or as server-sent events with
+	// Accept: text/event-stream
+	WatchSession(*WatchSessionRequest, grpc.ServerStreamingServer[SessionEvent]) error
 }
 
 // UnimplementedPracticeSessionServiceServer should be embedded to have
@@ -1181,6 +1542,27 @@ func (UnimplementedPracticeSessionServiceServer) DeletePracticeSession(context.C
 func (UnimplementedPracticeSessionServiceServer) GetPracticeStats(context.Context, *GetPracticeStatsRequest) (*PracticeStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPracticeStats not implemented")
 }
+func (UnimplementedPracticeSessionServiceServer) GetTargetProgress(context.Context, *GetTargetProgressRequest) (*TargetProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTargetProgress not implemented")
+}
+func (UnimplementedPracticeSessionServiceServer) GetConsistencyStats(context.Context, *GetConsistencyStatsRequest) (*ConsistencyStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsistencyStats not implemented")
+}
+func (UnimplementedPracticeSessionServiceServer) PauseSession(context.Context, *PauseSessionRequest) (*PracticeSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSession not implemented")
+}
+func (UnimplementedPracticeSessionServiceServer) ResumeSession(context.Context, *ResumeSessionRequest) (*PracticeSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSession not implemented")
+}
+func (UnimplementedPracticeSessionServiceServer) StartExercise(context.Context, *StartExerciseRequest) (*PracticeSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartExercise not implemented")
+}
+func (UnimplementedPracticeSessionServiceServer) StopExercise(context.Context, *StopExerciseRequest) (*PracticeSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopExercise not implemented")
+}
+func (UnimplementedPracticeSessionServiceServer) WatchSession(*WatchSessionRequest, grpc.ServerStreamingServer[SessionEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSession not implemented")
+}
 func (UnimplementedPracticeSessionServiceServer) testEmbeddedByValue() {}
 
 // UnsafePracticeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1309,50 +1691,202 @@ func _PracticeSessionService_GetPracticeStats_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
-// PracticeSessionService_ServiceDesc is the grpc.ServiceDesc for PracticeSessionService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var PracticeSessionService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "drummer.v1.PracticeSessionService",
-	HandlerType: (*PracticeSessionServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreatePracticeSession",
-			Handler:    _PracticeSessionService_CreatePracticeSession_Handler,
-		},
-		{
-			MethodName: "GetPracticeSession",
-			Handler:    _PracticeSessionService_GetPracticeSession_Handler,
-		},
-		{
-			MethodName: "ListPracticeSessions",
-			Handler:    _PracticeSessionService_ListPracticeSessions_Handler,
-		},
-		{
-			MethodName: "UpdatePracticeSession",
-			Handler:    _PracticeSessionService_UpdatePracticeSession_Handler,
-		},
-		{
-			MethodName: "DeletePracticeSession",
-			Handler:    _PracticeSessionService_DeletePracticeSession_Handler,
-		},
-		{
-			MethodName: "GetPracticeStats",
-			Handler:    _PracticeSessionService_GetPracticeStats_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "api/v1/tempus/tempus.proto",
+func _PracticeSessionService_GetTargetProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTargetProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PracticeSessionServiceServer).GetTargetProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PracticeSessionService_GetTargetProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PracticeSessionServiceServer).GetTargetProgress(ctx, req.(*GetTargetProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-const (
-	ExerciseHistoryService_CreateExerciseHistory_FullMethodName = "/drummer.v1.ExerciseHistoryService/CreateExerciseHistory"
-	ExerciseHistoryService_GetExerciseHistory_FullMethodName    = "/drummer.v1.ExerciseHistoryService/GetExerciseHistory"
-	ExerciseHistoryService_ListExerciseHistory_FullMethodName   = "/drummer.v1.ExerciseHistoryService/ListExerciseHistory"
-	ExerciseHistoryService_UpdateExerciseHistory_FullMethodName = "/drummer.v1.ExerciseHistoryService/UpdateExerciseHistory"
-	ExerciseHistoryService_DeleteExerciseHistory_FullMethodName = "/drummer.v1.ExerciseHistoryService/DeleteExerciseHistory"
-)
-
+func _PracticeSessionService_GetConsistencyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsistencyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PracticeSessionServiceServer).GetConsistencyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PracticeSessionService_GetConsistencyStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PracticeSessionServiceServer).GetConsistencyStats(ctx, req.(*GetConsistencyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PracticeSessionService_PauseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PracticeSessionServiceServer).PauseSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PracticeSessionService_PauseSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PracticeSessionServiceServer).PauseSession(ctx, req.(*PauseSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PracticeSessionService_ResumeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PracticeSessionServiceServer).ResumeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PracticeSessionService_ResumeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PracticeSessionServiceServer).ResumeSession(ctx, req.(*ResumeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PracticeSessionService_StartExercise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartExerciseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PracticeSessionServiceServer).StartExercise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PracticeSessionService_StartExercise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PracticeSessionServiceServer).StartExercise(ctx, req.(*StartExerciseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PracticeSessionService_StopExercise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopExerciseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PracticeSessionServiceServer).StopExercise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PracticeSessionService_StopExercise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PracticeSessionServiceServer).StopExercise(ctx, req.(*StopExerciseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PracticeSessionService_WatchSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSessionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PracticeSessionServiceServer).WatchSession(m, &grpc.GenericServerStream[WatchSessionRequest, SessionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PracticeSessionService_WatchSessionServer = grpc.ServerStreamingServer[SessionEvent]
+
+// PracticeSessionService_ServiceDesc is the grpc.ServiceDesc for PracticeSessionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PracticeSessionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "drummer.v1.PracticeSessionService",
+	HandlerType: (*PracticeSessionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePracticeSession",
+			Handler:    _PracticeSessionService_CreatePracticeSession_Handler,
+		},
+		{
+			MethodName: "GetPracticeSession",
+			Handler:    _PracticeSessionService_GetPracticeSession_Handler,
+		},
+		{
+			MethodName: "ListPracticeSessions",
+			Handler:    _PracticeSessionService_ListPracticeSessions_Handler,
+		},
+		{
+			MethodName: "UpdatePracticeSession",
+			Handler:    _PracticeSessionService_UpdatePracticeSession_Handler,
+		},
+		{
+			MethodName: "DeletePracticeSession",
+			Handler:    _PracticeSessionService_DeletePracticeSession_Handler,
+		},
+		{
+			MethodName: "GetPracticeStats",
+			Handler:    _PracticeSessionService_GetPracticeStats_Handler,
+		},
+		{
+			MethodName: "GetTargetProgress",
+			Handler:    _PracticeSessionService_GetTargetProgress_Handler,
+		},
+		{
+			MethodName: "GetConsistencyStats",
+			Handler:    _PracticeSessionService_GetConsistencyStats_Handler,
+		},
+		{
+			MethodName: "PauseSession",
+			Handler:    _PracticeSessionService_PauseSession_Handler,
+		},
+		{
+			MethodName: "ResumeSession",
+			Handler:    _PracticeSessionService_ResumeSession_Handler,
+		},
+		{
+			MethodName: "StartExercise",
+			Handler:    _PracticeSessionService_StartExercise_Handler,
+		},
+		{
+			MethodName: "StopExercise",
+			Handler:    _PracticeSessionService_StopExercise_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSession",
+			Handler:       _PracticeSessionService_WatchSession_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/v1/tempus/tempus.proto",
+}
+
+const (
+	ExerciseHistoryService_CreateExerciseHistory_FullMethodName = "/drummer.v1.ExerciseHistoryService/CreateExerciseHistory"
+	ExerciseHistoryService_GetExerciseHistory_FullMethodName    = "/drummer.v1.ExerciseHistoryService/GetExerciseHistory"
+	ExerciseHistoryService_ListExerciseHistory_FullMethodName   = "/drummer.v1.ExerciseHistoryService/ListExerciseHistory"
+	ExerciseHistoryService_UpdateExerciseHistory_FullMethodName = "/drummer.v1.ExerciseHistoryService/UpdateExerciseHistory"
+	ExerciseHistoryService_DeleteExerciseHistory_FullMethodName = "/drummer.v1.ExerciseHistoryService/DeleteExerciseHistory"
+	ExerciseHistoryService_UploadRecording_FullMethodName       = "/drummer.v1.ExerciseHistoryService/UploadRecording"
+	ExerciseHistoryService_GetRecording_FullMethodName          = "/drummer.v1.ExerciseHistoryService/GetRecording"
+	ExerciseHistoryService_DeleteRecording_FullMethodName       = "/drummer.v1.ExerciseHistoryService/DeleteRecording"
+)
+
 // ExerciseHistoryServiceClient is the client API for ExerciseHistoryService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -1367,6 +1901,13 @@ type ExerciseHistoryServiceClient interface {
 	UpdateExerciseHistory(ctx context.Context, in *UpdateExerciseHistoryRequest, opts ...grpc.CallOption) (*ExerciseHistory, error)
 	// Delete an exercise history entry
 	DeleteExerciseHistory(ctx context.Context, in *DeleteExerciseHistoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Upload an audio recording of a history entry in chunks, the gateway
+	// does not stream uploads so large recordings should be sent over gRPC
+	UploadRecording(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadRecordingRequest, ExerciseHistoryRecording], error)
+	// Get the details of a recording, its audio is served at its download URL
+	GetRecording(ctx context.Context, in *GetRecordingRequest, opts ...grpc.CallOption) (*ExerciseHistoryRecording, error)
+	// Delete a recording
+	DeleteRecording(ctx context.Context, in *DeleteRecordingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type exerciseHistoryServiceClient struct {
@@ -1427,6 +1968,39 @@ func (c *exerciseHistoryServiceClient) DeleteExerciseHistory(ctx context.Context
 	return out, nil
 }
 
+func (c *exerciseHistoryServiceClient) UploadRecording(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadRecordingRequest, ExerciseHistoryRecording], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExerciseHistoryService_ServiceDesc.Streams[0], ExerciseHistoryService_UploadRecording_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadRecordingRequest, ExerciseHistoryRecording]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExerciseHistoryService_UploadRecordingClient = grpc.ClientStreamingClient[UploadRecordingRequest, ExerciseHistoryRecording]
+
+func (c *exerciseHistoryServiceClient) GetRecording(ctx context.Context, in *GetRecordingRequest, opts ...grpc.CallOption) (*ExerciseHistoryRecording, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExerciseHistoryRecording)
+	err := c.cc.Invoke(ctx, ExerciseHistoryService_GetRecording_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exerciseHistoryServiceClient) DeleteRecording(ctx context.Context, in *DeleteRecordingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ExerciseHistoryService_DeleteRecording_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExerciseHistoryServiceServer is the server API for ExerciseHistoryService service.
 // All implementations should embed UnimplementedExerciseHistoryServiceServer
 // for forward compatibility.
@@ -1441,6 +2015,13 @@ type ExerciseHistoryServiceServer interface {
 	UpdateExerciseHistory(context.Context, *UpdateExerciseHistoryRequest) (*ExerciseHistory, error)
 	// Delete an exercise history entry
 	DeleteExerciseHistory(context.Context, *DeleteExerciseHistoryRequest) (*emptypb.Empty, error)
+	// Upload an audio recording of a history entry in chunks, the gateway
+	// does not stream uploads so large recordings should be sent over gRPC
+	UploadRecording(grpc.ClientStreamingServer[UploadRecordingRequest, ExerciseHistoryRecording]) error
+	// Get the details of a recording, its audio is served at its download URL
+	GetRecording(context.Context, *GetRecordingRequest) (*ExerciseHistoryRecording, error)
+	// Delete a recording
+	DeleteRecording(context.Context, *DeleteRecordingRequest) (*emptypb.Empty, error)
 }
 
 // UnimplementedExerciseHistoryServiceServer should be embedded to have
@@ -1465,6 +2046,15 @@ func (UnimplementedExerciseHistoryServiceServer) UpdateExerciseHistory(context.C
 func (UnimplementedExerciseHistoryServiceServer) DeleteExerciseHistory(context.Context, *DeleteExerciseHistoryRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteExerciseHistory not implemented")
 }
+func (UnimplementedExerciseHistoryServiceServer) UploadRecording(grpc.ClientStreamingServer[UploadRecordingRequest, ExerciseHistoryRecording]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadRecording not implemented")
+}
+func (UnimplementedExerciseHistoryServiceServer) GetRecording(context.Context, *GetRecordingRequest) (*ExerciseHistoryRecording, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecording not implemented")
+}
+func (UnimplementedExerciseHistoryServiceServer) DeleteRecording(context.Context, *DeleteRecordingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecording not implemented")
+}
 func (UnimplementedExerciseHistoryServiceServer) testEmbeddedByValue() {}
 
 // UnsafeExerciseHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1575,6 +2165,49 @@ func _ExerciseHistoryService_DeleteExerciseHistory_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExerciseHistoryService_UploadRecording_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExerciseHistoryServiceServer).UploadRecording(&grpc.GenericServerStream[UploadRecordingRequest, ExerciseHistoryRecording]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExerciseHistoryService_UploadRecordingServer = grpc.ClientStreamingServer[UploadRecordingRequest, ExerciseHistoryRecording]
+
+func _ExerciseHistoryService_GetRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExerciseHistoryServiceServer).GetRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExerciseHistoryService_GetRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExerciseHistoryServiceServer).GetRecording(ctx, req.(*GetRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExerciseHistoryService_DeleteRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExerciseHistoryServiceServer).DeleteRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExerciseHistoryService_DeleteRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExerciseHistoryServiceServer).DeleteRecording(ctx, req.(*DeleteRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExerciseHistoryService_ServiceDesc is the grpc.ServiceDesc for ExerciseHistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1602,6 +2235,2326 @@ var ExerciseHistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteExerciseHistory",
 			Handler:    _ExerciseHistoryService_DeleteExerciseHistory_Handler,
 		},
+		{
+			MethodName: "GetRecording",
+			Handler:    _ExerciseHistoryService_GetRecording_Handler,
+		},
+		{
+			MethodName: "DeleteRecording",
+			Handler:    _ExerciseHistoryService_DeleteRecording_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadRecording",
+			Handler:       _ExerciseHistoryService_UploadRecording_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "api/v1/tempus/tempus.proto",
+}
+
+const (
+	GoalService_CreateGoal_FullMethodName = "/drummer.v1.GoalService/CreateGoal"
+	GoalService_GetGoal_FullMethodName    = "/drummer.v1.GoalService/GetGoal"
+	GoalService_ListGoals_FullMethodName  = "/drummer.v1.GoalService/ListGoals"
+	GoalService_UpdateGoal_FullMethodName = "/drummer.v1.GoalService/UpdateGoal"
+	GoalService_DeleteGoal_FullMethodName = "/drummer.v1.GoalService/DeleteGoal"
+)
+
+// GoalServiceClient is the client API for GoalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GoalServiceClient interface {
+	// Create a new goal
+	CreateGoal(ctx context.Context, in *CreateGoalRequest, opts ...grpc.CallOption) (*Goal, error)
+	// Get a goal by ID
+	GetGoal(ctx context.Context, in *GetGoalRequest, opts ...grpc.CallOption) (*Goal, error)
+	// List goals with optional pagination and filtering
+	ListGoals(ctx context.Context, in *ListGoalsRequest, opts ...grpc.CallOption) (*ListGoalsResponse, error)
+	// Update a goal
+	UpdateGoal(ctx context.Context, in *UpdateGoalRequest, opts ...grpc.CallOption) (*Goal, error)
+	// Delete a goal
+	DeleteGoal(ctx context.Context, in *DeleteGoalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type goalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGoalServiceClient(cc grpc.ClientConnInterface) GoalServiceClient {
+	return &goalServiceClient{cc}
+}
+
+func (c *goalServiceClient) CreateGoal(ctx context.Context, in *CreateGoalRequest, opts ...grpc.CallOption) (*Goal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Goal)
+	err := c.cc.Invoke(ctx, GoalService_CreateGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goalServiceClient) GetGoal(ctx context.Context, in *GetGoalRequest, opts ...grpc.CallOption) (*Goal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Goal)
+	err := c.cc.Invoke(ctx, GoalService_GetGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goalServiceClient) ListGoals(ctx context.Context, in *ListGoalsRequest, opts ...grpc.CallOption) (*ListGoalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGoalsResponse)
+	err := c.cc.Invoke(ctx, GoalService_ListGoals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goalServiceClient) UpdateGoal(ctx context.Context, in *UpdateGoalRequest, opts ...grpc.CallOption) (*Goal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Goal)
+	err := c.cc.Invoke(ctx, GoalService_UpdateGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goalServiceClient) DeleteGoal(ctx context.Context, in *DeleteGoalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GoalService_DeleteGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GoalServiceServer is the server API for GoalService service.
+// All implementations should embed UnimplementedGoalServiceServer
+// for forward compatibility.
+type GoalServiceServer interface {
+	// Create a new goal
+	CreateGoal(context.Context, *CreateGoalRequest) (*Goal, error)
+	// Get a goal by ID
+	GetGoal(context.Context, *GetGoalRequest) (*Goal, error)
+	// List goals with optional pagination and filtering
+	ListGoals(context.Context, *ListGoalsRequest) (*ListGoalsResponse, error)
+	// Update a goal
+	UpdateGoal(context.Context, *UpdateGoalRequest) (*Goal, error)
+	// Delete a goal
+	DeleteGoal(context.Context, *DeleteGoalRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedGoalServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGoalServiceServer struct{}
+
+func (UnimplementedGoalServiceServer) CreateGoal(context.Context, *CreateGoalRequest) (*Goal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGoal not implemented")
+}
+func (UnimplementedGoalServiceServer) GetGoal(context.Context, *GetGoalRequest) (*Goal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoal not implemented")
+}
+func (UnimplementedGoalServiceServer) ListGoals(context.Context, *ListGoalsRequest) (*ListGoalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGoals not implemented")
+}
+func (UnimplementedGoalServiceServer) UpdateGoal(context.Context, *UpdateGoalRequest) (*Goal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoal not implemented")
+}
+func (UnimplementedGoalServiceServer) DeleteGoal(context.Context, *DeleteGoalRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGoal not implemented")
+}
+func (UnimplementedGoalServiceServer) testEmbeddedByValue() {}
+
+// UnsafeGoalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoalServiceServer will
+// result in compilation errors.
+type UnsafeGoalServiceServer interface {
+	mustEmbedUnimplementedGoalServiceServer()
+}
+
+func RegisterGoalServiceServer(s grpc.ServiceRegistrar, srv GoalServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGoalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GoalService_ServiceDesc, srv)
+}
+
+func _GoalService_CreateGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoalServiceServer).CreateGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoalService_CreateGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoalServiceServer).CreateGoal(ctx, req.(*CreateGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoalService_GetGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoalServiceServer).GetGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoalService_GetGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoalServiceServer).GetGoal(ctx, req.(*GetGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoalService_ListGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGoalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoalServiceServer).ListGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoalService_ListGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoalServiceServer).ListGoals(ctx, req.(*ListGoalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoalService_UpdateGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoalServiceServer).UpdateGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoalService_UpdateGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoalServiceServer).UpdateGoal(ctx, req.(*UpdateGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoalService_DeleteGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoalServiceServer).DeleteGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoalService_DeleteGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoalServiceServer).DeleteGoal(ctx, req.(*DeleteGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GoalService_ServiceDesc is the grpc.ServiceDesc for GoalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GoalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "drummer.v1.GoalService",
+	HandlerType: (*GoalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGoal",
+			Handler:    _GoalService_CreateGoal_Handler,
+		},
+		{
+			MethodName: "GetGoal",
+			Handler:    _GoalService_GetGoal_Handler,
+		},
+		{
+			MethodName: "ListGoals",
+			Handler:    _GoalService_ListGoals_Handler,
+		},
+		{
+			MethodName: "UpdateGoal",
+			Handler:    _GoalService_UpdateGoal_Handler,
+		},
+		{
+			MethodName: "DeleteGoal",
+			Handler:    _GoalService_DeleteGoal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/tempus/tempus.proto",
+}
+
+const (
+	RoutineService_CreateRoutine_FullMethodName           = "/drummer.v1.RoutineService/CreateRoutine"
+	RoutineService_GetRoutine_FullMethodName              = "/drummer.v1.RoutineService/GetRoutine"
+	RoutineService_ListRoutines_FullMethodName            = "/drummer.v1.RoutineService/ListRoutines"
+	RoutineService_UpdateRoutine_FullMethodName           = "/drummer.v1.RoutineService/UpdateRoutine"
+	RoutineService_DeleteRoutine_FullMethodName           = "/drummer.v1.RoutineService/DeleteRoutine"
+	RoutineService_StartSessionFromRoutine_FullMethodName = "/drummer.v1.RoutineService/StartSessionFromRoutine"
+)
+
+// RoutineServiceClient is the client API for RoutineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoutineServiceClient interface {
+	// Create a new routine
+	CreateRoutine(ctx context.Context, in *CreateRoutineRequest, opts ...grpc.CallOption) (*Routine, error)
+	// Get a routine by ID
+	GetRoutine(ctx context.Context, in *GetRoutineRequest, opts ...grpc.CallOption) (*Routine, error)
+	// List routines with optional pagination
+	ListRoutines(ctx context.Context, in *ListRoutinesRequest, opts ...grpc.CallOption) (*ListRoutinesResponse, error)
+	// Update a routine
+	UpdateRoutine(ctx context.Context, in *UpdateRoutineRequest, opts ...grpc.CallOption) (*Routine, error)
+	// Delete a routine
+	DeleteRoutine(ctx context.Context, in *DeleteRoutineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Start an active practice session from a routine
+	StartSessionFromRoutine(ctx context.Context, in *StartSessionFromRoutineRequest, opts ...grpc.CallOption) (*StartSessionFromRoutineResponse, error)
+}
+
+type routineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoutineServiceClient(cc grpc.ClientConnInterface) RoutineServiceClient {
+	return &routineServiceClient{cc}
+}
+
+func (c *routineServiceClient) CreateRoutine(ctx context.Context, in *CreateRoutineRequest, opts ...grpc.CallOption) (*Routine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Routine)
+	err := c.cc.Invoke(ctx, RoutineService_CreateRoutine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routineServiceClient) GetRoutine(ctx context.Context, in *GetRoutineRequest, opts ...grpc.CallOption) (*Routine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Routine)
+	err := c.cc.Invoke(ctx, RoutineService_GetRoutine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routineServiceClient) ListRoutines(ctx context.Context, in *ListRoutinesRequest, opts ...grpc.CallOption) (*ListRoutinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoutinesResponse)
+	err := c.cc.Invoke(ctx, RoutineService_ListRoutines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routineServiceClient) UpdateRoutine(ctx context.Context, in *UpdateRoutineRequest, opts ...grpc.CallOption) (*Routine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Routine)
+	err := c.cc.Invoke(ctx, RoutineService_UpdateRoutine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routineServiceClient) DeleteRoutine(ctx context.Context, in *DeleteRoutineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RoutineService_DeleteRoutine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routineServiceClient) StartSessionFromRoutine(ctx context.Context, in *StartSessionFromRoutineRequest, opts ...grpc.CallOption) (*StartSessionFromRoutineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartSessionFromRoutineResponse)
+	err := c.cc.Invoke(ctx, RoutineService_StartSessionFromRoutine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoutineServiceServer is the server API for RoutineService service.
+// All implementations should embed UnimplementedRoutineServiceServer
+// for forward compatibility.
+type RoutineServiceServer interface {
+	// Create a new routine
+	CreateRoutine(context.Context, *CreateRoutineRequest) (*Routine, error)
+	// Get a routine by ID
+	GetRoutine(context.Context, *GetRoutineRequest) (*Routine, error)
+	// List routines with optional pagination
+	ListRoutines(context.Context, *ListRoutinesRequest) (*ListRoutinesResponse, error)
+	// Update a routine
+	UpdateRoutine(context.Context, *UpdateRoutineRequest) (*Routine, error)
+	// Delete a routine
+	DeleteRoutine(context.Context, *DeleteRoutineRequest) (*emptypb.Empty, error)
+	// Start an active practice session from a routine
+	StartSessionFromRoutine(context.Context, *StartSessionFromRoutineRequest) (*StartSessionFromRoutineResponse, error)
+}
+
+// UnimplementedRoutineServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRoutineServiceServer struct{}
+
+func (UnimplementedRoutineServiceServer) CreateRoutine(context.Context, *CreateRoutineRequest) (*Routine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoutine not implemented")
+}
+func (UnimplementedRoutineServiceServer) GetRoutine(context.Context, *GetRoutineRequest) (*Routine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoutine not implemented")
+}
+func (UnimplementedRoutineServiceServer) ListRoutines(context.Context, *ListRoutinesRequest) (*ListRoutinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoutines not implemented")
+}
+func (UnimplementedRoutineServiceServer) UpdateRoutine(context.Context, *UpdateRoutineRequest) (*Routine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoutine not implemented")
+}
+func (UnimplementedRoutineServiceServer) DeleteRoutine(context.Context, *DeleteRoutineRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoutine not implemented")
+}
+func (UnimplementedRoutineServiceServer) StartSessionFromRoutine(context.Context, *StartSessionFromRoutineRequest) (*StartSessionFromRoutineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSessionFromRoutine not implemented")
+}
+func (UnimplementedRoutineServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRoutineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoutineServiceServer will
+// result in compilation errors.
+type UnsafeRoutineServiceServer interface {
+	mustEmbedUnimplementedRoutineServiceServer()
+}
+
+func RegisterRoutineServiceServer(s grpc.ServiceRegistrar, srv RoutineServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRoutineServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RoutineService_ServiceDesc, srv)
+}
+
+func _RoutineService_CreateRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoutineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).CreateRoutine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_CreateRoutine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).CreateRoutine(ctx, req.(*CreateRoutineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_GetRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoutineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).GetRoutine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_GetRoutine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).GetRoutine(ctx, req.(*GetRoutineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_ListRoutines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoutinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).ListRoutines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_ListRoutines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).ListRoutines(ctx, req.(*ListRoutinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_UpdateRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoutineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).UpdateRoutine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_UpdateRoutine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).UpdateRoutine(ctx, req.(*UpdateRoutineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_DeleteRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoutineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).DeleteRoutine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_DeleteRoutine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).DeleteRoutine(ctx, req.(*DeleteRoutineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoutineService_StartSessionFromRoutine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSessionFromRoutineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoutineServiceServer).StartSessionFromRoutine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoutineService_StartSessionFromRoutine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoutineServiceServer).StartSessionFromRoutine(ctx, req.(*StartSessionFromRoutineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoutineService_ServiceDesc is the grpc.ServiceDesc for RoutineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoutineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "drummer.v1.RoutineService",
+	HandlerType: (*RoutineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRoutine",
+			Handler:    _RoutineService_CreateRoutine_Handler,
+		},
+		{
+			MethodName: "GetRoutine",
+			Handler:    _RoutineService_GetRoutine_Handler,
+		},
+		{
+			MethodName: "ListRoutines",
+			Handler:    _RoutineService_ListRoutines_Handler,
+		},
+		{
+			MethodName: "UpdateRoutine",
+			Handler:    _RoutineService_UpdateRoutine_Handler,
+		},
+		{
+			MethodName: "DeleteRoutine",
+			Handler:    _RoutineService_DeleteRoutine_Handler,
+		},
+		{
+			MethodName: "StartSessionFromRoutine",
+			Handler:    _RoutineService_StartSessionFromRoutine_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/tempus/tempus.proto",
+}
+
+const (
+	RecommendationService_GetPracticePlan_FullMethodName = "/drummer.v1.RecommendationService/GetPracticePlan"
+)
+
+// RecommendationServiceClient is the client API for RecommendationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RecommendationServiceClient interface {
+	// Get a ranked plan of exercises for the available time
+	GetPracticePlan(ctx context.Context, in *GetPracticePlanRequest, opts ...grpc.CallOption) (*PracticePlan, error)
+}
+
+type recommendationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecommendationServiceClient(cc grpc.ClientConnInterface) RecommendationServiceClient {
+	return &recommendationServiceClient{cc}
+}
+
+func (c *recommendationServiceClient) GetPracticePlan(ctx context.Context, in *GetPracticePlanRequest, opts ...grpc.CallOption) (*PracticePlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PracticePlan)
+	err := c.cc.Invoke(ctx, RecommendationService_GetPracticePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecommendationServiceServer is the server API for RecommendationService service.
+// All implementations should embed UnimplementedRecommendationServiceServer
+// for forward compatibility.
+type RecommendationServiceServer interface {
+	// Get a ranked plan of exercises for the available time
+	GetPracticePlan(context.Context, *GetPracticePlanRequest) (*PracticePlan, error)
+}
+
+// UnimplementedRecommendationServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecommendationServiceServer struct{}
+
+func (UnimplementedRecommendationServiceServer) GetPracticePlan(context.Context, *GetPracticePlanRequest) (*PracticePlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPracticePlan not implemented")
+}
+func (UnimplementedRecommendationServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRecommendationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecommendationServiceServer will
+// result in compilation errors.
+type UnsafeRecommendationServiceServer interface {
+	mustEmbedUnimplementedRecommendationServiceServer()
+}
+
+func RegisterRecommendationServiceServer(s grpc.ServiceRegistrar, srv RecommendationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecommendationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecommendationService_ServiceDesc, srv)
+}
+
+func _RecommendationService_GetPracticePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPracticePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecommendationServiceServer).GetPracticePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecommendationService_GetPracticePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecommendationServiceServer).GetPracticePlan(ctx, req.(*GetPracticePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecommendationService_ServiceDesc is the grpc.ServiceDesc for RecommendationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecommendationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "drummer.v1.RecommendationService",
+	HandlerType: (*RecommendationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPracticePlan",
+			Handler:    _RecommendationService_GetPracticePlan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/tempus/tempus.proto",
+}
+
+const (
+	SearchService_Search_FullMethodName = "/drummer.v1.SearchService/Search"
+)
+
+// SearchServiceClient is the client API for SearchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SearchServiceClient interface {
+	// Search the text of exercises, links, sessions and exercise history
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+}
+
+type searchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSearchServiceClient(cc grpc.ClientConnInterface) SearchServiceClient {
+	return &searchServiceClient{cc}
+}
+
+func (c *searchServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, SearchService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchServiceServer is the server API for SearchService service.
+// All implementations should embed UnimplementedSearchServiceServer
+// for forward compatibility.
+type SearchServiceServer interface {
+	// Search the text of exercises, links, sessions and exercise history
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+}
+
+// UnimplementedSearchServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSearchServiceServer struct{}
+
+func (UnimplementedSearchServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedSearchServiceServer) testEmbeddedByValue() {}
+
+// UnsafeSearchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SearchServiceServer will
+// result in compilation errors.
+type UnsafeSearchServiceServer interface {
+	mustEmbedUnimplementedSearchServiceServer()
+}
+
+func RegisterSearchServiceServer(s grpc.ServiceRegistrar, srv SearchServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSearchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SearchService_ServiceDesc, srv)
+}
+
+func _SearchService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SearchService_ServiceDesc is the grpc.ServiceDesc for SearchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SearchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "drummer.v1.SearchService",
+	HandlerType: (*SearchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _SearchService_Search_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/tempus/tempus.proto",
+}
+
+const (
+	SettingsService_GetSettings_FullMethodName    = "/drummer.v1.SettingsService/GetSettings"
+	SettingsService_UpdateSettings_FullMethodName = "/drummer.v1.SettingsService/UpdateSettings"
+)
+
+// SettingsServiceClient is the client API for SettingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SettingsServiceClient interface {
+	// Get the settings of the authenticated user
+	GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*Settings, error)
+	// Update the settings of the authenticated user
+	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*Settings, error)
+}
+
+type settingsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSettingsServiceClient(cc grpc.ClientConnInterface) SettingsServiceClient {
+	return &settingsServiceClient{cc}
+}
+
+func (c *settingsServiceClient) GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*Settings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Settings)
+	err := c.cc.Invoke(ctx, SettingsService_GetSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingsServiceClient) UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*Settings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Settings)
+	err := c.cc.Invoke(ctx, SettingsService_UpdateSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SettingsServiceServer is the server API for SettingsService service.
+// All implementations should embed UnimplementedSettingsServiceServer
+// for forward compatibility.
+type SettingsServiceServer interface {
+	// Get the settings of the authenticated user
+	GetSettings(context.Context, *GetSettingsRequest) (*Settings, error)
+	// Update the settings of the authenticated user
+	UpdateSettings(context.Context, *UpdateSettingsRequest) (*Settings, error)
+}
+
+// UnimplementedSettingsServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSettingsServiceServer struct{}
+
+func (UnimplementedSettingsServiceServer) GetSettings(context.Context, *GetSettingsRequest) (*Settings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (UnimplementedSettingsServiceServer) UpdateSettings(context.Context, *UpdateSettingsRequest) (*Settings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettings not implemented")
+}
+func (UnimplementedSettingsServiceServer) testEmbeddedByValue() {}
+
+// UnsafeSettingsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SettingsServiceServer will
+// result in compilation errors.
+type UnsafeSettingsServiceServer interface {
+	mustEmbedUnimplementedSettingsServiceServer()
+}
+
+func RegisterSettingsServiceServer(s grpc.ServiceRegistrar, srv SettingsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSettingsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SettingsService_ServiceDesc, srv)
+}
+
+func _SettingsService_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingsService_GetSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).GetSettings(ctx, req.(*GetSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingsService_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingsService_UpdateSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).UpdateSettings(ctx, req.(*UpdateSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SettingsService_ServiceDesc is the grpc.ServiceDesc for SettingsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SettingsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "drummer.v1.SettingsService",
+	HandlerType: (*SettingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSettings",
+			Handler:    _SettingsService_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _SettingsService_UpdateSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/tempus/tempus.proto",
+}
+
+const (
+	DataService_ExportAll_FullMethodName = "/drummer.v1.DataService/ExportAll"
+	DataService_ImportAll_FullMethodName = "/drummer.v1.DataService/ImportAll"
+)
+
+// DataServiceClient is the client API for DataService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DataServiceClient interface {
+	// Export all data of the authenticated user as a single archive
+	ExportAll(ctx context.Context, in *ExportAllRequest, opts ...grpc.CallOption) (*DataArchive, error)
+	// Import a data archive into the data of the authenticated user
+	ImportAll(ctx context.Context, in *ImportAllRequest, opts ...grpc.CallOption) (*ImportAllResponse, error)
+}
+
+type dataServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDataServiceClient(cc grpc.ClientConnInterface) DataServiceClient {
+	return &dataServiceClient{cc}
+}
+
+func (c *dataServiceClient) ExportAll(ctx context.Context, in *ExportAllRequest, opts ...grpc.CallOption) (*DataArchive, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataArchive)
+	err := c.cc.Invoke(ctx, DataService_ExportAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) ImportAll(ctx context.Context, in *ImportAllRequest, opts ...grpc.CallOption) (*ImportAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportAllResponse)
+	err := c.cc.Invoke(ctx, DataService_ImportAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataServiceServer is the server API for DataService service.
+// All implementations should embed UnimplementedDataServiceServer
+// for forward compatibility.
+type DataServiceServer interface {
+	// Export all data of the authenticated user as a single archive
+	ExportAll(context.Context, *ExportAllRequest) (*DataArchive, error)
+	// Import a data archive into the data of the authenticated user
+	ImportAll(context.Context, *ImportAllRequest) (*ImportAllResponse, error)
+}
+
+// UnimplementedDataServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDataServiceServer struct{}
+
+func (UnimplementedDataServiceServer) ExportAll(context.Context, *ExportAllRequest) (*DataArchive, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAll not implemented")
+}
+func (UnimplementedDataServiceServer) ImportAll(context.Context, *ImportAllRequest) (*ImportAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAll not implemented")
+}
+func (UnimplementedDataServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDataServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DataServiceServer will
+// result in compilation errors.
+type UnsafeDataServiceServer interface {
+	mustEmbedUnimplementedDataServiceServer()
+}
+
+func RegisterDataServiceServer(s grpc.ServiceRegistrar, srv DataServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDataServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DataService_ServiceDesc, srv)
+}
+
+func _DataService_ExportAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ExportAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ExportAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ExportAll(ctx, req.(*ExportAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_ImportAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ImportAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ImportAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ImportAll(ctx, req.(*ImportAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DataService_ServiceDesc is the grpc.ServiceDesc for DataService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DataService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "drummer.v1.DataService",
+	HandlerType: (*DataServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportAll",
+			Handler:    _DataService_ExportAll_Handler,
+		},
+		{
+			MethodName: "ImportAll",
+			Handler:    _DataService_ImportAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/tempus/tempus.proto",
+}
+
+const (
+	AdminService_CreateBackup_FullMethodName = "/drummer.v1.AdminService/CreateBackup"
+	AdminService_ListBackups_FullMethodName  = "/drummer.v1.AdminService/ListBackups"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	// Take a database snapshot
+	CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*Backup, error)
+	// List the database snapshots
+	ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*Backup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Backup)
+	err := c.cc.Invoke(ctx, AdminService_CreateBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBackupsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListBackups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations should embed UnimplementedAdminServiceServer
+// for forward compatibility.
+type AdminServiceServer interface {
+	// Take a database snapshot
+	CreateBackup(context.Context, *CreateBackupRequest) (*Backup, error)
+	// List the database snapshots
+	ListBackups(context.Context, *ListBackupsRequest) (*ListBackupsResponse, error)
+}
+
+// UnimplementedAdminServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) CreateBackup(context.Context, *CreateBackupRequest) (*Backup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBackup not implemented")
+}
+func (UnimplementedAdminServiceServer) ListBackups(context.Context, *ListBackupsRequest) (*ListBackupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackups not implemented")
+}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_CreateBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateBackup(ctx, req.(*CreateBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListBackups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListBackups(ctx, req.(*ListBackupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "drummer.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBackup",
+			Handler:    _AdminService_CreateBackup_Handler,
+		},
+		{
+			MethodName: "ListBackups",
+			Handler:    _AdminService_ListBackups_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/tempus/tempus.proto",
+}
+
+const (
+	AuthService_Login_FullMethodName          = "/drummer.v1.AuthService/Login"
+	AuthService_Logout_FullMethodName         = "/drummer.v1.AuthService/Logout"
+	AuthService_GetCurrentUser_FullMethodName = "/drummer.v1.AuthService/GetCurrentUser"
+	AuthService_ChangePassword_FullMethodName = "/drummer.v1.AuthService/ChangePassword"
+	AuthService_CreateApiToken_FullMethodName = "/drummer.v1.AuthService/CreateApiToken"
+	AuthService_ListApiTokens_FullMethodName  = "/drummer.v1.AuthService/ListApiTokens"
+	AuthService_DeleteApiToken_FullMethodName = "/drummer.v1.AuthService/DeleteApiToken"
+)
+
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthServiceClient interface {
+	// Sign in with a password, the only call that needs no authentication
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// End the current session
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	// Get the authenticated user
+	GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*User, error)
+	// Change the password of the authenticated user
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Create an API token
+	CreateApiToken(ctx context.Context, in *CreateApiTokenRequest, opts ...grpc.CallOption) (*CreateApiTokenResponse, error)
+	// List the API tokens of the authenticated user
+	ListApiTokens(ctx context.Context, in *ListApiTokensRequest, opts ...grpc.CallOption) (*ListApiTokensResponse, error)
+	// Revoke an API token
+	DeleteApiToken(ctx context.Context, in *DeleteApiTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type authServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, AuthService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, AuthService_GetCurrentUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateApiToken(ctx context.Context, in *CreateApiTokenRequest, opts ...grpc.CallOption) (*CreateApiTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateApiToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListApiTokens(ctx context.Context, in *ListApiTokensRequest, opts ...grpc.CallOption) (*ListApiTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiTokensResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListApiTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteApiToken(ctx context.Context, in *DeleteApiTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_DeleteApiToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+// All implementations should embed UnimplementedAuthServiceServer
+// for forward compatibility.
+type AuthServiceServer interface {
+	// Sign in with a password, the only call that needs no authentication
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// End the current session
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// Get the authenticated user
+	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*User, error)
+	// Change the password of the authenticated user
+	ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error)
+	// Create an API token
+	CreateApiToken(context.Context, *CreateApiTokenRequest) (*CreateApiTokenResponse, error)
+	// List the API tokens of the authenticated user
+	ListApiTokens(context.Context, *ListApiTokensRequest) (*ListApiTokensResponse, error)
+	// Revoke an API token
+	DeleteApiToken(context.Context, *DeleteApiTokenRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedAuthServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthServiceServer struct{}
+
+func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedAuthServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedAuthServiceServer) GetCurrentUser(context.Context, *GetCurrentUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentUser not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateApiToken(context.Context, *CreateApiTokenRequest) (*CreateApiTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiToken not implemented")
+}
+func (UnimplementedAuthServiceServer) ListApiTokens(context.Context, *ListApiTokensRequest) (*ListApiTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiTokens not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteApiToken(context.Context, *DeleteApiTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiToken not implemented")
+}
+func (UnimplementedAuthServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServiceServer will
+// result in compilation errors.
+type UnsafeAuthServiceServer interface {
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthService_ServiceDesc, srv)
+}
+
+func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetCurrentUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetCurrentUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetCurrentUser(ctx, req.(*GetCurrentUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateApiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateApiToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateApiToken(ctx, req.(*CreateApiTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListApiTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListApiTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListApiTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListApiTokens(ctx, req.(*ListApiTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApiTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteApiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteApiToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteApiToken(ctx, req.(*DeleteApiTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "drummer.v1.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _AuthService_Login_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AuthService_Logout_Handler,
+		},
+		{
+			MethodName: "GetCurrentUser",
+			Handler:    _AuthService_GetCurrentUser_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _AuthService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "CreateApiToken",
+			Handler:    _AuthService_CreateApiToken_Handler,
+		},
+		{
+			MethodName: "ListApiTokens",
+			Handler:    _AuthService_ListApiTokens_Handler,
+		},
+		{
+			MethodName: "DeleteApiToken",
+			Handler:    _AuthService_DeleteApiToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/tempus/tempus.proto",
+}
+
+const (
+	UserService_CreateUser_FullMethodName    = "/drummer.v1.UserService/CreateUser"
+	UserService_ListUsers_FullMethodName     = "/drummer.v1.UserService/ListUsers"
+	UserService_UpdateUser_FullMethodName    = "/drummer.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName    = "/drummer.v1.UserService/DeleteUser"
+	UserService_AddStudent_FullMethodName    = "/drummer.v1.UserService/AddStudent"
+	UserService_RemoveStudent_FullMethodName = "/drummer.v1.UserService/RemoveStudent"
+)
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// UserService manages the users, only admins may call it
+type UserServiceClient interface {
+	// Create a user
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
+	// List the users
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// Update the roles of a user
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
+	// Delete a user
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Make a user a student of a teacher
+	AddStudent(ctx context.Context, in *AddStudentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// End the teaching of a student
+	RemoveStudent(ctx context.Context, in *RemoveStudentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddStudent(ctx context.Context, in *AddStudentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_AddStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveStudent(ctx context.Context, in *RemoveStudentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RemoveStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations should embed UnimplementedUserServiceServer
+// for forward compatibility.
+//
+// UserService manages the users, only admins may call it
+type UserServiceServer interface {
+	// Create a user
+	CreateUser(context.Context, *CreateUserRequest) (*User, error)
+	// List the users
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// Update the roles of a user
+	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
+	// Delete a user
+	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	// Make a user a student of a teacher
+	AddStudent(context.Context, *AddStudentRequest) (*emptypb.Empty, error)
+	// End the teaching of a student
+	RemoveStudent(context.Context, *RemoveStudentRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedUserServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserServiceServer) AddStudent(context.Context, *AddStudentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStudent not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveStudent(context.Context, *RemoveStudentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStudent not implemented")
+}
+func (UnimplementedUserServiceServer) testEmbeddedByValue() {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddStudent(ctx, req.(*AddStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveStudent(ctx, req.(*RemoveStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "drummer.v1.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _UserService_CreateUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UserService_ListUsers_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UserService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UserService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "AddStudent",
+			Handler:    _UserService_AddStudent_Handler,
+		},
+		{
+			MethodName: "RemoveStudent",
+			Handler:    _UserService_RemoveStudent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/tempus/tempus.proto",
+}
+
+const (
+	AssignmentService_CreateAssignment_FullMethodName      = "/drummer.v1.AssignmentService/CreateAssignment"
+	AssignmentService_GetAssignment_FullMethodName         = "/drummer.v1.AssignmentService/GetAssignment"
+	AssignmentService_ListAssignments_FullMethodName       = "/drummer.v1.AssignmentService/ListAssignments"
+	AssignmentService_UpdateAssignment_FullMethodName      = "/drummer.v1.AssignmentService/UpdateAssignment"
+	AssignmentService_DeleteAssignment_FullMethodName      = "/drummer.v1.AssignmentService/DeleteAssignment"
+	AssignmentService_ListAssignmentHistory_FullMethodName = "/drummer.v1.AssignmentService/ListAssignmentHistory"
+	AssignmentService_AddHistoryComment_FullMethodName     = "/drummer.v1.AssignmentService/AddHistoryComment"
+	AssignmentService_DeleteHistoryComment_FullMethodName  = "/drummer.v1.AssignmentService/DeleteHistoryComment"
+	AssignmentService_ListStudents_FullMethodName          = "/drummer.v1.AssignmentService/ListStudents"
+	AssignmentService_ListStudentExercises_FullMethodName  = "/drummer.v1.AssignmentService/ListStudentExercises"
+)
+
+// AssignmentServiceClient is the client API for AssignmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AssignmentServiceClient interface {
+	// Assign an exercise to a student, teachers only
+	CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error)
+	// Get an assignment by ID
+	GetAssignment(ctx context.Context, in *GetAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error)
+	// List assignments with their status, a feed of how the students are
+	// doing for teachers
+	ListAssignments(ctx context.Context, in *ListAssignmentsRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error)
+	// Update an assignment, teachers only
+	UpdateAssignment(ctx context.Context, in *UpdateAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error)
+	// Delete an assignment, teachers only
+	DeleteAssignment(ctx context.Context, in *DeleteAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the history of the assigned exercise with its comments
+	ListAssignmentHistory(ctx context.Context, in *ListAssignmentHistoryRequest, opts ...grpc.CallOption) (*ListAssignmentHistoryResponse, error)
+	// Comment on an exercise history entry
+	AddHistoryComment(ctx context.Context, in *AddHistoryCommentRequest, opts ...grpc.CallOption) (*HistoryComment, error)
+	// Delete a comment
+	DeleteHistoryComment(ctx context.Context, in *DeleteHistoryCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the students of the authenticated teacher
+	ListStudents(ctx context.Context, in *ListStudentsRequest, opts ...grpc.CallOption) (*ListStudentsResponse, error)
+	// List the exercises of a student of the authenticated teacher
+	ListStudentExercises(ctx context.Context, in *ListStudentExercisesRequest, opts ...grpc.CallOption) (*ListStudentExercisesResponse, error)
+}
+
+type assignmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAssignmentServiceClient(cc grpc.ClientConnInterface) AssignmentServiceClient {
+	return &assignmentServiceClient{cc}
+}
+
+func (c *assignmentServiceClient) CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignment)
+	err := c.cc.Invoke(ctx, AssignmentService_CreateAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) GetAssignment(ctx context.Context, in *GetAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignment)
+	err := c.cc.Invoke(ctx, AssignmentService_GetAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) ListAssignments(ctx context.Context, in *ListAssignmentsRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssignmentsResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_ListAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) UpdateAssignment(ctx context.Context, in *UpdateAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignment)
+	err := c.cc.Invoke(ctx, AssignmentService_UpdateAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) DeleteAssignment(ctx context.Context, in *DeleteAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AssignmentService_DeleteAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) ListAssignmentHistory(ctx context.Context, in *ListAssignmentHistoryRequest, opts ...grpc.CallOption) (*ListAssignmentHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssignmentHistoryResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_ListAssignmentHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) AddHistoryComment(ctx context.Context, in *AddHistoryCommentRequest, opts ...grpc.CallOption) (*HistoryComment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoryComment)
+	err := c.cc.Invoke(ctx, AssignmentService_AddHistoryComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) DeleteHistoryComment(ctx context.Context, in *DeleteHistoryCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AssignmentService_DeleteHistoryComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) ListStudents(ctx context.Context, in *ListStudentsRequest, opts ...grpc.CallOption) (*ListStudentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStudentsResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_ListStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) ListStudentExercises(ctx context.Context, in *ListStudentExercisesRequest, opts ...grpc.CallOption) (*ListStudentExercisesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStudentExercisesResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_ListStudentExercises_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AssignmentServiceServer is the server API for AssignmentService service.
+// All implementations should embed UnimplementedAssignmentServiceServer
+// for forward compatibility.
+type AssignmentServiceServer interface {
+	// Assign an exercise to a student, teachers only
+	CreateAssignment(context.Context, *CreateAssignmentRequest) (*Assignment, error)
+	// Get an assignment by ID
+	GetAssignment(context.Context, *GetAssignmentRequest) (*Assignment, error)
+	// List assignments with their status, a feed of how the students are
+	// doing for teachers
+	ListAssignments(context.Context, *ListAssignmentsRequest) (*ListAssignmentsResponse, error)
+	// Update an assignment, teachers only
+	UpdateAssignment(context.Context, *UpdateAssignmentRequest) (*Assignment, error)
+	// Delete an assignment, teachers only
+	DeleteAssignment(context.Context, *DeleteAssignmentRequest) (*emptypb.Empty, error)
+	// List the history of the assigned exercise with its comments
+	ListAssignmentHistory(context.Context, *ListAssignmentHistoryRequest) (*ListAssignmentHistoryResponse, error)
+	// Comment on an exercise history entry
+	AddHistoryComment(context.Context, *AddHistoryCommentRequest) (*HistoryComment, error)
+	// Delete a comment
+	DeleteHistoryComment(context.Context, *DeleteHistoryCommentRequest) (*emptypb.Empty, error)
+	// List the students of the authenticated teacher
+	ListStudents(context.Context, *ListStudentsRequest) (*ListStudentsResponse, error)
+	// List the exercises of a student of the authenticated teacher
+	ListStudentExercises(context.Context, *ListStudentExercisesRequest) (*ListStudentExercisesResponse, error)
+}
+
+// UnimplementedAssignmentServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAssignmentServiceServer struct{}
+
+func (UnimplementedAssignmentServiceServer) CreateAssignment(context.Context, *CreateAssignmentRequest) (*Assignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) GetAssignment(context.Context, *GetAssignmentRequest) (*Assignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) ListAssignments(context.Context, *ListAssignmentsRequest) (*ListAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssignments not implemented")
+}
+func (UnimplementedAssignmentServiceServer) UpdateAssignment(context.Context, *UpdateAssignmentRequest) (*Assignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) DeleteAssignment(context.Context, *DeleteAssignmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) ListAssignmentHistory(context.Context, *ListAssignmentHistoryRequest) (*ListAssignmentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssignmentHistory not implemented")
+}
+func (UnimplementedAssignmentServiceServer) AddHistoryComment(context.Context, *AddHistoryCommentRequest) (*HistoryComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddHistoryComment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) DeleteHistoryComment(context.Context, *DeleteHistoryCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHistoryComment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) ListStudents(context.Context, *ListStudentsRequest) (*ListStudentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudents not implemented")
+}
+func (UnimplementedAssignmentServiceServer) ListStudentExercises(context.Context, *ListStudentExercisesRequest) (*ListStudentExercisesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudentExercises not implemented")
+}
+func (UnimplementedAssignmentServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAssignmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AssignmentServiceServer will
+// result in compilation errors.
+type UnsafeAssignmentServiceServer interface {
+	mustEmbedUnimplementedAssignmentServiceServer()
+}
+
+func RegisterAssignmentServiceServer(s grpc.ServiceRegistrar, srv AssignmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAssignmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AssignmentService_ServiceDesc, srv)
+}
+
+func _AssignmentService_CreateAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).CreateAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_CreateAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).CreateAssignment(ctx, req.(*CreateAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_GetAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).GetAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_GetAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).GetAssignment(ctx, req.(*GetAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_ListAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).ListAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_ListAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).ListAssignments(ctx, req.(*ListAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_UpdateAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).UpdateAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_UpdateAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).UpdateAssignment(ctx, req.(*UpdateAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_DeleteAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).DeleteAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_DeleteAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).DeleteAssignment(ctx, req.(*DeleteAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_ListAssignmentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).ListAssignmentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_ListAssignmentHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).ListAssignmentHistory(ctx, req.(*ListAssignmentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_AddHistoryComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddHistoryCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).AddHistoryComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_AddHistoryComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).AddHistoryComment(ctx, req.(*AddHistoryCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_DeleteHistoryComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHistoryCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).DeleteHistoryComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_DeleteHistoryComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).DeleteHistoryComment(ctx, req.(*DeleteHistoryCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_ListStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).ListStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_ListStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).ListStudents(ctx, req.(*ListStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_ListStudentExercises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudentExercisesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).ListStudentExercises(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_ListStudentExercises_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).ListStudentExercises(ctx, req.(*ListStudentExercisesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AssignmentService_ServiceDesc is the grpc.ServiceDesc for AssignmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AssignmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "drummer.v1.AssignmentService",
+	HandlerType: (*AssignmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAssignment",
+			Handler:    _AssignmentService_CreateAssignment_Handler,
+		},
+		{
+			MethodName: "GetAssignment",
+			Handler:    _AssignmentService_GetAssignment_Handler,
+		},
+		{
+			MethodName: "ListAssignments",
+			Handler:    _AssignmentService_ListAssignments_Handler,
+		},
+		{
+			MethodName: "UpdateAssignment",
+			Handler:    _AssignmentService_UpdateAssignment_Handler,
+		},
+		{
+			MethodName: "DeleteAssignment",
+			Handler:    _AssignmentService_DeleteAssignment_Handler,
+		},
+		{
+			MethodName: "ListAssignmentHistory",
+			Handler:    _AssignmentService_ListAssignmentHistory_Handler,
+		},
+		{
+			MethodName: "AddHistoryComment",
+			Handler:    _AssignmentService_AddHistoryComment_Handler,
+		},
+		{
+			MethodName: "DeleteHistoryComment",
+			Handler:    _AssignmentService_DeleteHistoryComment_Handler,
+		},
+		{
+			MethodName: "ListStudents",
+			Handler:    _AssignmentService_ListStudents_Handler,
+		},
+		{
+			MethodName: "ListStudentExercises",
+			Handler:    _AssignmentService_ListStudentExercises_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/tempus/tempus.proto",
@@ -0,0 +1,69 @@
+// Package auth authenticates the requests of users, signed in with a session
+// cookie or sending an API token, on both the gRPC server and the HTTP
+// handlers served next to the gateway.
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Password length bounds, bcrypt only uses the first 72 bytes
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
+)
+
+// ErrPasswordLength is returned when hashing a password out of bounds
+var ErrPasswordLength = errors.New("password must be between 8 and 72 bytes long")
+
+// dummyHash is compared against for unknown usernames so that a failed login
+// takes as long whether or not the user exists
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("tempus dummy password"), bcrypt.DefaultCost)
+
+// HashPassword hashes a password with bcrypt
+func HashPassword(password string) (string, error) {
+	if len(password) < MinPasswordLength || len(password) > MaxPasswordLength {
+		return "", ErrPasswordLength
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword reports whether a password matches a hash, an empty hash
+// never matches but takes as long to check
+func CheckPassword(hash, password string) bool {
+	if hash == "" {
+		bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// NewToken generates a random session or API token and the hash it is
+// stored as
+func NewToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, HashToken(token), nil
+}
+
+// HashToken returns the hash a token is stored as. Tokens are random enough
+// that a fast hash suffices.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
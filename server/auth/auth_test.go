@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestHashPassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		err      error
+	}{
+		{"empty", "", ErrPasswordLength},
+		{"7 bytes", "1234567", ErrPasswordLength},
+		{"8 bytes", "12345678", nil},
+		{"72 bytes", strings.Repeat("a", 72), nil},
+		{"73 bytes", strings.Repeat("a", 73), ErrPasswordLength},
+		// The bounds count bytes, not characters
+		{"4 two byte characters", "éééé", nil},
+		{"37 two byte characters", strings.Repeat("é", 37), ErrPasswordLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := HashPassword(tt.password)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("HashPassword = %q, %v, want error %v", hash, err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if !CheckPassword(hash, tt.password) {
+				t.Error("CheckPassword rejects the hashed password")
+			}
+			if CheckPassword(hash, "x"+tt.password[1:]) {
+				t.Error("CheckPassword accepts another password")
+			}
+		})
+	}
+}
+
+func TestCheckPasswordWithoutHash(t *testing.T) {
+	// Unknown users have no hash, which matches no password
+	for _, password := range []string{"", "tempus dummy password"} {
+		if CheckPassword("", password) {
+			t.Errorf("CheckPassword of an empty hash accepts %q", password)
+		}
+	}
+}
+
+func TestNewToken(t *testing.T) {
+	token, hash, err := NewToken()
+	if err != nil {
+		t.Fatalf("NewToken: %v", err)
+	}
+	if len(token) != 43 {
+		t.Errorf("token %q has length %d, want 43", token, len(token))
+	}
+	if hash != HashToken(token) || hash == token {
+		t.Errorf("hash %q is not the hash of the token", hash)
+	}
+
+	other, _, err := NewToken()
+	if err != nil {
+		t.Fatalf("NewToken: %v", err)
+	}
+	if other == token {
+		t.Error("NewToken returned the same token twice")
+	}
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// SessionCookie is the name of the cookie holding the session token of the
+// web app
+const SessionCookie = "tempus_session"
+
+// Middleware authenticates the requests of HTTP handlers served outside the
+// gateway, with a bearer token or the session cookie
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := a.authenticate(r.Context(), requestToken(r))
+		if err != nil {
+			st := status.Convert(err)
+			http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// requestToken returns the bearer token of a request, or else its session
+// cookie
+func requestToken(r *http.Request) string {
+	if token := bearerToken(r.Header.Values("Authorization")); token != "" {
+		return token
+	}
+	if cookie, err := r.Cookie(SessionCookie); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
+// SessionCookies returns a gateway forward response option that sets the
+// session cookie from login responses, leaving their token out of the body,
+// and clears it on logout. Secure cookies are only sent over HTTPS.
+func SessionCookies(secure bool) func(context.Context, http.ResponseWriter, proto.Message) error {
+	return func(ctx context.Context, w http.ResponseWriter, msg proto.Message) error {
+		cookie := &http.Cookie{
+			Name:     SessionCookie,
+			Path:     "/",
+			HttpOnly: true,
+			Secure:   secure,
+			SameSite: http.SameSiteLaxMode,
+		}
+
+		switch resp := msg.(type) {
+		case *pb.LoginResponse:
+			cookie.Value = resp.SessionToken
+			cookie.Expires = resp.ExpiresAt.AsTime()
+			resp.SessionToken = ""
+		case *pb.LogoutResponse:
+			cookie.MaxAge = -1
+		default:
+			return nil
+		}
+
+		http.SetCookie(w, cookie)
+		return nil
+	}
+}
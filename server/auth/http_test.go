@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMiddleware(t *testing.T) {
+	a := testAuthenticator(t)
+
+	tests := []struct {
+		name          string
+		authorization string
+		cookie        string
+		status        int
+		user          string
+	}{
+		{"bearer session", "Bearer " + aliceSession, "", http.StatusOK, "alice"},
+		{"bearer API token", "Bearer " + aliceAPI, "", http.StatusOK, "alice"},
+		{"cookie", "", aliceSession, http.StatusOK, "alice"},
+		{"bearer before cookie", "Bearer " + rootSession, aliceSession, http.StatusOK, "root"},
+		{"other scheme falls back to the cookie", "Basic YWxpY2U6cHc=", aliceSession, http.StatusOK, "alice"},
+		{"no credentials", "", "", http.StatusUnauthorized, ""},
+		{"unknown token", "Bearer nope", "", http.StatusUnauthorized, ""},
+		{"expired session cookie", "", expiredSession, http.StatusUnauthorized, ""},
+		{"expired API token", "Bearer " + expiredAPI, "", http.StatusUnauthorized, ""},
+		{"revoked API token", "Bearer " + revokedAPI, "", http.StatusUnauthorized, ""},
+		{"logged out session", "", loggedOut, http.StatusUnauthorized, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var user *pb.User
+			h := a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				user = UserFromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/images/1", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: SessionCookie, Value: tt.cookie})
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("status %d, want %d", w.Code, tt.status)
+			}
+			if got := user.GetUsername(); got != tt.user {
+				t.Errorf("handler called as %q, want %q", got, tt.user)
+			}
+		})
+	}
+}
+
+func TestSessionCookies(t *testing.T) {
+	expires := time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		msg    *pb.LoginResponse
+		logout bool
+		secure bool
+		want   string
+	}{
+		{"login", &pb.LoginResponse{SessionToken: "abc", ExpiresAt: timestamppb.New(expires)}, false, false,
+			"tempus_session=abc; Path=/; Expires=Mon, 02 Mar 2026 18:00:00 GMT; HttpOnly; SameSite=Lax"},
+		{"secure login", &pb.LoginResponse{SessionToken: "abc", ExpiresAt: timestamppb.New(expires)}, false, true,
+			"tempus_session=abc; Path=/; Expires=Mon, 02 Mar 2026 18:00:00 GMT; HttpOnly; Secure; SameSite=Lax"},
+		{"logout", nil, true, false, "tempus_session=; Path=/; Max-Age=0; HttpOnly; SameSite=Lax"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			var err error
+			if tt.logout {
+				err = SessionCookies(tt.secure)(context.Background(), w, &pb.LogoutResponse{})
+			} else {
+				err = SessionCookies(tt.secure)(context.Background(), w, tt.msg)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := w.Header().Get("Set-Cookie"); got != tt.want {
+				t.Errorf("Set-Cookie = %q, want %q", got, tt.want)
+			}
+			// The token is only sent in the cookie
+			if tt.msg != nil && tt.msg.SessionToken != "" {
+				t.Errorf("response body keeps the session token %q", tt.msg.SessionToken)
+			}
+		})
+	}
+
+	// Other responses set no cookie
+	w := httptest.NewRecorder()
+	if err := SessionCookies(false)(context.Background(), w, &pb.User{Id: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if got := w.Header().Get("Set-Cookie"); got != "" {
+		t.Errorf("Set-Cookie = %q for a user, want none", got)
+	}
+}
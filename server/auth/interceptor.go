@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// publicMethods can be called without authenticating
+var publicMethods = map[string]bool{
+	pb.AuthService_Login_FullMethodName: true,
+}
+
+// adminServices can only be called by admins
+var adminServices = map[string]bool{
+	pb.UserService_ServiceDesc.ServiceName:  true,
+	pb.AdminService_ServiceDesc.ServiceName: true,
+}
+
+// gatewayCookieKey is the metadata key the gateway forwards the Cookie
+// header as
+const gatewayCookieKey = "grpcgateway-cookie"
+
+// Authenticator checks the credentials of requests against the stored tokens
+type Authenticator struct {
+	users storage.UserRepo
+}
+
+// NewAuthenticator creates a new Authenticator looking tokens up in users
+func NewAuthenticator(users storage.UserRepo) *Authenticator {
+	return &Authenticator{users: users}
+}
+
+// UnaryInterceptor authenticates unary calls
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor authenticates streaming calls
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticatedStream is a server stream with the context of its user
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorize authenticates a call of a gRPC method, sent directly with a
+// bearer token or through the gateway, and checks the user may call it
+func (a *Authenticator) authorize(ctx context.Context, method string) (context.Context, error) {
+	if publicMethods[method] {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	token := bearerToken(md.Get("authorization"))
+	if token == "" {
+		token = cookieToken(md.Get(gatewayCookieKey))
+	}
+
+	ctx, err := a.authenticate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	service, _, _ := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	if adminServices[service] && !UserFromContext(ctx).Admin {
+		return nil, status.Error(codes.PermissionDenied, "admin access required")
+	}
+
+	return ctx, nil
+}
+
+// authenticate returns a context holding the user of a token
+func (a *Authenticator) authenticate(ctx context.Context, token string) (context.Context, error) {
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	hash := HashToken(token)
+	user, err := a.users.Authenticate(ctx, hash)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired credentials")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to authenticate: %v", err)
+	}
+
+	// The repositories act on the data of the user
+	ctx = storage.WithOwner(ctx, user.Id)
+	return context.WithValue(ctx, identityKey{}, identity{user: user, tokenHash: hash}), nil
+}
+
+// identity is the user a request authenticated as and the hash of the token
+// it sent
+type identity struct {
+	user      *pb.User
+	tokenHash string
+}
+
+type identityKey struct{}
+
+// UserFromContext returns the user a request authenticated as, nil for
+// public methods
+func UserFromContext(ctx context.Context) *pb.User {
+	id, _ := ctx.Value(identityKey{}).(identity)
+	return id.user
+}
+
+// TokenHashFromContext returns the hash of the token a request authenticated
+// with, empty for public methods
+func TokenHashFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(identityKey{}).(identity)
+	return id.tokenHash
+}
+
+// bearerToken returns the token of the first bearer authorization value
+func bearerToken(values []string) string {
+	for _, value := range values {
+		scheme, token, ok := strings.Cut(value, " ")
+		if ok && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
+
+// cookieToken returns the session token among Cookie header values
+func cookieToken(values []string) string {
+	for _, value := range values {
+		cookies, err := http.ParseCookie(value)
+		if err != nil {
+			continue
+		}
+		for _, cookie := range cookies {
+			if cookie.Name == SessionCookie {
+				return cookie.Value
+			}
+		}
+	}
+	return ""
+}
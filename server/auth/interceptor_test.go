@@ -0,0 +1,303 @@
+package auth
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/blobstore"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Tokens of the test store, alice is a user and root an admin
+const (
+	aliceSession = "alice-session"
+	aliceAPI     = "alice-api"
+	rootSession  = "root-session"
+
+	expiredSession = "expired-session"
+	expiredAPI     = "expired-api"
+	revokedAPI     = "revoked-api"
+	loggedOut      = "logged-out"
+)
+
+// testAuthenticator returns an Authenticator of a migrated SQLite store
+// holding the tokens above
+func testAuthenticator(t *testing.T) *Authenticator {
+	t.Helper()
+
+	db, err := storage.Open(storage.DriverSQLite, filepath.Join(t.TempDir(), "tempus.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := storage.RunMigrations(db, storage.DriverSQLite); err != nil {
+		t.Fatalf("run migrations: %v", err)
+	}
+	blobs, err := blobstore.NewFS(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := storage.NewStore(db, storage.DriverSQLite, blobs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	users := store.Users()
+	alice, err := users.Create(ctx, "alice", "hash", false, false)
+	if err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	root, err := users.Create(ctx, "root", "hash", true, false)
+	if err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	future, past := time.Now().Add(time.Hour), time.Now().Add(-time.Hour)
+	tokens := []struct {
+		user  *pb.User
+		token string
+		kind  storage.TokenKind
+		// expires is when the token expires, never when zero
+		expires time.Time
+	}{
+		{alice, aliceSession, storage.TokenSession, future},
+		{alice, aliceAPI, storage.TokenAPI, time.Time{}},
+		{root, rootSession, storage.TokenSession, future},
+		{alice, expiredSession, storage.TokenSession, past},
+		{alice, expiredAPI, storage.TokenAPI, past},
+		{alice, revokedAPI, storage.TokenAPI, time.Time{}},
+		{alice, loggedOut, storage.TokenSession, future},
+	}
+	for _, tt := range tokens {
+		_, err := users.CreateToken(ctx, tt.user.Id, storage.NewToken{
+			Kind:      tt.kind,
+			Name:      tt.token,
+			Hash:      HashToken(tt.token),
+			ExpiresAt: tt.expires,
+		})
+		if err != nil {
+			t.Fatalf("create token %s: %v", tt.token, err)
+		}
+	}
+
+	apiTokens, err := users.ListTokens(ctx, alice.Id)
+	if err != nil {
+		t.Fatalf("list tokens: %v", err)
+	}
+	for _, token := range apiTokens {
+		if token.Name == revokedAPI {
+			if err := users.DeleteToken(ctx, alice.Id, token.Id); err != nil {
+				t.Fatalf("revoke token: %v", err)
+			}
+		}
+	}
+	if err := users.DeleteSession(ctx, HashToken(loggedOut)); err != nil {
+		t.Fatalf("log out: %v", err)
+	}
+
+	return NewAuthenticator(users)
+}
+
+// incoming returns a context of a call with the given metadata pairs
+func incoming(pairs ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+}
+
+// wantCode fails the test unless err has the given gRPC code
+func wantCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != code {
+		t.Fatalf("code %v (%v), want %v", got, err, code)
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{"none", nil, ""},
+		{"bearer", []string{"Bearer abc"}, "abc"},
+		{"scheme case", []string{"bEARER abc"}, "abc"},
+		{"extra spaces", []string{"Bearer   abc  "}, "abc"},
+		{"basic", []string{"Basic YWxpY2U6cHc="}, ""},
+		{"no token", []string{"Bearer"}, ""},
+		{"first bearer", []string{"Basic YWxpY2U6cHc=", "Bearer abc", "Bearer def"}, "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bearerToken(tt.values); got != tt.want {
+				t.Errorf("bearerToken(%q) = %q, want %q", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCookieToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{"none", nil, ""},
+		{"session", []string{"tempus_session=abc"}, "abc"},
+		{"among others", []string{"theme=dark; tempus_session=abc; lang=en"}, "abc"},
+		{"other cookies", []string{"theme=dark"}, ""},
+		{"malformed value first", []string{"=;;", "tempus_session=abc"}, "abc"},
+		{"similar name", []string{"tempus_session_old=abc"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cookieToken(tt.values); got != tt.want {
+				t.Errorf("cookieToken(%q) = %q, want %q", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	a := testAuthenticator(t)
+	const method = pb.ExerciseService_ListExercises_FullMethodName
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+		user string
+	}{
+		{"session", incoming("authorization", "Bearer "+aliceSession), codes.OK, "alice"},
+		{"API token", incoming("authorization", "Bearer "+aliceAPI), codes.OK, "alice"},
+		{"gateway cookie", incoming(gatewayCookieKey, "theme=dark; tempus_session="+aliceSession), codes.OK, "alice"},
+		{"bearer before cookie", incoming("authorization", "Bearer "+rootSession, gatewayCookieKey, "tempus_session="+aliceSession), codes.OK, "root"},
+		{"no metadata", context.Background(), codes.Unauthenticated, ""},
+		{"no credentials", incoming("x-other", "value"), codes.Unauthenticated, ""},
+		{"unknown token", incoming("authorization", "Bearer nope"), codes.Unauthenticated, ""},
+		{"expired session", incoming("authorization", "Bearer "+expiredSession), codes.Unauthenticated, ""},
+		{"expired API token", incoming("authorization", "Bearer "+expiredAPI), codes.Unauthenticated, ""},
+		{"revoked API token", incoming("authorization", "Bearer "+revokedAPI), codes.Unauthenticated, ""},
+		{"logged out session", incoming(gatewayCookieKey, "tempus_session="+loggedOut), codes.Unauthenticated, ""},
+		// Only the gateway forwards the Cookie header, under its own key
+		{"plain cookie", incoming("cookie", "tempus_session="+aliceSession), codes.Unauthenticated, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := a.authorize(tt.ctx, method)
+			wantCode(t, err, tt.code)
+			if err != nil {
+				return
+			}
+
+			user := UserFromContext(ctx)
+			if user == nil || user.Username != tt.user {
+				t.Fatalf("authenticated as %v, want %s", user, tt.user)
+			}
+			if TokenHashFromContext(ctx) == "" {
+				t.Error("context has no token hash")
+			}
+		})
+	}
+}
+
+func TestPublicMethods(t *testing.T) {
+	a := testAuthenticator(t)
+
+	tests := []struct {
+		method string
+		code   codes.Code
+	}{
+		{pb.AuthService_Login_FullMethodName, codes.OK},
+		{pb.AuthService_Logout_FullMethodName, codes.Unauthenticated},
+		{pb.AuthService_GetCurrentUser_FullMethodName, codes.Unauthenticated},
+		{pb.AuthService_ChangePassword_FullMethodName, codes.Unauthenticated},
+		{pb.ExerciseService_ListExercises_FullMethodName, codes.Unauthenticated},
+		{pb.UserService_ListUsers_FullMethodName, codes.Unauthenticated},
+		{"/tempus.v1.AuthService/Login/extra", codes.Unauthenticated},
+		{"", codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			ctx, err := a.authorize(context.Background(), tt.method)
+			wantCode(t, err, tt.code)
+			if err == nil && UserFromContext(ctx) != nil {
+				t.Error("public method has a user")
+			}
+		})
+	}
+}
+
+// fakeServerStream is a server stream of a context
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAdminServices(t *testing.T) {
+	a := testAuthenticator(t)
+	user := incoming("authorization", "Bearer "+aliceSession)
+	admin := incoming("authorization", "Bearer "+rootSession)
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		code   codes.Code
+	}{
+		{"user calls user service", user, pb.UserService_ListUsers_FullMethodName, codes.PermissionDenied},
+		{"user calls admin service", user, pb.AdminService_CreateBackup_FullMethodName, codes.PermissionDenied},
+		{"user calls exercise service", user, pb.ExerciseService_ListExercises_FullMethodName, codes.OK},
+		{"user calls auth service", user, pb.AuthService_GetCurrentUser_FullMethodName, codes.OK},
+		{"admin calls user service", admin, pb.UserService_ListUsers_FullMethodName, codes.OK},
+		{"admin calls admin service", admin, pb.AdminService_CreateBackup_FullMethodName, codes.OK},
+		{"admin calls exercise service", admin, pb.ExerciseService_ListExercises_FullMethodName, codes.OK},
+		{"anonymous calls admin service", context.Background(), pb.AdminService_ListBackups_FullMethodName, codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Run("unary", func(t *testing.T) {
+				var called *pb.User
+				handler := func(ctx context.Context, req any) (any, error) {
+					called = UserFromContext(ctx)
+					return req, nil
+				}
+				info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+				_, err := a.UnaryInterceptor()(tt.ctx, "request", info, handler)
+				wantCode(t, err, tt.code)
+				if (called != nil) != (tt.code == codes.OK) {
+					t.Errorf("handler called with %v, want it called %v", called, tt.code == codes.OK)
+				}
+			})
+
+			t.Run("stream", func(t *testing.T) {
+				var called *pb.User
+				handler := func(srv any, ss grpc.ServerStream) error {
+					called = UserFromContext(ss.Context())
+					return nil
+				}
+				info := &grpc.StreamServerInfo{FullMethod: tt.method, IsClientStream: true}
+				err := a.StreamInterceptor()(nil, &fakeServerStream{ctx: tt.ctx}, info, handler)
+				wantCode(t, err, tt.code)
+				if (called != nil) != (tt.code == codes.OK) {
+					t.Errorf("handler called with %v, want it called %v", called, tt.code == codes.OK)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,302 @@
+// Package backup takes point-in-time snapshots of the SQLite database and
+// restores them.
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	storage "github.com/Zach-Johnson/tempus/server/db"
+)
+
+const (
+	snapshotPrefix = "tempus-"
+	snapshotSuffix = ".db"
+
+	// snapshotTimeFormat is sortable, so lexical order is chronological order
+	snapshotTimeFormat = "20060102T150405.000Z"
+)
+
+// ErrNoSnapshots is returned when restoring the latest snapshot of an empty directory
+var ErrNoSnapshots = errors.New("no snapshots found")
+
+// Snapshot describes a database snapshot on disk
+type Snapshot struct {
+	Name      string
+	Path      string
+	Size      int64
+	CreatedAt time.Time
+}
+
+// Retention controls which snapshots are pruned after a new one is taken
+type Retention struct {
+	// KeepLast is the number of most recent snapshots that are always kept
+	KeepLast int
+	// MaxAge removes snapshots older than this that are not among the
+	// KeepLast most recent, zero removes every snapshot beyond KeepLast
+	MaxAge time.Duration
+}
+
+// Manager takes snapshots of a database into a directory
+type Manager struct {
+	db        *sql.DB
+	dir       string
+	retention Retention
+
+	// mu serializes snapshots taken on demand and on schedule
+	mu sync.Mutex
+}
+
+// NewManager creates a new Manager writing snapshots of db to dir
+func NewManager(db *sql.DB, dir string, retention Retention) *Manager {
+	return &Manager{db: db, dir: dir, retention: retention}
+}
+
+// Snapshot writes a consistent copy of the database and prunes old snapshots.
+//
+// VACUUM INTO reads the database in a single transaction, so it is safe to run
+// while other connections write; writers wait on the busy timeout at most.
+func (m *Manager) Snapshot(ctx context.Context) (*Snapshot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now().UTC()
+	snapshot, err := writeSnapshot(ctx, m.db, m.dir, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.prune(now); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// List returns the snapshots in the backup directory, most recent first
+func (m *Manager) List() ([]*Snapshot, error) {
+	return list(m.dir)
+}
+
+// Run takes a snapshot every interval until ctx is done
+func (m *Manager) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			snapshot, err := m.Snapshot(ctx)
+			if err != nil {
+				log.Printf("Scheduled backup failed: %v", err)
+				continue
+			}
+			log.Printf("Scheduled backup written to %s", snapshot.Path)
+		}
+	}
+}
+
+// prune removes the snapshots that fall outside the retention rules
+func (m *Manager) prune(now time.Time) error {
+	if m.retention.KeepLast <= 0 && m.retention.MaxAge <= 0 {
+		return nil
+	}
+
+	snapshots, err := m.List()
+	if err != nil {
+		return err
+	}
+
+	for i, snapshot := range snapshots {
+		if i < m.retention.KeepLast {
+			continue
+		}
+		if m.retention.MaxAge > 0 && now.Sub(snapshot.CreatedAt) <= m.retention.MaxAge {
+			continue
+		}
+		if err := os.Remove(snapshot.Path); err != nil {
+			return fmt.Errorf("remove expired snapshot: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Restore replaces the database file at dbPath with a snapshot from dir. The
+// snapshot is given by name, or "latest" for the most recent one. The current
+// database, if any, is first saved as a new snapshot that is returned as
+// previous, so a restore can be undone. It must be called before the server
+// opens the database.
+func Restore(dir, name, dbPath string) (restored, previous *Snapshot, err error) {
+	snapshots, err := list(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var snapshot *Snapshot
+	if name == "latest" {
+		if len(snapshots) == 0 {
+			return nil, nil, ErrNoSnapshots
+		}
+		snapshot = snapshots[0]
+	} else {
+		for _, s := range snapshots {
+			if s.Name == name {
+				snapshot = s
+				break
+			}
+		}
+		if snapshot == nil {
+			return nil, nil, fmt.Errorf("snapshot %q not found in %s", name, dir)
+		}
+	}
+
+	previous, err = snapshotFile(dbPath, dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("save current database: %w", err)
+	}
+
+	if err := copyFile(snapshot.Path, dbPath); err != nil {
+		return nil, nil, fmt.Errorf("restore snapshot: %w", err)
+	}
+
+	// Journal files of the replaced database must not be applied to the snapshot
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		if err := os.Remove(dbPath + suffix); err != nil && !os.IsNotExist(err) {
+			return nil, nil, fmt.Errorf("remove %s file: %w", suffix, err)
+		}
+	}
+
+	return snapshot, previous, nil
+}
+
+// snapshotFile saves the database file at dbPath as a snapshot in dir without
+// pruning, nil if there is no such file. The database is read through SQLite
+// so writes still in its journal files are kept.
+func snapshotFile(dbPath, dir string) (*Snapshot, error) {
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	db, err := storage.Open(storage.DriverSQLite, dbPath)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	return writeSnapshot(context.Background(), db, dir, time.Now().UTC())
+}
+
+// writeSnapshot writes a consistent copy of db to dir, named after its time
+func writeSnapshot(ctx context.Context, db *sql.DB, dir string, now time.Time) (*Snapshot, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create backup directory: %w", err)
+	}
+
+	name := snapshotPrefix + now.Format(snapshotTimeFormat) + snapshotSuffix
+	path := filepath.Join(dir, name)
+
+	// Write to a temporary file first so a partial snapshot never looks complete
+	tmp := path + ".tmp"
+	if _, err := db.ExecContext(ctx, "VACUUM INTO ?", tmp); err != nil {
+		os.Remove(tmp)
+		return nil, fmt.Errorf("vacuum into snapshot: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return nil, fmt.Errorf("finalize snapshot: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("stat snapshot: %w", err)
+	}
+
+	return &Snapshot{Name: name, Path: path, Size: info.Size(), CreatedAt: now}, nil
+}
+
+// list returns the snapshots in dir, most recent first
+func list(dir string) ([]*Snapshot, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read backup directory: %w", err)
+	}
+
+	var snapshots []*Snapshot
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, snapshotPrefix) || !strings.HasSuffix(name, snapshotSuffix) {
+			continue
+		}
+
+		createdAt, err := time.Parse(snapshotTimeFormat, strings.TrimSuffix(strings.TrimPrefix(name, snapshotPrefix), snapshotSuffix))
+		if err != nil {
+			continue // Not a snapshot written by us
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("stat snapshot: %w", err)
+		}
+
+		snapshots = append(snapshots, &Snapshot{
+			Name:      name,
+			Path:      filepath.Join(dir, name),
+			Size:      info.Size(),
+			CreatedAt: createdAt,
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.After(snapshots[j].CreatedAt)
+	})
+
+	return snapshots, nil
+}
+
+// copyFile copies src over dst by way of a temporary file in the same directory
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+
+	tmp := dst + ".restore"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	return os.Rename(tmp, dst)
+}
@@ -0,0 +1,247 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	storage "github.com/Zach-Johnson/tempus/server/db"
+)
+
+var backupNow = time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+// writeSnapshots creates empty snapshot files taken the given ages before
+// backupNow, returning their names
+func writeSnapshots(t *testing.T, dir string, ages ...time.Duration) []string {
+	t.Helper()
+
+	var names []string
+	for _, age := range ages {
+		name := snapshotPrefix + backupNow.Add(-age).Format(snapshotTimeFormat) + snapshotSuffix
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// snapshotNames returns the names of the snapshots in dir, most recent first
+func snapshotNames(t *testing.T, dir string) string {
+	t.Helper()
+
+	snapshots, err := list(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, snapshot := range snapshots {
+		names = append(names, snapshot.Name)
+	}
+	return strings.Join(names, " ")
+}
+
+func TestPrune(t *testing.T) {
+	day := 24 * time.Hour
+	ages := []time.Duration{time.Hour, day + time.Hour, 3 * day, 10 * day}
+
+	tests := []struct {
+		name      string
+		retention Retention
+		keep      []int // Indexes into ages of the snapshots kept
+	}{
+		{"no retention", Retention{}, []int{0, 1, 2, 3}},
+		{"keep last", Retention{KeepLast: 2}, []int{0, 1}},
+		{"keep more than there are", Retention{KeepLast: 10}, []int{0, 1, 2, 3}},
+		{"max age", Retention{MaxAge: 2 * day}, []int{0, 1}},
+		{"max age beyond the kept", Retention{KeepLast: 1, MaxAge: 5 * day}, []int{0, 1, 2}},
+		{"kept beyond the max age", Retention{KeepLast: 3, MaxAge: time.Minute}, []int{0, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			names := writeSnapshots(t, dir, ages...)
+
+			m := NewManager(nil, dir, tt.retention)
+			if err := m.prune(backupNow); err != nil {
+				t.Fatalf("prune: %v", err)
+			}
+
+			var want []string
+			for _, i := range tt.keep {
+				want = append(want, names[i])
+			}
+			if got := snapshotNames(t, dir); got != strings.Join(want, " ") {
+				t.Errorf("kept %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	dir := t.TempDir()
+
+	// Written out of order
+	names := writeSnapshots(t, dir, 2*time.Hour, time.Minute, 30*time.Hour)
+
+	// Files and directories that are not snapshots are skipped
+	for _, name := range []string{
+		names[0] + ".tmp",
+		"tempus-yesterday.db",
+		"notes.txt",
+		"tempus.db",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, names[1]+"-dir"+snapshotSuffix), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	want := strings.Join([]string{names[1], names[0], names[2]}, " ")
+	if got := snapshotNames(t, dir); got != want {
+		t.Errorf("listed %q, want %q", got, want)
+	}
+
+	snapshots, err := list(filepath.Join(dir, "missing"))
+	if err != nil || len(snapshots) != 0 {
+		t.Errorf("list of a missing directory = %v, %v, want none", snapshots, err)
+	}
+}
+
+// openDatabase opens a SQLite database with a notes table
+func openDatabase(t *testing.T, path string) *sql.DB {
+	t.Helper()
+
+	db, err := storage.Open(storage.DriverSQLite, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := db.Exec("CREATE TABLE IF NOT EXISTS notes (text TEXT NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// notes returns the notes stored in the database at path
+func notes(t *testing.T, path string) string {
+	t.Helper()
+
+	db := openDatabase(t, path)
+	rows, err := db.Query("SELECT text FROM notes ORDER BY rowid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var texts []string
+	for rows.Next() {
+		var text string
+		if err := rows.Scan(&text); err != nil {
+			t.Fatal(err)
+		}
+		texts = append(texts, text)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return strings.Join(texts, " ")
+}
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	dbPath := filepath.Join(t.TempDir(), "tempus.db")
+
+	// The database stays open with writes in its WAL during the restore
+	db := openDatabase(t, dbPath)
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec("PRAGMA journal_mode=WAL"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("PRAGMA wal_autocheckpoint=0"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("INSERT INTO notes (text) VALUES ('paradiddle')"); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := NewManager(db, dir, Retention{}).Snapshot(ctx)
+	if err != nil {
+		t.Fatalf("Snapshot: %v", err)
+	}
+	// Name the snapshot as an older one so the one saved on restore is newer
+	older := filepath.Join(dir, writeSnapshots(t, dir, time.Hour)[0])
+	if err := os.Rename(snapshot.Path, older); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec("INSERT INTO notes (text) VALUES ('flam')"); err != nil {
+		t.Fatal(err)
+	}
+
+	restored, previous, err := Restore(dir, "latest", dbPath)
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if restored.Path != older {
+		t.Errorf("restored %s, want %s", restored.Path, older)
+	}
+	if previous == nil {
+		t.Fatal("the replaced database was not saved")
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := notes(t, dbPath); got != "paradiddle" {
+		t.Errorf("restored database notes %q, want %q", got, "paradiddle")
+	}
+	// The saved copy keeps the writes that were only in the WAL
+	if got := notes(t, previous.Path); got != "paradiddle flam" {
+		t.Errorf("saved database notes %q, want %q", got, "paradiddle flam")
+	}
+
+	// Restoring the saved copy undoes the restore
+	if _, _, err := Restore(dir, previous.Name, dbPath); err != nil {
+		t.Fatalf("Restore %s: %v", previous.Name, err)
+	}
+	if got := notes(t, dbPath); got != "paradiddle flam" {
+		t.Errorf("notes after undoing the restore %q, want %q", got, "paradiddle flam")
+	}
+}
+
+func TestRestoreErrors(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "tempus.db")
+	openDatabase(t, dbPath).Close()
+
+	dir := t.TempDir()
+	if _, _, err := Restore(dir, "latest", dbPath); !errors.Is(err, ErrNoSnapshots) {
+		t.Errorf("Restore latest of no snapshots: err = %v, want ErrNoSnapshots", err)
+	}
+
+	writeSnapshots(t, dir, time.Hour)
+	if _, _, err := Restore(dir, "tempus-missing.db", dbPath); err == nil {
+		t.Error("Restore of an unknown snapshot succeeded")
+	}
+
+	// Nothing is saved when there is nothing to restore
+	if got := snapshotNames(t, dir); strings.Count(got, snapshotPrefix) != 1 {
+		t.Errorf("snapshots after failed restores %q, want one", got)
+	}
+
+	// Restoring without a database saves nothing
+	restored, previous, err := Restore(dir, "latest", filepath.Join(t.TempDir(), "new.db"))
+	if err != nil || restored == nil || previous != nil {
+		t.Errorf("Restore to a new path = %v, %v, %v, want no previous database", restored, previous, err)
+	}
+}
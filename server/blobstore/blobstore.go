@@ -0,0 +1,59 @@
+// Package blobstore keeps binary content such as exercise images and
+// recordings outside of the database, addressed by the SHA-256 hash of the
+// content so that identical uploads are stored once.
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrNotFound is returned when a blob does not exist
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore stores blobs by key. Keys are the hex SHA-256 hashes returned by
+// Key, putting a blob that already exists is a no-op. Large blobs are
+// streamed with PutReader and Open instead of being held in memory.
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+
+	// PutReader puts a blob of the given size read from r
+	PutReader(ctx context.Context, key string, r io.Reader, size int64) error
+	// Open returns a reader of a blob, reading from the store as it goes
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+}
+
+// Key returns the key of a blob, the hex SHA-256 hash of its content
+func Key(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// KeyReader returns the key of the content read from r along with its size,
+// for content too large to hold in memory
+func KeyReader(r io.Reader) (string, int64, error) {
+	hash := sha256.New()
+	size, err := io.Copy(hash, r)
+	if err != nil {
+		return "", size, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// checkKey guards the stores against keys that are not hashes, which could
+// otherwise escape their directory or bucket prefix
+func checkKey(key string) error {
+	if len(key) != sha256.Size*2 {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+	if _, err := hex.DecodeString(key); err != nil {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFS(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFS(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	data := []byte("image data")
+	key := Key(data)
+
+	if _, err := store.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get before Put: err = %v, want ErrNotFound", err)
+	}
+
+	if err := store.Put(ctx, key, data); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	path := filepath.Join(dir, key[:2], key)
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("blob not stored in its fan-out directory: %v", err)
+	}
+
+	got, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("Get = %q, want %q", got, data)
+	}
+
+	for range 2 {
+		if err := store.Delete(ctx, key); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+	}
+	if _, err := store.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestFSDedup(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFS(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	data := []byte("image data")
+	key := Key(data)
+
+	if err := store.Put(ctx, key, data); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	// Putting an existing blob leaves it alone, the key stands for its content
+	if err := store.Put(ctx, key, []byte("other data")); err != nil {
+		t.Fatalf("second Put: %v", err)
+	}
+
+	got, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("Get = %q, want the first content %q", got, data)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("stored files = %v, want one", files)
+	}
+}
+
+func TestFSInvalidKey(t *testing.T) {
+	store, err := NewFS(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	for _, key := range []string{"", "abc", "../../etc/passwd", Key(nil)[:62] + "zz"} {
+		if err := store.Put(ctx, key, []byte("x")); err == nil {
+			t.Errorf("Put(%q) succeeded", key)
+		}
+		if _, err := store.Get(ctx, key); err == nil || errors.Is(err, ErrNotFound) {
+			t.Errorf("Get(%q) err = %v, want an invalid key error", key, err)
+		}
+		if err := store.Delete(ctx, key); err == nil {
+			t.Errorf("Delete(%q) succeeded", key)
+		}
+	}
+}
+
+func TestFSStream(t *testing.T) {
+	store, err := NewFS(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("recording data "), 1000)
+	key := Key(data)
+
+	if _, err := store.Open(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Open before Put: err = %v, want ErrNotFound", err)
+	}
+
+	// A short read leaves no blob behind
+	if err := store.PutReader(ctx, key, bytes.NewReader(data[:10]), int64(len(data))); err == nil {
+		t.Error("PutReader of a short reader succeeded")
+	}
+	if _, err := store.Open(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Open after a failed PutReader: err = %v, want ErrNotFound", err)
+	}
+
+	if err := store.PutReader(ctx, key, bytes.NewReader(data), int64(len(data))); err != nil {
+		t.Fatalf("PutReader: %v", err)
+	}
+	testStream(t, store, key, data)
+}
+
+// testStream checks that an opened blob reads and seeks like its content
+func testStream(t *testing.T, store BlobStore, key string, data []byte) {
+	t.Helper()
+
+	r, err := store.Open(context.Background(), key)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer r.Close()
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("read %d bytes, want the %d stored", len(got), len(data))
+	}
+
+	if size, err := r.Seek(0, io.SeekEnd); err != nil || size != int64(len(data)) {
+		t.Errorf("Seek to the end = %d, %v, want %d", size, err, len(data))
+	}
+	if _, err := r.Seek(-20, io.SeekEnd); err != nil {
+		t.Fatalf("Seek: %v", err)
+	}
+	tail := make([]byte, 20)
+	if _, err := io.ReadFull(r, tail); err != nil {
+		t.Fatalf("read after Seek: %v", err)
+	}
+	if !bytes.Equal(tail, data[len(data)-20:]) {
+		t.Errorf("read %q after Seek, want %q", tail, data[len(data)-20:])
+	}
+}
@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExerciseActivity is the recent practice record of an exercise
+type ExerciseActivity struct {
+	// Exercise carries its tag and category IDs and last practice details
+	Exercise *pb.Exercise
+	// Entries are the most recent history entries, newest first
+	Entries []ActivityEntry
+}
+
+// ActivityEntry summarizes an exercise history entry
+type ActivityEntry struct {
+	StartTime       time.Time
+	MaxBPM          int32
+	Rating          int32 // Zero when unrated
+	DurationSeconds int32
+}
+
+// Activity returns the exercises matching the filter, ordered by ID, each
+// with up to recent of their latest history entries
+func (r *exerciseRepo) Activity(ctx context.Context, filter ExerciseFilter, recent int) ([]*ExerciseActivity, error) {
+	d := r.db.dialect
+
+	// Use a transaction to ensure consistency across queries
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	q := filter.query(d, ownerID(ctx))
+	rows, err := tx.QueryContext(ctx, q.sql("e.id"), q.where.params...)
+	if err != nil {
+		return nil, fmt.Errorf("select exercises: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan exercise: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read exercises: %w", err)
+	}
+	rows.Close()
+
+	exercises, err := loadExerciseSummaries(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	activity := make(map[int32]*ExerciseActivity, len(exercises))
+	for id, exercise := range exercises {
+		activity[id] = &ExerciseActivity{Exercise: exercise}
+	}
+
+	if len(ids) > 0 {
+		marks, args := placeholders(ids)
+		entryRows, err := tx.QueryContext(
+			ctx,
+			`SELECT exercise_id, start_time, bpms, notes, rating, duration
+			FROM (
+				SELECT
+					eh.exercise_id,
+					eh.start_time,
+					eh.bpms,
+					eh.notes,
+					COALESCE(eh.rating, 0) AS rating,
+					`+historyDuration(d)+` AS duration,
+					ROW_NUMBER() OVER (PARTITION BY eh.exercise_id ORDER BY eh.start_time DESC, eh.id DESC) AS n
+				FROM exercise_history eh
+				WHERE eh.exercise_id IN (`+marks+`)
+			) recent
+			WHERE n <= ?
+			ORDER BY exercise_id, n`,
+			append(args, recent)...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("select recent history: %w", err)
+		}
+		defer entryRows.Close()
+
+		for entryRows.Next() {
+			var (
+				exerciseID int32
+				entry      ActivityEntry
+				bpmJSON    string
+				notes      string
+			)
+			if err := entryRows.Scan(&exerciseID, &entry.StartTime, &bpmJSON, &notes, &entry.Rating, &entry.DurationSeconds); err != nil {
+				return nil, fmt.Errorf("scan recent history: %w", err)
+			}
+
+			bpms, err := decodeBPMs(bpmJSON)
+			if err != nil {
+				return nil, err
+			}
+			entry.MaxBPM = maxBPM(bpms)
+
+			a := activity[exerciseID]
+			if len(a.Entries) == 0 {
+				a.Exercise.LastPractice = timestamppb.New(entry.StartTime)
+				a.Exercise.LastBpms = bpms
+				a.Exercise.LastNotes = notes
+			}
+			a.Entries = append(a.Entries, entry)
+		}
+		if err := entryRows.Err(); err != nil {
+			return nil, fmt.Errorf("read recent history: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	result := make([]*ExerciseActivity, 0, len(activity))
+	for _, a := range activity {
+		result = append(result, a)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Exercise.Id < result[j].Exercise.Id
+	})
+
+	return result, nil
+}
@@ -0,0 +1,393 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// assignmentJoins returns the joins assignmentColumns read from, on
+// assignments aliased as a
+func assignmentJoins(d dialect) []string {
+	return []string{
+		"JOIN users t ON t.id = a.teacher_id",
+		"JOIN users s ON s.id = a.student_id",
+		"JOIN exercises e ON e.id = a.exercise_id",
+		// The latest practice of the exercise since it was assigned
+		`LEFT JOIN exercise_history latest ON latest.id = (
+			SELECT l.id FROM exercise_history l
+			WHERE l.exercise_id = a.exercise_id AND ` + epochKey(d, "l.start_time") + ` >= ` + epochKey(d, "a.created_at") + `
+			ORDER BY l.start_time DESC, l.id DESC
+			LIMIT 1
+		)`,
+	}
+}
+
+// assignmentBestBPM is the highest BPM the exercise of an assignment was
+// practiced at since it was assigned, 0 when it was not
+func assignmentBestBPM(d dialect) string {
+	return `COALESCE((
+		SELECT MAX(CAST(bpm.value AS INTEGER))
+		FROM exercise_history h, ` + d.bpmValues("h.bpms", "bpm") + `
+		WHERE h.exercise_id = a.exercise_id AND ` + epochKey(d, "h.start_time") + ` >= ` + epochKey(d, "a.created_at") + `
+	), 0)`
+}
+
+// assignmentColumns returns the columns read by scanAssignment, selected with
+// assignmentJoins
+func assignmentColumns(d dialect) string {
+	return `a.id, a.teacher_id, t.username, a.student_id, s.username, a.exercise_id, e.name,
+		a.target_bpm, a.due_date, a.notes, a.created_at, a.updated_at,
+		` + assignmentBestBPM(d) + `, latest.start_time`
+}
+
+// assignmentStatusCondition selects the assignments with a status as of now.
+// It follows assignmentStatus.
+func assignmentStatusCondition(d dialect, st pb.AssignmentStatus, now time.Time) (string, []any) {
+	met := assignmentBestBPM(d) + " >= a.target_bpm"
+	overdue := "a.due_date IS NOT NULL AND " + epochKey(d, "a.due_date") + " < ?"
+
+	switch st {
+	case pb.AssignmentStatus_ASSIGNMENT_STATUS_MET_TARGET:
+		return met, nil
+	case pb.AssignmentStatus_ASSIGNMENT_STATUS_OVERDUE:
+		return "NOT (" + met + ") AND " + overdue, []any{now.Unix()}
+	case pb.AssignmentStatus_ASSIGNMENT_STATUS_IN_PROGRESS:
+		return "NOT (" + met + ") AND NOT (" + overdue + ") AND latest.id IS NOT NULL", []any{now.Unix()}
+	default: // Not started
+		return "NOT (" + met + ") AND NOT (" + overdue + ") AND latest.id IS NULL", []any{now.Unix()}
+	}
+}
+
+// assignmentStatus works out the status of an assignment as of now
+func assignmentStatus(assignment *pb.Assignment, now time.Time) pb.AssignmentStatus {
+	switch {
+	case assignment.BestBpm >= assignment.TargetBpm:
+		return pb.AssignmentStatus_ASSIGNMENT_STATUS_MET_TARGET
+	case assignment.DueDate != nil && assignment.DueDate.AsTime().Unix() < now.Unix():
+		return pb.AssignmentStatus_ASSIGNMENT_STATUS_OVERDUE
+	case assignment.LastPractice != nil:
+		return pb.AssignmentStatus_ASSIGNMENT_STATUS_IN_PROGRESS
+	default:
+		return pb.AssignmentStatus_ASSIGNMENT_STATUS_NOT_STARTED
+	}
+}
+
+// assignmentRepo is the SQL implementation of AssignmentRepo
+type assignmentRepo struct {
+	db *conn
+}
+
+// Create inserts an assignment given by the user, the exercise must be one of
+// the student
+func (r *assignmentRepo) Create(ctx context.Context, assignment *pb.Assignment) (*pb.Assignment, error) {
+	var found bool
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM exercises WHERE id = ? AND user_id = ?)",
+		assignment.ExerciseId, assignment.StudentId,
+	).Scan(&found)
+	if err != nil {
+		return nil, fmt.Errorf("check exercises existence: %w", err)
+	}
+	if !found {
+		return nil, &NotFoundError{Entity: "exercise", ID: assignment.ExerciseId}
+	}
+
+	var id int32
+	err = r.db.QueryRowContext(
+		ctx,
+		`INSERT INTO assignments (teacher_id, student_id, exercise_id, target_bpm, due_date, notes)
+		VALUES (?, ?, ?, ?, ?, ?) RETURNING id`,
+		ownerID(ctx), assignment.StudentId, assignment.ExerciseId, assignment.TargetBpm,
+		nullTime(assignment.DueDate), assignment.Notes,
+	).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("insert assignment: %w", err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Get retrieves an assignment given by or to the user
+func (r *assignmentRepo) Get(ctx context.Context, id int32) (*pb.Assignment, error) {
+	q := &selectQuery{from: "assignments a", joins: assignmentJoins(r.db.dialect)}
+	q.where.add("a.id = ?", id)
+	q.where.add("(a.teacher_id = ? OR a.student_id = ?)", ownerID(ctx), ownerID(ctx))
+
+	assignment, err := scanAssignment(r.db.QueryRowContext(ctx, q.sql(assignmentColumns(r.db.dialect)), q.where.params...), time.Now())
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "assignment", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select assignment: %w", err)
+	}
+
+	return assignment, nil
+}
+
+// List returns a page of assignments, most recent first
+func (r *assignmentRepo) List(ctx context.Context, filter AssignmentFilter, opts ListOptions) (*Page[*pb.Assignment], error) {
+	now := time.Now()
+
+	q := &selectQuery{from: "assignments a", joins: assignmentJoins(r.db.dialect)}
+	if filter.Given {
+		q.where.add("a.teacher_id = ?", ownerID(ctx))
+		if filter.StudentID > 0 {
+			q.where.add("a.student_id = ?", filter.StudentID)
+		}
+	} else {
+		q.where.add("a.student_id = ?", ownerID(ctx))
+	}
+	if filter.Status != pb.AssignmentStatus_ASSIGNMENT_STATUS_UNSPECIFIED {
+		condition, params := assignmentStatusCondition(r.db.dialect, filter.Status, now)
+		q.where.add(condition, params...)
+	}
+
+	k := byTime(r.db.dialect, "a", "created_at", true)
+	return listPage(ctx, r.db, q, k, opts, func(clauses string, params ...any) ([]*pb.Assignment, error) {
+		rows, err := r.db.QueryContext(ctx, "SELECT "+assignmentColumns(r.db.dialect)+" FROM assignments a"+clauses, params...)
+		if err != nil {
+			return nil, fmt.Errorf("select assignments: %w", err)
+		}
+		defer rows.Close()
+
+		assignments := make([]*pb.Assignment, 0, opts.Limit+1)
+		for rows.Next() {
+			assignment, err := scanAssignment(rows, now)
+			if err != nil {
+				return nil, fmt.Errorf("scan assignment: %w", err)
+			}
+			assignments = append(assignments, assignment)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("read assignments: %w", err)
+		}
+
+		return assignments, nil
+	}, (*pb.Assignment).GetId)
+}
+
+// Update applies the non-nil fields of upd to an assignment given by the user
+func (r *assignmentRepo) Update(ctx context.Context, id int32, upd AssignmentUpdate) (*pb.Assignment, error) {
+	if err := mustExist(ctx, r.db, "assignments", "assignment", id); err != nil {
+		return nil, err
+	}
+
+	var set setClause
+	if upd.TargetBpm != nil {
+		set.add("target_bpm", *upd.TargetBpm)
+	}
+	if upd.DueDate != nil {
+		if upd.DueDate.IsZero() {
+			set.add("due_date", nil)
+		} else {
+			set.add("due_date", *upd.DueDate)
+		}
+	}
+	if upd.Notes != nil {
+		set.add("notes", *upd.Notes)
+	}
+
+	if !set.empty() {
+		if err := set.exec(ctx, r.db, "assignments", id); err != nil {
+			return nil, fmt.Errorf("update assignment: %w", err)
+		}
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Delete removes an assignment given by the user
+func (r *assignmentRepo) Delete(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "assignments", "assignment", id); err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM assignments WHERE id = ?", id); err != nil {
+		return fmt.Errorf("delete assignment: %w", err)
+	}
+
+	return nil
+}
+
+// History returns all the history of the student for the exercise of an
+// assignment, most recent first, and the comments on it. The entries are read
+// as data of the student.
+func (r *assignmentRepo) History(ctx context.Context, assignment *pb.Assignment) ([]*pb.ExerciseHistory, []*pb.HistoryComment, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	entries, err := listHistory(
+		WithOwner(ctx, assignment.StudentId), tx,
+		"WHERE exercise_id = ? AND user_id = ? ORDER BY start_time DESC, id DESC",
+		assignment.ExerciseId, assignment.StudentId,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT c.id, c.history_id, c.author_id, u.username, c.text, c.created_at
+		FROM history_comments c
+		JOIN users u ON u.id = c.author_id
+		WHERE c.history_id IN (SELECT id FROM exercise_history WHERE exercise_id = ? AND user_id = ?)
+		ORDER BY c.created_at, c.id`,
+		assignment.ExerciseId, assignment.StudentId,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("select history comments: %w", err)
+	}
+	defer rows.Close()
+
+	comments := []*pb.HistoryComment{}
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("scan history comment: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("read history comments: %w", err)
+	}
+	rows.Close()
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return entries, comments, nil
+}
+
+// StudentExercises lists the exercises of a student by name, read as data of
+// the student
+func (r *assignmentRepo) StudentExercises(ctx context.Context, studentID int32, opts ListOptions) (*Page[*pb.Exercise], error) {
+	exercises := &exerciseRepo{db: r.db}
+	return exercises.List(WithOwner(ctx, studentID), ExerciseFilter{}, ExerciseOrder{}, opts)
+}
+
+// HistoryAccess tells how the user relates to an exercise history entry
+func (r *assignmentRepo) HistoryAccess(ctx context.Context, historyID int32) (HistoryAccess, error) {
+	var access HistoryAccess
+	err := r.db.QueryRowContext(
+		ctx,
+		`SELECT h.user_id = ?, EXISTS(
+			SELECT 1 FROM assignments a
+			JOIN teacher_students ts ON ts.teacher_id = a.teacher_id AND ts.student_id = a.student_id
+			WHERE a.teacher_id = ? AND a.student_id = h.user_id AND a.exercise_id = h.exercise_id
+		)
+		FROM exercise_history h
+		WHERE h.id = ?`,
+		ownerID(ctx), ownerID(ctx), historyID,
+	).Scan(&access.Own, &access.Assigned)
+	if err == sql.ErrNoRows {
+		return HistoryAccess{}, &NotFoundError{Entity: "exercise history entry", ID: historyID}
+	} else if err != nil {
+		return HistoryAccess{}, fmt.Errorf("select exercise history entry: %w", err)
+	}
+
+	return access, nil
+}
+
+// AddComment inserts a comment of the user on an exercise history entry
+func (r *assignmentRepo) AddComment(ctx context.Context, historyID int32, text string) (*pb.HistoryComment, error) {
+	var id int32
+	err := r.db.QueryRowContext(
+		ctx,
+		"INSERT INTO history_comments (history_id, author_id, text) VALUES (?, ?, ?) RETURNING id",
+		historyID, ownerID(ctx), text,
+	).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("insert history comment: %w", err)
+	}
+
+	comment, err := scanComment(r.db.QueryRowContext(
+		ctx,
+		`SELECT c.id, c.history_id, c.author_id, u.username, c.text, c.created_at
+		FROM history_comments c
+		JOIN users u ON u.id = c.author_id
+		WHERE c.id = ?`,
+		id,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("select history comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+// DeleteComment removes a comment of the user
+func (r *assignmentRepo) DeleteComment(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "history_comments", "history comment", id); err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM history_comments WHERE id = ?", id); err != nil {
+		return fmt.Errorf("delete history comment: %w", err)
+	}
+
+	return nil
+}
+
+// scanAssignment reads a row selected with assignmentColumns, working out its
+// status as of now
+func scanAssignment(row rowScanner, now time.Time) (*pb.Assignment, error) {
+	var assignment pb.Assignment
+	var dueDate, lastPractice sql.NullTime
+	var createdAt, updatedAt time.Time
+
+	err := row.Scan(
+		&assignment.Id,
+		&assignment.TeacherId,
+		&assignment.TeacherUsername,
+		&assignment.StudentId,
+		&assignment.StudentUsername,
+		&assignment.ExerciseId,
+		&assignment.ExerciseName,
+		&assignment.TargetBpm,
+		&dueDate,
+		&assignment.Notes,
+		&createdAt,
+		&updatedAt,
+		&assignment.BestBpm,
+		&lastPractice,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if dueDate.Valid {
+		assignment.DueDate = timestamppb.New(dueDate.Time)
+	}
+	if lastPractice.Valid {
+		assignment.LastPractice = timestamppb.New(lastPractice.Time)
+	}
+	assignment.CreatedAt = timestamppb.New(createdAt)
+	assignment.UpdatedAt = timestamppb.New(updatedAt)
+	assignment.Status = assignmentStatus(&assignment, now)
+
+	return &assignment, nil
+}
+
+// scanComment reads a history_comments row joined with the username of its
+// author
+func scanComment(row rowScanner) (*pb.HistoryComment, error) {
+	var comment pb.HistoryComment
+	var createdAt time.Time
+
+	err := row.Scan(&comment.Id, &comment.HistoryId, &comment.AuthorId, &comment.AuthorUsername, &comment.Text, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+	comment.CreatedAt = timestamppb.New(createdAt)
+
+	return &comment, nil
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// categoryRepo is the SQL implementation of CategoryRepo
+type categoryRepo struct {
+	db *conn
+}
+
+// Create inserts a new category
+func (r *categoryRepo) Create(ctx context.Context, category *pb.Category) (*pb.Category, error) {
+	var (
+		id                   int32
+		createdAt, updatedAt time.Time
+	)
+
+	err := r.db.QueryRowContext(
+		ctx,
+		"INSERT INTO categories (user_id, name, description, weekly_target_minutes) VALUES (?, ?, ?, ?) RETURNING id, created_at, updated_at",
+		ownerID(ctx), category.Name, category.Description, category.WeeklyTargetMinutes,
+	).Scan(&id, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert category: %w", err)
+	}
+
+	return &pb.Category{
+		Id:                  id,
+		Name:                category.Name,
+		Description:         category.Description,
+		WeeklyTargetMinutes: category.WeeklyTargetMinutes,
+		CreatedAt:           timestamppb.New(createdAt),
+		UpdatedAt:           timestamppb.New(updatedAt),
+	}, nil
+}
+
+// Get retrieves a category by ID
+func (r *categoryRepo) Get(ctx context.Context, id int32) (*pb.Category, error) {
+	var category pb.Category
+	var createdAt, updatedAt time.Time
+
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT id, name, description, weekly_target_minutes, created_at, updated_at FROM categories WHERE id = ? AND user_id = ?",
+		id, ownerID(ctx),
+	).Scan(&category.Id, &category.Name, &category.Description, &category.WeeklyTargetMinutes, &createdAt, &updatedAt)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "category", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select category: %w", err)
+	}
+
+	category.CreatedAt = timestamppb.New(createdAt)
+	category.UpdatedAt = timestamppb.New(updatedAt)
+
+	return &category, nil
+}
+
+// List returns a page of categories ordered by name
+func (r *categoryRepo) List(ctx context.Context, opts ListOptions) (*Page[*pb.Category], error) {
+	q := &selectQuery{from: "categories c"}
+	q.where.add("c.user_id = ?", ownerID(ctx))
+	return listPage(ctx, r.db, q, byName("c"), opts, func(clauses string, params ...any) ([]*pb.Category, error) {
+		rows, err := r.db.QueryContext(
+			ctx,
+			"SELECT c.id, c.name, c.description, c.weekly_target_minutes, c.created_at, c.updated_at FROM categories c"+clauses,
+			params...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("select categories: %w", err)
+		}
+		defer rows.Close()
+
+		categories := make([]*pb.Category, 0, opts.Limit+1)
+		for rows.Next() {
+			var category pb.Category
+			var createdAt, updatedAt time.Time
+
+			if err := rows.Scan(&category.Id, &category.Name, &category.Description, &category.WeeklyTargetMinutes, &createdAt, &updatedAt); err != nil {
+				return nil, fmt.Errorf("scan category: %w", err)
+			}
+
+			category.CreatedAt = timestamppb.New(createdAt)
+			category.UpdatedAt = timestamppb.New(updatedAt)
+			categories = append(categories, &category)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("read categories: %w", err)
+		}
+
+		return categories, nil
+	}, (*pb.Category).GetId)
+}
+
+// Update applies the non-nil fields of upd to a category
+func (r *categoryRepo) Update(ctx context.Context, id int32, upd CategoryUpdate) (*pb.Category, error) {
+	if err := mustExist(ctx, r.db, "categories", "category", id); err != nil {
+		return nil, err
+	}
+
+	var set setClause
+	if upd.Name != nil {
+		set.add("name", *upd.Name)
+	}
+	if upd.Description != nil {
+		set.add("description", *upd.Description)
+	}
+	if upd.WeeklyTargetMinutes != nil {
+		set.add("weekly_target_minutes", *upd.WeeklyTargetMinutes)
+	}
+
+	if !set.empty() {
+		if err := set.exec(ctx, r.db, "categories", id); err != nil {
+			return nil, fmt.Errorf("update category: %w", err)
+		}
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Delete removes a category
+func (r *categoryRepo) Delete(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "categories", "category", id); err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM categories WHERE id = ? AND user_id = ?", id, ownerID(ctx)); err != nil {
+		return fmt.Errorf("delete category: %w", err)
+	}
+
+	return nil
+}
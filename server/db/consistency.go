@@ -0,0 +1,178 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Consistency computes practice streaks, a year-long heatmap ending on the day
+// of end, and the weekly, monthly and time of day totals within it. Calendar
+// days are those of loc, so practice is split at local midnights and hours.
+func (r *sessionRepo) Consistency(ctx context.Context, loc *time.Location, end time.Time) (*pb.ConsistencyStats, error) {
+	loc, err := location(ctx, r.db, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sessions timed with segments only count the time they were running,
+	// a running segment counts up to end
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT ps.start_time, ps.end_time FROM practice_sessions ps
+		WHERE ps.start_time <= ? AND ps.user_id = ?
+		AND NOT EXISTS (SELECT 1 FROM session_segments ss WHERE ss.session_id = ps.id)
+		UNION ALL
+		SELECT start_time, end_time FROM session_segments
+		WHERE start_time <= ?
+		AND session_id IN (SELECT id FROM practice_sessions WHERE user_id = ?)
+		ORDER BY 1`,
+		end.UTC(), ownerID(ctx), end.UTC(), ownerID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select practice sessions: %w", err)
+	}
+	defer rows.Close()
+
+	lastDay := civilDate(end.In(loc))
+	firstDay := weekStart(lastDay.AddDate(-1, 0, 1))
+
+	// Seconds practiced per calendar day, keyed by civilDate
+	daily := make(map[time.Time]int32)
+	var byWeekday [7]int32
+	var byHour [24]int32
+
+	for rows.Next() {
+		var start time.Time
+		var stop sql.NullTime
+		if err := rows.Scan(&start, &stop); err != nil {
+			return nil, fmt.Errorf("scan practice session: %w", err)
+		}
+		if !stop.Valid || stop.Time.After(end) {
+			stop.Time = end
+		}
+
+		splitByHour(start.In(loc), stop.Time, func(hour time.Time, seconds int32) {
+			date := civilDate(hour)
+			daily[date] += seconds
+			if date.Before(firstDay) {
+				return
+			}
+			byWeekday[hour.Weekday()] += seconds
+			byHour[hour.Hour()] += seconds
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read practice sessions: %w", err)
+	}
+
+	stats := &pb.ConsistencyStats{TimeZone: loc.String()}
+	stats.CurrentStreak, stats.LongestStreak = streaks(daily, lastDay)
+
+	weeks := int(lastDay.Sub(firstDay)/week) + 1
+	stats.Weekly = make([]*pb.PracticePeriod, weeks)
+	for i := range stats.Weekly {
+		stats.Weekly[i] = &pb.PracticePeriod{PeriodStart: localMidnight(firstDay.AddDate(0, 0, 7*i), loc)}
+	}
+
+	var month *pb.PracticePeriod
+	for date := firstDay; !date.After(lastDay); date = date.AddDate(0, 0, 1) {
+		if month == nil || date.Day() == 1 {
+			month = &pb.PracticePeriod{PeriodStart: localMidnight(date, loc)}
+			stats.Monthly = append(stats.Monthly, month)
+		}
+
+		offset := int(date.Sub(firstDay) / (24 * time.Hour))
+		seconds := daily[date]
+		stats.Heatmap = append(stats.Heatmap, &pb.HeatmapDay{
+			Date:    localMidnight(date, loc),
+			Week:    int32(offset / 7),
+			Weekday: int32(offset % 7),
+			Minutes: (seconds + 30) / 60,
+		})
+
+		if seconds > 0 {
+			w := stats.Weekly[offset/7]
+			w.DaysPracticed++
+			w.DurationSeconds += seconds
+			month.DaysPracticed++
+			month.DurationSeconds += seconds
+		}
+	}
+
+	for weekday, seconds := range byWeekday {
+		stats.DayOfWeekDistribution = append(stats.DayOfWeekDistribution, &pb.DayOfWeekTime{
+			DayOfWeek:       int32(weekday),
+			DurationSeconds: seconds,
+		})
+	}
+	for hour, seconds := range byHour {
+		stats.HourOfDayDistribution = append(stats.HourOfDayDistribution, &pb.HourOfDayTime{
+			Hour:            int32(hour),
+			DurationSeconds: seconds,
+		})
+	}
+
+	return stats, nil
+}
+
+// splitByHour calls fn with the start of every local clock hour, or part of
+// one, between start and end and the seconds spent within it. The hour is
+// ended by elapsed time, as the next hour on the clock may be skipped or
+// repeated when daylight saving time changes.
+func splitByHour(start, end time.Time, fn func(hour time.Time, seconds int32)) {
+	for t := start; t.Before(end); {
+		next := t.Add(time.Hour - time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+		if next.After(end) {
+			next = end
+		}
+		fn(t, int32(next.Sub(t)/time.Second))
+		t = next
+	}
+}
+
+// streaks returns the run of consecutive practiced days ending on last, or on
+// the day before while last has no practice yet, and the longest run overall
+func streaks(daily map[time.Time]int32, last time.Time) (current, longest int32) {
+	for date := last; daily[date] > 0 || date.Equal(last); date = date.AddDate(0, 0, -1) {
+		if daily[date] > 0 {
+			current++
+		}
+	}
+
+	for date, seconds := range daily {
+		// Only count runs from their first day
+		if seconds == 0 || daily[date.AddDate(0, 0, -1)] > 0 {
+			continue
+		}
+		var run int32
+		for d := date; daily[d] > 0; d = d.AddDate(0, 0, 1) {
+			run++
+		}
+		longest = max(longest, run)
+	}
+
+	return current, longest
+}
+
+// civilDate returns the calendar day of t as midnight UTC, so days can be used
+// as map keys and stepped through without daylight saving changes
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// inLocation converts a civilDate into the start of that day in loc. The
+// result is in UTC like the stored timestamps, which SQLite compares as text.
+func inLocation(date time.Time, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc).UTC()
+}
+
+// localMidnight converts a civilDate into a timestamp of the start of that day
+// in loc
+func localMidnight(date time.Time, loc *time.Location) *timestamppb.Timestamp {
+	return timestamppb.New(inLocation(date, loc))
+}
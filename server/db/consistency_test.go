@@ -0,0 +1,271 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newYork has daylight saving time, 2026 springs forward on March 8 at 2:00
+// and falls back on November 1 at 2:00
+func newYork(t *testing.T) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestSplitByHour(t *testing.T) {
+	ny := newYork(t)
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       string
+	}{
+		{
+			name:  "within an hour",
+			start: time.Date(2026, 3, 2, 18, 10, 0, 0, ny),
+			end:   time.Date(2026, 3, 2, 18, 40, 0, 0, ny),
+			want:  "03-02 18:1800",
+		},
+		{
+			name:  "across midnight",
+			start: time.Date(2026, 3, 2, 23, 30, 0, 0, ny),
+			end:   time.Date(2026, 3, 3, 0, 15, 0, 0, ny),
+			want:  "03-02 23:1800, 03-03 0:900",
+		},
+		{
+			// 2:00 is skipped, three hours pass
+			name:  "spring forward",
+			start: time.Date(2026, 3, 8, 0, 30, 0, 0, ny),
+			end:   time.Date(2026, 3, 8, 4, 30, 0, 0, ny),
+			want:  "03-08 0:1800, 03-08 1:3600, 03-08 3:3600, 03-08 4:1800",
+		},
+		{
+			// 1:00 is repeated, four hours pass
+			name:  "fall back",
+			start: time.Date(2026, 11, 1, 0, 30, 0, 0, ny),
+			end:   time.Date(2026, 11, 1, 3, 30, 0, 0, ny),
+			want:  "11-01 0:1800, 11-01 1:3600, 11-01 1:3600, 11-01 2:3600, 11-01 3:1800",
+		},
+		{
+			name:  "half hour time zone",
+			start: time.Date(2026, 3, 2, 10, 15, 0, 0, kolkata),
+			end:   time.Date(2026, 3, 2, 11, 45, 0, 0, kolkata),
+			want:  "03-02 10:2700, 03-02 11:2700",
+		},
+		{
+			name:  "empty",
+			start: time.Date(2026, 3, 2, 18, 0, 0, 0, ny),
+			end:   time.Date(2026, 3, 2, 18, 0, 0, 0, ny),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			var total int32
+			splitByHour(tt.start, tt.end, func(hour time.Time, seconds int32) {
+				got = append(got, fmt.Sprintf("%s %d:%d", civilDate(hour).Format("01-02"), hour.Hour(), seconds))
+				total += seconds
+			})
+			if strings.Join(got, ", ") != tt.want {
+				t.Errorf("split into %q, want %q", strings.Join(got, ", "), tt.want)
+			}
+			if want := int32(tt.end.Sub(tt.start) / time.Second); total != want {
+				t.Errorf("split %d seconds, want %d", total, want)
+			}
+		})
+	}
+}
+
+func TestSplitByHourDSTDays(t *testing.T) {
+	ny := newYork(t)
+
+	tests := []struct {
+		name  string
+		day   time.Time
+		hours int
+	}{
+		{"spring forward", time.Date(2026, 3, 8, 0, 0, 0, 0, ny), 23},
+		{"fall back", time.Date(2026, 11, 1, 0, 0, 0, 0, ny), 25},
+		{"standard", time.Date(2026, 11, 2, 0, 0, 0, 0, ny), 24},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hours int
+			daily := make(map[time.Time]int32)
+			splitByHour(tt.day, tt.day.AddDate(0, 0, 1), func(hour time.Time, seconds int32) {
+				hours++
+				daily[civilDate(hour)] += seconds
+			})
+
+			if hours != tt.hours {
+				t.Errorf("split into %d hours, want %d", hours, tt.hours)
+			}
+			if len(daily) != 1 || daily[civilDate(tt.day)] != int32(tt.hours*3600) {
+				t.Errorf("daily seconds %v, want %d on %s", daily, tt.hours*3600, tt.day.Format(time.DateOnly))
+			}
+		})
+	}
+}
+
+func TestCivilDate(t *testing.T) {
+	ny := newYork(t)
+
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{time.Date(2026, 3, 8, 0, 0, 0, 0, ny), "2026-03-08"},
+		{time.Date(2026, 3, 8, 23, 59, 0, 0, ny), "2026-03-08"},
+		{time.Date(2026, 3, 9, 3, 30, 0, 0, time.UTC).In(ny), "2026-03-08"},
+		{time.Date(2026, 11, 1, 1, 30, 0, 0, ny).Add(time.Hour), "2026-11-01"},
+		{time.Date(2026, 11, 2, 4, 59, 0, 0, time.UTC).In(ny), "2026-11-01"},
+		{time.Date(2026, 11, 2, 5, 0, 0, 0, time.UTC).In(ny), "2026-11-02"},
+	}
+
+	for _, tt := range tests {
+		got := civilDate(tt.t)
+		if got.Format(time.DateOnly) != tt.want || got.Location() != time.UTC || got.Hour() != 0 {
+			t.Errorf("civilDate(%v) = %v, want %s at midnight UTC", tt.t, got, tt.want)
+		}
+	}
+
+	// Days step by 24 hours across the changes
+	date := civilDate(time.Date(2026, 3, 7, 12, 0, 0, 0, ny))
+	for _, want := range []string{"2026-03-08", "2026-03-09"} {
+		date = date.AddDate(0, 0, 1)
+		if date.Format(time.DateOnly) != want {
+			t.Errorf("next day %s, want %s", date.Format(time.DateOnly), want)
+		}
+	}
+	if got := inLocation(civilDate(time.Date(2026, 11, 2, 0, 0, 0, 0, ny)), ny).Sub(inLocation(civilDate(time.Date(2026, 11, 1, 0, 0, 0, 0, ny)), ny)); got != 25*time.Hour {
+		t.Errorf("November 1 in New York lasts %v, want 25h", got)
+	}
+}
+
+func TestConsistencyAcrossDST(t *testing.T) {
+	ny := newYork(t)
+
+	tests := []struct {
+		name     string
+		sessions [][2]time.Time
+		end      time.Time
+		minutes  map[string]int32 // Heatmap minutes by date
+		hours    map[int32]int32  // Seconds by hour of day
+		daily    string           // Practice frequency by start date
+	}{
+		{
+			name: "spring forward",
+			sessions: [][2]time.Time{
+				{time.Date(2026, 3, 7, 21, 0, 0, 0, ny), time.Date(2026, 3, 7, 22, 0, 0, 0, ny)},
+				// One hour from 1:30 EST to 3:30 EDT
+				{time.Date(2026, 3, 8, 1, 30, 0, 0, ny), time.Date(2026, 3, 8, 3, 30, 0, 0, ny)},
+				{time.Date(2026, 3, 9, 20, 0, 0, 0, ny), time.Date(2026, 3, 9, 20, 30, 0, 0, ny)},
+			},
+			end:     time.Date(2026, 3, 9, 22, 0, 0, 0, ny),
+			minutes: map[string]int32{"2026-03-06": 0, "2026-03-07": 60, "2026-03-08": 60, "2026-03-09": 30},
+			hours:   map[int32]int32{1: 1800, 2: 0, 3: 1800, 20: 1800, 21: 3600},
+			daily:   "2026-03-07 3600, 2026-03-08 3600, 2026-03-09 1800",
+		},
+		{
+			name: "fall back",
+			sessions: [][2]time.Time{
+				{time.Date(2026, 10, 31, 23, 30, 0, 0, ny), time.Date(2026, 11, 1, 0, 30, 0, 0, ny)},
+				// One hour from 1:30 EDT to 1:30 EST
+				{time.Date(2026, 11, 1, 1, 30, 0, 0, ny), time.Date(2026, 11, 1, 1, 30, 0, 0, ny).Add(time.Hour)},
+				{time.Date(2026, 11, 1, 23, 30, 0, 0, ny), time.Date(2026, 11, 1, 23, 45, 0, 0, ny)},
+				{time.Date(2026, 11, 2, 0, 0, 0, 0, ny), time.Date(2026, 11, 2, 0, 20, 0, 0, ny)},
+			},
+			end:     time.Date(2026, 11, 2, 9, 0, 0, 0, ny),
+			minutes: map[string]int32{"2026-10-30": 0, "2026-10-31": 30, "2026-11-01": 105, "2026-11-02": 20},
+			hours:   map[int32]int32{0: 3000, 1: 3600, 23: 2700},
+			daily:   "2026-10-31 3600, 2026-11-01 4500, 2026-11-02 1200",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forEachDriver(t, func(t *testing.T, s *Store) {
+				ctx := userContext(t, s, "alice")
+				ended := false
+				for _, times := range tt.sessions {
+					session, err := s.Sessions().Create(ctx, &pb.PracticeSession{
+						StartTime: timestamppb.New(times[0]),
+						EndTime:   timestamppb.New(times[1]),
+					})
+					if err != nil {
+						t.Fatalf("create session: %v", err)
+					}
+					if _, err := s.Sessions().Update(ctx, session.Id, SessionUpdate{Active: &ended}); err != nil {
+						t.Fatalf("end session: %v", err)
+					}
+				}
+
+				consistency, err := s.Sessions().Consistency(ctx, ny, tt.end)
+				if err != nil {
+					t.Fatalf("Consistency: %v", err)
+				}
+
+				// The streak spans the change
+				if consistency.CurrentStreak != 3 || consistency.LongestStreak != 3 {
+					t.Errorf("streaks %d and %d, want 3 and 3", consistency.CurrentStreak, consistency.LongestStreak)
+				}
+
+				days := make(map[string]*pb.HeatmapDay)
+				for _, day := range consistency.Heatmap {
+					date := day.Date.AsTime().In(ny)
+					if date.Hour() != 0 || date.Minute() != 0 {
+						t.Errorf("heatmap day starts at %v, want local midnight", date)
+					}
+					days[date.Format(time.DateOnly)] = day
+				}
+				for date, minutes := range tt.minutes {
+					if day := days[date]; day == nil || day.Minutes != minutes {
+						t.Errorf("heatmap %s = %v, want %d minutes", date, day, minutes)
+					}
+				}
+				last := consistency.Heatmap[len(consistency.Heatmap)-1]
+				if got := last.Date.AsTime().In(ny).Format(time.DateOnly); got != tt.end.Format(time.DateOnly) {
+					t.Errorf("heatmap ends on %s, want %s", got, tt.end.Format(time.DateOnly))
+				}
+
+				for _, hour := range consistency.HourOfDayDistribution {
+					if hour.DurationSeconds != tt.hours[hour.Hour] {
+						t.Errorf("hour %d: %d seconds, want %d", hour.Hour, hour.DurationSeconds, tt.hours[hour.Hour])
+					}
+				}
+
+				stats, err := s.Sessions().Stats(ctx, PracticeStatsFilter{Location: ny})
+				if err != nil {
+					t.Fatalf("Stats: %v", err)
+				}
+				var daily []string
+				for _, point := range stats.PracticeFrequency {
+					date := point.Date.AsTime().In(ny)
+					if date.Hour() != 0 {
+						t.Errorf("daily bucket starts at %v, want local midnight", date)
+					}
+					daily = append(daily, fmt.Sprintf("%s %d", date.Format(time.DateOnly), point.DurationSeconds))
+				}
+				if got := strings.Join(daily, ", "); got != tt.daily {
+					t.Errorf("practice frequency %q, want %q", got, tt.daily)
+				}
+			})
+		})
+	}
+}
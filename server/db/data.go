@@ -0,0 +1,902 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/blobstore"
+	"github.com/Zach-Johnson/tempus/server/imaging"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// archiveVersion is the version of the archives written by Export, Import
+// only accepts archives of this version
+const archiveVersion = 1
+
+// archiveTables are the tables with generated IDs that make up an archive
+var archiveTables = []string{
+	"categories",
+	"tags",
+	"exercises",
+	"exercise_images",
+	"exercise_notations",
+	"exercise_links",
+	"practice_sessions",
+	"exercise_history",
+	"exercise_history_recordings",
+	"goals",
+	"routines",
+}
+
+// dataRepo is the SQL implementation of DataRepo
+type dataRepo struct {
+	db    *conn
+	blobs blobstore.BlobStore
+}
+
+// Export reads all data of the user into a single archive
+func (r *dataRepo) Export(ctx context.Context) (*pb.DataArchive, error) {
+	// Use a transaction to get a consistent snapshot across tables
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	archive := &pb.DataArchive{
+		Version:    archiveVersion,
+		ExportedAt: timestamppb.Now(),
+	}
+
+	if archive.Categories, err = exportCategories(ctx, tx); err != nil {
+		return nil, err
+	}
+	if archive.Tags, err = exportTags(ctx, tx); err != nil {
+		return nil, err
+	}
+	if archive.Exercises, err = exportExercises(ctx, tx, r.blobs); err != nil {
+		return nil, err
+	}
+	if archive.Sessions, err = exportSessions(ctx, tx); err != nil {
+		return nil, err
+	}
+	if archive.History, err = exportHistory(ctx, tx, r.blobs); err != nil {
+		return nil, err
+	}
+	if archive.Goals, err = listGoals(ctx, tx, " WHERE user_id = ? ORDER BY id", ownerID(ctx)); err != nil {
+		return nil, err
+	}
+	if archive.Routines, err = listRoutines(ctx, tx, " WHERE user_id = ? ORDER BY id", ownerID(ctx)); err != nil {
+		return nil, err
+	}
+	if archive.Settings, err = getSettings(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return archive, nil
+}
+
+func exportCategories(ctx context.Context, q querier) ([]*pb.Category, error) {
+	rows, err := q.QueryContext(
+		ctx,
+		"SELECT id, name, description, weekly_target_minutes, created_at, updated_at FROM categories WHERE user_id = ? ORDER BY id",
+		ownerID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []*pb.Category
+	for rows.Next() {
+		var category pb.Category
+		var createdAt, updatedAt time.Time
+		if err := rows.Scan(&category.Id, &category.Name, &category.Description, &category.WeeklyTargetMinutes, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("scan category: %w", err)
+		}
+		category.CreatedAt = timestamppb.New(createdAt)
+		category.UpdatedAt = timestamppb.New(updatedAt)
+		categories = append(categories, &category)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+func exportTags(ctx context.Context, q querier) ([]*pb.Tag, error) {
+	rows, err := q.QueryContext(ctx, "SELECT id, name, created_at FROM tags WHERE user_id = ? ORDER BY id", ownerID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("select tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []*pb.Tag
+	tagMap := make(map[int32]*pb.Tag)
+	for rows.Next() {
+		var tag pb.Tag
+		var createdAt time.Time
+		if err := rows.Scan(&tag.Id, &tag.Name, &createdAt); err != nil {
+			return nil, fmt.Errorf("scan tag: %w", err)
+		}
+		tag.CreatedAt = timestamppb.New(createdAt)
+		tags = append(tags, &tag)
+		tagMap[tag.Id] = &tag
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read tags: %w", err)
+	}
+	rows.Close()
+
+	err = exportPairs(ctx, q, "SELECT tag_id, category_id FROM tag_categories ORDER BY tag_id, category_id", func(tagID, categoryID int32) {
+		if tag, ok := tagMap[tagID]; ok {
+			tag.CategoryIds = append(tag.CategoryIds, categoryID)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tag categories: %w", err)
+	}
+
+	return tags, nil
+}
+
+func exportExercises(ctx context.Context, q querier, blobs blobstore.BlobStore) ([]*pb.Exercise, error) {
+	rows, err := q.QueryContext(ctx, "SELECT "+exerciseColumns+" FROM exercises e WHERE e.user_id = ? ORDER BY e.id", ownerID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("select exercises: %w", err)
+	}
+	defer rows.Close()
+
+	var exercises []*pb.Exercise
+	exerciseMap := make(map[int32]*pb.Exercise)
+	for rows.Next() {
+		exercise, err := scanExercise(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan exercise: %w", err)
+		}
+		exercises = append(exercises, exercise)
+		exerciseMap[exercise.Id] = exercise
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read exercises: %w", err)
+	}
+	rows.Close()
+
+	err = exportPairs(ctx, q, "SELECT exercise_id, tag_id FROM exercise_tags ORDER BY exercise_id, tag_id", func(exerciseID, tagID int32) {
+		if exercise, ok := exerciseMap[exerciseID]; ok {
+			exercise.TagIds = append(exercise.TagIds, tagID)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("exercise tags: %w", err)
+	}
+
+	imageRows, err := q.QueryContext(
+		ctx,
+		"SELECT "+imageColumns+" FROM exercise_images WHERE "+ownedBy("exercise_images")+" ORDER BY id",
+		ownerID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select exercise images: %w", err)
+	}
+	defer imageRows.Close()
+
+	var images []*pb.ExerciseImage
+	for imageRows.Next() {
+		image, err := scanImage(imageRows)
+		if err != nil {
+			return nil, fmt.Errorf("scan exercise image: %w", err)
+		}
+		images = append(images, image)
+		if exercise, ok := exerciseMap[image.ExerciseId]; ok {
+			exercise.Images = append(exercise.Images, image)
+		}
+	}
+	if err := imageRows.Err(); err != nil {
+		return nil, fmt.Errorf("read exercise images: %w", err)
+	}
+	imageRows.Close()
+
+	// Archives carry the image data, read once the rows are closed
+	for _, image := range images {
+		if image.ImageData, err = imageData(ctx, q, blobs, image.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	linkRows, err := q.QueryContext(
+		ctx,
+		"SELECT id, exercise_id, url, description, created_at FROM exercise_links WHERE "+ownedBy("exercise_links")+" ORDER BY id",
+		ownerID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select exercise links: %w", err)
+	}
+	defer linkRows.Close()
+
+	for linkRows.Next() {
+		var link pb.ExerciseLink
+		var createdAt time.Time
+		if err := linkRows.Scan(&link.Id, &link.ExerciseId, &link.Url, &link.Description, &createdAt); err != nil {
+			return nil, fmt.Errorf("scan exercise link: %w", err)
+		}
+		link.CreatedAt = timestamppb.New(createdAt)
+		if exercise, ok := exerciseMap[link.ExerciseId]; ok {
+			exercise.Links = append(exercise.Links, &link)
+		}
+	}
+	if err := linkRows.Err(); err != nil {
+		return nil, fmt.Errorf("read exercise links: %w", err)
+	}
+
+	var data []byte
+	notationRows, err := q.QueryContext(
+		ctx,
+		"SELECT "+notationColumns+", data FROM exercise_notations WHERE "+ownedBy("exercise_notations")+" ORDER BY id",
+		ownerID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select exercise notations: %w", err)
+	}
+	defer notationRows.Close()
+
+	for notationRows.Next() {
+		n, err := scanNotation(notationRows, &data)
+		if err != nil {
+			return nil, fmt.Errorf("scan exercise notation: %w", err)
+		}
+		n.Data = data
+		if exercise, ok := exerciseMap[n.ExerciseId]; ok {
+			exercise.Notations = append(exercise.Notations, n)
+		}
+	}
+	if err := notationRows.Err(); err != nil {
+		return nil, fmt.Errorf("read exercise notations: %w", err)
+	}
+
+	return exercises, nil
+}
+
+func exportSessions(ctx context.Context, q querier) ([]*pb.PracticeSession, error) {
+	rows, err := q.QueryContext(ctx, "SELECT "+sessionColumns+" FROM practice_sessions WHERE user_id = ? ORDER BY id", ownerID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("select practice sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*pb.PracticeSession
+	byID := make(map[int32]*pb.PracticeSession)
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan practice session: %w", err)
+		}
+		sessions = append(sessions, session)
+		byID[session.Id] = session
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read practice sessions: %w", err)
+	}
+	rows.Close()
+
+	segmentRows, err := q.QueryContext(
+		ctx,
+		"SELECT "+segmentColumns+" FROM session_segments WHERE "+ownedBy("session_segments")+" ORDER BY session_id, start_time, id",
+		ownerID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select session segments: %w", err)
+	}
+	defer segmentRows.Close()
+
+	for segmentRows.Next() {
+		sessionID, segment, err := scanSegment(segmentRows)
+		if err != nil {
+			return nil, fmt.Errorf("scan session segment: %w", err)
+		}
+		if session, ok := byID[sessionID]; ok {
+			session.Segments = append(session.Segments, segment)
+		}
+	}
+	if err := segmentRows.Err(); err != nil {
+		return nil, fmt.Errorf("read session segments: %w", err)
+	}
+
+	return sessions, nil
+}
+
+func exportHistory(ctx context.Context, q querier, blobs blobstore.BlobStore) ([]*pb.ExerciseHistory, error) {
+	rows, err := q.QueryContext(ctx, "SELECT "+historyColumns+" FROM exercise_history WHERE user_id = ? ORDER BY id", ownerID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("select exercise history: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*pb.ExerciseHistory
+	byID := make(map[int32]*pb.ExerciseHistory)
+	for rows.Next() {
+		entry, err := scanHistory(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+		byID[entry.Id] = entry
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read exercise history: %w", err)
+	}
+	rows.Close()
+
+	recordingRows, err := q.QueryContext(
+		ctx,
+		"SELECT "+recordingColumns+", audio_data, content_hash FROM exercise_history_recordings WHERE "+ownedBy("exercise_history_recordings")+" ORDER BY id",
+		ownerID(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select recordings: %w", err)
+	}
+	defer recordingRows.Close()
+
+	// The audio of recordings moved to the blob store is read after the rows
+	stored := make(map[*pb.ExerciseHistoryRecording]string)
+	for recordingRows.Next() {
+		var recording pb.ExerciseHistoryRecording
+		var createdAt time.Time
+		var hash string
+		err := recordingRows.Scan(
+			&recording.Id, &recording.HistoryId, &recording.Filename, &recording.MimeType,
+			&recording.DurationSeconds, &recording.SizeBytes, &createdAt, &recording.AudioData, &hash,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan recording: %w", err)
+		}
+		recording.CreatedAt = timestamppb.New(createdAt)
+		if recording.AudioData == nil {
+			stored[&recording] = hash
+		}
+		if entry, ok := byID[recording.HistoryId]; ok {
+			entry.Recordings = append(entry.Recordings, &recording)
+		}
+	}
+	if err := recordingRows.Err(); err != nil {
+		return nil, fmt.Errorf("read recordings: %w", err)
+	}
+	recordingRows.Close()
+
+	for recording, hash := range stored {
+		recording.AudioData, err = blobs.Get(ctx, hash)
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return nil, fmt.Errorf("audio of recording %d is missing from the blob store", recording.Id)
+		} else if err != nil {
+			return nil, fmt.Errorf("read recording audio: %w", err)
+		}
+	}
+
+	return entries, nil
+}
+
+// exportPairs calls fn for every row of a query selecting two IDs
+func exportPairs(ctx context.Context, q querier, query string, fn func(a, b int32)) error {
+	rows, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("select: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a, b int32
+		if err := rows.Scan(&a, &b); err != nil {
+			return fmt.Errorf("scan: %w", err)
+		}
+		fn(a, b)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("read: %w", err)
+	}
+
+	return nil
+}
+
+// Import writes an archive in a single transaction as data of the user.
+// Without RemapIDs the archived IDs are preserved, which requires the user to
+// have no data. IDs are remapped anyway when other users have data, whose
+// rows may hold the archived IDs.
+func (r *dataRepo) Import(ctx context.Context, archive *pb.DataArchive, opts ImportOptions) (*pb.ImportAllResponse, error) {
+	if archive.GetVersion() != archiveVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidArchive, archive.GetVersion())
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	remap := opts.RemapIDs
+	if !opts.RemapIDs {
+		for _, table := range archiveTables {
+			var owned, found bool
+			err := tx.QueryRowContext(
+				ctx,
+				"SELECT EXISTS(SELECT 1 FROM "+table+" WHERE "+ownedBy(table)+"), EXISTS(SELECT 1 FROM "+table+")",
+				ownerID(ctx),
+			).Scan(&owned, &found)
+			if err != nil {
+				return nil, fmt.Errorf("check %s contents: %w", table, err)
+			}
+			if owned {
+				return nil, ErrNotEmpty
+			}
+			remap = remap || found
+		}
+	}
+
+	imp := &importer{
+		tx:         tx,
+		blobs:      r.blobs,
+		remap:      remap,
+		owner:      ownerID(ctx),
+		categories: make(idMap),
+		tags:       make(idMap),
+		exercises:  make(idMap),
+		sessions:   make(idMap),
+		history:    make(idMap),
+		summary:    &pb.ImportAllResponse{},
+	}
+
+	steps := []func(context.Context, *pb.DataArchive) error{
+		imp.importCategories,
+		imp.importTags,
+		imp.importExercises,
+		imp.importSessions,
+		imp.importHistory,
+		imp.importSegments,
+		imp.importGoals,
+		imp.importRoutines,
+	}
+	for _, step := range steps {
+		if err := step(ctx, archive); err != nil {
+			return nil, err
+		}
+	}
+
+	// Settings are restored with the rest of the data, merging keeps the
+	// current ones
+	if !opts.RemapIDs && archive.Settings != nil {
+		if err := setTimeZone(ctx, tx, archive.Settings.TimeZone); err != nil {
+			return nil, err
+		}
+	}
+
+	if !remap {
+		for _, table := range archiveTables {
+			query := r.db.dialect.resetSequence(table)
+			if query == "" {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, query); err != nil {
+				return nil, fmt.Errorf("reset %s ID sequence: %w", table, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return imp.summary, nil
+}
+
+// idMap translates archived IDs into the IDs the rows were imported with
+type idMap map[int32]int32
+
+// lookup returns the imported ID of an archived entity
+func (m idMap) lookup(entity string, id int32) (int32, error) {
+	imported, ok := m[id]
+	if !ok {
+		return 0, fmt.Errorf("%w: reference to unknown %s %d", ErrInvalidArchive, entity, id)
+	}
+	return imported, nil
+}
+
+// importer writes the entities of an archive, tracking the IDs they were
+// imported with so that references between them can be translated
+type importer struct {
+	tx    *txConn
+	blobs blobstore.BlobStore
+	remap bool
+	owner int32
+
+	categories idMap
+	tags       idMap
+	exercises  idMap
+	sessions   idMap
+	history    idMap
+
+	summary *pb.ImportAllResponse
+}
+
+// insert adds a row and returns its ID, the archived ID is kept unless IDs
+// are being remapped
+func (imp *importer) insert(ctx context.Context, table string, id int32, columns []string, values ...any) (int32, error) {
+	if !imp.remap {
+		columns = append([]string{"id"}, columns...)
+		values = append([]any{id}, values...)
+	}
+
+	marks := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	query := "INSERT INTO " + table + " (" + strings.Join(columns, ", ") + ") VALUES (" + marks + ") RETURNING id"
+
+	var inserted int32
+	if err := imp.tx.QueryRowContext(ctx, query, values...).Scan(&inserted); err != nil {
+		return 0, fmt.Errorf("insert into %s: %w", table, err)
+	}
+	return inserted, nil
+}
+
+// existingID looks up the ID of a row of the user by its unique name when
+// merging
+func (imp *importer) existingID(ctx context.Context, table, name string) (int32, bool, error) {
+	if !imp.remap {
+		return 0, false, nil
+	}
+
+	return idByName(ctx, imp.tx, table, name)
+}
+
+// idByName looks up the ID of a row of the user by its unique name
+func idByName(ctx context.Context, q querier, table, name string) (int32, bool, error) {
+	var id int32
+	err := q.QueryRowContext(ctx, "SELECT id FROM "+table+" WHERE name = ? AND user_id = ?", name, ownerID(ctx)).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, fmt.Errorf("select %s by name: %w", table, err)
+	}
+	return id, true, nil
+}
+
+func (imp *importer) importCategories(ctx context.Context, archive *pb.DataArchive) error {
+	for _, category := range archive.Categories {
+		id, found, err := imp.existingID(ctx, "categories", category.Name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			id, err = imp.insert(
+				ctx, "categories", category.Id,
+				[]string{"user_id", "name", "description", "weekly_target_minutes", "created_at", "updated_at"},
+				imp.owner, category.Name, category.Description, category.WeeklyTargetMinutes, archivedTime(category.CreatedAt), archivedTime(category.UpdatedAt),
+			)
+			if err != nil {
+				return err
+			}
+			imp.summary.Categories++
+		}
+		imp.categories[category.Id] = id
+	}
+	return nil
+}
+
+func (imp *importer) importTags(ctx context.Context, archive *pb.DataArchive) error {
+	for _, tag := range archive.Tags {
+		id, found, err := imp.existingID(ctx, "tags", tag.Name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			id, err = imp.insert(ctx, "tags", tag.Id, []string{"user_id", "name", "created_at"}, imp.owner, tag.Name, archivedTime(tag.CreatedAt))
+			if err != nil {
+				return err
+			}
+			imp.summary.Tags++
+		}
+		imp.tags[tag.Id] = id
+
+		for _, archivedID := range tag.CategoryIds {
+			categoryID, err := imp.categories.lookup("category", archivedID)
+			if err != nil {
+				return err
+			}
+			_, err = imp.tx.ExecContext(
+				ctx,
+				"INSERT INTO tag_categories (tag_id, category_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+				id, categoryID,
+			)
+			if err != nil {
+				return fmt.Errorf("associate tag with category: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (imp *importer) importExercises(ctx context.Context, archive *pb.DataArchive) error {
+	for _, exercise := range archive.Exercises {
+		id, err := imp.insert(
+			ctx, "exercises", exercise.Id,
+			append([]string{"user_id", "name", "description", "created_at", "updated_at"}, tempoPlanColumns...),
+			append([]any{imp.owner, exercise.Name, exercise.Description, archivedTime(exercise.CreatedAt), archivedTime(exercise.UpdatedAt)},
+				tempoPlanValues(exercise.TempoPlan)...)...,
+		)
+		if err != nil {
+			return err
+		}
+		imp.exercises[exercise.Id] = id
+		imp.summary.Exercises++
+
+		for _, archivedID := range exercise.TagIds {
+			tagID, err := imp.tags.lookup("tag", archivedID)
+			if err != nil {
+				return err
+			}
+			_, err = imp.tx.ExecContext(
+				ctx,
+				"INSERT INTO exercise_tags (exercise_id, tag_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+				id, tagID,
+			)
+			if err != nil {
+				return fmt.Errorf("associate exercise with tag: %w", err)
+			}
+		}
+
+		for _, image := range exercise.Images {
+			// Archived images are processed like uploads, older archives may
+			// hold images that do not decode and are kept without thumbnails
+			processed, err := imaging.Process(image.ImageData)
+			if errors.Is(err, imaging.ErrInvalid) {
+				processed = &imaging.Image{Data: image.ImageData, MimeType: image.MimeType}
+			} else if err != nil {
+				return fmt.Errorf("process image: %w", err)
+			}
+
+			hash := blobstore.Key(processed.Data)
+			imageID, err := imp.insert(
+				ctx, "exercise_images", image.Id,
+				[]string{"exercise_id", "content_hash", "size_bytes", "width", "height", "filename", "mime_type", "description", "created_at"},
+				id, hash, len(processed.Data), processed.Width, processed.Height,
+				image.Filename, processed.MimeType, image.Description, archivedTime(image.CreatedAt),
+			)
+			if err != nil {
+				return err
+			}
+			if _, err := insertThumbnails(ctx, imp.tx, imageID, processed.Thumbnails); err != nil {
+				return err
+			}
+			if err := putImage(ctx, imp.tx, imp.blobs, processed); err != nil {
+				return err
+			}
+		}
+
+		for _, n := range exercise.Notations {
+			// The details are read from the notation again like when it is added
+			if err := readNotation(n); err != nil {
+				return fmt.Errorf("%w: notation %d: %v", ErrInvalidArchive, n.Id, err)
+			}
+
+			_, err := imp.insert(
+				ctx, "exercise_notations", n.Id,
+				[]string{"exercise_id", "format", "data", "filename", "title", "time_signature", "measure_count", "created_at"},
+				id, n.Format, n.Data, n.Filename, n.Title, n.TimeSignature, n.MeasureCount, archivedTime(n.CreatedAt),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, link := range exercise.Links {
+			_, err := imp.insert(
+				ctx, "exercise_links", link.Id,
+				[]string{"exercise_id", "url", "description", "created_at"},
+				id, link.Url, link.Description, archivedTime(link.CreatedAt),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (imp *importer) importSessions(ctx context.Context, archive *pb.DataArchive) error {
+	for _, session := range archive.Sessions {
+		if session.StartTime == nil || session.EndTime == nil {
+			return fmt.Errorf("%w: practice session %d is missing its start or end time", ErrInvalidArchive, session.Id)
+		}
+
+		// Only a single session of the user can be active, later ones are
+		// imported as inactive
+		active := 0
+		if session.Active {
+			err := checkNoActiveSession(ctx, imp.tx)
+			if err == nil {
+				active = 1
+			} else if !errors.Is(err, ErrActiveSession) {
+				return err
+			}
+		}
+
+		id, err := imp.insert(
+			ctx, "practice_sessions", session.Id,
+			[]string{"user_id", "start_time", "end_time", "notes", "created_at", "updated_at", "active", "duration_seconds"},
+			imp.owner, session.StartTime.AsTime(), session.EndTime.AsTime(), session.Notes,
+			archivedTime(session.CreatedAt), archivedTime(session.UpdatedAt), active, session.DurationSeconds,
+		)
+		if err != nil {
+			return err
+		}
+		imp.sessions[session.Id] = id
+		imp.summary.Sessions++
+	}
+	return nil
+}
+
+func (imp *importer) importHistory(ctx context.Context, archive *pb.DataArchive) error {
+	for _, entry := range archive.History {
+		if entry.StartTime == nil || entry.EndTime == nil {
+			return fmt.Errorf("%w: exercise history entry %d is missing its start or end time", ErrInvalidArchive, entry.Id)
+		}
+
+		exerciseID, err := imp.exercises.lookup("exercise", entry.ExerciseId)
+		if err != nil {
+			return err
+		}
+		sessionID, err := imp.sessions.lookup("practice session", entry.SessionId)
+		if err != nil {
+			return err
+		}
+
+		bpmJSON, err := encodeBPMs(entry.Bpms)
+		if err != nil {
+			return err
+		}
+
+		id, err := imp.insert(
+			ctx, "exercise_history", entry.Id,
+			[]string{"user_id", "exercise_id", "session_id", "start_time", "end_time", "bpms", "time_signature", "notes", "rating", "duration_seconds", "suggested_bpm"},
+			imp.owner, exerciseID, sessionID, entry.StartTime.AsTime(), entry.EndTime.AsTime(),
+			bpmJSON, entry.TimeSignature, entry.Notes, entry.Rating, entry.DurationSeconds, entry.SuggestedBpm,
+		)
+		if err != nil {
+			return err
+		}
+		imp.history[entry.Id] = id
+		imp.summary.HistoryEntries++
+
+		for _, recording := range entry.Recordings {
+			if len(recording.AudioData) == 0 {
+				return fmt.Errorf("%w: recording %d has no audio", ErrInvalidArchive, recording.Id)
+			}
+			hash := blobstore.Key(recording.AudioData)
+			_, err := imp.insert(
+				ctx, "exercise_history_recordings", recording.Id,
+				[]string{"history_id", "content_hash", "filename", "mime_type", "duration_seconds", "size_bytes", "created_at"},
+				id, hash, recording.Filename, recording.MimeType,
+				recording.DurationSeconds, len(recording.AudioData), archivedTime(recording.CreatedAt),
+			)
+			if err != nil {
+				return err
+			}
+			if err := putBlob(ctx, imp.tx, imp.blobs, hash, recording.AudioData); err != nil {
+				return fmt.Errorf("store recording audio: %w", err)
+			}
+			imp.summary.Recordings++
+		}
+	}
+	return nil
+}
+
+// importSegments restores the timers of the sessions, which refer to their
+// exercise history
+func (imp *importer) importSegments(ctx context.Context, archive *pb.DataArchive) error {
+	for _, session := range archive.Sessions {
+		sessionID := imp.sessions[session.Id]
+		for _, segment := range session.Segments {
+			if segment.StartTime == nil {
+				return fmt.Errorf("%w: a segment of practice session %d is missing its start time", ErrInvalidArchive, session.Id)
+			}
+
+			var historyID any
+			if segment.HistoryId != 0 {
+				id, err := imp.history.lookup("exercise history entry", segment.HistoryId)
+				if err != nil {
+					return err
+				}
+				historyID = id
+			}
+
+			_, err := imp.tx.ExecContext(
+				ctx,
+				"INSERT INTO session_segments (session_id, history_id, start_time, end_time) VALUES (?, ?, ?, ?)",
+				sessionID, historyID, segment.StartTime.AsTime(), nullTime(segment.EndTime),
+			)
+			if err != nil {
+				return fmt.Errorf("insert session segment: %w", err)
+			}
+		}
+
+		// Only the active session has an exercise in progress
+		if session.CurrentHistoryId != 0 {
+			historyID, err := imp.history.lookup("exercise history entry", session.CurrentHistoryId)
+			if err != nil {
+				return err
+			}
+			_, err = imp.tx.ExecContext(ctx, "UPDATE practice_sessions SET current_history_id = ? WHERE id = ? AND active = 1", historyID, sessionID)
+			if err != nil {
+				return fmt.Errorf("update current exercise: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (imp *importer) importGoals(ctx context.Context, archive *pb.DataArchive) error {
+	for _, goal := range archive.Goals {
+		exerciseID, err := imp.exercises.lookup("exercise", goal.ExerciseId)
+		if err != nil {
+			return err
+		}
+
+		_, err = imp.insert(
+			ctx, "goals", goal.Id,
+			[]string{"user_id", "exercise_id", "target_bpm", "target_date", "time_signature", "achieved_at", "created_at", "updated_at"},
+			imp.owner, exerciseID, goal.TargetBpm, nullTime(goal.TargetDate), goal.TimeSignature,
+			nullTime(goal.AchievedAt), archivedTime(goal.CreatedAt), archivedTime(goal.UpdatedAt),
+		)
+		if err != nil {
+			return err
+		}
+		imp.summary.Goals++
+	}
+	return nil
+}
+
+func (imp *importer) importRoutines(ctx context.Context, archive *pb.DataArchive) error {
+	for _, routine := range archive.Routines {
+		id, err := imp.insert(
+			ctx, "routines", routine.Id,
+			[]string{"user_id", "name", "description", "created_at", "updated_at"},
+			imp.owner, routine.Name, routine.Description, archivedTime(routine.CreatedAt), archivedTime(routine.UpdatedAt),
+		)
+		if err != nil {
+			return err
+		}
+
+		steps := make([]*pb.RoutineStep, 0, len(routine.Steps))
+		for _, step := range routine.Steps {
+			exerciseID, err := imp.exercises.lookup("exercise", step.ExerciseId)
+			if err != nil {
+				return err
+			}
+			steps = append(steps, &pb.RoutineStep{
+				ExerciseId:             exerciseID,
+				PlannedDurationSeconds: step.PlannedDurationSeconds,
+				StartBpm:               step.StartBpm,
+				TimeSignature:          step.TimeSignature,
+			})
+		}
+		if err := setRoutineSteps(ctx, imp.tx, id, steps); err != nil {
+			return err
+		}
+		imp.summary.Routines++
+	}
+	return nil
+}
+
+// archivedTime converts an archived timestamp, defaulting to now when unset
+func archivedTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Now()
+	}
+	return ts.AsTime()
+}
@@ -1,20 +1,156 @@
 package storage
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
+
+	"github.com/Zach-Johnson/tempus/server/blobstore"
 )
 
-// SQLiteStore handles database operations for the app
-type SQLiteStore struct {
-	db *sql.DB
+// Open opens a database with one of the supported drivers. For SQLite the
+// source is the path of the database file, for Postgres a connection URL.
+func Open(driver, source string) (*sql.DB, error) {
+	switch driver {
+	case DriverSQLite:
+		return sql.Open(sqliteDriverName, source+"?_foreign_keys=1")
+	case DriverPostgres:
+		return sql.Open("pgx", source)
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", driver)
+	}
+}
+
+// Store handles database operations for the app
+type Store struct {
+	db    *conn
+	blobs blobstore.BlobStore
 }
 
-// NewSQLiteStore creates a new SQLiteStore instance
-func NewSQLiteStore(db *sql.DB) *SQLiteStore {
-	return &SQLiteStore{db: db}
+// NewStore creates a new Store for a database opened with the given driver,
+// keeping image data in blobs
+func NewStore(db *sql.DB, driver string, blobs blobstore.BlobStore) (*Store, error) {
+	d, err := dialectFor(driver)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{db: &conn{db: db, dialect: d}, blobs: blobs}, nil
 }
 
 // GetDB returns the database connection
-func (s *SQLiteStore) GetDB() *sql.DB {
-	return s.db
+func (s *Store) GetDB() *sql.DB {
+	return s.db.db
+}
+
+// Categories returns the category repository
+func (s *Store) Categories() CategoryRepo {
+	return &categoryRepo{db: s.db}
+}
+
+// Tags returns the tag repository
+func (s *Store) Tags() TagRepo {
+	return &tagRepo{db: s.db}
+}
+
+// Exercises returns the exercise repository
+func (s *Store) Exercises() ExerciseRepo {
+	return &exerciseRepo{db: s.db, blobs: s.blobs}
+}
+
+// Sessions returns the practice session repository
+func (s *Store) Sessions() SessionRepo {
+	return &sessionRepo{db: s.db}
+}
+
+// History returns the exercise history repository
+func (s *Store) History() HistoryRepo {
+	return &historyRepo{db: s.db, blobs: s.blobs}
+}
+
+// Goals returns the goal repository
+func (s *Store) Goals() GoalRepo {
+	return &goalRepo{db: s.db}
+}
+
+// Routines returns the routine repository
+func (s *Store) Routines() RoutineRepo {
+	return &routineRepo{db: s.db}
+}
+
+// Assignments returns the assignment repository
+func (s *Store) Assignments() AssignmentRepo {
+	return &assignmentRepo{db: s.db}
+}
+
+// Settings returns the settings repository
+func (s *Store) Settings() SettingsRepo {
+	return &settingsRepo{db: s.db}
+}
+
+// Users returns the user repository
+func (s *Store) Users() UserRepo {
+	return &userRepo{db: s.db}
+}
+
+// Search returns the full-text search repository
+func (s *Store) Search() SearchRepo {
+	return &searchRepo{db: s.db}
+}
+
+// Data returns the repository for exporting and importing all data
+func (s *Store) Data() DataRepo {
+	return &dataRepo{db: s.db, blobs: s.blobs}
+}
+
+// conn is a database handle that rebinds every query for its dialect
+type conn struct {
+	db      *sql.DB
+	dialect dialect
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return c.db.ExecContext(ctx, c.dialect.rebind(query), args...)
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return c.db.QueryContext(ctx, c.dialect.rebind(query), args...)
+}
+
+func (c *conn) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return c.db.QueryRowContext(ctx, c.dialect.rebind(query), args...)
+}
+
+// BeginTx starts a transaction that rebinds queries like its parent handle
+func (c *conn) BeginTx(ctx context.Context, opts *sql.TxOptions) (*txConn, error) {
+	tx, err := c.db.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &txConn{tx: tx, dialect: c.dialect}, nil
+}
+
+// txConn is a transaction that rebinds every query for its dialect
+type txConn struct {
+	tx      *sql.Tx
+	dialect dialect
+}
+
+func (t *txConn) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return t.tx.ExecContext(ctx, t.dialect.rebind(query), args...)
+}
+
+func (t *txConn) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return t.tx.QueryContext(ctx, t.dialect.rebind(query), args...)
+}
+
+func (t *txConn) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return t.tx.QueryRowContext(ctx, t.dialect.rebind(query), args...)
+}
+
+func (t *txConn) Commit() error {
+	return t.tx.Commit()
+}
+
+func (t *txConn) Rollback() error {
+	return t.tx.Rollback()
 }
@@ -0,0 +1,206 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mattn/go-sqlite3"
+)
+
+// Supported database drivers
+const (
+	DriverSQLite   = "sqlite3"
+	DriverPostgres = "postgres"
+)
+
+// dialect hides the SQL differences between the supported databases. Queries
+// are written with ? placeholders and SQLite syntax everywhere except for the
+// fragments produced here.
+type dialect interface {
+	// name identifies the dialect and its migrations directory
+	name() string
+
+	// rebind rewrites the ? placeholders of a query for the driver
+	rebind(query string) string
+
+	// epochSeconds converts a timestamp expression to whole seconds since the epoch
+	epochSeconds(expr string) string
+
+	// day formats a timestamp expression as the YYYY-MM-DD date it falls on
+	// in loc
+	day(expr string, loc *time.Location) string
+
+	// bpmValues returns a FROM item expanding the JSON BPM array in expr into
+	// rows with a single value column, usable after a comma join
+	bpmValues(expr, alias string) string
+
+	// resetSequence returns a statement moving the ID generator of table past
+	// its highest ID after rows were inserted with explicit IDs, or "" when
+	// the database takes care of that itself
+	resetSequence(table string) string
+
+	// searchMatch returns the full-text query argument matching all terms,
+	// the last one as a prefix
+	searchMatch(terms []string) string
+
+	// searchQuery returns a query for the best matches of a search target,
+	// taking the searchMatch argument, the owning user and a limit
+	searchQuery(t searchTarget) string
+
+	// uniqueViolation reports whether err is a violation of a unique
+	// constraint or index
+	uniqueViolation(err error) bool
+}
+
+// dialectFor returns the dialect of a driver
+func dialectFor(driver string) (dialect, error) {
+	switch driver {
+	case DriverSQLite:
+		return sqliteDialect{}, nil
+	case DriverPostgres:
+		return postgresDialect{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", driver)
+	}
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) name() string { return "sqlite" }
+
+func (sqliteDialect) rebind(query string) string { return query }
+
+func (sqliteDialect) epochSeconds(expr string) string {
+	return "strftime('%s', " + expr + ")"
+}
+
+func (sqliteDialect) day(expr string, loc *time.Location) string {
+	if loc == time.UTC {
+		return "date(" + expr + ")"
+	}
+	// SQLite has no time zone database, see localDate
+	return "local_date(" + expr + ", " + quote(loc.String()) + ")"
+}
+
+func (sqliteDialect) bpmValues(expr, alias string) string {
+	// Rows with NULL or malformed JSON contribute no values
+	return "json_each(CASE WHEN json_valid(" + expr + ") THEN " + expr + " ELSE '[]' END) " + alias
+}
+
+func (sqliteDialect) resetSequence(string) string {
+	// AUTOINCREMENT keeps track of explicitly inserted IDs
+	return ""
+}
+
+func (sqliteDialect) searchMatch(terms []string) string {
+	// Terms are only letters and digits, quoting keeps them from being
+	// read as FTS5 operators
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = `"` + term + `"`
+	}
+	return strings.Join(quoted, " ") + "*"
+}
+
+func (sqliteDialect) searchQuery(t searchTarget) string {
+	// The FTS5 table of a table is named after it, see the search migration.
+	// bm25 ranks better matches lower and weighs the first column most.
+	index := t.table + "_search"
+	weights := "10.0" + strings.Repeat(", 1.0", len(t.columns)-1)
+	return `SELECT t.id, ` + t.title + `, snippet(` + index + `, -1, char(2), char(3), '…', 24),
+			-bm25(` + index + `, ` + weights + `) AS score, ` + t.time + `, ` + t.exerciseID + `, ` + t.sessionID + `
+		FROM ` + index + `
+		JOIN ` + t.table + ` t ON t.id = ` + index + `.rowid ` + t.join + `
+		WHERE ` + index + ` MATCH ? AND ` + t.owner + ` = ?
+		ORDER BY score DESC, t.id DESC
+		LIMIT ?`
+}
+
+func (sqliteDialect) uniqueViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) name() string { return "postgres" }
+
+// rebind numbers the placeholders as $1, $2, ... skipping quoted text
+func (postgresDialect) rebind(query string) string {
+	var b strings.Builder
+	b.Grow(len(query) + 8)
+
+	n := 0
+	var quote byte
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '?':
+			n++
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+			continue
+		}
+		b.WriteByte(c)
+	}
+
+	return b.String()
+}
+
+func (postgresDialect) epochSeconds(expr string) string {
+	return "CAST(EXTRACT(EPOCH FROM " + expr + ") AS BIGINT)"
+}
+
+func (postgresDialect) day(expr string, loc *time.Location) string {
+	return "to_char(" + expr + " AT TIME ZONE " + quote(loc.String()) + ", 'YYYY-MM-DD')"
+}
+
+func (postgresDialect) bpmValues(expr, alias string) string {
+	// Anything that is not a JSON array, including NULL, contributes no values
+	return `jsonb_array_elements_text(CASE
+			WHEN jsonb_typeof(` + expr + `::jsonb) = 'array' THEN ` + expr + `::jsonb
+			ELSE '[]'::jsonb
+		END) ` + alias
+}
+
+func (postgresDialect) resetSequence(table string) string {
+	return "SELECT setval(pg_get_serial_sequence('" + table + "', 'id'), COALESCE(MAX(id), 0) + 1, false) FROM " + table
+}
+
+func (postgresDialect) searchMatch(terms []string) string {
+	return strings.Join(terms, " & ") + ":*"
+}
+
+func (postgresDialect) searchQuery(t searchTarget) string {
+	// search_vector is generated from the columns, see the search migration.
+	// Headlines mark matches like SQLite snippets do.
+	return `SELECT t.id, ` + t.title + `,
+			ts_headline('english', concat_ws(' ', t.` + strings.Join(t.columns, ", t.") + `), q,
+				'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxWords=24, MinWords=12'),
+			ts_rank(t.search_vector, q) AS score, ` + t.time + `, ` + t.exerciseID + `, ` + t.sessionID + `
+		FROM ` + t.table + ` t
+		CROSS JOIN to_tsquery('english', ?) q ` + t.join + `
+		WHERE t.search_vector @@ q AND ` + t.owner + ` = ?
+		ORDER BY score DESC, t.id DESC
+		LIMIT ?`
+}
+
+// quote returns s as an SQL string literal
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func (postgresDialect) uniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" // unique_violation
+}
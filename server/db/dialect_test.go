@@ -0,0 +1,47 @@
+package storage
+
+import "testing"
+
+func TestPostgresRebind(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"no placeholders", "SELECT 1", "SELECT 1"},
+		{"placeholders", "SELECT id FROM t WHERE a = ? AND b IN (?, ?)", "SELECT id FROM t WHERE a = $1 AND b IN ($2, $3)"},
+		{"string literal", "SELECT '?' FROM t WHERE a = ?", "SELECT '?' FROM t WHERE a = $1"},
+		{"escaped quote", "SELECT 'it''s ?' WHERE a = ? AND b = 'x?'", "SELECT 'it''s ?' WHERE a = $1 AND b = 'x?'"},
+		{"quoted identifier", `SELECT "odd?name" FROM t WHERE a = ?`, `SELECT "odd?name" FROM t WHERE a = $1`},
+		{"double quote in string", `SELECT '"?' WHERE a = ?`, `SELECT '"?' WHERE a = $1`},
+		{"many placeholders", "VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", "VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (postgresDialect{}).rebind(tt.query); got != tt.want {
+				t.Errorf("rebind(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLiteRebind(t *testing.T) {
+	query := "SELECT '?' FROM t WHERE a = ?"
+	if got := (sqliteDialect{}).rebind(query); got != query {
+		t.Errorf("rebind(%q) = %q, want the query unchanged", query, got)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	tests := map[string]string{
+		"UTC":              "'UTC'",
+		"America/New_York": "'America/New_York'",
+		"it's":             "'it''s'",
+	}
+	for s, want := range tests {
+		if got := quote(s); got != want {
+			t.Errorf("quote(%q) = %s, want %s", s, got, want)
+		}
+	}
+}
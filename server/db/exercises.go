@@ -0,0 +1,732 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/blobstore"
+	"github.com/Zach-Johnson/tempus/server/tempo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// exerciseColumns are the exercises columns read by scanExercise, selected
+// from exercises aliased as e
+const exerciseColumns = `e.id, e.name, e.description, e.created_at, e.updated_at,
+	e.tempo_start_bpm, e.tempo_end_bpm, e.tempo_increment, e.tempo_bars_per_step,
+	e.tempo_time_signature, e.tempo_subdivision, e.tempo_accent_pattern,
+	COALESCE((SELECT s.pack_name FROM exercise_sources s WHERE s.exercise_id = e.id), '')`
+
+// tempoPlanColumns are the exercises columns holding the tempo plan, in the
+// order of tempoPlanValues
+var tempoPlanColumns = []string{
+	"tempo_start_bpm",
+	"tempo_end_bpm",
+	"tempo_increment",
+	"tempo_bars_per_step",
+	"tempo_time_signature",
+	"tempo_subdivision",
+	"tempo_accent_pattern",
+}
+
+// exerciseRepo is the SQL implementation of ExerciseRepo
+type exerciseRepo struct {
+	db    *conn
+	blobs blobstore.BlobStore
+}
+
+// Create inserts a new exercise with its tags, images and links
+func (r *exerciseRepo) Create(ctx context.Context, exercise *pb.Exercise) (*pb.Exercise, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	var (
+		id                   int32
+		createdAt, updatedAt time.Time
+	)
+	err = tx.QueryRowContext(
+		ctx,
+		`INSERT INTO exercises (user_id, name, description, `+strings.Join(tempoPlanColumns, ", ")+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING id, created_at, updated_at`,
+		append([]any{ownerID(ctx), exercise.Name, exercise.Description}, tempoPlanValues(exercise.TempoPlan)...)...,
+	).Scan(&id, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert exercise: %w", err)
+	}
+
+	if err := setExerciseTags(ctx, tx, id, exercise.TagIds); err != nil {
+		return nil, err
+	}
+
+	// The image data is not echoed back to avoid giant responses.
+	images := make([]*pb.ExerciseImage, 0, len(exercise.Images))
+	for _, image := range exercise.Images {
+		image.ExerciseId = id
+		created, err := storeImage(ctx, tx, r.blobs, image)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, created)
+	}
+
+	links := make([]*pb.ExerciseLink, 0, len(exercise.Links))
+	for _, link := range exercise.Links {
+		link.ExerciseId = id
+		created, err := insertLink(ctx, tx, link)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, created)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return &pb.Exercise{
+		Id:           id,
+		Name:         exercise.Name,
+		Description:  exercise.Description,
+		CreatedAt:    timestamppb.New(createdAt),
+		UpdatedAt:    timestamppb.New(updatedAt),
+		TagIds:       exercise.TagIds,
+		Images:       images,
+		Links:        links,
+		TempoPlan:    tempoPlan(exercise.TempoPlan),
+		SuggestedBpm: tempo.Next(exercise.TempoPlan, nil, 0),
+	}, nil
+}
+
+// Get retrieves an exercise by ID with its tags, images, links and last practice
+func (r *exerciseRepo) Get(ctx context.Context, id int32) (*pb.Exercise, error) {
+	exercise, err := scanExercise(r.db.QueryRowContext(
+		ctx,
+		"SELECT "+exerciseColumns+" FROM exercises e WHERE e.id = ? AND e.user_id = ?",
+		id, ownerID(ctx),
+	))
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "exercise", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select exercise: %w", err)
+	}
+
+	if err := r.addRelatedData(ctx, []*pb.Exercise{exercise}); err != nil {
+		return nil, err
+	}
+
+	return exercise, nil
+}
+
+// List returns a page of exercises in the given order
+func (r *exerciseRepo) List(ctx context.Context, filter ExerciseFilter, order ExerciseOrder, opts ListOptions) (*Page[*pb.Exercise], error) {
+	q := filter.query(r.db.dialect, ownerID(ctx))
+	k := order.keyset(q, r.db.dialect)
+
+	page, err := listPage(ctx, r.db, q, k, opts, func(clauses string, params ...any) ([]*pb.Exercise, error) {
+		rows, err := r.db.QueryContext(ctx, "SELECT "+exerciseColumns+" FROM exercises e"+clauses, params...)
+		if err != nil {
+			return nil, fmt.Errorf("select exercises: %w", err)
+		}
+		defer rows.Close()
+
+		exercises := make([]*pb.Exercise, 0, opts.Limit+1)
+		for rows.Next() {
+			exercise, err := scanExercise(rows)
+			if err != nil {
+				return nil, fmt.Errorf("scan exercise: %w", err)
+			}
+			exercises = append(exercises, exercise)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("read exercises: %w", err)
+		}
+
+		return exercises, nil
+	}, (*pb.Exercise).GetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.addRelatedData(ctx, page.Items); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// addRelatedData adds tags, images, notation, links and the last practice to
+// the exercises
+func (r *exerciseRepo) addRelatedData(ctx context.Context, exercises []*pb.Exercise) error {
+	if len(exercises) == 0 {
+		return nil
+	}
+
+	exerciseMap := make(map[int32]*pb.Exercise, len(exercises))
+	ids := make([]int32, 0, len(exercises))
+	for _, ex := range exercises {
+		exerciseMap[ex.Id] = ex
+		ids = append(ids, ex.Id)
+
+		// The first rung until the exercise is practiced
+		ex.SuggestedBpm = tempo.Next(ex.TempoPlan, nil, 0)
+	}
+	marks, args := placeholders(ids)
+
+	// Get tags for all exercises
+	tagRows, err := r.db.QueryContext(ctx, "SELECT exercise_id, tag_id FROM exercise_tags WHERE exercise_id IN ("+marks+")", args...)
+	if err != nil {
+		return fmt.Errorf("select exercise tags: %w", err)
+	}
+	defer tagRows.Close()
+
+	for tagRows.Next() {
+		var exerciseID, tagID int32
+		if err := tagRows.Scan(&exerciseID, &tagID); err != nil {
+			return fmt.Errorf("scan exercise tag: %w", err)
+		}
+		if exercise, ok := exerciseMap[exerciseID]; ok {
+			exercise.TagIds = append(exercise.TagIds, tagID)
+		}
+	}
+	if err := tagRows.Err(); err != nil {
+		return fmt.Errorf("read exercise tags: %w", err)
+	}
+
+	// Get images for all exercises, the actual image data is not included here to avoid giant responses.
+	imageRows, err := r.db.QueryContext(
+		ctx,
+		"SELECT "+imageColumns+" FROM exercise_images WHERE exercise_id IN ("+marks+")",
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("select exercise images: %w", err)
+	}
+	defer imageRows.Close()
+
+	var images []*pb.ExerciseImage
+	for imageRows.Next() {
+		image, err := scanImage(imageRows)
+		if err != nil {
+			return fmt.Errorf("scan exercise image: %w", err)
+		}
+
+		if exercise, ok := exerciseMap[image.ExerciseId]; ok {
+			exercise.Images = append(exercise.Images, image)
+			images = append(images, image)
+		}
+	}
+	if err := imageRows.Err(); err != nil {
+		return fmt.Errorf("read exercise images: %w", err)
+	}
+	imageRows.Close()
+
+	// Thumbnails let lists preview images cheaply
+	if err := loadThumbnails(ctx, r.db, images); err != nil {
+		return err
+	}
+
+	// Get links for all exercises
+	linkRows, err := r.db.QueryContext(
+		ctx,
+		"SELECT id, exercise_id, url, description, created_at FROM exercise_links WHERE exercise_id IN ("+marks+")",
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("select exercise links: %w", err)
+	}
+	defer linkRows.Close()
+
+	for linkRows.Next() {
+		var link pb.ExerciseLink
+		var createdAt time.Time
+		if err := linkRows.Scan(&link.Id, &link.ExerciseId, &link.Url, &link.Description, &createdAt); err != nil {
+			return fmt.Errorf("scan exercise link: %w", err)
+		}
+		link.CreatedAt = timestamppb.New(createdAt)
+
+		if exercise, ok := exerciseMap[link.ExerciseId]; ok {
+			exercise.Links = append(exercise.Links, &link)
+		}
+	}
+	if err := linkRows.Err(); err != nil {
+		return fmt.Errorf("read exercise links: %w", err)
+	}
+
+	// Get notation for all exercises, without its data like images
+	notationRows, err := r.db.QueryContext(
+		ctx,
+		"SELECT "+notationColumns+" FROM exercise_notations WHERE exercise_id IN ("+marks+") ORDER BY id",
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("select exercise notations: %w", err)
+	}
+	defer notationRows.Close()
+
+	for notationRows.Next() {
+		n, err := scanNotation(notationRows)
+		if err != nil {
+			return fmt.Errorf("scan exercise notation: %w", err)
+		}
+
+		if exercise, ok := exerciseMap[n.ExerciseId]; ok {
+			exercise.Notations = append(exercise.Notations, n)
+		}
+	}
+	if err := notationRows.Err(); err != nil {
+		return fmt.Errorf("read exercise notations: %w", err)
+	}
+
+	// Get the most recent history entry of each exercise
+	lastRows, err := r.db.QueryContext(
+		ctx,
+		`SELECT eh.exercise_id, eh.start_time, eh.bpms, eh.notes, COALESCE(eh.rating, 0)
+		FROM exercise_history eh
+		WHERE eh.exercise_id IN (`+marks+`)
+		AND eh.id = (
+			SELECT latest.id FROM exercise_history latest
+			WHERE latest.exercise_id = eh.exercise_id
+			ORDER BY latest.start_time DESC, latest.id DESC
+			LIMIT 1
+		)`,
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("select exercise last practice: %w", err)
+	}
+	defer lastRows.Close()
+
+	for lastRows.Next() {
+		var (
+			exerciseID   int32
+			lastPractice time.Time
+			lastBPMJSON  string
+			lastNotes    string
+			lastRating   int32
+		)
+		if err := lastRows.Scan(&exerciseID, &lastPractice, &lastBPMJSON, &lastNotes, &lastRating); err != nil {
+			return fmt.Errorf("scan exercise last practice: %w", err)
+		}
+
+		exercise, ok := exerciseMap[exerciseID]
+		if !ok {
+			continue
+		}
+
+		bpms, err := decodeBPMs(lastBPMJSON)
+		if err != nil {
+			return err
+		}
+		exercise.LastPractice = timestamppb.New(lastPractice)
+		exercise.LastBpms = bpms
+		exercise.LastNotes = lastNotes
+		exercise.SuggestedBpm = tempo.Next(exercise.TempoPlan, bpms, lastRating)
+	}
+	if err := lastRows.Err(); err != nil {
+		return fmt.Errorf("read exercise last practice: %w", err)
+	}
+
+	return nil
+}
+
+// Update applies the non-nil fields of upd to an exercise
+func (r *exerciseRepo) Update(ctx context.Context, id int32, upd ExerciseUpdate) (*pb.Exercise, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if err := mustExist(ctx, tx, "exercises", "exercise", id); err != nil {
+		return nil, err
+	}
+
+	var set setClause
+	if upd.Name != nil {
+		set.add("name", *upd.Name)
+	}
+	if upd.Description != nil {
+		set.add("description", *upd.Description)
+	}
+	if upd.TempoPlan != nil {
+		for i, value := range tempoPlanValues(upd.TempoPlan) {
+			set.add(tempoPlanColumns[i], value)
+		}
+	}
+	if !set.empty() {
+		if err := set.exec(ctx, tx, "exercises", id); err != nil {
+			return nil, fmt.Errorf("update exercise: %w", err)
+		}
+	}
+
+	if upd.TagIDs != nil {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM exercise_tags WHERE exercise_id = ?", id); err != nil {
+			return nil, fmt.Errorf("remove existing tag associations: %w", err)
+		}
+		if err := setExerciseTags(ctx, tx, id, *upd.TagIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Delete removes an exercise, related rows are removed by ON DELETE CASCADE
+func (r *exerciseRepo) Delete(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "exercises", "exercise", id); err != nil {
+		return err
+	}
+
+	// The images go with the exercise, their blobs once no image uses them
+	hashes, err := imageHashes(ctx, r.db, "WHERE exercise_id = ?", id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM exercises WHERE id = ? AND user_id = ?", id, ownerID(ctx)); err != nil {
+		return fmt.Errorf("delete exercise: %w", err)
+	}
+
+	return releaseBlobs(ctx, r.db, hashes)
+}
+
+// AddImage stores an image for an exercise
+func (r *exerciseRepo) AddImage(ctx context.Context, image *pb.ExerciseImage) (*pb.ExerciseImage, error) {
+	if err := mustExist(ctx, r.db, "exercises", "exercise", image.ExerciseId); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	created, err := storeImage(ctx, tx, r.blobs, image)
+	if err != nil {
+		return nil, err
+	}
+	created.ImageData = image.ImageData
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetImage retrieves an image including its data
+func (r *exerciseRepo) GetImage(ctx context.Context, exerciseID, imageID int32) (*pb.ExerciseImage, error) {
+	image, err := scanImage(r.db.QueryRowContext(
+		ctx,
+		"SELECT "+imageColumns+" FROM exercise_images WHERE id = ? AND exercise_id = ? AND "+ownedBy("exercise_images"),
+		imageID, exerciseID, ownerID(ctx),
+	))
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "image", ID: imageID}
+	} else if err != nil {
+		return nil, fmt.Errorf("select image: %w", err)
+	}
+
+	if image.ImageData, err = imageData(ctx, r.db, r.blobs, imageID); err != nil {
+		return nil, err
+	}
+	if err := loadThumbnails(ctx, r.db, []*pb.ExerciseImage{image}); err != nil {
+		return nil, err
+	}
+
+	return image, nil
+}
+
+// DeleteImage removes an image
+func (r *exerciseRepo) DeleteImage(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "exercise_images", "image", id); err != nil {
+		return err
+	}
+
+	hashes, err := imageHashes(ctx, r.db, "WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM exercise_images WHERE id = ?", id); err != nil {
+		return fmt.Errorf("delete image: %w", err)
+	}
+
+	return releaseBlobs(ctx, r.db, hashes)
+}
+
+// AddLink stores an external link for an exercise
+func (r *exerciseRepo) AddLink(ctx context.Context, link *pb.ExerciseLink) (*pb.ExerciseLink, error) {
+	if err := mustExist(ctx, r.db, "exercises", "exercise", link.ExerciseId); err != nil {
+		return nil, err
+	}
+
+	return insertLink(ctx, r.db, link)
+}
+
+// DeleteLink removes a link
+func (r *exerciseRepo) DeleteLink(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "exercise_links", "link", id); err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM exercise_links WHERE id = ?", id); err != nil {
+		return fmt.Errorf("delete link: %w", err)
+	}
+
+	return nil
+}
+
+// scanExercise reads an exercises row selected with exerciseColumns
+func scanExercise(row rowScanner) (*pb.Exercise, error) {
+	var exercise pb.Exercise
+	var plan pb.TempoPlan
+	var createdAt, updatedAt time.Time
+
+	err := row.Scan(
+		&exercise.Id, &exercise.Name, &exercise.Description, &createdAt, &updatedAt,
+		&plan.StartBpm, &plan.EndBpm, &plan.Increment, &plan.BarsPerStep,
+		&plan.TimeSignature, &plan.Subdivision, &plan.AccentPattern, &exercise.PackName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	exercise.CreatedAt = timestamppb.New(createdAt)
+	exercise.UpdatedAt = timestamppb.New(updatedAt)
+	exercise.TempoPlan = tempoPlan(&plan)
+
+	return &exercise, nil
+}
+
+// insertLink inserts a link row
+func insertLink(ctx context.Context, q querier, link *pb.ExerciseLink) (*pb.ExerciseLink, error) {
+	var id int32
+	var createdAt time.Time
+
+	err := q.QueryRowContext(
+		ctx,
+		"INSERT INTO exercise_links (exercise_id, url, description) VALUES (?, ?, ?) RETURNING id, created_at",
+		link.ExerciseId, link.Url, link.Description,
+	).Scan(&id, &createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert link: %w", err)
+	}
+
+	return &pb.ExerciseLink{
+		Id:          id,
+		ExerciseId:  link.ExerciseId,
+		Url:         link.Url,
+		Description: link.Description,
+		CreatedAt:   timestamppb.New(createdAt),
+	}, nil
+}
+
+// setExerciseTags associates an exercise with each of the given tags
+func setExerciseTags(ctx context.Context, tx *txConn, exerciseID int32, tagIDs []int32) error {
+	for _, tagID := range tagIDs {
+		if err := mustExist(ctx, tx, "tags", "tag", tagID); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO exercise_tags (exercise_id, tag_id) VALUES (?, ?)",
+			exerciseID, tagID,
+		)
+		if err != nil {
+			return fmt.Errorf("associate exercise with tag: %w", err)
+		}
+	}
+	return nil
+}
+
+// query returns the query of the exercises (aliased e) of a user passing the
+// filter
+func (f ExerciseFilter) query(d dialect, owner int32) *selectQuery {
+	q := &selectQuery{from: "exercises e"}
+	q.where.add("e.user_id = ?", owner)
+
+	if f.CategoryID > 0 {
+		// Categories are reached through the tags of an exercise
+		q.where.add(`e.id IN (
+                SELECT et.exercise_id
+                FROM exercise_tags et
+                JOIN tag_categories tc ON et.tag_id = tc.tag_id
+                WHERE tc.category_id = ?
+            )`, f.CategoryID)
+	}
+	if f.AllTags {
+		for _, id := range f.TagIDs {
+			addTagCondition(&q.where, "IN", []int32{id})
+		}
+	} else if len(f.TagIDs) > 0 {
+		addTagCondition(&q.where, "IN", f.TagIDs)
+	}
+	if len(f.ExcludeTagIDs) > 0 {
+		addTagCondition(&q.where, "NOT IN", f.ExcludeTagIDs)
+	}
+
+	if f.NotPracticedSince != nil {
+		q.join(practiceJoin(d))
+		q.where.add("(practice.last_practice IS NULL OR practice.last_practice < ?)", *f.NotPracticedSince)
+	}
+	if f.MinLastBPM > 0 {
+		q.join(latestPracticeJoin)
+		q.where.add(lastBPM(d)+" >= ?", f.MinLastBPM)
+	}
+	if f.MaxLastBPM > 0 {
+		q.join(latestPracticeJoin)
+		q.where.add(lastBPM(d)+" <= ?", f.MaxLastBPM)
+	}
+	if f.MinRating > 0 {
+		q.join(latestPracticeJoin)
+		q.where.add("latest.rating >= ?", f.MinRating)
+	}
+	if f.MaxRating > 0 {
+		q.join(latestPracticeJoin)
+		q.where.add("latest.rating BETWEEN 1 AND ?", f.MaxRating)
+	}
+
+	return q
+}
+
+// keyset returns the order of exercises, adding the joins its sort key needs
+// to the query of an exercise filter
+func (o ExerciseOrder) keyset(q *selectQuery, d dialect) keyset {
+	k := keyset{key: "e.name", id: "e.id", descending: o.Descending}
+	switch o.By {
+	case pb.ExerciseSort_EXERCISE_SORT_CREATED_AT:
+		k.key, k.numeric = epochKey(d, "e.created_at"), true
+	case pb.ExerciseSort_EXERCISE_SORT_LAST_PRACTICE:
+		// Never practiced exercises sort as practiced at the epoch
+		q.join(practiceJoin(d))
+		k.key, k.numeric = "COALESCE("+epochKey(d, "practice.last_practice")+", 0)", true
+	case pb.ExerciseSort_EXERCISE_SORT_TOTAL_PRACTICE_TIME:
+		q.join(practiceJoin(d))
+		k.key, k.numeric = "CAST(COALESCE(practice.total_seconds, 0) AS BIGINT)", true
+	}
+	return k
+}
+
+// addTagCondition adds a condition comparing the exercise ID with the
+// operator, IN or NOT IN, to the exercises with any of the tags
+func addTagCondition(where *whereClause, operator string, tagIDs []int32) {
+	marks, args := placeholders(tagIDs)
+	where.add("e.id "+operator+" (SELECT et.exercise_id FROM exercise_tags et WHERE et.tag_id IN ("+marks+"))", args...)
+}
+
+// practiceJoin joins the time of the last practice and total practice time of
+// exercises as practice, NULL when never practiced
+func practiceJoin(d dialect) string {
+	return `LEFT JOIN (
+		SELECT eh.exercise_id, MAX(eh.start_time) AS last_practice, SUM(` + historyDuration(d) + `) AS total_seconds
+		FROM exercise_history eh
+		GROUP BY eh.exercise_id
+	) practice ON practice.exercise_id = e.id`
+}
+
+// latestPracticeJoin joins the latest history entry of exercises as latest,
+// the same entry addRelatedData reads the last practice from
+const latestPracticeJoin = `LEFT JOIN exercise_history latest ON latest.id = (
+		SELECT l.id FROM exercise_history l
+		WHERE l.exercise_id = e.id
+		ORDER BY l.start_time DESC, l.id DESC
+		LIMIT 1
+	)`
+
+// lastBPM is the highest BPM of the latest history entry, joined by
+// latestPracticeJoin
+func lastBPM(d dialect) string {
+	return "(SELECT MAX(CAST(bpm.value AS INTEGER)) FROM " + d.bpmValues("latest.bpms", "bpm") + ")"
+}
+
+// loadExerciseSummaries fetches the exercises of the owner with the given IDs
+// along with their tag and category IDs, keyed by exercise ID
+func loadExerciseSummaries(ctx context.Context, q querier, ids []int32) (map[int32]*pb.Exercise, error) {
+	result := make(map[int32]*pb.Exercise, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+	marks, args := placeholders(ids)
+
+	rows, err := q.QueryContext(
+		ctx,
+		"SELECT "+exerciseColumns+" FROM exercises e WHERE e.id IN ("+marks+") AND e.user_id = ?",
+		append(args, ownerID(ctx))...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select exercises: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		exercise, err := scanExercise(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan exercise: %w", err)
+		}
+		result[exercise.Id] = exercise
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read exercises: %w", err)
+	}
+
+	tagRows, err := q.QueryContext(ctx, "SELECT exercise_id, tag_id FROM exercise_tags WHERE exercise_id IN ("+marks+")", args...)
+	if err != nil {
+		return nil, fmt.Errorf("select exercise tags: %w", err)
+	}
+	defer tagRows.Close()
+
+	for tagRows.Next() {
+		var exerciseID, tagID int32
+		if err := tagRows.Scan(&exerciseID, &tagID); err != nil {
+			return nil, fmt.Errorf("scan exercise tag: %w", err)
+		}
+		if exercise, ok := result[exerciseID]; ok {
+			exercise.TagIds = append(exercise.TagIds, tagID)
+		}
+	}
+	if err := tagRows.Err(); err != nil {
+		return nil, fmt.Errorf("read exercise tags: %w", err)
+	}
+
+	catRows, err := q.QueryContext(
+		ctx,
+		`SELECT et.exercise_id, tc.category_id
+		FROM exercise_tags et
+		JOIN tag_categories tc ON tc.tag_id = et.tag_id
+		WHERE et.exercise_id IN (`+marks+`)
+		GROUP BY et.exercise_id, tc.category_id`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select exercise categories: %w", err)
+	}
+	defer catRows.Close()
+
+	for catRows.Next() {
+		var exerciseID, categoryID int32
+		if err := catRows.Scan(&exerciseID, &categoryID); err != nil {
+			return nil, fmt.Errorf("scan exercise category: %w", err)
+		}
+		if exercise, ok := result[exerciseID]; ok {
+			exercise.CategoryIds = append(exercise.CategoryIds, categoryID)
+		}
+	}
+	if err := catRows.Err(); err != nil {
+		return nil, fmt.Errorf("read exercise categories: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,11 @@
+//go:build !sqlite_fts5
+
+package storage
+
+import "testing"
+
+// TestFTS5 fails the storage tests of a build without FTS5 rather than
+// letting the tests against SQLite pass without running
+func TestFTS5(t *testing.T) {
+	t.Fatal("SQLite is built without FTS5, run the tests with go test -tags sqlite_fts5 or task test")
+}
@@ -0,0 +1,368 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// goalColumns are the goals columns read by scanGoal
+const goalColumns = "id, exercise_id, target_bpm, target_date, time_signature, achieved_at, created_at, updated_at"
+
+// goalRepo is the SQL implementation of GoalRepo
+type goalRepo struct {
+	db *conn
+}
+
+// Create inserts a new goal, marking it achieved right away when the
+// recorded history already meets the target
+func (r *goalRepo) Create(ctx context.Context, goal *pb.Goal) (*pb.Goal, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if err := mustExist(ctx, tx, "exercises", "exercise", goal.ExerciseId); err != nil {
+		return nil, err
+	}
+
+	var id int32
+	err = tx.QueryRowContext(
+		ctx,
+		"INSERT INTO goals (user_id, exercise_id, target_bpm, target_date, time_signature) VALUES (?, ?, ?, ?, ?) RETURNING id",
+		ownerID(ctx), goal.ExerciseId, goal.TargetBpm, nullTime(goal.TargetDate), goal.TimeSignature,
+	).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("insert goal: %w", err)
+	}
+
+	if err := evaluateGoal(ctx, tx, id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Get retrieves a goal by ID
+func (r *goalRepo) Get(ctx context.Context, id int32) (*pb.Goal, error) {
+	goal, err := scanGoal(r.db.QueryRowContext(ctx, "SELECT "+goalColumns+" FROM goals WHERE id = ? AND user_id = ?", id, ownerID(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "goal", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select goal: %w", err)
+	}
+
+	return goal, nil
+}
+
+// List returns a page of goals, oldest first
+func (r *goalRepo) List(ctx context.Context, filter GoalFilter, opts ListOptions) (*Page[*pb.Goal], error) {
+	q := &selectQuery{from: "goals"}
+	q.where.add("user_id = ?", ownerID(ctx))
+	if filter.ExerciseID > 0 {
+		q.where.add("exercise_id = ?", filter.ExerciseID)
+	}
+
+	k := keyset{key: "goals.id", numeric: true, id: "goals.id"}
+	return listPage(ctx, r.db, q, k, opts, func(clauses string, params ...any) ([]*pb.Goal, error) {
+		return listGoals(ctx, r.db, clauses, params...)
+	}, (*pb.Goal).GetId)
+}
+
+// Update applies the non-nil fields of upd to a goal, re-evaluating whether
+// it is achieved when its target changes
+func (r *goalRepo) Update(ctx context.Context, id int32, upd GoalUpdate) (*pb.Goal, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if err := mustExist(ctx, tx, "goals", "goal", id); err != nil {
+		return nil, err
+	}
+
+	var set setClause
+	if upd.TargetBpm != nil {
+		set.add("target_bpm", *upd.TargetBpm)
+	}
+	if upd.TargetDate != nil {
+		if upd.TargetDate.IsZero() {
+			set.add("target_date", nil)
+		} else {
+			set.add("target_date", *upd.TargetDate)
+		}
+	}
+	if upd.TimeSignature != nil {
+		set.add("time_signature", *upd.TimeSignature)
+	}
+
+	if !set.empty() {
+		if err := set.exec(ctx, tx, "goals", id); err != nil {
+			return nil, fmt.Errorf("update goal: %w", err)
+		}
+	}
+
+	if upd.TargetBpm != nil || upd.TimeSignature != nil {
+		if err := evaluateGoal(ctx, tx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Delete removes a goal
+func (r *goalRepo) Delete(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "goals", "goal", id); err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM goals WHERE id = ?", id); err != nil {
+		return fmt.Errorf("delete goal: %w", err)
+	}
+
+	return nil
+}
+
+// listGoals selects the goals matching the given clause
+func listGoals(ctx context.Context, q querier, clause string, params ...any) ([]*pb.Goal, error) {
+	rows, err := q.QueryContext(ctx, "SELECT "+goalColumns+" FROM goals"+clause, params...)
+	if err != nil {
+		return nil, fmt.Errorf("select goals: %w", err)
+	}
+	defer rows.Close()
+
+	var goals []*pb.Goal
+	for rows.Next() {
+		goal, err := scanGoal(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan goal: %w", err)
+		}
+		goals = append(goals, goal)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read goals: %w", err)
+	}
+
+	return goals, nil
+}
+
+// scanGoal reads a goals row selected with goalColumns
+func scanGoal(row rowScanner) (*pb.Goal, error) {
+	var goal pb.Goal
+	var targetDate, achievedAt sql.NullTime
+	var createdAt, updatedAt time.Time
+
+	err := row.Scan(
+		&goal.Id,
+		&goal.ExerciseId,
+		&goal.TargetBpm,
+		&targetDate,
+		&goal.TimeSignature,
+		&achievedAt,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if targetDate.Valid {
+		goal.TargetDate = timestamppb.New(targetDate.Time)
+	}
+	if achievedAt.Valid {
+		goal.AchievedAt = timestamppb.New(achievedAt.Time)
+	}
+	goal.CreatedAt = timestamppb.New(createdAt)
+	goal.UpdatedAt = timestamppb.New(updatedAt)
+
+	return &goal, nil
+}
+
+// evaluateGoal sets or clears the achieved time of a goal from the recorded
+// history, a goal is achieved at the end of the first entry meeting its target
+func evaluateGoal(ctx context.Context, q querier, id int32) error {
+	var exerciseID, targetBpm int32
+	var timeSignature string
+
+	err := q.QueryRowContext(ctx, "SELECT exercise_id, target_bpm, time_signature FROM goals WHERE id = ?", id).
+		Scan(&exerciseID, &targetBpm, &timeSignature)
+	if err != nil {
+		return fmt.Errorf("select goal: %w", err)
+	}
+
+	var where whereClause
+	where.add("exercise_id = ?", exerciseID)
+	if timeSignature != "" {
+		where.add("time_signature = ?", timeSignature)
+	}
+
+	rows, err := q.QueryContext(ctx, "SELECT bpms, end_time FROM exercise_history"+where.String()+" ORDER BY end_time, id", where.params...)
+	if err != nil {
+		return fmt.Errorf("select goal history: %w", err)
+	}
+	defer rows.Close()
+
+	var achievedAt any
+	for rows.Next() {
+		var bpmJSON string
+		var endTime time.Time
+		if err := rows.Scan(&bpmJSON, &endTime); err != nil {
+			return fmt.Errorf("scan goal history: %w", err)
+		}
+
+		bpms, err := decodeBPMs(bpmJSON)
+		if err != nil {
+			return err
+		}
+		if maxBPM(bpms) >= targetBpm {
+			achievedAt = endTime
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("read goal history: %w", err)
+	}
+	rows.Close()
+
+	if _, err := q.ExecContext(ctx, "UPDATE goals SET achieved_at = ? WHERE id = ?", achievedAt, id); err != nil {
+		return fmt.Errorf("update goal achievement: %w", err)
+	}
+
+	return nil
+}
+
+// markGoalsAchieved marks the open goals met by a history entry as achieved
+func markGoalsAchieved(ctx context.Context, q querier, entry *pb.ExerciseHistory) error {
+	best := maxBPM(entry.Bpms)
+	if best == 0 {
+		return nil
+	}
+
+	_, err := q.ExecContext(
+		ctx,
+		`UPDATE goals SET achieved_at = ?
+		WHERE exercise_id = ?
+		AND achieved_at IS NULL
+		AND target_bpm <= ?
+		AND (time_signature = '' OR time_signature = ?)`,
+		entry.EndTime.AsTime(), entry.ExerciseId, best, entry.TimeSignature,
+	)
+	if err != nil {
+		return fmt.Errorf("mark goals achieved: %w", err)
+	}
+
+	return nil
+}
+
+// goalProgress reports how close an exercise is to each of its goals, trends
+// are measured over days in loc
+func goalProgress(ctx context.Context, q querier, d dialect, exerciseID int32, loc *time.Location, now time.Time) ([]*pb.GoalProgress, error) {
+	goals, err := listGoals(ctx, q, " WHERE exercise_id = ? ORDER BY id", exerciseID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make([]*pb.GoalProgress, 0, len(goals))
+	for _, goal := range goals {
+		var where whereClause
+		where.add("eh.exercise_id = ?", exerciseID)
+		if goal.TimeSignature != "" {
+			where.add("eh.time_signature = ?", goal.TimeSignature)
+		}
+
+		points, err := bpmProgress(ctx, q, d, where, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		p := &pb.GoalProgress{Goal: goal}
+		for _, point := range points {
+			p.CurrentBpm = max(p.CurrentBpm, point.Bpm)
+		}
+		p.ProgressPercentage = math.Round(min(float64(p.CurrentBpm)/float64(goal.TargetBpm), 1)*10000) / 100
+
+		if goal.AchievedAt != nil {
+			p.ProjectedCompletionDate = goal.AchievedAt
+			p.OnTrack = true
+		} else if projected := projectCompletion(points, goal.TargetBpm, now); projected != nil {
+			p.ProjectedCompletionDate = timestamppb.New(*projected)
+			p.OnTrack = goal.TargetDate == nil || !projected.After(goal.TargetDate.AsTime())
+		}
+
+		progress = append(progress, p)
+	}
+
+	return progress, nil
+}
+
+// projectCompletion fits a least squares line through the daily BPM points and
+// returns the day it reaches the target, or nil when the trend is not rising.
+// Projections that fall in the past are moved to now.
+func projectCompletion(points []*pb.BpmProgressPoint, target int32, now time.Time) *time.Time {
+	if len(points) < 2 {
+		return nil
+	}
+
+	first := points[0].Date.AsTime()
+	n := float64(len(points))
+	var sumX, sumY, sumXY, sumXX float64
+	for _, point := range points {
+		x := point.Date.AsTime().Sub(first).Hours() / 24
+		y := float64(point.Bpm)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return nil
+	}
+	slope := (n*sumXY - sumX*sumY) / denominator
+	if slope <= 0 {
+		return nil
+	}
+	intercept := (sumY - slope*sumX) / n
+
+	days := (float64(target) - intercept) / slope
+	projected := first.Add(time.Duration(days * 24 * float64(time.Hour)))
+	if projected.Before(now) {
+		projected = now
+	}
+
+	return &projected
+}
+
+// maxBPM returns the highest of the BPM values, or zero when there are none
+func maxBPM(bpms []int32) int32 {
+	var best int32
+	for _, bpm := range bpms {
+		best = max(best, bpm)
+	}
+	return best
+}
+
+// nullTime converts a timestamp that may be unset into a query argument
+func nullTime(ts *timestamppb.Timestamp) any {
+	if ts == nil {
+		return nil
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,317 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/blobstore"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// historyColumns are the exercise_history columns read by scanHistory
+const historyColumns = "id, exercise_id, session_id, start_time, end_time, bpms, time_signature, notes, rating, COALESCE(duration_seconds, 0), suggested_bpm"
+
+// historyRepo is the SQL implementation of HistoryRepo
+type historyRepo struct {
+	db    *conn
+	blobs blobstore.BlobStore
+}
+
+// Create inserts a new exercise history entry
+func (r *historyRepo) Create(ctx context.Context, entry *pb.ExerciseHistory) (*pb.ExerciseHistory, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if err := mustExist(ctx, tx, "exercises", "exercise", entry.ExerciseId); err != nil {
+		return nil, err
+	}
+	if err := mustExist(ctx, tx, "practice_sessions", "practice session", entry.SessionId); err != nil {
+		return nil, err
+	}
+
+	bpmJSON, err := encodeBPMs(entry.Bpms)
+	if err != nil {
+		return nil, err
+	}
+
+	// Suggest from the practice before this entry
+	suggested, err := suggestBPM(ctx, tx, entry.ExerciseId)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.TimeSignature == "" {
+		if entry.TimeSignature, err = notationTimeSignature(ctx, tx, entry.ExerciseId); err != nil {
+			return nil, err
+		}
+	}
+
+	var id int32
+	err = tx.QueryRowContext(
+		ctx,
+		`INSERT INTO exercise_history (user_id, exercise_id, session_id, start_time, end_time, bpms, time_signature, notes, rating, duration_seconds, suggested_bpm)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING id`,
+		ownerID(ctx), entry.ExerciseId, entry.SessionId, entry.StartTime.AsTime(), entry.EndTime.AsTime(),
+		bpmJSON, entry.TimeSignature, entry.Notes, entry.Rating, entry.DurationSeconds, suggested,
+	).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("insert exercise history entry: %w", err)
+	}
+
+	if err := markGoalsAchieved(ctx, tx, entry); err != nil {
+		return nil, err
+	}
+
+	exercises, err := loadExerciseSummaries(ctx, tx, []int32{entry.ExerciseId})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return &pb.ExerciseHistory{
+		Id:              id,
+		ExerciseId:      entry.ExerciseId,
+		SessionId:       entry.SessionId,
+		StartTime:       entry.StartTime,
+		EndTime:         entry.EndTime,
+		Bpms:            entry.Bpms,
+		TimeSignature:   entry.TimeSignature,
+		Notes:           entry.Notes,
+		Rating:          entry.Rating,
+		Exercise:        exercises[entry.ExerciseId],
+		DurationSeconds: entry.DurationSeconds,
+		SuggestedBpm:    suggested,
+	}, nil
+}
+
+// Get retrieves an exercise history entry by ID
+func (r *historyRepo) Get(ctx context.Context, id int32) (*pb.ExerciseHistory, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	entries, err := listHistory(ctx, tx, "WHERE id = ? AND user_id = ?", id, ownerID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, &NotFoundError{Entity: "exercise history entry", ID: id}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return entries[0], nil
+}
+
+// List returns a page of history entries, most recent first
+func (r *historyRepo) List(ctx context.Context, filter HistoryFilter, opts ListOptions) (*Page[*pb.ExerciseHistory], error) {
+	q := &selectQuery{from: "exercise_history"}
+	q.where.add("user_id = ?", ownerID(ctx))
+	if filter.ExerciseID > 0 {
+		q.where.add("exercise_id = ?", filter.ExerciseID)
+	}
+	if filter.Start != nil {
+		q.where.add("start_time >= ?", *filter.Start)
+	}
+	if filter.End != nil {
+		q.where.add("end_time <= ?", *filter.End)
+	}
+	if filter.SessionID > 0 {
+		q.where.add("session_id = ?", filter.SessionID)
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	k := byTime(r.db.dialect, "exercise_history", "start_time", true)
+	page, err := listPage(ctx, tx, q, k, opts, func(clauses string, params ...any) ([]*pb.ExerciseHistory, error) {
+		return listHistory(ctx, tx, clauses, params...)
+	}, (*pb.ExerciseHistory).GetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return page, nil
+}
+
+// Update applies the non-nil fields of upd to a history entry
+func (r *historyRepo) Update(ctx context.Context, id int32, upd HistoryUpdate) (*pb.ExerciseHistory, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if err := mustExist(ctx, tx, "exercise_history", "exercise history entry", id); err != nil {
+		return nil, err
+	}
+
+	var set setClause
+	if upd.StartTime != nil {
+		set.add("start_time", *upd.StartTime)
+	}
+	if upd.EndTime != nil {
+		set.add("end_time", *upd.EndTime)
+	}
+	if upd.Bpms != nil {
+		bpmJSON, err := encodeBPMs(*upd.Bpms)
+		if err != nil {
+			return nil, err
+		}
+		set.add("bpms", bpmJSON)
+	}
+	if upd.TimeSignature != nil {
+		set.add("time_signature", *upd.TimeSignature)
+	}
+	if upd.Notes != nil {
+		set.add("notes", *upd.Notes)
+	}
+	if upd.Rating != nil {
+		set.add("rating", *upd.Rating)
+	}
+	if upd.DurationSeconds != nil {
+		set.add("duration_seconds", *upd.DurationSeconds)
+	}
+
+	if !set.empty() {
+		if err := set.exec(ctx, tx, "exercise_history", id); err != nil {
+			return nil, fmt.Errorf("update exercise history: %w", err)
+		}
+
+	}
+
+	// Only the BPMs given here were actually played, those of an entry in
+	// progress may still be the suggested tempo
+	if upd.Bpms != nil || upd.TimeSignature != nil {
+		updated, err := scanHistory(tx.QueryRowContext(ctx, "SELECT "+historyColumns+" FROM exercise_history WHERE id = ?", id))
+		if err != nil {
+			return nil, err
+		}
+		if err := markGoalsAchieved(ctx, tx, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Delete removes an exercise history entry
+func (r *historyRepo) Delete(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "exercise_history", "exercise history entry", id); err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM exercise_history WHERE id = ?", id); err != nil {
+		return fmt.Errorf("delete exercise history entry: %w", err)
+	}
+
+	return nil
+}
+
+// listHistory selects the exercise_history rows matching the given clause
+// and attaches the exercise each entry refers to
+func listHistory(ctx context.Context, q querier, clause string, params ...any) ([]*pb.ExerciseHistory, error) {
+	rows, err := q.QueryContext(ctx, "SELECT "+historyColumns+" FROM exercise_history "+clause, params...)
+	if err != nil {
+		return nil, fmt.Errorf("select exercise history: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*pb.ExerciseHistory
+	var exerciseIDs, historyIDs []int32
+	seen := make(map[int32]bool)
+
+	for rows.Next() {
+		entry, err := scanHistory(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+		historyIDs = append(historyIDs, entry.Id)
+
+		if !seen[entry.ExerciseId] {
+			seen[entry.ExerciseId] = true
+			exerciseIDs = append(exerciseIDs, entry.ExerciseId)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read exercise history: %w", err)
+	}
+	rows.Close()
+
+	exercises, err := loadExerciseSummaries(ctx, q, exerciseIDs)
+	if err != nil {
+		return nil, err
+	}
+	recordings, err := loadRecordings(ctx, q, historyIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		entry.Exercise = exercises[entry.ExerciseId]
+		entry.Recordings = recordings[entry.Id]
+	}
+
+	return entries, nil
+}
+
+// scanHistory reads an exercise_history row selected with historyColumns
+func scanHistory(row rowScanner) (*pb.ExerciseHistory, error) {
+	var entry pb.ExerciseHistory
+	var startTime, endTime time.Time
+	var bpmJSON string
+	var rating sql.NullInt32
+
+	err := row.Scan(
+		&entry.Id,
+		&entry.ExerciseId,
+		&entry.SessionId,
+		&startTime,
+		&endTime,
+		&bpmJSON,
+		&entry.TimeSignature,
+		&entry.Notes,
+		&rating,
+		&entry.DurationSeconds,
+		&entry.SuggestedBpm,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("scan exercise history: %w", err)
+	}
+
+	bpms, err := decodeBPMs(bpmJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Bpms = bpms
+	entry.Rating = rating.Int32
+	entry.StartTime = timestamppb.New(startTime)
+	entry.EndTime = timestamppb.New(endTime)
+
+	return &entry, nil
+}
@@ -0,0 +1,552 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/blobstore"
+	"github.com/Zach-Johnson/tempus/server/imaging"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// imageColumns are the exercise_images columns read by scanImage, the image
+// data lives in the blob store
+const imageColumns = "id, exercise_id, content_hash, size_bytes, width, height, filename, mime_type, description, created_at"
+
+// thumbnailColumns are the exercise_image_thumbnails columns read by
+// scanThumbnail
+const thumbnailColumns = "image_id, size, content_hash, mime_type, width, height"
+
+// ImageInfo retrieves the details of an image without its data
+func (r *exerciseRepo) ImageInfo(ctx context.Context, id int32) (*pb.ExerciseImage, error) {
+	image, err := scanImage(r.db.QueryRowContext(
+		ctx,
+		"SELECT "+imageColumns+" FROM exercise_images WHERE id = ? AND "+ownedBy("exercise_images"),
+		id, ownerID(ctx),
+	))
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "image", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select image: %w", err)
+	}
+
+	if err := loadThumbnails(ctx, r.db, []*pb.ExerciseImage{image}); err != nil {
+		return nil, err
+	}
+
+	return image, nil
+}
+
+// ThumbnailInfo retrieves the details of an image thumbnail without its data
+func (r *exerciseRepo) ThumbnailInfo(ctx context.Context, imageID int32, size string) (*pb.ExerciseImageThumbnail, error) {
+	_, thumbnail, err := scanThumbnail(r.db.QueryRowContext(
+		ctx,
+		"SELECT "+thumbnailColumns+" FROM exercise_image_thumbnails WHERE image_id = ? AND size = ? AND "+ownedBy("exercise_image_thumbnails"),
+		imageID, size, ownerID(ctx),
+	))
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: size + " thumbnail of image", ID: imageID}
+	} else if err != nil {
+		return nil, fmt.Errorf("select thumbnail: %w", err)
+	}
+
+	return thumbnail, nil
+}
+
+// GetThumbnail retrieves an image thumbnail and its data
+func (r *exerciseRepo) GetThumbnail(ctx context.Context, imageID int32, size string) (*pb.ExerciseImageThumbnail, []byte, error) {
+	thumbnail, err := r.ThumbnailInfo(ctx, imageID, size)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := r.blobs.Get(ctx, thumbnail.ContentHash)
+	if errors.Is(err, blobstore.ErrNotFound) {
+		return nil, nil, fmt.Errorf("data of the %s thumbnail of image %d is missing from the blob store", size, imageID)
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("read thumbnail data: %w", err)
+	}
+
+	return thumbnail, data, nil
+}
+
+// GenerateThumbnails renders the thumbnails of the images stored before
+// images were processed, they are recognized by their unknown dimensions.
+// Images that cannot be decoded are left as they are. It returns the number
+// of images given thumbnails.
+func (s *Store) GenerateThumbnails(ctx context.Context) (int, error) {
+	generated := 0
+	var last int32
+	for {
+		var id int32
+		err := s.db.QueryRowContext(
+			ctx,
+			"SELECT id FROM exercise_images WHERE width = 0 AND id > ? ORDER BY id LIMIT 1",
+			last,
+		).Scan(&id)
+		if err == sql.ErrNoRows {
+			return generated, nil
+		} else if err != nil {
+			return generated, fmt.Errorf("select image without thumbnails: %w", err)
+		}
+		last = id
+
+		data, err := imageData(ctx, s.db, s.blobs, id)
+		if err != nil {
+			return generated, err
+		}
+		processed, err := imaging.Process(data)
+		if errors.Is(err, imaging.ErrInvalid) {
+			continue
+		} else if err != nil {
+			return generated, fmt.Errorf("process image %d: %w", id, err)
+		}
+
+		// The stored image stays as it is, only thumbnails are added
+		if err := s.addThumbnails(ctx, id, processed); err != nil {
+			return generated, err
+		}
+		generated++
+	}
+}
+
+// addThumbnails records the thumbnails, dimensions and detected type of an
+// existing image
+func (s *Store) addThumbnails(ctx context.Context, id int32, processed *imaging.Image) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM exercise_image_thumbnails WHERE image_id = ?", id); err != nil {
+		return fmt.Errorf("delete thumbnails of image %d: %w", id, err)
+	}
+	if _, err := insertThumbnails(ctx, tx, id, processed.Thumbnails); err != nil {
+		return err
+	}
+	if err := putThumbnails(ctx, tx, s.blobs, processed.Thumbnails); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE exercise_images SET width = ?, height = ?, mime_type = ? WHERE id = ?",
+		processed.Width, processed.Height, processed.MimeType, id,
+	)
+	if err != nil {
+		return fmt.Errorf("update image %d: %w", id, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// MoveImageBlobs moves the data of images still stored in the database to
+// the blob store, one image at a time so that an interrupted move resumes
+// where it stopped. It returns the number of images moved.
+func (s *Store) MoveImageBlobs(ctx context.Context) (int, error) {
+	moved := 0
+	for {
+		var id int32
+		var data []byte
+		err := s.db.QueryRowContext(
+			ctx,
+			"SELECT id, image_data FROM exercise_images WHERE image_data IS NOT NULL ORDER BY id LIMIT 1",
+		).Scan(&id, &data)
+		if err == sql.ErrNoRows {
+			return moved, nil
+		} else if err != nil {
+			return moved, fmt.Errorf("select image data: %w", err)
+		}
+
+		if err := s.moveImageBlob(ctx, id, data); err != nil {
+			return moved, err
+		}
+		moved++
+	}
+}
+
+// moveImageBlob moves the data of an image to the blob store
+func (s *Store) moveImageBlob(ctx context.Context, id int32, data []byte) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	hash := blobstore.Key(data)
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE exercise_images SET content_hash = ?, size_bytes = ?, image_data = NULL WHERE id = ?",
+		hash, len(data), id,
+	)
+	if err != nil {
+		return fmt.Errorf("update image %d: %w", id, err)
+	}
+	if err := putBlob(ctx, tx, s.blobs, hash, data); err != nil {
+		return fmt.Errorf("store image %d: %w", id, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// storeImage validates an image, inserts its rows and puts its data and
+// thumbnails in the blob store. The image data and MIME type are replaced by
+// the processed ones, the returned image carries no data.
+func storeImage(ctx context.Context, q querier, blobs blobstore.BlobStore, image *pb.ExerciseImage) (*pb.ExerciseImage, error) {
+	processed, err := imaging.Process(image.ImageData)
+	if err != nil {
+		return nil, err
+	}
+	image.ImageData = processed.Data
+	image.MimeType = processed.MimeType
+
+	hash := blobstore.Key(processed.Data)
+	var id int32
+	var createdAt time.Time
+	err = q.QueryRowContext(
+		ctx,
+		`INSERT INTO exercise_images (exercise_id, content_hash, size_bytes, width, height, filename, mime_type, description)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?) RETURNING id, created_at`,
+		image.ExerciseId, hash, len(processed.Data), processed.Width, processed.Height,
+		image.Filename, image.MimeType, image.Description,
+	).Scan(&id, &createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert image: %w", err)
+	}
+
+	thumbnails, err := insertThumbnails(ctx, q, id, processed.Thumbnails)
+	if err != nil {
+		return nil, err
+	}
+	if err := putImage(ctx, q, blobs, processed); err != nil {
+		return nil, err
+	}
+
+	return &pb.ExerciseImage{
+		Id:          id,
+		ExerciseId:  image.ExerciseId,
+		Filename:    image.Filename,
+		MimeType:    image.MimeType,
+		Description: image.Description,
+		CreatedAt:   timestamppb.New(createdAt),
+		ContentHash: hash,
+		SizeBytes:   int64(len(processed.Data)),
+		Url:         imageURL(id),
+		Width:       int32(processed.Width),
+		Height:      int32(processed.Height),
+		Thumbnails:  thumbnails,
+	}, nil
+}
+
+// putImage puts the data of a processed image and its thumbnails in the
+// blob store, see putBlob
+func putImage(ctx context.Context, q querier, blobs blobstore.BlobStore, processed *imaging.Image) error {
+	if err := putThumbnails(ctx, q, blobs, processed.Thumbnails); err != nil {
+		return err
+	}
+	if err := putBlob(ctx, q, blobs, blobstore.Key(processed.Data), processed.Data); err != nil {
+		return fmt.Errorf("store image data: %w", err)
+	}
+	return nil
+}
+
+// putThumbnails puts the data of thumbnails in the blob store, see putBlob
+func putThumbnails(ctx context.Context, q querier, blobs blobstore.BlobStore, thumbnails []imaging.Thumbnail) error {
+	for _, thumbnail := range thumbnails {
+		if err := putBlob(ctx, q, blobs, blobstore.Key(thumbnail.Data), thumbnail.Data); err != nil {
+			return fmt.Errorf("store %s thumbnail: %w", thumbnail.Size, err)
+		}
+	}
+	return nil
+}
+
+// putBlob puts a blob a transaction refers to in the blob store. It must run
+// in the transaction after the rows referring to the blob are written: a
+// sweep deletes a blob in the transaction that removes its release, so
+// removing the release here waits for a concurrent sweep to commit, and the
+// blob is put again if that sweep deleted it.
+func putBlob(ctx context.Context, q querier, blobs blobstore.BlobStore, hash string, data []byte) error {
+	if _, err := q.ExecContext(ctx, "DELETE FROM blob_releases WHERE content_hash = ?", hash); err != nil {
+		return fmt.Errorf("claim released blob: %w", err)
+	}
+	return blobs.Put(ctx, hash, data)
+}
+
+// insertThumbnails inserts the thumbnail rows of an image, their data is put
+// in the blob store after them
+func insertThumbnails(ctx context.Context, q querier, imageID int32, thumbnails []imaging.Thumbnail) ([]*pb.ExerciseImageThumbnail, error) {
+	inserted := make([]*pb.ExerciseImageThumbnail, 0, len(thumbnails))
+	for _, thumbnail := range thumbnails {
+		hash := blobstore.Key(thumbnail.Data)
+		_, err := q.ExecContext(
+			ctx,
+			`INSERT INTO exercise_image_thumbnails (image_id, size, content_hash, mime_type, width, height, size_bytes)
+             VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			imageID, thumbnail.Size, hash, thumbnail.MimeType, thumbnail.Width, thumbnail.Height, len(thumbnail.Data),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("insert %s thumbnail: %w", thumbnail.Size, err)
+		}
+
+		inserted = append(inserted, &pb.ExerciseImageThumbnail{
+			Size:        thumbnail.Size,
+			MimeType:    thumbnail.MimeType,
+			Width:       int32(thumbnail.Width),
+			Height:      int32(thumbnail.Height),
+			ContentHash: hash,
+			Url:         thumbnailURL(imageID, thumbnail.Size),
+		})
+	}
+	return inserted, nil
+}
+
+// imageData reads the data of an image from the blob store, or from the
+// database when it has not been moved yet
+func imageData(ctx context.Context, q querier, blobs blobstore.BlobStore, id int32) ([]byte, error) {
+	var data []byte
+	var hash string
+	err := q.QueryRowContext(ctx, "SELECT image_data, content_hash FROM exercise_images WHERE id = ?", id).Scan(&data, &hash)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "image", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select image: %w", err)
+	}
+	if data != nil {
+		return data, nil
+	}
+
+	data, err = blobs.Get(ctx, hash)
+	if errors.Is(err, blobstore.ErrNotFound) {
+		return nil, fmt.Errorf("data of image %d is missing from the blob store", id)
+	} else if err != nil {
+		return nil, fmt.Errorf("read image data: %w", err)
+	}
+	return data, nil
+}
+
+// imageHashes returns the hashes of the images matching the given clause and
+// of their thumbnails
+func imageHashes(ctx context.Context, q querier, clause string, params ...any) ([]string, error) {
+	ids := "SELECT id FROM exercise_images " + clause
+	rows, err := q.QueryContext(
+		ctx,
+		`SELECT content_hash FROM exercise_images WHERE id IN (`+ids+`)
+         UNION SELECT content_hash FROM exercise_image_thumbnails WHERE image_id IN (`+ids+`)`,
+		append(append([]any{}, params...), params...)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select image hashes: %w", err)
+	}
+	defer rows.Close()
+
+	var hashes []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, fmt.Errorf("scan image hash: %w", err)
+		}
+		if hash != "" {
+			hashes = append(hashes, hash)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read image hashes: %w", err)
+	}
+
+	return hashes, nil
+}
+
+// releaseBlobs records that images no longer refer to blobs. They are not
+// deleted right away: SweepBlobs deletes them once snapshots that could be
+// restored no longer refer to them either.
+func releaseBlobs(ctx context.Context, q querier, hashes []string) error {
+	for _, hash := range hashes {
+		_, err := q.ExecContext(
+			ctx,
+			`INSERT INTO blob_releases (content_hash) VALUES (?)
+             ON CONFLICT (content_hash) DO UPDATE SET released_at = CURRENT_TIMESTAMP`,
+			hash,
+		)
+		if err != nil {
+			return fmt.Errorf("release image blob: %w", err)
+		}
+	}
+	return nil
+}
+
+// SweepBlobs deletes the blobs released before the given time that no image,
+// thumbnail or recording refers to, identical content shares a blob. It returns the
+// number of blobs deleted.
+func (s *Store) SweepBlobs(ctx context.Context, before time.Time) (int, error) {
+	released := epochKey(s.db.dialect, "released_at") + " < ?"
+	rows, err := s.db.QueryContext(ctx, "SELECT content_hash FROM blob_releases WHERE "+released, before.Unix())
+	if err != nil {
+		return 0, fmt.Errorf("select released blobs: %w", err)
+	}
+	var hashes []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("scan released blob: %w", err)
+		}
+		hashes = append(hashes, hash)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("read released blobs: %w", err)
+	}
+
+	deleted := 0
+	for _, hash := range hashes {
+		ok, err := s.sweepBlob(ctx, hash, released, before)
+		if err != nil {
+			return deleted, err
+		}
+		if ok {
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// sweepBlob deletes a released blob unless something refers to it again. The
+// blob is deleted before the release is committed so that putBlob, which
+// waits for that commit, can put it back.
+func (s *Store) sweepBlob(ctx context.Context, hash, released string, before time.Time) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM blob_releases WHERE content_hash = ? AND "+released, hash, before.Unix())
+	if err != nil {
+		return false, fmt.Errorf("delete blob release: %w", err)
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return false, fmt.Errorf("delete blob release: %w", err)
+	} else if n == 0 {
+		return false, nil // Claimed or released again meanwhile
+	}
+
+	var used bool
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT EXISTS(SELECT 1 FROM exercise_images WHERE content_hash = ?)
+         OR EXISTS(SELECT 1 FROM exercise_image_thumbnails WHERE content_hash = ?)
+         OR EXISTS(SELECT 1 FROM exercise_history_recordings WHERE content_hash = ?)`,
+		hash, hash, hash,
+	).Scan(&used)
+	if err != nil {
+		return false, fmt.Errorf("check blob use: %w", err)
+	}
+	if !used {
+		if err := s.blobs.Delete(ctx, hash); err != nil {
+			return false, fmt.Errorf("delete blob: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit transaction: %w", err)
+	}
+	return !used, nil
+}
+
+// scanImage reads an exercise_images row selected with imageColumns
+func scanImage(row rowScanner) (*pb.ExerciseImage, error) {
+	var image pb.ExerciseImage
+	var createdAt time.Time
+
+	err := row.Scan(
+		&image.Id, &image.ExerciseId, &image.ContentHash, &image.SizeBytes, &image.Width, &image.Height,
+		&image.Filename, &image.MimeType, &image.Description, &createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	image.CreatedAt = timestamppb.New(createdAt)
+	image.Url = imageURL(image.Id)
+
+	return &image, nil
+}
+
+// loadThumbnails attaches their thumbnails to images
+func loadThumbnails(ctx context.Context, q querier, images []*pb.ExerciseImage) error {
+	if len(images) == 0 {
+		return nil
+	}
+
+	imageMap := make(map[int32]*pb.ExerciseImage, len(images))
+	ids := make([]int32, 0, len(images))
+	for _, image := range images {
+		imageMap[image.Id] = image
+		ids = append(ids, image.Id)
+	}
+
+	marks, args := placeholders(ids)
+	rows, err := q.QueryContext(
+		ctx,
+		"SELECT "+thumbnailColumns+" FROM exercise_image_thumbnails WHERE image_id IN ("+marks+") ORDER BY width",
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("select image thumbnails: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		imageID, thumbnail, err := scanThumbnail(rows)
+		if err != nil {
+			return fmt.Errorf("scan image thumbnail: %w", err)
+		}
+		if image, ok := imageMap[imageID]; ok {
+			image.Thumbnails = append(image.Thumbnails, thumbnail)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("read image thumbnails: %w", err)
+	}
+
+	return nil
+}
+
+// scanThumbnail reads an exercise_image_thumbnails row selected with
+// thumbnailColumns, returning the ID of its image
+func scanThumbnail(row rowScanner) (int32, *pb.ExerciseImageThumbnail, error) {
+	var imageID int32
+	var thumbnail pb.ExerciseImageThumbnail
+
+	err := row.Scan(
+		&imageID, &thumbnail.Size, &thumbnail.ContentHash,
+		&thumbnail.MimeType, &thumbnail.Width, &thumbnail.Height,
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	thumbnail.Url = thumbnailURL(imageID, thumbnail.Size)
+
+	return imageID, &thumbnail, nil
+}
+
+// imageURL returns the path an image is served at
+func imageURL(id int32) string {
+	return fmt.Sprintf("/api/v1/images/%d", id)
+}
+
+// thumbnailURL returns the path an image thumbnail is served at
+func thumbnailURL(imageID int32, size string) string {
+	return fmt.Sprintf("/api/v1/images/%d/thumbnails/%s", imageID, size)
+}
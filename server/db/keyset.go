@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+)
+
+// Cursor is the position of a row in a listing, the value of its sort key
+// and its ID. Keys are strings or int64s, times being seconds since the epoch.
+type Cursor struct {
+	Key any
+	ID  int32
+}
+
+// Page is a page of a listing
+type Page[T any] struct {
+	Items []T
+	// Next is the cursor of the last item when more rows follow, nil on the
+	// last page
+	Next *Cursor
+	// TotalCount is the number of rows of the whole listing, only counted
+	// when asked for with ListOptions.Count
+	TotalCount int32
+}
+
+// keyset orders a listing by a sort key and then ID, both in the same
+// direction, so a page continues after the last row of the previous one
+// however rows were added or removed in between
+type keyset struct {
+	// key is a non-NULL expression of the sort key
+	key        string
+	numeric    bool
+	id         string
+	descending bool
+}
+
+// byName orders rows of a table, or its alias, by name
+func byName(alias string) keyset {
+	return keyset{key: alias + ".name", id: alias + ".id"}
+}
+
+// byTime orders rows of a table, or its alias, by a timestamp column
+func byTime(d dialect, alias, column string, descending bool) keyset {
+	return keyset{
+		key:        epochKey(d, alias+"."+column),
+		numeric:    true,
+		id:         alias + ".id",
+		descending: descending,
+	}
+}
+
+// epochKey is a timestamp expression as a sort key. Timestamps are compared
+// as seconds since the epoch, SQLite keeps them as text in whatever format
+// and time zone they were written.
+func epochKey(d dialect, expr string) string {
+	return "CAST(" + d.epochSeconds(expr) + " AS BIGINT)"
+}
+
+// listPage queries a page of the rows of q in the order of k. fetch reads the
+// rows of a query made of q's clauses followed by limit, its parameters are
+// those of q and the limit; the page holds at most opts.Limit of them.
+func listPage[T any](
+	ctx context.Context,
+	db querier,
+	q *selectQuery,
+	k keyset,
+	opts ListOptions,
+	fetch func(clauses string, params ...any) ([]T, error),
+	id func(T) int32,
+) (*Page[T], error) {
+	page := &Page[T]{}
+	if opts.Count {
+		if err := db.QueryRowContext(ctx, q.count(), q.where.params...).Scan(&page.TotalCount); err != nil {
+			return nil, fmt.Errorf("count %s: %w", q.from, err)
+		}
+	}
+
+	// Sorted without the cursor so the key of the last row can be looked up
+	// with the same joins
+	lookup := *q
+
+	if opts.After != nil {
+		op := " > "
+		if k.descending {
+			op = " < "
+		}
+		q.where.add("("+k.key+", "+k.id+")"+op+"(?, ?)", opts.After.Key, opts.After.ID)
+	}
+	q.sort(k.key, k.descending)
+	q.sort(k.id, k.descending)
+
+	// One more row than the page tells whether there is a next page
+	items, err := fetch(q.clauses("LIMIT ?"), append(q.where.params, opts.Limit+1)...)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) <= opts.Limit {
+		page.Items = items
+		return page, nil
+	}
+	page.Items = items[:opts.Limit]
+
+	last := id(page.Items[len(page.Items)-1])
+	lookup.where = lookup.where.with(k.id+" = ?", last)
+	page.Next = &Cursor{ID: last}
+	if k.numeric {
+		var key int64
+		err = db.QueryRowContext(ctx, lookup.sql(k.key), lookup.where.params...).Scan(&key)
+		page.Next.Key = key
+	} else {
+		var key string
+		err = db.QueryRowContext(ctx, lookup.sql(k.key), lookup.where.params...).Scan(&key)
+		page.Next.Key = key
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select %s sort key: %w", q.from, err)
+	}
+
+	return page, nil
+}
@@ -6,26 +6,46 @@ import (
 	"fmt"
 
 	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	pgxmigrate "github.com/golang-migrate/migrate/v4/database/pgx/v5"
 	"github.com/golang-migrate/migrate/v4/database/sqlite3"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
+	_ "github.com/jackc/pgx/v5/stdlib"
 	_ "github.com/mattn/go-sqlite3"
 )
 
-//go:embed migrations/*.sql
+// Each dialect has its own migration set under migrations/<dialect name>,
+// the versions of the sets are kept in step.
+//
+//go:embed migrations/sqlite/*.sql migrations/postgres/*.sql
 var migrationsFS embed.FS
 
-func RunMigrations(db *sql.DB) error {
-	driver, err := sqlite3.WithInstance(db, &sqlite3.Config{})
+func RunMigrations(db *sql.DB, driverName string) error {
+	d, err := dialectFor(driverName)
 	if err != nil {
-		return fmt.Errorf("sqlite driver error: %w", err)
+		return err
 	}
 
-	d, err := iofs.New(migrationsFS, "migrations")
+	var driver database.Driver
+	switch driverName {
+	case DriverSQLite:
+		if err := checkFTS5(db); err != nil {
+			return err
+		}
+		driver, err = sqlite3.WithInstance(db, &sqlite3.Config{})
+	case DriverPostgres:
+		driver, err = pgxmigrate.WithInstance(db, &pgxmigrate.Config{})
+	}
+	if err != nil {
+		return fmt.Errorf("%s driver error: %w", d.name(), err)
+	}
+
+	src, err := iofs.New(migrationsFS, "migrations/"+d.name())
 	if err != nil {
 		return fmt.Errorf("migration source error: %w", err)
 	}
 
-	m, err := migrate.NewWithInstance("iofs", d, "sqlite3", driver)
+	m, err := migrate.NewWithInstance("iofs", src, d.name(), driver)
 	if err != nil {
 		return fmt.Errorf("migration instance error: %w", err)
 	}
@@ -37,3 +57,16 @@ func RunMigrations(db *sql.DB) error {
 
 	return nil
 }
+
+// checkFTS5 makes sure SQLite has the FTS5 extension search relies on, which
+// go-sqlite3 only compiles in with the sqlite_fts5 build tag
+func checkFTS5(db *sql.DB) error {
+	var enabled bool
+	if err := db.QueryRow("SELECT sqlite_compileoption_used('ENABLE_FTS5')").Scan(&enabled); err != nil {
+		return fmt.Errorf("check sqlite options: %w", err)
+	}
+	if !enabled {
+		return fmt.Errorf("sqlite was built without FTS5, build with -tags sqlite_fts5")
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+-- Tempo plan of an exercise, a start BPM of 0 means none
+ALTER TABLE exercises ADD COLUMN tempo_start_bpm INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE exercises ADD COLUMN tempo_end_bpm INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE exercises ADD COLUMN tempo_increment INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE exercises ADD COLUMN tempo_bars_per_step INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE exercises ADD COLUMN tempo_time_signature TEXT NOT NULL DEFAULT '';
+ALTER TABLE exercises ADD COLUMN tempo_subdivision INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE exercises ADD COLUMN tempo_accent_pattern TEXT NOT NULL DEFAULT '';
+
+-- Tempo plan rung suggested when a history entry was added
+ALTER TABLE exercise_history ADD COLUMN suggested_bpm INTEGER NOT NULL DEFAULT 0;
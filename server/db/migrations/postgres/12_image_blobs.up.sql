@@ -0,0 +1,11 @@
+-- Image content moves to the blob store, the table keeps the SHA-256 hash
+-- it is stored under. The data of existing images stays until the server
+-- moves it to the blob store after migrating.
+ALTER TABLE exercise_images ALTER COLUMN image_data DROP NOT NULL;
+ALTER TABLE exercise_images ADD COLUMN content_hash TEXT NOT NULL DEFAULT '';
+ALTER TABLE exercise_images ADD COLUMN size_bytes BIGINT NOT NULL DEFAULT 0;
+
+UPDATE exercise_images SET size_bytes = octet_length(image_data);
+
+CREATE INDEX IF NOT EXISTS idx_exercise_images_exercise_id ON exercise_images(exercise_id);
+CREATE INDEX IF NOT EXISTS idx_exercise_images_content_hash ON exercise_images(content_hash);
@@ -0,0 +1,19 @@
+-- Image dimensions and the thumbnails previews use. Thumbnail data lives in
+-- the blob store like the images, existing images get their thumbnails when
+-- the server starts.
+ALTER TABLE exercise_images ADD COLUMN width INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE exercise_images ADD COLUMN height INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS exercise_image_thumbnails (
+    image_id INTEGER NOT NULL,
+    size TEXT NOT NULL,
+    content_hash TEXT NOT NULL,
+    mime_type TEXT NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    PRIMARY KEY (image_id, size),
+    FOREIGN KEY (image_id) REFERENCES exercise_images(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_exercise_image_thumbnails_content_hash ON exercise_image_thumbnails(content_hash);
@@ -0,0 +1,25 @@
+-- Full-text search indexes. Each searchable table has a generated tsvector of
+-- its text columns, the most important column weighted A, which Postgres
+-- keeps in step with the row.
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'B')
+) STORED;
+
+ALTER TABLE exercise_links ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(description, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(url, '')), 'B')
+) STORED;
+
+ALTER TABLE practice_sessions ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(notes, '')), 'A')
+) STORED;
+
+ALTER TABLE exercise_history ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(notes, '')), 'A')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_exercises_search ON exercises USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_exercise_links_search ON exercise_links USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_practice_sessions_search ON practice_sessions USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_exercise_history_search ON exercise_history USING GIN (search_vector);
@@ -0,0 +1,28 @@
+-- Data belongs to the user who created it. Rows created before users existed
+-- have no owner until the server assigns them to the first admin after
+-- migrating. Rows of other tables belong to the owner of their exercise or
+-- history entry.
+ALTER TABLE categories ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE tags ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE exercises ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE practice_sessions ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE exercise_history ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE goals ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE routines ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_exercises_user_id ON exercises(user_id);
+CREATE INDEX IF NOT EXISTS idx_practice_sessions_user_id ON practice_sessions(user_id, active);
+CREATE INDEX IF NOT EXISTS idx_exercise_history_user_id ON exercise_history(user_id);
+CREATE INDEX IF NOT EXISTS idx_goals_user_id ON goals(user_id);
+CREATE INDEX IF NOT EXISTS idx_routines_user_id ON routines(user_id);
+
+-- Category and tag names are unique per user
+ALTER TABLE categories DROP CONSTRAINT categories_name_key;
+ALTER TABLE categories ADD CONSTRAINT categories_user_id_name_key UNIQUE (user_id, name);
+ALTER TABLE tags DROP CONSTRAINT tags_name_key;
+ALTER TABLE tags ADD CONSTRAINT tags_user_id_name_key UNIQUE (user_id, name);
+
+-- Each user has their own settings, users without a row have the defaults
+ALTER TABLE settings DROP CONSTRAINT settings_id_check;
+ALTER TABLE settings ALTER COLUMN id ADD GENERATED BY DEFAULT AS IDENTITY (START WITH 2);
+ALTER TABLE settings ADD COLUMN user_id INTEGER UNIQUE REFERENCES users(id) ON DELETE CASCADE;
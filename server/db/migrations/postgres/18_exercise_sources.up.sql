@@ -0,0 +1,12 @@
+-- Exercise Sources Table (identity of exercises in exercise packs and the
+-- pack each one was last imported from)
+CREATE TABLE IF NOT EXISTS exercise_sources (
+    exercise_id INTEGER PRIMARY KEY,
+    uid TEXT NOT NULL,
+    pack_name TEXT NOT NULL DEFAULT '',
+    pack_updated_at TIMESTAMPTZ,  -- Update time of the exercise in that pack, NULL when authored here
+    imported_at TIMESTAMPTZ,
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_exercise_sources_uid ON exercise_sources(uid);
@@ -0,0 +1,52 @@
+-- Teachers assign exercises to the users that are their students
+ALTER TABLE users ADD COLUMN teacher BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Teacher Students Table (the students each teacher may review)
+CREATE TABLE IF NOT EXISTS teacher_students (
+    teacher_id INTEGER NOT NULL,
+    student_id INTEGER NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (teacher_id, student_id),
+    FOREIGN KEY (teacher_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (student_id) REFERENCES users(id) ON DELETE CASCADE,
+    CHECK (teacher_id <> student_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_teacher_students_student_id ON teacher_students(student_id);
+
+-- Assignments Table (exercises of students their teacher set a target for)
+CREATE TABLE IF NOT EXISTS assignments (
+    id SERIAL PRIMARY KEY,
+    teacher_id INTEGER NOT NULL,
+    student_id INTEGER NOT NULL,
+    exercise_id INTEGER NOT NULL,
+    target_bpm INTEGER NOT NULL,
+    due_date TIMESTAMPTZ,
+    notes TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (teacher_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (student_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_assignments_teacher_id ON assignments(teacher_id, student_id);
+CREATE INDEX IF NOT EXISTS idx_assignments_student_id ON assignments(student_id);
+CREATE INDEX IF NOT EXISTS idx_assignments_exercise_id ON assignments(exercise_id);
+
+CREATE TRIGGER update_assignments_timestamp
+BEFORE UPDATE ON assignments
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- History Comments Table (review of exercise history entries)
+CREATE TABLE IF NOT EXISTS history_comments (
+    id SERIAL PRIMARY KEY,
+    history_id INTEGER NOT NULL,
+    author_id INTEGER NOT NULL,
+    text TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (history_id) REFERENCES exercise_history(id) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_history_comments_history_id ON history_comments(history_id);
@@ -0,0 +1,118 @@
+-- Categories Table
+CREATE TABLE IF NOT EXISTS categories (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Tags Table
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Tag Categories Junction Table (many-to-many relationship)
+CREATE TABLE IF NOT EXISTS tag_categories (
+    tag_id INTEGER NOT NULL,
+    category_id INTEGER NOT NULL,
+    PRIMARY KEY (tag_id, category_id),
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE
+);
+
+-- Exercises Table
+CREATE TABLE IF NOT EXISTS exercises (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Exercise Images Table
+CREATE TABLE IF NOT EXISTS exercise_images (
+    id SERIAL PRIMARY KEY,
+    exercise_id INTEGER NOT NULL,
+    image_data BYTEA NOT NULL,
+    filename TEXT,
+    mime_type TEXT,
+    description TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE
+);
+
+-- Exercise Links Table (for external resources)
+CREATE TABLE IF NOT EXISTS exercise_links (
+    id SERIAL PRIMARY KEY,
+    exercise_id INTEGER NOT NULL,
+    url TEXT NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE
+);
+
+-- Exercise Tags Junction Table (many-to-many relationship)
+CREATE TABLE IF NOT EXISTS exercise_tags (
+    exercise_id INTEGER NOT NULL,
+    tag_id INTEGER NOT NULL,
+    PRIMARY KEY (exercise_id, tag_id),
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+-- Exercise Categories Junction Table (many-to-many relationship)
+CREATE TABLE IF NOT EXISTS exercise_categories (
+    exercise_id INTEGER NOT NULL,
+    category_id INTEGER NOT NULL,
+    PRIMARY KEY (exercise_id, category_id),
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE
+);
+
+-- Practice Sessions Table
+CREATE TABLE IF NOT EXISTS practice_sessions (
+    id SERIAL PRIMARY KEY,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    notes TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Exercise History Table (for tracking progress over time)
+CREATE TABLE IF NOT EXISTS exercise_history (
+    id SERIAL PRIMARY KEY,
+    exercise_id INTEGER NOT NULL,
+    session_id INTEGER NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    bpms TEXT, -- JSON array of bpm values
+    time_signature TEXT,
+    notes TEXT,
+    rating INTEGER, -- Optional: User rating of their performance (1-5)
+    FOREIGN KEY (session_id) REFERENCES practice_sessions(id) ON DELETE CASCADE,
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE
+);
+
+-- Trigger function to update the updated_at timestamp
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER update_exercises_timestamp
+BEFORE UPDATE ON exercises
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TRIGGER update_categories_timestamp
+BEFORE UPDATE ON categories
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TRIGGER update_practice_sessions_timestamp
+BEFORE UPDATE ON practice_sessions
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
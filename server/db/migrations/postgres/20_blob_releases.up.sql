@@ -0,0 +1,6 @@
+-- Blob Releases Table (image blobs no longer referred to, deleted by a later
+-- sweep unless an image refers to them again)
+CREATE TABLE IF NOT EXISTS blob_releases (
+    content_hash TEXT PRIMARY KEY,
+    released_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
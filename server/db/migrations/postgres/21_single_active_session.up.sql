@@ -0,0 +1,7 @@
+-- Only the most recent active session of a user stays active, the index keeps
+-- concurrent requests from activating a second one
+UPDATE practice_sessions SET active = 0
+WHERE active = 1
+AND id NOT IN (SELECT MAX(id) FROM practice_sessions WHERE active = 1 GROUP BY user_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_practice_sessions_active ON practice_sessions(user_id) WHERE active = 1;
@@ -0,0 +1,23 @@
+-- Recording audio moves to the blob store, the table keeps the SHA-256 hash
+-- it is stored under. The audio of existing recordings stays until the
+-- server moves it to the blob store after migrating.
+ALTER TABLE exercise_history_recordings ALTER COLUMN audio_data DROP NOT NULL;
+ALTER TABLE exercise_history_recordings ADD COLUMN content_hash TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_exercise_history_recordings_content_hash ON exercise_history_recordings(content_hash);
+
+-- Recordings are deleted along with their history entries, sessions,
+-- exercises and users, so their blobs are released by a trigger that also
+-- sees the cascades
+CREATE OR REPLACE FUNCTION release_recording_blob() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO blob_releases (content_hash) VALUES (OLD.content_hash)
+    ON CONFLICT (content_hash) DO UPDATE SET released_at = CURRENT_TIMESTAMP;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER release_recording_blob
+AFTER DELETE ON exercise_history_recordings
+FOR EACH ROW WHEN (OLD.content_hash <> '')
+EXECUTE FUNCTION release_recording_blob();
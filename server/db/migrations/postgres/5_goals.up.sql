@@ -0,0 +1,18 @@
+-- Goals Table (target BPM per exercise)
+CREATE TABLE IF NOT EXISTS goals (
+    id SERIAL PRIMARY KEY,
+    exercise_id INTEGER NOT NULL,
+    target_bpm INTEGER NOT NULL,
+    target_date TIMESTAMPTZ,
+    time_signature TEXT NOT NULL DEFAULT '',
+    achieved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_goals_exercise_id ON goals(exercise_id);
+
+CREATE TRIGGER update_goals_timestamp
+BEFORE UPDATE ON goals
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
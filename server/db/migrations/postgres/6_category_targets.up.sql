@@ -0,0 +1 @@
+ALTER TABLE categories ADD COLUMN weekly_target_minutes INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,27 @@
+-- Routines Table (reusable session plans)
+CREATE TABLE IF NOT EXISTS routines (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Routine Steps Table (ordered exercises of a routine)
+CREATE TABLE IF NOT EXISTS routine_steps (
+    routine_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    exercise_id INTEGER NOT NULL,
+    planned_duration_seconds INTEGER NOT NULL DEFAULT 0,
+    start_bpm INTEGER NOT NULL DEFAULT 0,
+    time_signature TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (routine_id, position),
+    FOREIGN KEY (routine_id) REFERENCES routines(id) ON DELETE CASCADE,
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_routine_steps_exercise_id ON routine_steps(exercise_id);
+
+CREATE TRIGGER update_routines_timestamp
+BEFORE UPDATE ON routines
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
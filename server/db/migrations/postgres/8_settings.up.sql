@@ -0,0 +1,12 @@
+-- Settings Table (a single row of user preferences)
+CREATE TABLE IF NOT EXISTS settings (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    time_zone TEXT NOT NULL DEFAULT 'UTC',
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO settings (id) VALUES (1);
+
+CREATE TRIGGER update_settings_timestamp
+BEFORE UPDATE ON settings
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
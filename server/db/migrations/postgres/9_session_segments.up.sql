@@ -0,0 +1,19 @@
+-- Practice time without pauses, computed from the segments when finished
+ALTER TABLE practice_sessions ADD COLUMN duration_seconds INTEGER;
+
+-- Exercise history entry being timed in an active session
+ALTER TABLE practice_sessions ADD COLUMN current_history_id INTEGER REFERENCES exercise_history(id) ON DELETE SET NULL;
+
+-- Session Segments Table (stretches of time a session was running)
+CREATE TABLE IF NOT EXISTS session_segments (
+    id SERIAL PRIMARY KEY,
+    session_id INTEGER NOT NULL,
+    history_id INTEGER,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ,
+    FOREIGN KEY (session_id) REFERENCES practice_sessions(id) ON DELETE CASCADE,
+    FOREIGN KEY (history_id) REFERENCES exercise_history(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_segments_session_id ON session_segments(session_id);
+CREATE INDEX IF NOT EXISTS idx_session_segments_history_id ON session_segments(history_id);
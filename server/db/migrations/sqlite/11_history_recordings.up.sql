@@ -0,0 +1,14 @@
+-- Exercise History Recordings Table (audio of practice attempts)
+CREATE TABLE IF NOT EXISTS exercise_history_recordings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    history_id INTEGER NOT NULL,
+    audio_data BLOB NOT NULL,
+    filename TEXT NOT NULL DEFAULT '',
+    mime_type TEXT NOT NULL,
+    duration_seconds INTEGER NOT NULL DEFAULT 0,
+    size_bytes INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (history_id) REFERENCES exercise_history(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_exercise_history_recordings_history_id ON exercise_history_recordings(history_id);
@@ -0,0 +1,26 @@
+-- Image content moves to the blob store, the table keeps the SHA-256 hash
+-- it is stored under. SQLite cannot drop the NOT NULL constraint of
+-- image_data in place, so the table is rebuilt. The data of existing images
+-- stays until the server moves it to the blob store after migrating.
+CREATE TABLE exercise_images_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    exercise_id INTEGER NOT NULL,
+    image_data BLOB,
+    content_hash TEXT NOT NULL DEFAULT '',
+    size_bytes INTEGER NOT NULL DEFAULT 0,
+    filename TEXT,
+    mime_type TEXT,
+    description TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE
+);
+
+INSERT INTO exercise_images_new (id, exercise_id, image_data, size_bytes, filename, mime_type, description, created_at)
+SELECT id, exercise_id, image_data, length(image_data), filename, mime_type, description, created_at
+FROM exercise_images;
+
+DROP TABLE exercise_images;
+ALTER TABLE exercise_images_new RENAME TO exercise_images;
+
+CREATE INDEX IF NOT EXISTS idx_exercise_images_exercise_id ON exercise_images(exercise_id);
+CREATE INDEX IF NOT EXISTS idx_exercise_images_content_hash ON exercise_images(content_hash);
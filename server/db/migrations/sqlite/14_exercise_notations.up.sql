@@ -0,0 +1,16 @@
+-- Exercise Notations Table (sheet music attached to exercises). Title, time
+-- signature and measure count are read from the notation when it is added.
+CREATE TABLE IF NOT EXISTS exercise_notations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    exercise_id INTEGER NOT NULL,
+    format INTEGER NOT NULL,
+    data BLOB NOT NULL,
+    filename TEXT NOT NULL DEFAULT '',
+    title TEXT NOT NULL DEFAULT '',
+    time_signature TEXT NOT NULL DEFAULT '',
+    measure_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_exercise_notations_exercise_id ON exercise_notations(exercise_id);
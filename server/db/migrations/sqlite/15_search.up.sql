@@ -0,0 +1,84 @@
+-- Full-text search indexes. Each FTS5 table indexes the text columns of the
+-- table it is named after, its rowid is the row's id, and triggers keep it in
+-- step with that table.
+CREATE VIRTUAL TABLE IF NOT EXISTS exercises_search USING fts5(
+    name, description,
+    content='exercises', content_rowid='id', tokenize='porter unicode61'
+);
+
+CREATE VIRTUAL TABLE IF NOT EXISTS exercise_links_search USING fts5(
+    description, url,
+    content='exercise_links', content_rowid='id', tokenize='porter unicode61'
+);
+
+CREATE VIRTUAL TABLE IF NOT EXISTS practice_sessions_search USING fts5(
+    notes,
+    content='practice_sessions', content_rowid='id', tokenize='porter unicode61'
+);
+
+CREATE VIRTUAL TABLE IF NOT EXISTS exercise_history_search USING fts5(
+    notes,
+    content='exercise_history', content_rowid='id', tokenize='porter unicode61'
+);
+
+-- Exercises
+CREATE TRIGGER IF NOT EXISTS exercises_search_insert AFTER INSERT ON exercises BEGIN
+    INSERT INTO exercises_search (rowid, name, description) VALUES (new.id, new.name, new.description);
+END;
+
+CREATE TRIGGER IF NOT EXISTS exercises_search_delete AFTER DELETE ON exercises BEGIN
+    INSERT INTO exercises_search (exercises_search, rowid, name, description) VALUES ('delete', old.id, old.name, old.description);
+END;
+
+CREATE TRIGGER IF NOT EXISTS exercises_search_update AFTER UPDATE OF name, description ON exercises BEGIN
+    INSERT INTO exercises_search (exercises_search, rowid, name, description) VALUES ('delete', old.id, old.name, old.description);
+    INSERT INTO exercises_search (rowid, name, description) VALUES (new.id, new.name, new.description);
+END;
+
+-- Exercise links
+CREATE TRIGGER IF NOT EXISTS exercise_links_search_insert AFTER INSERT ON exercise_links BEGIN
+    INSERT INTO exercise_links_search (rowid, description, url) VALUES (new.id, new.description, new.url);
+END;
+
+CREATE TRIGGER IF NOT EXISTS exercise_links_search_delete AFTER DELETE ON exercise_links BEGIN
+    INSERT INTO exercise_links_search (exercise_links_search, rowid, description, url) VALUES ('delete', old.id, old.description, old.url);
+END;
+
+CREATE TRIGGER IF NOT EXISTS exercise_links_search_update AFTER UPDATE OF description, url ON exercise_links BEGIN
+    INSERT INTO exercise_links_search (exercise_links_search, rowid, description, url) VALUES ('delete', old.id, old.description, old.url);
+    INSERT INTO exercise_links_search (rowid, description, url) VALUES (new.id, new.description, new.url);
+END;
+
+-- Practice sessions
+CREATE TRIGGER IF NOT EXISTS practice_sessions_search_insert AFTER INSERT ON practice_sessions BEGIN
+    INSERT INTO practice_sessions_search (rowid, notes) VALUES (new.id, new.notes);
+END;
+
+CREATE TRIGGER IF NOT EXISTS practice_sessions_search_delete AFTER DELETE ON practice_sessions BEGIN
+    INSERT INTO practice_sessions_search (practice_sessions_search, rowid, notes) VALUES ('delete', old.id, old.notes);
+END;
+
+CREATE TRIGGER IF NOT EXISTS practice_sessions_search_update AFTER UPDATE OF notes ON practice_sessions BEGIN
+    INSERT INTO practice_sessions_search (practice_sessions_search, rowid, notes) VALUES ('delete', old.id, old.notes);
+    INSERT INTO practice_sessions_search (rowid, notes) VALUES (new.id, new.notes);
+END;
+
+-- Exercise history
+CREATE TRIGGER IF NOT EXISTS exercise_history_search_insert AFTER INSERT ON exercise_history BEGIN
+    INSERT INTO exercise_history_search (rowid, notes) VALUES (new.id, new.notes);
+END;
+
+CREATE TRIGGER IF NOT EXISTS exercise_history_search_delete AFTER DELETE ON exercise_history BEGIN
+    INSERT INTO exercise_history_search (exercise_history_search, rowid, notes) VALUES ('delete', old.id, old.notes);
+END;
+
+CREATE TRIGGER IF NOT EXISTS exercise_history_search_update AFTER UPDATE OF notes ON exercise_history BEGIN
+    INSERT INTO exercise_history_search (exercise_history_search, rowid, notes) VALUES ('delete', old.id, old.notes);
+    INSERT INTO exercise_history_search (rowid, notes) VALUES (new.id, new.notes);
+END;
+
+-- Index existing rows
+INSERT INTO exercises_search (exercises_search) VALUES ('rebuild');
+INSERT INTO exercise_links_search (exercise_links_search) VALUES ('rebuild');
+INSERT INTO practice_sessions_search (practice_sessions_search) VALUES ('rebuild');
+INSERT INTO exercise_history_search (exercise_history_search) VALUES ('rebuild');
@@ -0,0 +1,107 @@
+-- Data belongs to the user who created it. Rows created before users existed
+-- have no owner until the server assigns them to the first admin after
+-- migrating. Rows of other tables belong to the owner of their exercise or
+-- history entry.
+ALTER TABLE exercises ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE practice_sessions ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE exercise_history ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE goals ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE routines ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_exercises_user_id ON exercises(user_id);
+CREATE INDEX IF NOT EXISTS idx_practice_sessions_user_id ON practice_sessions(user_id, active);
+CREATE INDEX IF NOT EXISTS idx_exercise_history_user_id ON exercise_history(user_id);
+CREATE INDEX IF NOT EXISTS idx_goals_user_id ON goals(user_id);
+CREATE INDEX IF NOT EXISTS idx_routines_user_id ON routines(user_id);
+
+-- Category and tag names are unique per user. SQLite cannot change the
+-- constraints in place, so the tables are rebuilt. Dropping them would cascade
+-- to the junction tables, which are set aside and rebuilt after them.
+CREATE TABLE tag_categories_old AS SELECT * FROM tag_categories;
+CREATE TABLE exercise_tags_old AS SELECT * FROM exercise_tags;
+DROP TABLE tag_categories;
+DROP TABLE exercise_tags;
+
+CREATE TABLE categories_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER,
+    name TEXT NOT NULL,
+    description TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    weekly_target_minutes INTEGER NOT NULL DEFAULT 0,
+    UNIQUE (user_id, name),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+INSERT INTO categories_new (id, name, description, created_at, updated_at, weekly_target_minutes)
+SELECT id, name, description, created_at, updated_at, weekly_target_minutes
+FROM categories;
+
+DROP TABLE categories;
+ALTER TABLE categories_new RENAME TO categories;
+
+CREATE TRIGGER IF NOT EXISTS update_categories_timestamp
+AFTER UPDATE ON categories
+BEGIN
+    UPDATE categories SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
+
+CREATE TABLE tags_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+INSERT INTO tags_new (id, name, created_at)
+SELECT id, name, created_at
+FROM tags;
+
+DROP TABLE tags;
+ALTER TABLE tags_new RENAME TO tags;
+
+CREATE TABLE tag_categories (
+    tag_id INTEGER NOT NULL,
+    category_id INTEGER NOT NULL,
+    PRIMARY KEY (tag_id, category_id),
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE
+);
+
+CREATE TABLE exercise_tags (
+    exercise_id INTEGER NOT NULL,
+    tag_id INTEGER NOT NULL,
+    PRIMARY KEY (exercise_id, tag_id),
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+INSERT INTO tag_categories (tag_id, category_id) SELECT tag_id, category_id FROM tag_categories_old;
+INSERT INTO exercise_tags (exercise_id, tag_id) SELECT exercise_id, tag_id FROM exercise_tags_old;
+DROP TABLE tag_categories_old;
+DROP TABLE exercise_tags_old;
+
+-- Each user has their own settings, users without a row have the defaults
+CREATE TABLE settings_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER UNIQUE,
+    time_zone TEXT NOT NULL DEFAULT 'UTC',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+INSERT INTO settings_new (id, time_zone, updated_at)
+SELECT id, time_zone, updated_at
+FROM settings;
+
+DROP TABLE settings;
+ALTER TABLE settings_new RENAME TO settings;
+
+CREATE TRIGGER IF NOT EXISTS update_settings_timestamp
+AFTER UPDATE ON settings
+BEGIN
+    UPDATE settings SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
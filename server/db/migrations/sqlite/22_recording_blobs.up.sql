@@ -0,0 +1,38 @@
+-- Recording audio moves to the blob store, the table keeps the SHA-256 hash
+-- it is stored under. SQLite cannot drop the NOT NULL constraint of
+-- audio_data in place, so the table is rebuilt. The audio of existing
+-- recordings stays until the server moves it to the blob store after
+-- migrating.
+CREATE TABLE exercise_history_recordings_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    history_id INTEGER NOT NULL,
+    audio_data BLOB,
+    content_hash TEXT NOT NULL DEFAULT '',
+    filename TEXT NOT NULL DEFAULT '',
+    mime_type TEXT NOT NULL,
+    duration_seconds INTEGER NOT NULL DEFAULT 0,
+    size_bytes INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (history_id) REFERENCES exercise_history(id) ON DELETE CASCADE
+);
+
+INSERT INTO exercise_history_recordings_new (id, history_id, audio_data, filename, mime_type, duration_seconds, size_bytes, created_at)
+SELECT id, history_id, audio_data, filename, mime_type, duration_seconds, size_bytes, created_at
+FROM exercise_history_recordings;
+
+DROP TABLE exercise_history_recordings;
+ALTER TABLE exercise_history_recordings_new RENAME TO exercise_history_recordings;
+
+CREATE INDEX IF NOT EXISTS idx_exercise_history_recordings_history_id ON exercise_history_recordings(history_id);
+CREATE INDEX IF NOT EXISTS idx_exercise_history_recordings_content_hash ON exercise_history_recordings(content_hash);
+
+-- Recordings are deleted along with their history entries, sessions,
+-- exercises and users, so their blobs are released by a trigger that also
+-- sees the cascades
+CREATE TRIGGER IF NOT EXISTS release_recording_blob
+AFTER DELETE ON exercise_history_recordings
+WHEN OLD.content_hash <> ''
+BEGIN
+    INSERT INTO blob_releases (content_hash) VALUES (OLD.content_hash)
+    ON CONFLICT (content_hash) DO UPDATE SET released_at = CURRENT_TIMESTAMP;
+END;
@@ -0,0 +1,15 @@
+-- A UID identifies one exercise of each user. Sources belong to the owner of
+-- their exercise, those of exercises created before users existed have no
+-- owner until the server assigns them to the first admin after migrating.
+ALTER TABLE exercise_sources ADD COLUMN user_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+
+UPDATE exercise_sources
+SET user_id = (SELECT e.user_id FROM exercises e WHERE e.id = exercise_sources.exercise_id);
+
+-- Of the exercises of a user sharing a UID, only the oldest keeps it and is
+-- updated by packs
+DELETE FROM exercise_sources
+WHERE exercise_id NOT IN (SELECT MIN(exercise_id) FROM exercise_sources GROUP BY user_id, uid);
+
+DROP INDEX IF EXISTS idx_exercise_sources_uid;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_exercise_sources_user_id_uid ON exercise_sources(user_id, uid);
@@ -0,0 +1 @@
+ALTER TABLE practice_sessions ADD COLUMN active INTEGER DEFAULT 0; -- Boolean
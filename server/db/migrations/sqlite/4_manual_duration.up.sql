@@ -0,0 +1 @@
+ALTER TABLE exercise_history ADD COLUMN duration_seconds INTEGER;
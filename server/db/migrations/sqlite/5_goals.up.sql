@@ -0,0 +1,20 @@
+-- Goals Table (target BPM per exercise)
+CREATE TABLE IF NOT EXISTS goals (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    exercise_id INTEGER NOT NULL,
+    target_bpm INTEGER NOT NULL,
+    target_date TIMESTAMP,
+    time_signature TEXT NOT NULL DEFAULT '',
+    achieved_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_goals_exercise_id ON goals(exercise_id);
+
+CREATE TRIGGER IF NOT EXISTS update_goals_timestamp
+AFTER UPDATE ON goals
+BEGIN
+    UPDATE goals SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/notation"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// notationColumns are the exercise_notations columns read by scanNotation,
+// the data is only read when asked for
+const notationColumns = "id, exercise_id, format, filename, title, time_signature, measure_count, created_at"
+
+// AddNotation reads notation and stores it for an exercise, the returned
+// notation carries no data
+func (r *exerciseRepo) AddNotation(ctx context.Context, n *pb.ExerciseNotation) (*pb.ExerciseNotation, error) {
+	if err := mustExist(ctx, r.db, "exercises", "exercise", n.ExerciseId); err != nil {
+		return nil, err
+	}
+
+	if err := readNotation(n); err != nil {
+		return nil, err
+	}
+
+	var createdAt time.Time
+	err := r.db.QueryRowContext(
+		ctx,
+		`INSERT INTO exercise_notations (exercise_id, format, data, filename, title, time_signature, measure_count)
+         VALUES (?, ?, ?, ?, ?, ?, ?) RETURNING id, created_at`,
+		n.ExerciseId, n.Format, n.Data, n.Filename, n.Title, n.TimeSignature, n.MeasureCount,
+	).Scan(&n.Id, &createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert notation: %w", err)
+	}
+
+	n.CreatedAt = timestamppb.New(createdAt)
+	n.SvgUrl = notationSVGURL(n.Id)
+	n.Data = nil
+
+	return n, nil
+}
+
+// GetNotation retrieves notation including its data
+func (r *exerciseRepo) GetNotation(ctx context.Context, id int32) (*pb.ExerciseNotation, error) {
+	var data []byte
+	n, err := scanNotation(r.db.QueryRowContext(
+		ctx,
+		"SELECT "+notationColumns+", data FROM exercise_notations WHERE id = ? AND "+ownedBy("exercise_notations"),
+		id, ownerID(ctx),
+	), &data)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "notation", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select notation: %w", err)
+	}
+	n.Data = data
+
+	return n, nil
+}
+
+// DeleteNotation removes notation
+func (r *exerciseRepo) DeleteNotation(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "exercise_notations", "notation", id); err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM exercise_notations WHERE id = ?", id); err != nil {
+		return fmt.Errorf("delete notation: %w", err)
+	}
+	return nil
+}
+
+// readNotation parses the data of notation, filling in its format when
+// unspecified and the details read from it
+func readNotation(n *pb.ExerciseNotation) error {
+	score, format, err := notation.Parse(n.Format, n.Data)
+	if err != nil {
+		return err
+	}
+
+	n.Format = format
+	n.Title = score.Title
+	n.TimeSignature = score.TimeSignature
+	n.MeasureCount = int32(len(score.Measures))
+	return nil
+}
+
+// notationTimeSignature returns the time signature of the first notation of
+// an exercise that has one, history entries default to it
+func notationTimeSignature(ctx context.Context, q querier, exerciseID int32) (string, error) {
+	var signature string
+	err := q.QueryRowContext(
+		ctx,
+		`SELECT time_signature FROM exercise_notations
+		WHERE exercise_id = ? AND time_signature <> ''
+		ORDER BY id LIMIT 1`,
+		exerciseID,
+	).Scan(&signature)
+	if err == sql.ErrNoRows {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("select notation time signature: %w", err)
+	}
+	return signature, nil
+}
+
+// scanNotation reads an exercise_notations row selected with notationColumns
+// and then any extra columns into extra
+func scanNotation(row rowScanner, extra ...any) (*pb.ExerciseNotation, error) {
+	var n pb.ExerciseNotation
+	var createdAt time.Time
+
+	dest := []any{
+		&n.Id, &n.ExerciseId, &n.Format, &n.Filename,
+		&n.Title, &n.TimeSignature, &n.MeasureCount, &createdAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	n.CreatedAt = timestamppb.New(createdAt)
+	n.SvgUrl = notationSVGURL(n.Id)
+
+	return &n, nil
+}
+
+// notationSVGURL returns the path the SVG preview of notation is served at
+func notationSVGURL(id int32) string {
+	return fmt.Sprintf("/api/v1/notations/%d/svg", id)
+}
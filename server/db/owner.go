@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type ownerKey struct{}
+
+// WithOwner returns a context in which the repositories act on the data of a
+// user: queries only see the rows of the user and created rows belong to them
+func WithOwner(ctx context.Context, userID int32) context.Context {
+	return context.WithValue(ctx, ownerKey{}, userID)
+}
+
+// ownerID returns the user the repositories act for. Without one it is 0,
+// which matches no rows and fails the foreign key of inserted ones.
+func ownerID(ctx context.Context) int32 {
+	id, _ := ctx.Value(ownerKey{}).(int32)
+	return id
+}
+
+// ownerConditions select the rows of the user given as parameter in each
+// table holding user data, directly or through the row they belong to.
+// Columns are unqualified.
+var ownerConditions = map[string]string{
+	"categories":                  "user_id = ?",
+	"tags":                        "user_id = ?",
+	"exercises":                   "user_id = ?",
+	"practice_sessions":           "user_id = ?",
+	"exercise_history":            "user_id = ?",
+	"goals":                       "user_id = ?",
+	"routines":                    "user_id = ?",
+	"exercise_images":             "exercise_id IN (SELECT id FROM exercises WHERE user_id = ?)",
+	"exercise_links":              "exercise_id IN (SELECT id FROM exercises WHERE user_id = ?)",
+	"exercise_notations":          "exercise_id IN (SELECT id FROM exercises WHERE user_id = ?)",
+	"exercise_history_recordings": "history_id IN (SELECT id FROM exercise_history WHERE user_id = ?)",
+	"session_segments":            "session_id IN (SELECT id FROM practice_sessions WHERE user_id = ?)",
+	"exercise_image_thumbnails":   "image_id IN (SELECT id FROM exercise_images WHERE exercise_id IN (SELECT id FROM exercises WHERE user_id = ?))",
+	// Assignments belong to the teacher who gave them, comments to their
+	// author
+	"assignments":      "teacher_id = ?",
+	"history_comments": "author_id = ?",
+}
+
+// ownedBy returns the condition selecting the rows of table owned by the user
+// given as parameter
+func ownedBy(table string) string {
+	condition, ok := ownerConditions[table]
+	if !ok {
+		panic(fmt.Sprintf("table %s has no owner", table))
+	}
+	return condition
+}
+
+// ownedTables are the tables with a user_id column
+var ownedTables = []string{
+	"categories", "tags", "exercises", "exercise_sources", "practice_sessions",
+	"exercise_history", "goals", "routines",
+}
+
+// ClaimUnowned assigns the data created before there were users to the first
+// admin and returns the number of rows assigned. Without an admin the data
+// stays unowned.
+func (s *Store) ClaimUnowned(ctx context.Context) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	var admin int32
+	err = tx.QueryRowContext(ctx, "SELECT id FROM users WHERE admin = ? ORDER BY id LIMIT 1", true).Scan(&admin)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("select admin: %w", err)
+	}
+
+	claimed := 0
+	for _, table := range ownedTables {
+		result, err := tx.ExecContext(ctx, "UPDATE "+table+" SET user_id = ? WHERE user_id IS NULL", admin)
+		if err != nil {
+			return 0, fmt.Errorf("claim %s: %w", table, err)
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("claim %s: %w", table, err)
+		}
+		claimed += int(n)
+	}
+
+	// The settings from before are kept unless the admin has their own
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE settings SET user_id = ? WHERE user_id IS NULL AND NOT EXISTS (SELECT 1 FROM settings WHERE user_id = ?)",
+		admin, admin,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("claim settings: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return claimed, nil
+}
@@ -0,0 +1,484 @@
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/blobstore"
+	"github.com/Zach-Johnson/tempus/server/imaging"
+	"github.com/Zach-Johnson/tempus/server/pack"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExportPack builds an exercise pack of exercises of the user with their tags
+// and categories. Exercises exported for the first time are given the UID
+// that identifies them in packs from then on.
+func (r *exerciseRepo) ExportPack(ctx context.Context, ids []int32, name, description string) (*pack.Pack, error) {
+	p := pack.New(name, description)
+
+	var tagIDs []int32
+	seenTags := make(map[int32]bool)
+	exerciseTags := make([][]int32, 0, len(ids))
+	for _, id := range ids {
+		exercise, err := r.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		uid, err := exerciseUID(ctx, r.db, id)
+		if err != nil {
+			return nil, err
+		}
+		version, err := packVersion(ctx, r.db, id)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := &pb.PackExercise{
+			Uid:         uid,
+			Name:        exercise.Name,
+			Description: exercise.Description,
+			UpdatedAt:   timestamppb.New(version),
+			TempoPlan:   exercise.TempoPlan,
+		}
+		for _, link := range exercise.Links {
+			entry.Links = append(entry.Links, &pb.PackLink{Url: link.Url, Description: link.Description})
+		}
+		for _, image := range exercise.Images {
+			data, err := imageData(ctx, r.db, r.blobs, image.Id)
+			if err != nil {
+				return nil, err
+			}
+			entry.Images = append(entry.Images, &pb.PackImage{
+				Path:        p.AddImage(data),
+				Filename:    image.Filename,
+				MimeType:    image.MimeType,
+				Description: image.Description,
+			})
+		}
+
+		exerciseTags = append(exerciseTags, exercise.TagIds)
+		for _, tagID := range exercise.TagIds {
+			if !seenTags[tagID] {
+				seenTags[tagID] = true
+				tagIDs = append(tagIDs, tagID)
+			}
+		}
+		p.Manifest.Exercises = append(p.Manifest.Exercises, entry)
+	}
+
+	tagNames, err := r.exportPackTags(ctx, p, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i, entry := range p.Manifest.Exercises {
+		for _, tagID := range exerciseTags[i] {
+			entry.Tags = append(entry.Tags, tagNames[tagID])
+		}
+	}
+
+	return p, nil
+}
+
+// exportPackTags adds tags and their categories to a pack and returns the
+// tag names by ID
+func (r *exerciseRepo) exportPackTags(ctx context.Context, p *pack.Pack, tagIDs []int32) (map[int32]string, error) {
+	names := make(map[int32]string, len(tagIDs))
+	if len(tagIDs) == 0 {
+		return names, nil
+	}
+	marks, args := placeholders(tagIDs)
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT id, name FROM tags WHERE id IN ("+marks+") AND user_id = ? ORDER BY name",
+		append(args, ownerID(ctx))...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := make(map[int32]*pb.PackTag, len(tagIDs))
+	for rows.Next() {
+		var id int32
+		tag := &pb.PackTag{}
+		if err := rows.Scan(&id, &tag.Name); err != nil {
+			return nil, fmt.Errorf("scan tag: %w", err)
+		}
+		names[id] = tag.Name
+		tags[id] = tag
+		p.Manifest.Tags = append(p.Manifest.Tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read tags: %w", err)
+	}
+	rows.Close()
+
+	categoryRows, err := r.db.QueryContext(
+		ctx,
+		`SELECT tc.tag_id, c.name, c.description
+		FROM tag_categories tc
+		JOIN categories c ON c.id = tc.category_id
+		WHERE tc.tag_id IN (`+marks+`)
+		ORDER BY c.name`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select tag categories: %w", err)
+	}
+	defer categoryRows.Close()
+
+	seen := make(map[string]bool)
+	for categoryRows.Next() {
+		var tagID int32
+		var category pb.PackCategory
+		if err := categoryRows.Scan(&tagID, &category.Name, &category.Description); err != nil {
+			return nil, fmt.Errorf("scan tag category: %w", err)
+		}
+		if tag, ok := tags[tagID]; ok {
+			tag.Categories = append(tag.Categories, category.Name)
+		}
+		if !seen[category.Name] {
+			seen[category.Name] = true
+			p.Manifest.Categories = append(p.Manifest.Categories, &category)
+		}
+	}
+	if err := categoryRows.Err(); err != nil {
+		return nil, fmt.Errorf("read tag categories: %w", err)
+	}
+
+	return names, nil
+}
+
+// exerciseUID returns the UID of an exercise in packs, assigning one the
+// first time
+func exerciseUID(ctx context.Context, q querier, id int32) (string, error) {
+	_, err := q.ExecContext(
+		ctx,
+		`INSERT INTO exercise_sources (exercise_id, user_id, uid)
+		SELECT id, user_id, ? FROM exercises WHERE id = ?
+		ON CONFLICT (exercise_id) DO NOTHING`,
+		rand.Text(), id,
+	)
+	if err != nil {
+		return "", fmt.Errorf("assign exercise UID: %w", err)
+	}
+
+	var uid string
+	if err := q.QueryRowContext(ctx, "SELECT uid FROM exercise_sources WHERE exercise_id = ?", id).Scan(&uid); err != nil {
+		return "", fmt.Errorf("select exercise UID: %w", err)
+	}
+	return uid, nil
+}
+
+// packVersion returns the update time an exercise is exported with. Exercises
+// imported from a pack and not edited since keep the time of the pack, so
+// that passing a pack on does not make it look newer.
+func packVersion(ctx context.Context, q querier, id int32) (time.Time, error) {
+	edited, err := editedAt(ctx, q, id)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var packUpdatedAt, importedAt sql.NullTime
+	err = q.QueryRowContext(
+		ctx,
+		"SELECT pack_updated_at, imported_at FROM exercise_sources WHERE exercise_id = ?",
+		id,
+	).Scan(&packUpdatedAt, &importedAt)
+	if err != nil && err != sql.ErrNoRows {
+		return time.Time{}, fmt.Errorf("select exercise source: %w", err)
+	}
+
+	if packUpdatedAt.Valid && importedAt.Valid && !edited.After(importedAt.Time) {
+		return packUpdatedAt.Time, nil
+	}
+	return edited, nil
+}
+
+// editedAt returns when an exercise was last edited. Adding images or links
+// leaves the exercise row as it is, they count as edits as well.
+func editedAt(ctx context.Context, q querier, id int32) (time.Time, error) {
+	var edited time.Time
+	if err := q.QueryRowContext(ctx, "SELECT updated_at FROM exercises WHERE id = ?", id).Scan(&edited); err != nil {
+		return time.Time{}, fmt.Errorf("select exercise update time: %w", err)
+	}
+
+	for _, table := range []string{"exercise_links", "exercise_images"} {
+		var createdAt time.Time
+		err := q.QueryRowContext(
+			ctx,
+			"SELECT created_at FROM "+table+" WHERE exercise_id = ? ORDER BY created_at DESC LIMIT 1",
+			id,
+		).Scan(&createdAt)
+		if err == sql.ErrNoRows {
+			continue
+		} else if err != nil {
+			return time.Time{}, fmt.Errorf("select latest of %s: %w", table, err)
+		}
+		if createdAt.After(edited) {
+			edited = createdAt
+		}
+	}
+
+	return edited, nil
+}
+
+// ImportPack writes an exercise pack in a single transaction as data of the
+// user. Tags and categories are merged with those of the same name. Exercises
+// imported before, from any version of the pack, are updated when the pack
+// holds a newer version of them and left as they are otherwise.
+func (r *exerciseRepo) ImportPack(ctx context.Context, p *pack.Pack) (*pb.ImportExercisePackResponse, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	imp := &packImporter{
+		tx:         tx,
+		blobs:      r.blobs,
+		owner:      ownerID(ctx),
+		pack:       p,
+		categories: make(map[string]int32),
+		tags:       make(map[string]int32),
+		summary:    &pb.ImportExercisePackResponse{},
+	}
+
+	steps := []func(context.Context) error{
+		imp.importCategories,
+		imp.importTags,
+		imp.importExercises,
+	}
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	if err := releaseBlobs(ctx, r.db, imp.replaced); err != nil {
+		return nil, err
+	}
+
+	return imp.summary, nil
+}
+
+// packImporter writes the entities of an exercise pack, tracking the IDs of
+// the tags and categories by name
+type packImporter struct {
+	tx    *txConn
+	blobs blobstore.BlobStore
+	owner int32
+	pack  *pack.Pack
+
+	categories map[string]int32
+	tags       map[string]int32
+	// replaced are the hashes of the images replaced by newer versions
+	replaced []string
+
+	summary *pb.ImportExercisePackResponse
+}
+
+func (imp *packImporter) importCategories(ctx context.Context) error {
+	for _, category := range imp.pack.Manifest.Categories {
+		id, found, err := idByName(ctx, imp.tx, "categories", category.Name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			err := imp.tx.QueryRowContext(
+				ctx,
+				"INSERT INTO categories (user_id, name, description) VALUES (?, ?, ?) RETURNING id",
+				imp.owner, category.Name, category.Description,
+			).Scan(&id)
+			if err != nil {
+				return fmt.Errorf("insert category: %w", err)
+			}
+			imp.summary.Categories++
+		}
+		imp.categories[category.Name] = id
+	}
+	return nil
+}
+
+func (imp *packImporter) importTags(ctx context.Context) error {
+	for _, tag := range imp.pack.Manifest.Tags {
+		id, found, err := idByName(ctx, imp.tx, "tags", tag.Name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			err := imp.tx.QueryRowContext(
+				ctx,
+				"INSERT INTO tags (user_id, name) VALUES (?, ?) RETURNING id",
+				imp.owner, tag.Name,
+			).Scan(&id)
+			if err != nil {
+				return fmt.Errorf("insert tag: %w", err)
+			}
+			imp.summary.Tags++
+		}
+		imp.tags[tag.Name] = id
+
+		for _, name := range tag.Categories {
+			_, err := imp.tx.ExecContext(
+				ctx,
+				"INSERT INTO tag_categories (tag_id, category_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+				id, imp.categories[name],
+			)
+			if err != nil {
+				return fmt.Errorf("associate tag with category: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (imp *packImporter) importExercises(ctx context.Context) error {
+	for _, exercise := range imp.pack.Manifest.Exercises {
+		// Postgres keeps microseconds, compared times must survive storing
+		updatedAt := exercise.UpdatedAt.AsTime().Truncate(time.Microsecond)
+
+		var id int32
+		err := imp.tx.QueryRowContext(
+			ctx,
+			"SELECT exercise_id FROM exercise_sources WHERE user_id = ? AND uid = ?",
+			imp.owner, exercise.Uid,
+		).Scan(&id)
+
+		switch {
+		case err == sql.ErrNoRows:
+			err = imp.tx.QueryRowContext(
+				ctx,
+				`INSERT INTO exercises (user_id, name, description, `+strings.Join(tempoPlanColumns, ", ")+`)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING id`,
+				append([]any{imp.owner, exercise.Name, exercise.Description}, tempoPlanValues(exercise.TempoPlan)...)...,
+			).Scan(&id)
+			if err != nil {
+				return fmt.Errorf("insert exercise: %w", err)
+			}
+			imp.summary.Created++
+
+		case err != nil:
+			return fmt.Errorf("select exercise by UID: %w", err)
+
+		default:
+			current, err := packVersion(ctx, imp.tx, id)
+			if err != nil {
+				return err
+			}
+			if !updatedAt.After(current) {
+				imp.summary.Unchanged++
+				continue
+			}
+
+			if err := imp.clearExercise(ctx, id); err != nil {
+				return err
+			}
+			_, err = imp.tx.ExecContext(
+				ctx,
+				"UPDATE exercises SET name = ?, description = ?, "+strings.Join(tempoPlanColumns, " = ?, ")+" = ? WHERE id = ?",
+				append(append([]any{exercise.Name, exercise.Description}, tempoPlanValues(exercise.TempoPlan)...), id)...,
+			)
+			if err != nil {
+				return fmt.Errorf("update exercise: %w", err)
+			}
+			imp.summary.Updated++
+		}
+
+		if err := imp.addContents(ctx, id, exercise); err != nil {
+			return err
+		}
+
+		// Recorded last, edits after the import time are edits made here
+		_, err = imp.tx.ExecContext(
+			ctx,
+			`INSERT INTO exercise_sources (exercise_id, user_id, uid, pack_name, pack_updated_at, imported_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT (exercise_id) DO UPDATE SET
+				pack_name = excluded.pack_name,
+				pack_updated_at = excluded.pack_updated_at,
+				imported_at = excluded.imported_at`,
+			id, imp.owner, exercise.Uid, imp.pack.Manifest.Name, updatedAt, time.Now().UTC(),
+		)
+		if err != nil {
+			return fmt.Errorf("record exercise source: %w", err)
+		}
+	}
+	return nil
+}
+
+// clearExercise removes the tags, links and images of an exercise about to be
+// replaced by its version in the pack
+func (imp *packImporter) clearExercise(ctx context.Context, id int32) error {
+	hashes, err := imageHashes(ctx, imp.tx, "WHERE exercise_id = ?", id)
+	if err != nil {
+		return err
+	}
+	imp.replaced = append(imp.replaced, hashes...)
+
+	for _, table := range []string{"exercise_tags", "exercise_links", "exercise_images"} {
+		if _, err := imp.tx.ExecContext(ctx, "DELETE FROM "+table+" WHERE exercise_id = ?", id); err != nil {
+			return fmt.Errorf("delete from %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// addContents adds the tags, links and images of an exercise in the pack
+func (imp *packImporter) addContents(ctx context.Context, id int32, exercise *pb.PackExercise) error {
+	for _, name := range exercise.Tags {
+		_, err := imp.tx.ExecContext(
+			ctx,
+			"INSERT INTO exercise_tags (exercise_id, tag_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+			id, imp.tags[name],
+		)
+		if err != nil {
+			return fmt.Errorf("associate exercise with tag: %w", err)
+		}
+	}
+
+	for _, link := range exercise.Links {
+		if _, err := insertLink(ctx, imp.tx, &pb.ExerciseLink{ExerciseId: id, Url: link.Url, Description: link.Description}); err != nil {
+			return err
+		}
+	}
+
+	for _, image := range exercise.Images {
+		// Images from other instances are processed like uploads
+		processed, err := imaging.Process(imp.pack.Images[image.Path])
+		if err != nil {
+			return fmt.Errorf("image %s: %w", image.Path, err)
+		}
+
+		var imageID int32
+		err = imp.tx.QueryRowContext(
+			ctx,
+			`INSERT INTO exercise_images (exercise_id, content_hash, size_bytes, width, height, filename, mime_type, description)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?) RETURNING id`,
+			id, blobstore.Key(processed.Data), len(processed.Data), processed.Width, processed.Height,
+			image.Filename, processed.MimeType, image.Description,
+		).Scan(&imageID)
+		if err != nil {
+			return fmt.Errorf("insert image: %w", err)
+		}
+		if _, err := insertThumbnails(ctx, imp.tx, imageID, processed.Thumbnails); err != nil {
+			return err
+		}
+		if err := putImage(ctx, imp.tx, imp.blobs, processed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,332 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/pack"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// pngImage returns a PNG of a single color
+func pngImage(t *testing.T, c color.Color) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, 16, 8))
+	for x := range 16 {
+		for y := range 8 {
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// userExercises lists the exercises of a user by name
+func userExercises(t *testing.T, s *Store, ctx context.Context) map[string]*pb.Exercise {
+	t.Helper()
+
+	page, err := s.Exercises().List(ctx, ExerciseFilter{}, ExerciseOrder{}, ListOptions{Limit: 100})
+	if err != nil {
+		t.Fatalf("list exercises: %v", err)
+	}
+	exercises := make(map[string]*pb.Exercise, len(page.Items))
+	for _, exercise := range page.Items {
+		full, err := s.Exercises().Get(ctx, exercise.Id)
+		if err != nil {
+			t.Fatalf("get exercise: %v", err)
+		}
+		exercises[exercise.Name] = full
+	}
+	return exercises
+}
+
+// tagIDs returns the IDs of the tags of a user by name
+func tagIDs(t *testing.T, s *Store, ctx context.Context) map[string]int32 {
+	t.Helper()
+
+	page, err := s.Tags().List(ctx, TagFilter{}, ListOptions{Limit: 100})
+	if err != nil {
+		t.Fatalf("list tags: %v", err)
+	}
+	ids := make(map[string]int32, len(page.Items))
+	for _, tag := range page.Items {
+		ids[tag.Name] = tag.Id
+	}
+	return ids
+}
+
+// reread writes a pack as an archive and reads it back, as importing an
+// exported pack does
+func reread(t *testing.T, p *pack.Pack) *pack.Pack {
+	t.Helper()
+
+	data, err := p.Bytes()
+	if err != nil {
+		t.Fatalf("write pack: %v", err)
+	}
+	read, err := pack.Read(data)
+	if err != nil {
+		t.Fatalf("read pack: %v", err)
+	}
+	return read
+}
+
+func TestPackRoundTrip(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, s *Store) {
+		alice := userContext(t, s, "alice")
+		bob := userContext(t, s, "bob")
+
+		// Alice exports an exercise with a tag, a link and an image
+		hands, err := s.Categories().Create(alice, &pb.Category{Name: "Hands", Description: "Stick control"})
+		if err != nil {
+			t.Fatalf("create category: %v", err)
+		}
+		rudiment, err := s.Tags().Create(alice, "Rudiment", []int32{hands.Id})
+		if err != nil {
+			t.Fatalf("create tag: %v", err)
+		}
+		plan := &pb.TempoPlan{StartBpm: 80, EndBpm: 120, Increment: 5}
+		paradiddle, err := s.Exercises().Create(alice, &pb.Exercise{
+			Name:        "Paradiddle",
+			Description: "RLRR LRLL",
+			TagIds:      []int32{rudiment.Id},
+			TempoPlan:   plan,
+		})
+		if err != nil {
+			t.Fatalf("create exercise: %v", err)
+		}
+		if _, err := s.Exercises().AddLink(alice, &pb.ExerciseLink{ExerciseId: paradiddle.Id, Url: "https://example.com/paradiddle"}); err != nil {
+			t.Fatalf("add link: %v", err)
+		}
+		if _, err := s.Exercises().AddImage(alice, &pb.ExerciseImage{ExerciseId: paradiddle.Id, ImageData: pngImage(t, color.White), Filename: "sticking.png"}); err != nil {
+			t.Fatalf("add image: %v", err)
+		}
+
+		p, err := s.Exercises().ExportPack(alice, []int32{paradiddle.Id}, "Rudiments", "")
+		if err != nil {
+			t.Fatalf("ExportPack: %v", err)
+		}
+		again, err := s.Exercises().ExportPack(alice, []int32{paradiddle.Id}, "Rudiments", "")
+		if err != nil {
+			t.Fatalf("ExportPack: %v", err)
+		}
+		uid := p.Manifest.Exercises[0].Uid
+		if uid == "" || again.Manifest.Exercises[0].Uid != uid {
+			t.Fatalf("exported UIDs %q and %q, want the same one", uid, again.Manifest.Exercises[0].Uid)
+		}
+		if _, err := s.Exercises().ExportPack(bob, []int32{paradiddle.Id}, "Stolen", ""); err == nil {
+			t.Error("ExportPack of the exercise of another user succeeded")
+		}
+
+		// Bob has a tag and category of the same names, they are merged
+		bobHands, err := s.Categories().Create(bob, &pb.Category{Name: "Hands"})
+		if err != nil {
+			t.Fatalf("create category: %v", err)
+		}
+		bobRudiment, err := s.Tags().Create(bob, "Rudiment", nil)
+		if err != nil {
+			t.Fatalf("create tag: %v", err)
+		}
+
+		imported := reread(t, p)
+		summary, err := s.Exercises().ImportPack(bob, imported)
+		if err != nil {
+			t.Fatalf("ImportPack: %v", err)
+		}
+		want := &pb.ImportExercisePackResponse{Created: 1}
+		if !proto.Equal(summary, want) {
+			t.Errorf("first import %v, want %v", summary, want)
+		}
+
+		got := userExercises(t, s, bob)["Paradiddle"]
+		if got == nil {
+			t.Fatal("imported exercise not found")
+		}
+		if got.Description != "RLRR LRLL" || !proto.Equal(got.TempoPlan, plan) {
+			t.Errorf("imported %v, want the exported description and tempo plan", got)
+		}
+		if len(got.TagIds) != 1 || got.TagIds[0] != bobRudiment.Id {
+			t.Errorf("imported tags %v, want Bob's tag %d", got.TagIds, bobRudiment.Id)
+		}
+		if len(got.Links) != 1 || len(got.Images) != 1 {
+			t.Errorf("imported %d links and %d images, want 1 of each", len(got.Links), len(got.Images))
+		}
+		tag, err := s.Tags().Get(bob, bobRudiment.Id)
+		if err != nil {
+			t.Fatalf("get tag: %v", err)
+		}
+		if len(tag.CategoryIds) != 1 || tag.CategoryIds[0] != bobHands.Id {
+			t.Errorf("merged tag categories %v, want Bob's category %d", tag.CategoryIds, bobHands.Id)
+		}
+		if _, ok := userExercises(t, s, alice)["Paradiddle"]; !ok || len(tagIDs(t, s, alice)) != 1 {
+			t.Error("importing changed the data of the exporting user")
+		}
+
+		// The same pack again leaves the exercise as it is
+		summary, err = s.Exercises().ImportPack(bob, reread(t, p))
+		if err != nil {
+			t.Fatalf("second ImportPack: %v", err)
+		}
+		if want := (&pb.ImportExercisePackResponse{Unchanged: 1}); !proto.Equal(summary, want) {
+			t.Errorf("second import %v, want %v", summary, want)
+		}
+		if exercises := userExercises(t, s, bob); len(exercises) != 1 {
+			t.Errorf("Bob has %d exercises after a second import, want 1", len(exercises))
+		}
+
+		// Bob's export keeps the UID and update time of the pack, so that
+		// passing it on does not make it look newer
+		passed, err := s.Exercises().ExportPack(bob, []int32{got.Id}, "Rudiments", "")
+		if err != nil {
+			t.Fatalf("ExportPack: %v", err)
+		}
+		if exercise := passed.Manifest.Exercises[0]; exercise.Uid != uid || !exercise.UpdatedAt.AsTime().Equal(p.Manifest.Exercises[0].UpdatedAt.AsTime().Truncate(time.Microsecond)) {
+			t.Errorf("passed on as %s at %v, want %s at %v", exercise.Uid, exercise.UpdatedAt.AsTime(), uid, p.Manifest.Exercises[0].UpdatedAt.AsTime())
+		}
+
+		// Importing her own export finds Alice's exercise by its UID
+		summary, err = s.Exercises().ImportPack(alice, reread(t, p))
+		if err != nil {
+			t.Fatalf("ImportPack: %v", err)
+		}
+		if want := (&pb.ImportExercisePackResponse{Unchanged: 1}); !proto.Equal(summary, want) {
+			t.Errorf("import into the exporting user %v, want %v", summary, want)
+		}
+	})
+}
+
+func TestPackReimport(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, s *Store) {
+		ctx := userContext(t, s, "alice")
+		updated := time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC)
+
+		version := func(name string, at time.Time, tags ...string) *pack.Pack {
+			p := pack.New("Rudiments", "")
+			p.Manifest.Tags = []*pb.PackTag{{Name: "Rudiment"}, {Name: "Warmup"}}
+			p.Manifest.Exercises = []*pb.PackExercise{{
+				Uid:       "UID1",
+				Name:      name,
+				UpdatedAt: timestamppb.New(at),
+				Tags:      tags,
+				Links:     []*pb.PackLink{{Url: "https://example.com/" + name}},
+				Images:    []*pb.PackImage{{Path: p.AddImage(pngImage(t, color.White)), Filename: name + ".png"}},
+			}}
+			return reread(t, p)
+		}
+		importPack := func(p *pack.Pack) *pb.ImportExercisePackResponse {
+			t.Helper()
+			summary, err := s.Exercises().ImportPack(ctx, p)
+			if err != nil {
+				t.Fatalf("ImportPack: %v", err)
+			}
+			return summary
+		}
+
+		if summary := importPack(version("Paradiddle", updated, "Rudiment")); summary.Created != 1 || summary.Tags != 2 {
+			t.Fatalf("first import %v, want an exercise and 2 tags created", summary)
+		}
+
+		// A newer version replaces the exercise and its contents
+		newer := updated.Add(time.Hour)
+		summary := importPack(version("Double paradiddle", newer, "Warmup"))
+		if want := (&pb.ImportExercisePackResponse{Updated: 1}); !proto.Equal(summary, want) {
+			t.Errorf("newer import %v, want %v", summary, want)
+		}
+		exercises := userExercises(t, s, ctx)
+		got, ok := exercises["Double paradiddle"]
+		if len(exercises) != 1 || !ok {
+			t.Fatalf("exercises %v after an update, want the updated one only", exercises)
+		}
+		if tags := tagIDs(t, s, ctx); len(got.TagIds) != 1 || got.TagIds[0] != tags["Warmup"] {
+			t.Errorf("updated tags %v, want only Warmup %d", got.TagIds, tags["Warmup"])
+		}
+		if len(got.Links) != 1 || got.Links[0].Url != "https://example.com/Double paradiddle" {
+			t.Errorf("updated links %v, want the link of the newer version", got.Links)
+		}
+		if len(got.Images) != 1 || got.Images[0].Filename != "Double paradiddle.png" {
+			t.Errorf("updated images %v, want the image of the newer version", got.Images)
+		}
+
+		// An older version, or the same one, is left out
+		for _, at := range []time.Time{updated, newer} {
+			summary := importPack(version("Paradiddle", at, "Rudiment"))
+			if want := (&pb.ImportExercisePackResponse{Unchanged: 1}); !proto.Equal(summary, want) {
+				t.Errorf("import of the version of %v: %v, want %v", at, summary, want)
+			}
+		}
+
+		// Local edits are newer than the pack they were imported from. Update
+		// times are kept to the second, the import is moved back to tell them
+		// apart.
+		if _, err := s.db.ExecContext(ctx, "UPDATE exercise_sources SET imported_at = ?", time.Now().UTC().Add(-time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+		name := "Edited paradiddle"
+		if _, err := s.Exercises().Update(ctx, got.Id, ExerciseUpdate{Name: &name}); err != nil {
+			t.Fatalf("update exercise: %v", err)
+		}
+		summary = importPack(version("Paradiddle", newer.Add(time.Minute), "Rudiment"))
+		if want := (&pb.ImportExercisePackResponse{Unchanged: 1}); !proto.Equal(summary, want) {
+			t.Errorf("import over a local edit %v, want %v", summary, want)
+		}
+
+		// A UID identifies one exercise of each user
+		var sources int
+		if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM exercise_sources WHERE uid = ?", "UID1").Scan(&sources); err != nil {
+			t.Fatal(err)
+		}
+		if sources != 1 {
+			t.Errorf("%d sources of UID1, want 1", sources)
+		}
+		other, err := s.Exercises().Create(ctx, &pb.Exercise{Name: "Flam"})
+		if err != nil {
+			t.Fatalf("create exercise: %v", err)
+		}
+		_, err = s.db.ExecContext(
+			ctx,
+			"INSERT INTO exercise_sources (exercise_id, user_id, uid) VALUES (?, ?, ?)",
+			other.Id, ownerID(ctx), "UID1",
+		)
+		if !s.db.dialect.uniqueViolation(err) {
+			t.Errorf("second exercise with UID1: err = %v, want a unique violation", err)
+		}
+	})
+}
+
+func TestPackImportRejects(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, s *Store) {
+		ctx := userContext(t, s, "alice")
+
+		// Images are processed like uploads, the pack is imported entirely
+		// or not at all
+		p := pack.New("Broken", "")
+		p.Manifest.Categories = []*pb.PackCategory{{Name: "Hands"}}
+		p.Manifest.Tags = []*pb.PackTag{{Name: "Rudiment", Categories: []string{"Hands"}}}
+		updated := timestamppb.New(time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC))
+		p.Manifest.Exercises = []*pb.PackExercise{
+			{Uid: "UID1", Name: "Paradiddle", UpdatedAt: updated, Tags: []string{"Rudiment"}},
+			{Uid: "UID2", Name: "Flam", UpdatedAt: updated, Images: []*pb.PackImage{{Path: p.AddImage([]byte("not an image"))}}},
+		}
+
+		if summary, err := s.Exercises().ImportPack(ctx, reread(t, p)); err == nil {
+			t.Fatalf("ImportPack = %v, want an error for the image", summary)
+		}
+		if exercises := userExercises(t, s, ctx); len(exercises) != 0 {
+			t.Errorf("%d exercises after a failed import, want none", len(exercises))
+		}
+		if tags := tagIDs(t, s, ctx); len(tags) != 0 {
+			t.Errorf("tags %v after a failed import, want none", tags)
+		}
+	})
+}
@@ -0,0 +1,347 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/blobstore"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// recordingColumns are the exercise_history_recordings columns read by
+// scanRecording
+const recordingColumns = "id, history_id, filename, mime_type, duration_seconds, size_bytes, created_at"
+
+// recordingReadSize is the amount of audio read from the database at a time
+const recordingReadSize = 1 << 20
+
+// AddRecording stores the audio of a recording for a history entry, the
+// returned recording carries no audio. The audio is put in the blob store
+// before the transaction that inserts the recording so that a long upload
+// does not hold up the database.
+func (r *historyRepo) AddRecording(ctx context.Context, recording *pb.ExerciseHistoryRecording, audio io.ReadSeeker) (*pb.ExerciseHistoryRecording, error) {
+	if err := mustExist(ctx, r.db, "exercise_history", "exercise history entry", recording.HistoryId); err != nil {
+		return nil, err
+	}
+
+	hash, size, err := blobstore.KeyReader(audio)
+	if err != nil {
+		return nil, fmt.Errorf("read recording audio: %w", err)
+	}
+	if _, err := audio.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("read recording audio: %w", err)
+	}
+	if err := r.blobs.PutReader(ctx, hash, audio, size); err != nil {
+		return nil, fmt.Errorf("store recording audio: %w", err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	inserted, err := insertRecording(ctx, tx, recording, hash, size)
+	if err != nil {
+		return nil, err
+	}
+	if err := putRecordingBlob(ctx, tx, r.blobs, hash, audio, size); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return inserted, nil
+}
+
+// GetRecording retrieves the details of a recording
+func (r *historyRepo) GetRecording(ctx context.Context, id int32) (*pb.ExerciseHistoryRecording, error) {
+	recording, err := scanRecording(r.db.QueryRowContext(
+		ctx,
+		"SELECT "+recordingColumns+" FROM exercise_history_recordings WHERE id = ? AND "+ownedBy("exercise_history_recordings"),
+		id, ownerID(ctx),
+	))
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "recording", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select recording: %w", err)
+	}
+
+	return recording, nil
+}
+
+// OpenRecording retrieves the details of a recording along with a reader of
+// its audio, which reads from the blob store as it goes and is only valid as
+// long as ctx is
+func (r *historyRepo) OpenRecording(ctx context.Context, id int32) (*pb.ExerciseHistoryRecording, io.ReadSeekCloser, error) {
+	recording, err := r.GetRecording(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var hash string
+	err = r.db.QueryRowContext(ctx, "SELECT content_hash FROM exercise_history_recordings WHERE id = ?", id).Scan(&hash)
+	if err == sql.ErrNoRows {
+		return nil, nil, &NotFoundError{Entity: "recording", ID: id}
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("select recording: %w", err)
+	}
+	if hash == "" {
+		// Not moved to the blob store yet
+		return recording, &recordingReader{ctx: ctx, q: r.db, id: id, size: recording.SizeBytes}, nil
+	}
+
+	audio, err := r.blobs.Open(ctx, hash)
+	if errors.Is(err, blobstore.ErrNotFound) {
+		return nil, nil, fmt.Errorf("audio of recording %d is missing from the blob store", id)
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("open recording audio: %w", err)
+	}
+	return recording, audio, nil
+}
+
+// DeleteRecording removes a recording
+func (r *historyRepo) DeleteRecording(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "exercise_history_recordings", "recording", id); err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM exercise_history_recordings WHERE id = ?", id); err != nil {
+		return fmt.Errorf("delete recording: %w", err)
+	}
+
+	return nil
+}
+
+// insertRecording inserts a recording row for audio stored under hash, the
+// returned recording carries no audio
+func insertRecording(ctx context.Context, q querier, recording *pb.ExerciseHistoryRecording, hash string, size int64) (*pb.ExerciseHistoryRecording, error) {
+	var id int32
+	var createdAt time.Time
+
+	err := q.QueryRowContext(
+		ctx,
+		`INSERT INTO exercise_history_recordings (history_id, content_hash, filename, mime_type, duration_seconds, size_bytes)
+         VALUES (?, ?, ?, ?, ?, ?) RETURNING id, created_at`,
+		recording.HistoryId, hash, recording.Filename, recording.MimeType, recording.DurationSeconds, size,
+	).Scan(&id, &createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert recording: %w", err)
+	}
+
+	return &pb.ExerciseHistoryRecording{
+		Id:              id,
+		HistoryId:       recording.HistoryId,
+		Filename:        recording.Filename,
+		MimeType:        recording.MimeType,
+		DurationSeconds: recording.DurationSeconds,
+		SizeBytes:       size,
+		CreatedAt:       timestamppb.New(createdAt),
+		DownloadUrl:     recordingURL(id),
+	}, nil
+}
+
+// putRecordingBlob streams the audio of a recording to the blob store, see
+// putBlob. Audio put ahead of the transaction is only read again if a sweep
+// deleted it meanwhile.
+func putRecordingBlob(ctx context.Context, q querier, blobs blobstore.BlobStore, hash string, audio io.ReadSeeker, size int64) error {
+	if _, err := q.ExecContext(ctx, "DELETE FROM blob_releases WHERE content_hash = ?", hash); err != nil {
+		return fmt.Errorf("claim released blob: %w", err)
+	}
+	if _, err := audio.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("read recording audio: %w", err)
+	}
+	if err := blobs.PutReader(ctx, hash, audio, size); err != nil {
+		return fmt.Errorf("store recording audio: %w", err)
+	}
+	return nil
+}
+
+// MoveRecordingBlobs moves the audio of recordings still stored in the
+// database to the blob store, one recording at a time so that an interrupted
+// move resumes where it stopped. It returns the number of recordings moved.
+func (s *Store) MoveRecordingBlobs(ctx context.Context) (int, error) {
+	moved := 0
+	for {
+		var id int32
+		var size int64
+		err := s.db.QueryRowContext(
+			ctx,
+			"SELECT id, size_bytes FROM exercise_history_recordings WHERE audio_data IS NOT NULL ORDER BY id LIMIT 1",
+		).Scan(&id, &size)
+		if err == sql.ErrNoRows {
+			return moved, nil
+		} else if err != nil {
+			return moved, fmt.Errorf("select recording: %w", err)
+		}
+
+		if err := s.moveRecordingBlob(ctx, id, size); err != nil {
+			return moved, err
+		}
+		moved++
+	}
+}
+
+// moveRecordingBlob moves the audio of a recording to the blob store, reading
+// it from the database a block at a time
+func (s *Store) moveRecordingBlob(ctx context.Context, id int32, size int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	audio := &recordingReader{ctx: ctx, q: tx, id: id, size: size}
+	hash, _, err := blobstore.KeyReader(audio)
+	if err != nil {
+		return fmt.Errorf("read recording %d: %w", id, err)
+	}
+	// The audio is read from the row, so it is put before the row is updated
+	if err := putRecordingBlob(ctx, tx, s.blobs, hash, audio, size); err != nil {
+		return fmt.Errorf("recording %d: %w", id, err)
+	}
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE exercise_history_recordings SET content_hash = ?, audio_data = NULL WHERE id = ?",
+		hash, id,
+	)
+	if err != nil {
+		return fmt.Errorf("update recording %d: %w", id, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// loadRecordings returns the recordings of history entries by entry ID
+func loadRecordings(ctx context.Context, q querier, historyIDs []int32) (map[int32][]*pb.ExerciseHistoryRecording, error) {
+	recordings := make(map[int32][]*pb.ExerciseHistoryRecording)
+	if len(historyIDs) == 0 {
+		return recordings, nil
+	}
+
+	marks, args := placeholders(historyIDs)
+	rows, err := q.QueryContext(
+		ctx,
+		"SELECT "+recordingColumns+" FROM exercise_history_recordings WHERE history_id IN ("+marks+") ORDER BY id",
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select recordings: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		recording, err := scanRecording(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan recording: %w", err)
+		}
+		recordings[recording.HistoryId] = append(recordings[recording.HistoryId], recording)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read recordings: %w", err)
+	}
+
+	return recordings, nil
+}
+
+// scanRecording reads an exercise_history_recordings row selected with
+// recordingColumns
+func scanRecording(row rowScanner) (*pb.ExerciseHistoryRecording, error) {
+	var recording pb.ExerciseHistoryRecording
+	var createdAt time.Time
+
+	err := row.Scan(
+		&recording.Id, &recording.HistoryId, &recording.Filename, &recording.MimeType,
+		&recording.DurationSeconds, &recording.SizeBytes, &createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	recording.CreatedAt = timestamppb.New(createdAt)
+	recording.DownloadUrl = recordingURL(recording.Id)
+
+	return &recording, nil
+}
+
+// recordingURL returns the path the audio of a recording is served at
+func recordingURL(id int32) string {
+	return fmt.Sprintf("/api/v1/recordings/%d/audio", id)
+}
+
+// recordingReader reads the audio of a recording that is still stored in the
+// database a block at a time, so that serving a range of a long recording
+// does not load all of it
+type recordingReader struct {
+	ctx  context.Context
+	q    querier
+	id   int32
+	size int64
+	off  int64
+
+	buf    []byte // Block read last
+	bufOff int64  // Offset of the block
+}
+
+// Read implements io.Reader
+func (r *recordingReader) Read(p []byte) (int, error) {
+	if r.off >= r.size {
+		return 0, io.EOF
+	}
+
+	if r.off < r.bufOff || r.off >= r.bufOff+int64(len(r.buf)) {
+		var block []byte
+		err := r.q.QueryRowContext(
+			r.ctx,
+			"SELECT substr(audio_data, ?, ?) FROM exercise_history_recordings WHERE id = ?",
+			r.off+1, min(recordingReadSize, r.size-r.off), r.id,
+		).Scan(&block)
+		if err == sql.ErrNoRows {
+			return 0, &NotFoundError{Entity: "recording", ID: r.id}
+		} else if err != nil {
+			return 0, fmt.Errorf("read recording: %w", err)
+		}
+		if len(block) == 0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		r.buf, r.bufOff = block, r.off
+	}
+
+	n := copy(p, r.buf[r.off-r.bufOff:])
+	r.off += int64(n)
+	return n, nil
+}
+
+// Seek implements io.Seeker
+func (r *recordingReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.off
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	r.off = offset
+	return offset, nil
+}
+
+// Close implements io.Closer, the reader holds nothing open
+func (r *recordingReader) Close() error {
+	return nil
+}
@@ -0,0 +1,169 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/blobstore"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// historyEntry creates an exercise with a history entry in an ended session
+func historyEntry(t *testing.T, s *Store, ctx context.Context) *pb.ExerciseHistory {
+	t.Helper()
+
+	exercise, err := s.Exercises().Create(ctx, &pb.Exercise{Name: "Paradiddle"})
+	if err != nil {
+		t.Fatalf("create exercise: %v", err)
+	}
+	start := time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC)
+	session, err := s.Sessions().Create(ctx, &pb.PracticeSession{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(start.Add(time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+	entry, err := s.History().Create(ctx, &pb.ExerciseHistory{
+		ExerciseId: exercise.Id,
+		SessionId:  session.Id,
+		StartTime:  timestamppb.New(start),
+		EndTime:    timestamppb.New(start.Add(10 * time.Minute)),
+	})
+	if err != nil {
+		t.Fatalf("create exercise history entry: %v", err)
+	}
+	return entry
+}
+
+// readRecording reads the audio of a recording
+func readRecording(t *testing.T, s *Store, ctx context.Context, id int32) []byte {
+	t.Helper()
+
+	_, audio, err := s.History().OpenRecording(ctx, id)
+	if err != nil {
+		t.Fatalf("OpenRecording: %v", err)
+	}
+	defer audio.Close()
+
+	data, err := io.ReadAll(audio)
+	if err != nil {
+		t.Fatalf("read recording: %v", err)
+	}
+	return data
+}
+
+func TestRecordingBlobs(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, s *Store) {
+		ctx := userContext(t, s, "alice")
+		entry := historyEntry(t, s, ctx)
+		audio := bytes.Repeat([]byte("RIFF audio "), 1000)
+		hash := blobstore.Key(audio)
+
+		add := func() *pb.ExerciseHistoryRecording {
+			t.Helper()
+			recording, err := s.History().AddRecording(ctx, &pb.ExerciseHistoryRecording{
+				HistoryId: entry.Id,
+				Filename:  "take.wav",
+				MimeType:  "audio/wav",
+			}, bytes.NewReader(audio))
+			if err != nil {
+				t.Fatalf("AddRecording: %v", err)
+			}
+			return recording
+		}
+		first := add()
+		second := add()
+		if first.SizeBytes != int64(len(audio)) {
+			t.Errorf("recording size %d, want %d", first.SizeBytes, len(audio))
+		}
+
+		// The audio is stored once in the blob store and not in the database
+		var stored []byte
+		var storedHash string
+		err := s.db.QueryRowContext(ctx, "SELECT audio_data, content_hash FROM exercise_history_recordings WHERE id = ?", first.Id).Scan(&stored, &storedHash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stored != nil || storedHash != hash {
+			t.Errorf("stored %d bytes under hash %q, want none under %q", len(stored), storedHash, hash)
+		}
+		if got := readRecording(t, s, ctx, first.Id); !bytes.Equal(got, audio) {
+			t.Errorf("read %d bytes, want the %d uploaded", len(got), len(audio))
+		}
+
+		archive, err := s.Data().Export(ctx)
+		if err != nil {
+			t.Fatalf("Export: %v", err)
+		}
+		if recordings := archive.History[0].Recordings; len(recordings) != 2 || !bytes.Equal(recordings[0].AudioData, audio) {
+			t.Errorf("exported recordings %d, want 2 with their audio", len(recordings))
+		}
+
+		// The blob is kept while the second recording refers to it
+		sweepAfter := time.Now().Add(time.Hour)
+		if err := s.History().DeleteRecording(ctx, first.Id); err != nil {
+			t.Fatalf("DeleteRecording: %v", err)
+		}
+		if deleted, err := s.SweepBlobs(ctx, sweepAfter); err != nil || deleted != 0 {
+			t.Errorf("SweepBlobs = %d, %v, want the shared blob kept", deleted, err)
+		}
+
+		// Deleting the history entry deletes the recording and releases the blob
+		if err := s.History().Delete(ctx, entry.Id); err != nil {
+			t.Fatalf("delete exercise history entry: %v", err)
+		}
+		if _, err := s.History().GetRecording(ctx, second.Id); err == nil {
+			t.Error("recording of a deleted history entry still exists")
+		}
+		if deleted, err := s.SweepBlobs(ctx, sweepAfter); err != nil || deleted != 1 {
+			t.Errorf("SweepBlobs = %d, %v, want the released blob deleted", deleted, err)
+		}
+		if _, err := s.blobs.Get(ctx, hash); !errors.Is(err, blobstore.ErrNotFound) {
+			t.Errorf("blob after sweep: err = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestMoveRecordingBlobs(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, s *Store) {
+		ctx := userContext(t, s, "alice")
+		entry := historyEntry(t, s, ctx)
+		audio := bytes.Repeat([]byte("RIFF audio "), 200000) // Two database blocks
+
+		// Recordings used to be stored in the database
+		var id int32
+		err := s.db.QueryRowContext(
+			ctx,
+			`INSERT INTO exercise_history_recordings (history_id, audio_data, mime_type, size_bytes)
+             VALUES (?, ?, ?, ?) RETURNING id`,
+			entry.Id, audio, "audio/wav", len(audio),
+		).Scan(&id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := readRecording(t, s, ctx, id); !bytes.Equal(got, audio) {
+			t.Errorf("read %d bytes before the move, want %d", len(got), len(audio))
+		}
+
+		moved, err := s.MoveRecordingBlobs(ctx)
+		if err != nil || moved != 1 {
+			t.Fatalf("MoveRecordingBlobs = %d, %v, want 1", moved, err)
+		}
+		if moved, err := s.MoveRecordingBlobs(ctx); err != nil || moved != 0 {
+			t.Errorf("second MoveRecordingBlobs = %d, %v, want 0", moved, err)
+		}
+
+		if _, err := s.blobs.Get(ctx, blobstore.Key(audio)); err != nil {
+			t.Errorf("moved blob: %v", err)
+		}
+		if got := readRecording(t, s, ctx, id); !bytes.Equal(got, audio) {
+			t.Errorf("read %d bytes after the move, want %d", len(got), len(audio))
+		}
+	})
+}
@@ -0,0 +1,600 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/pack"
+)
+
+// ErrNotFound is matched by every NotFoundError returned from a repository
+var ErrNotFound = errors.New("not found")
+
+// ErrActiveSession is returned when an operation would leave a user with more
+// than one practice session active at the same time
+var ErrActiveSession = errors.New("a practice session is already active")
+
+// ErrSessionNotActive is returned when timing a practice session that is
+// not active
+var ErrSessionNotActive = errors.New("practice session is not active")
+
+// ErrSessionPaused is returned when pausing a paused practice session
+var ErrSessionPaused = errors.New("practice session is already paused")
+
+// ErrSessionNotPaused is returned when resuming a running practice session
+var ErrSessionNotPaused = errors.New("practice session is not paused")
+
+// ErrNoExerciseInProgress is returned when stopping an exercise while none is
+// being timed
+var ErrNoExerciseInProgress = errors.New("no exercise is in progress")
+
+// ErrNotEmpty is returned when importing with preserved IDs for a user
+// that already holds data
+var ErrNotEmpty = errors.New("user already has data")
+
+// ErrInvalidArchive is returned when a data archive cannot be imported
+var ErrInvalidArchive = errors.New("invalid archive")
+
+// ErrUsernameTaken is returned when creating a user with a username that is
+// already in use
+var ErrUsernameTaken = errors.New("username is already taken")
+
+// NotFoundError reports that a referenced entity does not exist
+type NotFoundError struct {
+	Entity string
+	ID     int32
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s with ID %d not found", e.Entity, e.ID)
+}
+
+// Is makes NotFoundError match ErrNotFound
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// ListOptions selects a page of a listing
+type ListOptions struct {
+	Limit int
+	// After continues the listing after the row of a cursor, from the start
+	// when nil
+	After *Cursor
+	// Count asks for the total count of the listing, which takes a query of
+	// its own
+	Count bool
+}
+
+// CategoryRepo persists categories
+type CategoryRepo interface {
+	Create(ctx context.Context, category *pb.Category) (*pb.Category, error)
+	Get(ctx context.Context, id int32) (*pb.Category, error)
+	List(ctx context.Context, opts ListOptions) (*Page[*pb.Category], error)
+	Update(ctx context.Context, id int32, upd CategoryUpdate) (*pb.Category, error)
+	Delete(ctx context.Context, id int32) error
+}
+
+// CategoryUpdate holds the category fields to change, nil fields are left as is
+type CategoryUpdate struct {
+	Name                *string
+	Description         *string
+	WeeklyTargetMinutes *int32
+}
+
+// TagRepo persists tags and their category associations
+type TagRepo interface {
+	Create(ctx context.Context, name string, categoryIDs []int32) (*pb.Tag, error)
+	Get(ctx context.Context, id int32) (*pb.Tag, error)
+	List(ctx context.Context, filter TagFilter, opts ListOptions) (*Page[*pb.Tag], error)
+	Update(ctx context.Context, id int32, upd TagUpdate) (*pb.Tag, error)
+	Delete(ctx context.Context, id int32) error
+}
+
+// TagFilter narrows a tag listing
+type TagFilter struct {
+	CategoryID int32
+}
+
+// TagUpdate holds the tag fields to change, nil fields are left as is
+type TagUpdate struct {
+	Name        *string
+	CategoryIDs *[]int32
+}
+
+// ExerciseRepo persists exercises along with their tags, images, notation and
+// links
+type ExerciseRepo interface {
+	Create(ctx context.Context, exercise *pb.Exercise) (*pb.Exercise, error)
+	Get(ctx context.Context, id int32) (*pb.Exercise, error)
+	List(ctx context.Context, filter ExerciseFilter, order ExerciseOrder, opts ListOptions) (*Page[*pb.Exercise], error)
+	Update(ctx context.Context, id int32, upd ExerciseUpdate) (*pb.Exercise, error)
+	Delete(ctx context.Context, id int32) error
+
+	AddImage(ctx context.Context, image *pb.ExerciseImage) (*pb.ExerciseImage, error)
+	GetImage(ctx context.Context, exerciseID, imageID int32) (*pb.ExerciseImage, error)
+	ImageInfo(ctx context.Context, id int32) (*pb.ExerciseImage, error)
+	ThumbnailInfo(ctx context.Context, imageID int32, size string) (*pb.ExerciseImageThumbnail, error)
+	GetThumbnail(ctx context.Context, imageID int32, size string) (*pb.ExerciseImageThumbnail, []byte, error)
+	DeleteImage(ctx context.Context, id int32) error
+
+	AddNotation(ctx context.Context, notation *pb.ExerciseNotation) (*pb.ExerciseNotation, error)
+	GetNotation(ctx context.Context, id int32) (*pb.ExerciseNotation, error)
+	DeleteNotation(ctx context.Context, id int32) error
+
+	AddLink(ctx context.Context, link *pb.ExerciseLink) (*pb.ExerciseLink, error)
+	DeleteLink(ctx context.Context, id int32) error
+
+	ExportPack(ctx context.Context, ids []int32, name, description string) (*pack.Pack, error)
+	ImportPack(ctx context.Context, p *pack.Pack) (*pb.ImportExercisePackResponse, error)
+
+	// Stats buckets days in loc, or in the time zone of the settings when nil
+	Stats(ctx context.Context, exerciseID int32, dates DateRange, loc *time.Location) (*pb.ExerciseStats, error)
+	Activity(ctx context.Context, filter ExerciseFilter, recent int) ([]*ExerciseActivity, error)
+}
+
+// ExerciseFilter narrows an exercise listing, exercises must pass every set
+// field. The last BPM and rating are those of the latest history entry.
+type ExerciseFilter struct {
+	CategoryID int32
+	// TagIDs selects exercises with any of the tags, or all of them with AllTags
+	TagIDs  []int32
+	AllTags bool
+	// ExcludeTagIDs leaves out exercises with any of the tags
+	ExcludeTagIDs []int32
+	// NotPracticedSince selects exercises last practiced before it or never
+	NotPracticedSince *time.Time
+	// MinLastBPM and MaxLastBPM bound the highest BPM of the latest practice
+	MinLastBPM int32
+	MaxLastBPM int32
+	// MinRating and MaxRating bound the rating of the latest practice,
+	// unrated exercises never pass
+	MinRating int32
+	MaxRating int32
+}
+
+// ExerciseOrder is the order of an exercise listing, ties are broken by ID
+type ExerciseOrder struct {
+	// By is the sort key, the name when unspecified
+	By         pb.ExerciseSort
+	Descending bool
+}
+
+// ExerciseUpdate holds the exercise fields to change, nil fields are left as is
+type ExerciseUpdate struct {
+	Name        *string
+	Description *string
+	TagIDs      *[]int32
+	// TempoPlan replaces the tempo plan, an empty plan removes it
+	TempoPlan *pb.TempoPlan
+}
+
+// SessionRepo persists practice sessions
+type SessionRepo interface {
+	Create(ctx context.Context, session *pb.PracticeSession) (*pb.PracticeSession, error)
+	Get(ctx context.Context, id int32) (*pb.PracticeSession, error)
+	List(ctx context.Context, filter SessionFilter, opts ListOptions) (*Page[*pb.PracticeSession], error)
+	Update(ctx context.Context, id int32, upd SessionUpdate) (*pb.PracticeSession, error)
+	Delete(ctx context.Context, id int32) error
+
+	// Timer of an active session, excluding pauses from the practice time
+	Pause(ctx context.Context, id int32, at time.Time) (*pb.PracticeSession, error)
+	Resume(ctx context.Context, id int32, at time.Time) (*pb.PracticeSession, error)
+	// StartExercise uses the exercise ID, BPMs and time signature of entry
+	StartExercise(ctx context.Context, id int32, entry *pb.ExerciseHistory, at time.Time) (*pb.PracticeSession, error)
+	StopExercise(ctx context.Context, id int32, at time.Time) (*pb.PracticeSession, error)
+
+	Stats(ctx context.Context, filter PracticeStatsFilter) (*pb.PracticeStats, error)
+	TargetProgress(ctx context.Context, filter PracticeStatsFilter) (*pb.TargetProgress, error)
+	// Consistency uses the time zone of the settings when loc is nil
+	Consistency(ctx context.Context, loc *time.Location, end time.Time) (*pb.ConsistencyStats, error)
+}
+
+// SessionFilter narrows a practice session listing
+type SessionFilter struct {
+	DateRange
+	ExerciseID int32
+	ActiveOnly bool
+}
+
+// SessionUpdate holds the session fields to change, nil fields are left as is
+type SessionUpdate struct {
+	StartTime *time.Time
+	EndTime   *time.Time
+	Notes     *string
+	Active    *bool
+}
+
+// PracticeStatsFilter narrows the sessions that practice stats are computed over
+type PracticeStatsFilter struct {
+	DateRange
+	CategoryID int32
+	// Location buckets days and weeks, the time zone of the settings when nil
+	Location *time.Location
+}
+
+// HistoryRepo persists exercise history entries
+type HistoryRepo interface {
+	Create(ctx context.Context, entry *pb.ExerciseHistory) (*pb.ExerciseHistory, error)
+	Get(ctx context.Context, id int32) (*pb.ExerciseHistory, error)
+	List(ctx context.Context, filter HistoryFilter, opts ListOptions) (*Page[*pb.ExerciseHistory], error)
+	Update(ctx context.Context, id int32, upd HistoryUpdate) (*pb.ExerciseHistory, error)
+	Delete(ctx context.Context, id int32) error
+	AddRecording(ctx context.Context, recording *pb.ExerciseHistoryRecording, audio io.ReadSeeker) (*pb.ExerciseHistoryRecording, error)
+	GetRecording(ctx context.Context, id int32) (*pb.ExerciseHistoryRecording, error)
+	OpenRecording(ctx context.Context, id int32) (*pb.ExerciseHistoryRecording, io.ReadSeekCloser, error)
+	DeleteRecording(ctx context.Context, id int32) error
+}
+
+// HistoryFilter narrows an exercise history listing
+type HistoryFilter struct {
+	DateRange
+	ExerciseID int32
+	SessionID  int32
+}
+
+// HistoryUpdate holds the history fields to change, nil fields are left as is
+type HistoryUpdate struct {
+	StartTime       *time.Time
+	EndTime         *time.Time
+	Bpms            *[]int32
+	TimeSignature   *string
+	Notes           *string
+	Rating          *int32
+	DurationSeconds *int32
+}
+
+// GoalRepo persists exercise goals
+type GoalRepo interface {
+	Create(ctx context.Context, goal *pb.Goal) (*pb.Goal, error)
+	Get(ctx context.Context, id int32) (*pb.Goal, error)
+	List(ctx context.Context, filter GoalFilter, opts ListOptions) (*Page[*pb.Goal], error)
+	Update(ctx context.Context, id int32, upd GoalUpdate) (*pb.Goal, error)
+	Delete(ctx context.Context, id int32) error
+}
+
+// GoalFilter narrows a goal listing
+type GoalFilter struct {
+	ExerciseID int32
+}
+
+// GoalUpdate holds the goal fields to change, nil fields are left as is
+type GoalUpdate struct {
+	TargetBpm *int32
+	// TargetDate is cleared when set to the zero time
+	TargetDate    *time.Time
+	TimeSignature *string
+}
+
+// UserRepo persists users and the tokens they authenticate with. Only hashes
+// of the tokens are stored, tokens are looked up by them.
+type UserRepo interface {
+	Create(ctx context.Context, username, passwordHash string, admin, teacher bool) (*pb.User, error)
+	Get(ctx context.Context, id int32) (*pb.User, error)
+	List(ctx context.Context) ([]*pb.User, error)
+	Update(ctx context.Context, id int32, upd UserUpdate) (*pb.User, error)
+	Delete(ctx context.Context, id int32) error
+	Count(ctx context.Context) (int, error)
+	Credentials(ctx context.Context, username string) (*pb.User, string, error)
+	PasswordHash(ctx context.Context, id int32) (string, error)
+	SetPassword(ctx context.Context, id int32, passwordHash, keepSession string) error
+	CreateToken(ctx context.Context, userID int32, token NewToken) (*pb.ApiToken, error)
+	ListTokens(ctx context.Context, userID int32) ([]*pb.ApiToken, error)
+	DeleteToken(ctx context.Context, userID, id int32) error
+	DeleteSession(ctx context.Context, tokenHash string) error
+	Authenticate(ctx context.Context, tokenHash string) (*pb.User, error)
+
+	AddStudent(ctx context.Context, teacherID, studentID int32) error
+	RemoveStudent(ctx context.Context, teacherID, studentID int32) error
+	IsStudent(ctx context.Context, teacherID, studentID int32) (bool, error)
+	Students(ctx context.Context, teacherID int32) ([]*pb.User, error)
+}
+
+// UserUpdate holds the optional changes to the roles of a user
+type UserUpdate struct {
+	Teacher *bool
+}
+
+// TokenKind tells login sessions and API tokens apart
+type TokenKind string
+
+// Kinds of tokens
+const (
+	TokenSession TokenKind = "session"
+	TokenAPI     TokenKind = "api"
+)
+
+// NewToken holds a token to store
+type NewToken struct {
+	Kind TokenKind
+	Name string
+	Hash string
+	// ExpiresAt is when the token stops working, never when zero
+	ExpiresAt time.Time
+}
+
+// SettingsRepo persists the settings of each user
+type SettingsRepo interface {
+	Get(ctx context.Context) (*pb.Settings, error)
+	Update(ctx context.Context, upd SettingsUpdate) (*pb.Settings, error)
+}
+
+// SettingsUpdate holds the settings to change, nil fields are left as is
+type SettingsUpdate struct {
+	TimeZone *string
+}
+
+// AssignmentRepo persists the assignments teachers give their students and
+// the comments on exercise history. Assignments are visible to the teacher
+// who gave them and to the student they were given to.
+type AssignmentRepo interface {
+	Create(ctx context.Context, assignment *pb.Assignment) (*pb.Assignment, error)
+	Get(ctx context.Context, id int32) (*pb.Assignment, error)
+	List(ctx context.Context, filter AssignmentFilter, opts ListOptions) (*Page[*pb.Assignment], error)
+	Update(ctx context.Context, id int32, upd AssignmentUpdate) (*pb.Assignment, error)
+	Delete(ctx context.Context, id int32) error
+	History(ctx context.Context, assignment *pb.Assignment) ([]*pb.ExerciseHistory, []*pb.HistoryComment, error)
+	StudentExercises(ctx context.Context, studentID int32, opts ListOptions) (*Page[*pb.Exercise], error)
+
+	HistoryAccess(ctx context.Context, historyID int32) (HistoryAccess, error)
+	AddComment(ctx context.Context, historyID int32, text string) (*pb.HistoryComment, error)
+	DeleteComment(ctx context.Context, id int32) error
+}
+
+// AssignmentFilter narrows an assignment listing
+type AssignmentFilter struct {
+	// Given lists the assignments the user gave instead of those given to
+	// them, StudentID only applies to those
+	Given     bool
+	StudentID int32
+	Status    pb.AssignmentStatus
+}
+
+// AssignmentUpdate holds the assignment fields to change, nil fields are left
+// as is
+type AssignmentUpdate struct {
+	TargetBpm *int32
+	// DueDate is cleared when set to the zero time
+	DueDate *time.Time
+	Notes   *string
+}
+
+// HistoryAccess tells how a user relates to an exercise history entry
+type HistoryAccess struct {
+	// Own is set for entries of the user
+	Own bool
+	// Assigned is set for entries of students of the user for an exercise
+	// the user assigned them
+	Assigned bool
+}
+
+// RoutineRepo persists routines and their steps
+type RoutineRepo interface {
+	Create(ctx context.Context, routine *pb.Routine) (*pb.Routine, error)
+	Get(ctx context.Context, id int32) (*pb.Routine, error)
+	List(ctx context.Context, opts ListOptions) (*Page[*pb.Routine], error)
+	Update(ctx context.Context, id int32, upd RoutineUpdate) (*pb.Routine, error)
+	Delete(ctx context.Context, id int32) error
+
+	// Start creates an active practice session following the routine, failing
+	// with ErrActiveSession when another session is still active
+	Start(ctx context.Context, id int32, startTime time.Time) (*pb.PracticeSession, *pb.Routine, error)
+}
+
+// RoutineUpdate holds the routine fields to change, nil fields are left as is
+type RoutineUpdate struct {
+	Name        *string
+	Description *string
+	// Steps replaces all steps when non-nil
+	Steps []*pb.RoutineStep
+}
+
+// SearchRepo searches the text of exercises, links, sessions and history
+type SearchRepo interface {
+	// Search returns up to the limit of results of each type, grouped by type
+	// in the order of pb.SearchEntityType and best match first. Types without
+	// matches are left out.
+	Search(ctx context.Context, query SearchQuery) ([]*pb.SearchResultGroup, error)
+}
+
+// SearchQuery describes a search
+type SearchQuery struct {
+	// Text is split into words that must all match, the last one as a prefix
+	Text string
+	// Types limits the search to these types, all types are searched if empty
+	Types []pb.SearchEntityType
+	Limit int
+}
+
+// DataRepo exports and imports all data at once
+type DataRepo interface {
+	Export(ctx context.Context) (*pb.DataArchive, error)
+	Import(ctx context.Context, archive *pb.DataArchive, opts ImportOptions) (*pb.ImportAllResponse, error)
+}
+
+// ImportOptions controls how an archive is imported
+type ImportOptions struct {
+	// RemapIDs assigns new IDs instead of preserving the archived ones,
+	// categories and tags are merged with existing ones of the same name
+	RemapIDs bool
+}
+
+// DateRange is an optional, inclusive time window, a nil bound is open
+type DateRange struct {
+	Start *time.Time
+	End   *time.Time
+}
+
+// querier is satisfied by both conn and txConn
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// exists reports whether a row with the given ID exists in table and belongs
+// to the owner of the context
+func exists(ctx context.Context, q querier, table string, id int32) (bool, error) {
+	var found bool
+	query := "SELECT EXISTS(SELECT 1 FROM " + table + " WHERE id = ? AND " + ownedBy(table) + ")"
+	err := q.QueryRowContext(ctx, query, id, ownerID(ctx)).Scan(&found)
+	if err != nil {
+		return false, fmt.Errorf("check %s existence: %w", table, err)
+	}
+	return found, nil
+}
+
+// mustExist returns a NotFoundError for entity when the row is missing
+func mustExist(ctx context.Context, q querier, table, entity string, id int32) error {
+	found, err := exists(ctx, q, table, id)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return &NotFoundError{Entity: entity, ID: id}
+	}
+	return nil
+}
+
+// placeholders returns a comma separated list of n bind parameters and the
+// IDs as query arguments
+func placeholders(ids []int32) (string, []any) {
+	marks := make([]string, len(ids))
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		marks[i] = "?"
+		args[i] = id
+	}
+	return strings.Join(marks, ","), args
+}
+
+// setClause accumulates the assignments of an UPDATE statement
+type setClause struct {
+	columns []string
+	params  []any
+}
+
+func (s *setClause) add(column string, value any) {
+	s.columns = append(s.columns, column+" = ?")
+	s.params = append(s.params, value)
+}
+
+func (s *setClause) empty() bool {
+	return len(s.columns) == 0
+}
+
+// exec runs the update against the row with the given ID, if it belongs to
+// the owner of the context
+func (s *setClause) exec(ctx context.Context, q querier, table string, id int32) error {
+	query := "UPDATE " + table + " SET " + strings.Join(s.columns, ", ") + " WHERE id = ? AND " + ownedBy(table)
+	_, err := q.ExecContext(ctx, query, append(s.params, id, ownerID(ctx))...)
+	return err
+}
+
+// whereClause accumulates AND-ed filter conditions
+type whereClause struct {
+	conditions []string
+	params     []any
+}
+
+func (w *whereClause) add(condition string, params ...any) {
+	w.conditions = append(w.conditions, condition)
+	w.params = append(w.params, params...)
+}
+
+// with returns a copy of the clause with an extra condition, leaving w untouched
+func (w whereClause) with(condition string, params ...any) whereClause {
+	return whereClause{
+		conditions: append(w.conditions[:len(w.conditions):len(w.conditions)], condition),
+		params:     append(w.params[:len(w.params):len(w.params)], params...),
+	}
+}
+
+func (w *whereClause) String() string {
+	if len(w.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(w.conditions, " AND ")
+}
+
+// selectQuery composes a SELECT from parts added independently, joins needed
+// by several parts are only added once
+type selectQuery struct {
+	from    string
+	joins   []string
+	where   whereClause
+	orderBy []string
+}
+
+// join adds a join unless the query already has it
+func (q *selectQuery) join(join string) {
+	if !slices.Contains(q.joins, join) {
+		q.joins = append(q.joins, join)
+	}
+}
+
+// sort adds a term to the ORDER BY clause
+func (q *selectQuery) sort(term string, descending bool) {
+	if descending {
+		term += " DESC"
+	}
+	q.orderBy = append(q.orderBy, term)
+}
+
+// sql returns the query selecting columns, followed by the given clauses
+// such as LIMIT
+func (q *selectQuery) sql(columns string, extra ...string) string {
+	return "SELECT " + columns + " FROM " + q.from + q.clauses(extra...)
+}
+
+// clauses returns the part of the query after FROM, followed by the given
+// clauses, each starting with a space
+func (q *selectQuery) clauses(extra ...string) string {
+	var b strings.Builder
+	for _, join := range q.joins {
+		b.WriteString(" " + join)
+	}
+	b.WriteString(q.where.String())
+	if len(q.orderBy) > 0 {
+		b.WriteString(" ORDER BY " + strings.Join(q.orderBy, ", "))
+	}
+	for _, clause := range extra {
+		b.WriteString(" " + clause)
+	}
+	return b.String()
+}
+
+// count returns the query counting the matching rows, ignoring the order
+func (q *selectQuery) count() string {
+	unordered := *q
+	unordered.orderBy = nil
+	return unordered.sql("COUNT(*)")
+}
+
+// encodeBPMs serializes BPM values into the JSON stored in the bpms column
+func encodeBPMs(bpms []int32) (string, error) {
+	b, err := json.Marshal(bpms)
+	if err != nil {
+		return "", fmt.Errorf("marshal BPM values: %w", err)
+	}
+	return string(b), nil
+}
+
+// decodeBPMs parses the JSON stored in the bpms column
+func decodeBPMs(s string) ([]int32, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var bpms []int32
+	if err := json.Unmarshal([]byte(s), &bpms); err != nil {
+		return nil, fmt.Errorf("unmarshal BPM values: %w", err)
+	}
+	return bpms, nil
+}
@@ -0,0 +1,334 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// routineColumns are the routines columns read by scanRoutine
+const routineColumns = "id, name, description, created_at, updated_at"
+
+// routineRepo is the SQL implementation of RoutineRepo
+type routineRepo struct {
+	db *conn
+}
+
+// Create inserts a new routine along with its steps
+func (r *routineRepo) Create(ctx context.Context, routine *pb.Routine) (*pb.Routine, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	var id int32
+	err = tx.QueryRowContext(
+		ctx,
+		"INSERT INTO routines (user_id, name, description) VALUES (?, ?, ?) RETURNING id",
+		ownerID(ctx), routine.Name, routine.Description,
+	).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("insert routine: %w", err)
+	}
+
+	if err := setRoutineSteps(ctx, tx, id, routine.Steps); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Get retrieves a routine by ID along with its steps
+func (r *routineRepo) Get(ctx context.Context, id int32) (*pb.Routine, error) {
+	// Use a transaction to ensure consistency across queries
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	routine, err := getRoutine(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return routine, nil
+}
+
+// List returns a page of routines ordered by name
+func (r *routineRepo) List(ctx context.Context, opts ListOptions) (*Page[*pb.Routine], error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	q := &selectQuery{from: "routines"}
+	q.where.add("user_id = ?", ownerID(ctx))
+	page, err := listPage(ctx, tx, q, byName("routines"), opts, func(clauses string, params ...any) ([]*pb.Routine, error) {
+		return listRoutines(ctx, tx, clauses, params...)
+	}, (*pb.Routine).GetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return page, nil
+}
+
+// Update applies the non-nil fields of upd to a routine
+func (r *routineRepo) Update(ctx context.Context, id int32, upd RoutineUpdate) (*pb.Routine, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if err := mustExist(ctx, tx, "routines", "routine", id); err != nil {
+		return nil, err
+	}
+
+	var set setClause
+	if upd.Name != nil {
+		set.add("name", *upd.Name)
+	}
+	if upd.Description != nil {
+		set.add("description", *upd.Description)
+	}
+
+	if !set.empty() {
+		if err := set.exec(ctx, tx, "routines", id); err != nil {
+			return nil, fmt.Errorf("update routine: %w", err)
+		}
+	}
+
+	if upd.Steps != nil {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM routine_steps WHERE routine_id = ?", id); err != nil {
+			return nil, fmt.Errorf("delete routine steps: %w", err)
+		}
+		if err := setRoutineSteps(ctx, tx, id, upd.Steps); err != nil {
+			return nil, err
+		}
+		if set.empty() {
+			// Steps live in their own table, so touch the routine to bump updated_at
+			if _, err := tx.ExecContext(ctx, "UPDATE routines SET name = name WHERE id = ?", id); err != nil {
+				return nil, fmt.Errorf("touch routine: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Delete removes a routine and its steps
+func (r *routineRepo) Delete(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "routines", "routine", id); err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM routines WHERE id = ?", id); err != nil {
+		return fmt.Errorf("delete routine: %w", err)
+	}
+
+	return nil
+}
+
+// Start creates an active practice session planned to last as long as the
+// steps of the routine, and returns it with the routine
+func (r *routineRepo) Start(ctx context.Context, id int32, startTime time.Time) (*pb.PracticeSession, *pb.Routine, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	routine, err := getRoutine(ctx, tx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := checkNoActiveSession(ctx, tx); err != nil {
+		return nil, nil, err
+	}
+
+	var planned time.Duration
+	for _, step := range routine.Steps {
+		planned += time.Duration(step.PlannedDurationSeconds) * time.Second
+	}
+
+	session := &pb.PracticeSession{
+		StartTime: timestamppb.New(startTime),
+		EndTime:   timestamppb.New(startTime.Add(planned)),
+		Notes:     routine.Name,
+		Active:    true,
+	}
+
+	var createdAt, updatedAt time.Time
+	err = tx.QueryRowContext(
+		ctx,
+		"INSERT INTO practice_sessions (user_id, start_time, end_time, notes, active) VALUES (?, ?, ?, ?, 1) RETURNING id, created_at, updated_at",
+		ownerID(ctx), session.StartTime.AsTime(), session.EndTime.AsTime(), session.Notes,
+	).Scan(&session.Id, &createdAt, &updatedAt)
+	if tx.dialect.uniqueViolation(err) {
+		return nil, nil, ErrActiveSession
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("insert practice session: %w", err)
+	}
+	session.CreatedAt = timestamppb.New(createdAt)
+	session.UpdatedAt = timestamppb.New(updatedAt)
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return session, routine, nil
+}
+
+// getRoutine reads a routine along with its steps
+func getRoutine(ctx context.Context, q querier, id int32) (*pb.Routine, error) {
+	routine, err := scanRoutine(q.QueryRowContext(
+		ctx,
+		"SELECT "+routineColumns+" FROM routines WHERE id = ? AND user_id = ?",
+		id, ownerID(ctx),
+	))
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "routine", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select routine: %w", err)
+	}
+
+	if err := loadRoutineSteps(ctx, q, []*pb.Routine{routine}); err != nil {
+		return nil, err
+	}
+
+	return routine, nil
+}
+
+// listRoutines selects the routines matching the given clause along with
+// their steps
+func listRoutines(ctx context.Context, q querier, clause string, params ...any) ([]*pb.Routine, error) {
+	rows, err := q.QueryContext(ctx, "SELECT "+routineColumns+" FROM routines"+clause, params...)
+	if err != nil {
+		return nil, fmt.Errorf("select routines: %w", err)
+	}
+	defer rows.Close()
+
+	var routines []*pb.Routine
+	for rows.Next() {
+		routine, err := scanRoutine(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan routine: %w", err)
+		}
+		routines = append(routines, routine)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read routines: %w", err)
+	}
+	rows.Close()
+
+	if err := loadRoutineSteps(ctx, q, routines); err != nil {
+		return nil, err
+	}
+
+	return routines, nil
+}
+
+// scanRoutine reads a routines row selected with routineColumns
+func scanRoutine(row rowScanner) (*pb.Routine, error) {
+	var routine pb.Routine
+	var description sql.NullString
+	var createdAt, updatedAt time.Time
+
+	if err := row.Scan(&routine.Id, &routine.Name, &description, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	routine.Description = description.String
+	routine.CreatedAt = timestamppb.New(createdAt)
+	routine.UpdatedAt = timestamppb.New(updatedAt)
+
+	return &routine, nil
+}
+
+// loadRoutineSteps fills in the steps of the routines in order
+func loadRoutineSteps(ctx context.Context, q querier, routines []*pb.Routine) error {
+	if len(routines) == 0 {
+		return nil
+	}
+
+	byID := make(map[int32]*pb.Routine, len(routines))
+	ids := make([]int32, 0, len(routines))
+	for _, routine := range routines {
+		byID[routine.Id] = routine
+		ids = append(ids, routine.Id)
+	}
+	marks, args := placeholders(ids)
+
+	rows, err := q.QueryContext(
+		ctx,
+		`SELECT rs.routine_id, rs.exercise_id, e.name, rs.planned_duration_seconds, rs.start_bpm, rs.time_signature
+		FROM routine_steps rs
+		JOIN exercises e ON e.id = rs.exercise_id
+		WHERE rs.routine_id IN (`+marks+`)
+		ORDER BY rs.routine_id, rs.position`,
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("select routine steps: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var routineID int32
+		var step pb.RoutineStep
+		if err := rows.Scan(&routineID, &step.ExerciseId, &step.ExerciseName, &step.PlannedDurationSeconds, &step.StartBpm, &step.TimeSignature); err != nil {
+			return fmt.Errorf("scan routine step: %w", err)
+		}
+		byID[routineID].Steps = append(byID[routineID].Steps, &step)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("read routine steps: %w", err)
+	}
+
+	return nil
+}
+
+// setRoutineSteps inserts the steps of a routine in order
+func setRoutineSteps(ctx context.Context, tx *txConn, routineID int32, steps []*pb.RoutineStep) error {
+	for position, step := range steps {
+		if err := mustExist(ctx, tx, "exercises", "exercise", step.ExerciseId); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO routine_steps (routine_id, position, exercise_id, planned_duration_seconds, start_bpm, time_signature)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+			routineID, position, step.ExerciseId, step.PlannedDurationSeconds, step.StartBpm, step.TimeSignature,
+		)
+		if err != nil {
+			return fmt.Errorf("insert routine step: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// searchTarget describes a table searched by text and how its matches become
+// search results. Expressions refer to the table as t.
+type searchTarget struct {
+	typ   pb.SearchEntityType
+	table string
+	// columns are the indexed text columns, the first weighing most
+	columns []string
+	// join adds the tables the result expressions need
+	join string
+	// owner is the column of the user the rows belong to
+	owner string
+
+	title, time, exerciseID, sessionID string
+}
+
+// searchTargets are the searched tables in the order their results are grouped
+var searchTargets = []searchTarget{
+	{
+		typ:        pb.SearchEntityType_SEARCH_ENTITY_TYPE_EXERCISE,
+		table:      "exercises",
+		columns:    []string{"name", "description"},
+		owner:      "t.user_id",
+		title:      "t.name",
+		time:       "t.created_at",
+		exerciseID: "t.id",
+		sessionID:  "0",
+	},
+	{
+		typ:        pb.SearchEntityType_SEARCH_ENTITY_TYPE_EXERCISE_LINK,
+		table:      "exercise_links",
+		columns:    []string{"description", "url"},
+		join:       "JOIN exercises e ON e.id = t.exercise_id",
+		owner:      "e.user_id",
+		title:      "e.name",
+		time:       "t.created_at",
+		exerciseID: "t.exercise_id",
+		sessionID:  "0",
+	},
+	{
+		typ:        pb.SearchEntityType_SEARCH_ENTITY_TYPE_PRACTICE_SESSION,
+		table:      "practice_sessions",
+		columns:    []string{"notes"},
+		owner:      "t.user_id",
+		title:      "''",
+		time:       "t.start_time",
+		exerciseID: "0",
+		sessionID:  "t.id",
+	},
+	{
+		typ:        pb.SearchEntityType_SEARCH_ENTITY_TYPE_EXERCISE_HISTORY,
+		table:      "exercise_history",
+		columns:    []string{"notes"},
+		join:       "JOIN exercises e ON e.id = t.exercise_id",
+		owner:      "t.user_id",
+		title:      "e.name",
+		time:       "t.start_time",
+		exerciseID: "t.exercise_id",
+		sessionID:  "t.session_id",
+	},
+}
+
+// Markers the dialects put around matches in snippets, replaced with <mark>
+// elements once the snippet is escaped
+const (
+	matchStart = "\x02"
+	matchEnd   = "\x03"
+)
+
+type searchRepo struct {
+	db *conn
+}
+
+// Search returns the best matches of each searched type among the data of the
+// user
+func (r *searchRepo) Search(ctx context.Context, query SearchQuery) ([]*pb.SearchResultGroup, error) {
+	terms := searchTerms(query.Text)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	match := r.db.dialect.searchMatch(terms)
+
+	var groups []*pb.SearchResultGroup
+	for _, target := range searchTargets {
+		if len(query.Types) > 0 && !slices.Contains(query.Types, target.typ) {
+			continue
+		}
+
+		results, err := r.search(ctx, target, match, query.Limit)
+		if err != nil {
+			return nil, err
+		}
+		if len(results) > 0 {
+			groups = append(groups, &pb.SearchResultGroup{Type: target.typ, Results: results})
+		}
+	}
+
+	return groups, nil
+}
+
+// search returns the best matches of a target
+func (r *searchRepo) search(ctx context.Context, target searchTarget, match string, limit int) ([]*pb.SearchResult, error) {
+	rows, err := r.db.QueryContext(ctx, r.db.dialect.searchQuery(target), match, ownerID(ctx), limit)
+	if err != nil {
+		return nil, fmt.Errorf("search %s: %w", target.table, err)
+	}
+	defer rows.Close()
+
+	var results []*pb.SearchResult
+	for rows.Next() {
+		result := pb.SearchResult{Type: target.typ}
+		var t time.Time
+		err := rows.Scan(
+			&result.Id, &result.Title, &result.Snippet, &result.Score,
+			&t, &result.ExerciseId, &result.SessionId,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan %s search result: %w", target.table, err)
+		}
+		result.Snippet = highlight(result.Snippet)
+		result.Time = timestamppb.New(t)
+		results = append(results, &result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate %s search results: %w", target.table, err)
+	}
+
+	return results, nil
+}
+
+// searchTerms splits a query into its words, anything but letters and digits
+// separates them
+func searchTerms(query string) []string {
+	return strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// highlight escapes a snippet for HTML and turns its match markers into
+// <mark> elements
+func highlight(snippet string) string {
+	return strings.NewReplacer(
+		matchStart, "<mark>",
+		matchEnd, "</mark>",
+	).Replace(html.EscapeString(snippet))
+}
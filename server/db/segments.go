@@ -0,0 +1,374 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// segmentColumns are the session_segments columns read by scanSegment
+const segmentColumns = "session_id, COALESCE(history_id, 0), start_time, end_time"
+
+// sessionState is the timer state of a practice session. An active session
+// without segments has been running since its start time.
+type sessionState struct {
+	id          int32
+	active      bool
+	startTime   time.Time
+	currentID   int32 // Exercise history entry in progress
+	segments    int
+	openSegment int32 // Running segment
+}
+
+// running reports whether the timer of the session is running
+func (s *sessionState) running() bool {
+	return s.active && (s.segments == 0 || s.openSegment != 0)
+}
+
+// Pause stops the timer of an active, running practice session
+func (r *sessionRepo) Pause(ctx context.Context, id int32, at time.Time) (*pb.PracticeSession, error) {
+	at = at.UTC()
+	return r.withTimer(ctx, id, func(tx *txConn, state *sessionState) error {
+		if !state.running() {
+			return ErrSessionPaused
+		}
+		return stopTimer(ctx, tx, state, at)
+	})
+}
+
+// Resume restarts the timer of a paused practice session, continuing the
+// exercise in progress
+func (r *sessionRepo) Resume(ctx context.Context, id int32, at time.Time) (*pb.PracticeSession, error) {
+	at = at.UTC()
+	return r.withTimer(ctx, id, func(tx *txConn, state *sessionState) error {
+		if state.running() {
+			return ErrSessionNotPaused
+		}
+		return openSegment(ctx, tx, id, state.currentID, at)
+	})
+}
+
+// StartExercise adds a history entry for an exercise to an active practice
+// session and times it from now, stopping the exercise in progress. Starting
+// an exercise resumes a paused session. Without BPMs the entry starts at the
+// tempo plan rung suggested for the exercise; goals are only checked against
+// the BPMs once the exercise is stopped.
+func (r *sessionRepo) StartExercise(ctx context.Context, id int32, entry *pb.ExerciseHistory, at time.Time) (*pb.PracticeSession, error) {
+	at = at.UTC()
+	return r.withTimer(ctx, id, func(tx *txConn, state *sessionState) error {
+		if err := mustExist(ctx, tx, "exercises", "exercise", entry.ExerciseId); err != nil {
+			return err
+		}
+
+		if state.running() {
+			if err := stopTimer(ctx, tx, state, at); err != nil {
+				return err
+			}
+		}
+		if state.currentID != 0 {
+			if err := finishExercise(ctx, tx, state.currentID); err != nil {
+				return err
+			}
+		}
+
+		suggested, err := suggestBPM(ctx, tx, entry.ExerciseId)
+		if err != nil {
+			return err
+		}
+		bpms := entry.Bpms
+		if len(bpms) == 0 && suggested > 0 {
+			bpms = []int32{suggested}
+		}
+		if entry.TimeSignature == "" {
+			if entry.TimeSignature, err = notationTimeSignature(ctx, tx, entry.ExerciseId); err != nil {
+				return err
+			}
+		}
+
+		bpmJSON, err := encodeBPMs(bpms)
+		if err != nil {
+			return err
+		}
+
+		var historyID int32
+		err = tx.QueryRowContext(
+			ctx,
+			`INSERT INTO exercise_history (user_id, exercise_id, session_id, start_time, end_time, bpms, time_signature, notes, rating, suggested_bpm)
+			VALUES (?, ?, ?, ?, ?, ?, ?, '', 0, ?) RETURNING id`,
+			ownerID(ctx), entry.ExerciseId, id, at, at, bpmJSON, entry.TimeSignature, suggested,
+		).Scan(&historyID)
+		if err != nil {
+			return fmt.Errorf("insert exercise history entry: %w", err)
+		}
+
+		if err := openSegment(ctx, tx, id, historyID, at); err != nil {
+			return err
+		}
+		return setCurrentExercise(ctx, tx, id, historyID)
+	})
+}
+
+// StopExercise stops timing the exercise in progress, the session timer keeps
+// running when it was
+func (r *sessionRepo) StopExercise(ctx context.Context, id int32, at time.Time) (*pb.PracticeSession, error) {
+	at = at.UTC()
+	return r.withTimer(ctx, id, func(tx *txConn, state *sessionState) error {
+		if state.currentID == 0 {
+			return ErrNoExerciseInProgress
+		}
+
+		if state.running() {
+			if err := stopTimer(ctx, tx, state, at); err != nil {
+				return err
+			}
+			if err := openSegment(ctx, tx, id, 0, at); err != nil {
+				return err
+			}
+		}
+
+		if err := finishExercise(ctx, tx, state.currentID); err != nil {
+			return err
+		}
+		return setCurrentExercise(ctx, tx, id, 0)
+	})
+}
+
+// withTimer runs fn in a transaction with the timer state of an active
+// practice session and returns the updated session
+func (r *sessionRepo) withTimer(ctx context.Context, id int32, fn func(tx *txConn, state *sessionState) error) (*pb.PracticeSession, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	state, err := getSessionState(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !state.active {
+		return nil, ErrSessionNotActive
+	}
+
+	if err := fn(tx, state); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// getSessionState reads the timer state of a practice session
+func getSessionState(ctx context.Context, q querier, id int32) (*sessionState, error) {
+	state := &sessionState{id: id}
+	var active int
+
+	err := q.QueryRowContext(
+		ctx,
+		`SELECT ps.active, ps.start_time, COALESCE(ps.current_history_id, 0),
+			(SELECT COUNT(*) FROM session_segments ss WHERE ss.session_id = ps.id),
+			COALESCE((SELECT MAX(ss.id) FROM session_segments ss WHERE ss.session_id = ps.id AND ss.end_time IS NULL), 0)
+		FROM practice_sessions ps
+		WHERE ps.id = ? AND ps.user_id = ?`,
+		id, ownerID(ctx),
+	).Scan(&active, &state.startTime, &state.currentID, &state.segments, &state.openSegment)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "practice session", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select practice session state: %w", err)
+	}
+	state.active = active == 1
+
+	return state, nil
+}
+
+// stopTimer ends the running segment of a session, recording the time since
+// the session start when it has no segments yet
+func stopTimer(ctx context.Context, tx *txConn, state *sessionState, at time.Time) error {
+	if state.openSegment != 0 {
+		if _, err := tx.ExecContext(ctx, "UPDATE session_segments SET end_time = ? WHERE id = ?", at, state.openSegment); err != nil {
+			return fmt.Errorf("close session segment: %w", err)
+		}
+		state.openSegment = 0
+		return nil
+	}
+
+	start := state.startTime.UTC()
+	if start.After(at) {
+		start = at
+	}
+	_, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO session_segments (session_id, start_time, end_time) VALUES (?, ?, ?)",
+		state.id, start, at,
+	)
+	if err != nil {
+		return fmt.Errorf("insert session segment: %w", err)
+	}
+	state.segments++
+	return nil
+}
+
+// openSegment starts a running segment, spent on an exercise when historyID is set
+func openSegment(ctx context.Context, tx *txConn, sessionID, historyID int32, at time.Time) error {
+	_, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO session_segments (session_id, history_id, start_time) VALUES (?, ?, ?)",
+		sessionID, nullID(historyID), at,
+	)
+	if err != nil {
+		return fmt.Errorf("insert session segment: %w", err)
+	}
+	return nil
+}
+
+// setCurrentExercise records the exercise history entry in progress, none
+// when historyID is zero
+func setCurrentExercise(ctx context.Context, tx *txConn, sessionID, historyID int32) error {
+	_, err := tx.ExecContext(ctx, "UPDATE practice_sessions SET current_history_id = ? WHERE id = ?", nullID(historyID), sessionID)
+	if err != nil {
+		return fmt.Errorf("update current exercise: %w", err)
+	}
+	return nil
+}
+
+// finishExercise sets the time span and duration of a stopped history entry
+// from its segments and marks the goals met by its BPMs as achieved
+func finishExercise(ctx context.Context, tx *txConn, historyID int32) error {
+	start, end, seconds, err := segmentTotals(ctx, tx, "history_id", historyID)
+	if err != nil {
+		return err
+	}
+
+	if !start.IsZero() {
+		_, err = tx.ExecContext(
+			ctx,
+			"UPDATE exercise_history SET start_time = ?, end_time = ?, duration_seconds = ? WHERE id = ?",
+			start, end, seconds, historyID,
+		)
+		if err != nil {
+			return fmt.Errorf("update exercise history entry: %w", err)
+		}
+	}
+
+	entry, err := scanHistory(tx.QueryRowContext(ctx, "SELECT "+historyColumns+" FROM exercise_history WHERE id = ?", historyID))
+	if err != nil {
+		return err
+	}
+	return markGoalsAchieved(ctx, tx, entry)
+}
+
+// finishSegments stops the timer of a session being finished and sets its
+// times and duration from the segments. Sessions that were never timed keep
+// the times they were given.
+func finishSegments(ctx context.Context, tx *txConn, id int32, at time.Time) error {
+	state, err := getSessionState(ctx, tx, id)
+	if err != nil || state.segments == 0 {
+		return err
+	}
+
+	if state.openSegment != 0 {
+		if err := stopTimer(ctx, tx, state, at); err != nil {
+			return err
+		}
+	}
+	if state.currentID != 0 {
+		if err := finishExercise(ctx, tx, state.currentID); err != nil {
+			return err
+		}
+	}
+
+	start, end, seconds, err := segmentTotals(ctx, tx, "session_id", id)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE practice_sessions SET start_time = ?, end_time = ?, duration_seconds = ?, current_history_id = NULL WHERE id = ?",
+		start, end, seconds, id,
+	)
+	if err != nil {
+		return fmt.Errorf("update practice session: %w", err)
+	}
+	return nil
+}
+
+// segmentTotals returns the span and total seconds of the stopped segments
+// with the given owner, zero times when there are none
+func segmentTotals(ctx context.Context, q querier, column string, id int32) (start, end time.Time, seconds int32, err error) {
+	segments, err := listSegments(ctx, q, "WHERE "+column+" = ? AND end_time IS NOT NULL", id)
+	if err != nil {
+		return start, end, 0, err
+	}
+
+	for _, segment := range segments {
+		s, e := segment.StartTime.AsTime(), segment.EndTime.AsTime()
+		if start.IsZero() || s.Before(start) {
+			start = s
+		}
+		if e.After(end) {
+			end = e
+		}
+		seconds += int32(e.Sub(s) / time.Second)
+	}
+	return start, end, seconds, nil
+}
+
+// listSegments selects the session segments matching the given clause
+func listSegments(ctx context.Context, q querier, clause string, params ...any) ([]*pb.SessionSegment, error) {
+	rows, err := q.QueryContext(ctx, "SELECT "+segmentColumns+" FROM session_segments "+clause, params...)
+	if err != nil {
+		return nil, fmt.Errorf("select session segments: %w", err)
+	}
+	defer rows.Close()
+
+	segments := []*pb.SessionSegment{}
+	for rows.Next() {
+		_, segment, err := scanSegment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan session segment: %w", err)
+		}
+		segments = append(segments, segment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read session segments: %w", err)
+	}
+
+	return segments, nil
+}
+
+// scanSegment reads a session_segments row selected with segmentColumns
+// along with the ID of its session
+func scanSegment(row rowScanner) (int32, *pb.SessionSegment, error) {
+	var sessionID int32
+	var segment pb.SessionSegment
+	var startTime time.Time
+	var endTime sql.NullTime
+
+	if err := row.Scan(&sessionID, &segment.HistoryId, &startTime, &endTime); err != nil {
+		return 0, nil, err
+	}
+
+	segment.StartTime = timestamppb.New(startTime)
+	if endTime.Valid {
+		segment.EndTime = timestamppb.New(endTime.Time)
+	}
+
+	return sessionID, &segment, nil
+}
+
+// nullID stores a zero ID as NULL
+func nullID(id int32) any {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
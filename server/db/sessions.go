@@ -0,0 +1,274 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sessionColumns are the practice_sessions columns read by scanSession. An
+// active session is paused when it has segments but none of them is running.
+const sessionColumns = `id, start_time, end_time, notes, created_at, updated_at, active,
+	COALESCE(duration_seconds, 0), COALESCE(current_history_id, 0),
+	CASE WHEN active = 1
+		AND EXISTS (SELECT 1 FROM session_segments ss WHERE ss.session_id = practice_sessions.id)
+		AND NOT EXISTS (SELECT 1 FROM session_segments ss WHERE ss.session_id = practice_sessions.id AND ss.end_time IS NULL)
+	THEN 1 ELSE 0 END`
+
+// sessionRepo is the SQL implementation of SessionRepo
+type sessionRepo struct {
+	db *conn
+}
+
+// Create inserts a new active practice session, failing with
+// ErrActiveSession when another session of the user is still active
+func (r *sessionRepo) Create(ctx context.Context, session *pb.PracticeSession) (*pb.PracticeSession, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if err := checkNoActiveSession(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	var (
+		id                   int32
+		createdAt, updatedAt time.Time
+	)
+	err = tx.QueryRowContext(
+		ctx,
+		"INSERT INTO practice_sessions (user_id, start_time, end_time, notes, active) VALUES (?, ?, ?, ?, 1) RETURNING id, created_at, updated_at",
+		ownerID(ctx), session.StartTime.AsTime(), session.EndTime.AsTime(), session.Notes,
+	).Scan(&id, &createdAt, &updatedAt)
+	if tx.dialect.uniqueViolation(err) {
+		// Another session was activated since the check
+		return nil, ErrActiveSession
+	} else if err != nil {
+		return nil, fmt.Errorf("insert practice session: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return &pb.PracticeSession{
+		Id:        id,
+		StartTime: session.StartTime,
+		EndTime:   session.EndTime,
+		Notes:     session.Notes,
+		CreatedAt: timestamppb.New(createdAt),
+		UpdatedAt: timestamppb.New(updatedAt),
+		Active:    true,
+	}, nil
+}
+
+// Get retrieves a practice session by ID along with its exercise history
+func (r *sessionRepo) Get(ctx context.Context, id int32) (*pb.PracticeSession, error) {
+	// Use a transaction to ensure consistency across queries
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	session, err := scanSession(tx.QueryRowContext(
+		ctx,
+		"SELECT "+sessionColumns+" FROM practice_sessions WHERE id = ? AND user_id = ?",
+		id, ownerID(ctx),
+	))
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "practice session", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select practice session: %w", err)
+	}
+
+	entries, err := listHistory(ctx, tx, "WHERE session_id = ? ORDER BY start_time", id)
+	if err != nil {
+		return nil, err
+	}
+	session.Exercises = entries
+
+	if session.Segments, err = listSegments(ctx, tx, "WHERE session_id = ? ORDER BY start_time, id", id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return session, nil
+}
+
+// List returns a page of sessions, most recent first
+func (r *sessionRepo) List(ctx context.Context, filter SessionFilter, opts ListOptions) (*Page[*pb.PracticeSession], error) {
+	q := &selectQuery{from: "practice_sessions"}
+	q.where.add("user_id = ?", ownerID(ctx))
+	if filter.Start != nil {
+		q.where.add("start_time >= ?", *filter.Start)
+	}
+	if filter.End != nil {
+		q.where.add("end_time <= ?", *filter.End)
+	}
+	if filter.ExerciseID > 0 {
+		q.where.add("id IN (SELECT session_id FROM exercise_history WHERE exercise_id = ?)", filter.ExerciseID)
+	}
+	if filter.ActiveOnly {
+		q.where.add("active = 1")
+	}
+
+	k := byTime(r.db.dialect, "practice_sessions", "start_time", true)
+	return listPage(ctx, r.db, q, k, opts, func(clauses string, params ...any) ([]*pb.PracticeSession, error) {
+		rows, err := r.db.QueryContext(ctx, "SELECT "+sessionColumns+" FROM practice_sessions"+clauses, params...)
+		if err != nil {
+			return nil, fmt.Errorf("select practice sessions: %w", err)
+		}
+		defer rows.Close()
+
+		sessions := make([]*pb.PracticeSession, 0, opts.Limit+1)
+		for rows.Next() {
+			session, err := scanSession(rows)
+			if err != nil {
+				return nil, fmt.Errorf("scan practice session: %w", err)
+			}
+			session.Exercises = []*pb.ExerciseHistory{}
+			sessions = append(sessions, session)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("read practice sessions: %w", err)
+		}
+
+		return sessions, nil
+	}, (*pb.PracticeSession).GetId)
+}
+
+// Update applies the non-nil fields of upd to a practice session, failing
+// with ErrActiveSession when activating it while another session of the user
+// is active. Finishing a session with segments stops its timer and derives the
+// end time and duration from them.
+func (r *sessionRepo) Update(ctx context.Context, id int32, upd SessionUpdate) (*pb.PracticeSession, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if err := mustExist(ctx, tx, "practice_sessions", "practice session", id); err != nil {
+		return nil, err
+	}
+
+	var set setClause
+	if upd.StartTime != nil {
+		set.add("start_time", *upd.StartTime)
+	}
+	if upd.EndTime != nil {
+		set.add("end_time", *upd.EndTime)
+	}
+	if upd.Notes != nil {
+		set.add("notes", *upd.Notes)
+	}
+	finishing := false
+	if upd.Active != nil {
+		val := 0
+		if *upd.Active {
+			if err := checkNoActiveSession(ctx, tx); err != nil {
+				return nil, err
+			}
+			val = 1
+		} else {
+			state, err := getSessionState(ctx, tx, id)
+			if err != nil {
+				return nil, err
+			}
+			finishing = state.active
+		}
+		set.add("active", val)
+	}
+
+	if !set.empty() {
+		err := set.exec(ctx, tx, "practice_sessions", id)
+		if tx.dialect.uniqueViolation(err) {
+			return nil, ErrActiveSession
+		} else if err != nil {
+			return nil, fmt.Errorf("update practice session: %w", err)
+		}
+	}
+
+	if finishing {
+		if err := finishSegments(ctx, tx, id, time.Now().UTC()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Delete removes a practice session, its history is removed by ON DELETE CASCADE
+func (r *sessionRepo) Delete(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "practice_sessions", "practice session", id); err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM practice_sessions WHERE id = ?", id); err != nil {
+		return fmt.Errorf("delete practice session: %w", err)
+	}
+
+	return nil
+}
+
+// checkNoActiveSession returns ErrActiveSession if any session of the user is
+// active. Concurrent requests can both pass the check, the unique index on the
+// active session of each user rejects the second one.
+func checkNoActiveSession(ctx context.Context, q querier) error {
+	var activeCount int
+	err := q.QueryRowContext(
+		ctx,
+		"SELECT COUNT(1) FROM practice_sessions WHERE active = 1 AND user_id = ?",
+		ownerID(ctx),
+	).Scan(&activeCount)
+	if err != nil {
+		return fmt.Errorf("check for active practice session: %w", err)
+	}
+	if activeCount > 0 {
+		return ErrActiveSession
+	}
+	return nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanSession reads a practice_sessions row selected with sessionColumns
+func scanSession(row rowScanner) (*pb.PracticeSession, error) {
+	var session pb.PracticeSession
+	var startTime, endTime, createdAt, updatedAt time.Time
+	var active, paused int
+
+	err := row.Scan(
+		&session.Id, &startTime, &endTime, &session.Notes, &createdAt, &updatedAt, &active,
+		&session.DurationSeconds, &session.CurrentHistoryId, &paused,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	session.Active = active == 1
+	session.Paused = paused == 1
+	session.StartTime = timestamppb.New(startTime)
+	session.EndTime = timestamppb.New(endTime)
+	session.CreatedAt = timestamppb.New(createdAt)
+	session.UpdatedAt = timestamppb.New(updatedAt)
+
+	return &session, nil
+}
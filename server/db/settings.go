@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// settingsRepo is the SQL implementation of SettingsRepo
+type settingsRepo struct {
+	db *conn
+}
+
+// defaultTimeZone is the time zone of users who have not set one
+const defaultTimeZone = "UTC"
+
+// Get retrieves the settings of the user
+func (r *settingsRepo) Get(ctx context.Context) (*pb.Settings, error) {
+	return getSettings(ctx, r.db)
+}
+
+// Update applies the non-nil fields of upd to the settings of the user
+func (r *settingsRepo) Update(ctx context.Context, upd SettingsUpdate) (*pb.Settings, error) {
+	if upd.TimeZone != nil {
+		if err := setTimeZone(ctx, r.db, *upd.TimeZone); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.Get(ctx)
+}
+
+// getSettings reads the settings row of the user, users without one have the
+// defaults
+func getSettings(ctx context.Context, q querier) (*pb.Settings, error) {
+	var settings pb.Settings
+	var updatedAt time.Time
+
+	err := q.QueryRowContext(ctx, "SELECT time_zone, updated_at FROM settings WHERE user_id = ?", ownerID(ctx)).Scan(&settings.TimeZone, &updatedAt)
+	if err == sql.ErrNoRows {
+		return &pb.Settings{TimeZone: defaultTimeZone}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("select settings: %w", err)
+	}
+	settings.UpdatedAt = timestamppb.New(updatedAt)
+
+	return &settings, nil
+}
+
+// setTimeZone sets the time zone of the user, adding their settings row the
+// first time
+func setTimeZone(ctx context.Context, q querier, timeZone string) error {
+	_, err := q.ExecContext(
+		ctx,
+		`INSERT INTO settings (user_id, time_zone) VALUES (?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET time_zone = excluded.time_zone`,
+		ownerID(ctx), timeZone,
+	)
+	if err != nil {
+		return fmt.Errorf("update settings: %w", err)
+	}
+	return nil
+}
+
+// location returns loc, or the time zone of the settings of the user when loc
+// is nil
+func location(ctx context.Context, q querier, loc *time.Location) (*time.Location, error) {
+	if loc != nil {
+		return loc, nil
+	}
+
+	settings, err := getSettings(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err = time.LoadLocation(settings.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("load settings time zone: %w", err)
+	}
+	return loc, nil
+}
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// sqliteDriverName is the SQLite driver with the functions SQLite lacks
+// registered on every connection
+const sqliteDriverName = "sqlite3_tempus"
+
+func init() {
+	sql.Register(sqliteDriverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("local_date", localDate, true)
+		},
+	})
+}
+
+// locations caches the time zones loaded by localDate, which runs per row
+var locations sync.Map
+
+// localDate formats a stored timestamp as the YYYY-MM-DD date it falls on in
+// a time zone, taking daylight saving time into account
+func localDate(value, zone string) (string, error) {
+	cached, ok := locations.Load(zone)
+	if !ok {
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			return "", err
+		}
+		cached, _ = locations.LoadOrStore(zone, loc)
+	}
+
+	// Parse like the driver does for timestamp columns
+	value = strings.TrimSuffix(value, "Z")
+	for _, format := range sqlite3.SQLiteTimestampFormats {
+		if t, err := time.ParseInLocation(format, value, time.UTC); err == nil {
+			return t.In(cached.(*time.Location)).Format(time.DateOnly), nil
+		}
+	}
+	return "", fmt.Errorf("invalid timestamp %q", value)
+}
@@ -0,0 +1,42 @@
+package storage
+
+import "testing"
+
+func TestLocalDate(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		zone  string
+		want  string
+	}{
+		{"UTC", "2026-03-08 04:30:00+00:00", "UTC", "2026-03-08"},
+		{"evening before", "2026-03-08 04:30:00+00:00", "America/New_York", "2026-03-07"},
+		{"midnight before spring forward", "2026-03-08 05:00:00+00:00", "America/New_York", "2026-03-08"},
+		{"last minute of the 23 hour day", "2026-03-09 03:59:00+00:00", "America/New_York", "2026-03-08"},
+		{"midnight after spring forward", "2026-03-09 04:00:00+00:00", "America/New_York", "2026-03-09"},
+		{"last minute before fall back", "2026-11-01 03:59:00Z", "America/New_York", "2026-10-31"},
+		{"repeated hour", "2026-11-01T06:30:00Z", "America/New_York", "2026-11-01"},
+		{"last minute of the 25 hour day", "2026-11-02 04:59:00", "America/New_York", "2026-11-01"},
+		{"midnight after fall back", "2026-11-02 05:00:00.123456789", "America/New_York", "2026-11-02"},
+		{"date only", "2026-11-02", "America/New_York", "2026-11-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := localDate(tt.value, tt.zone)
+			if err != nil {
+				t.Fatalf("localDate(%q, %q): %v", tt.value, tt.zone, err)
+			}
+			if got != tt.want {
+				t.Errorf("localDate(%q, %q) = %s, want %s", tt.value, tt.zone, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := localDate("2026-03-08 04:30:00", "Mars/Olympus_Mons"); err == nil {
+		t.Error("localDate of an unknown time zone succeeded")
+	}
+	if _, err := localDate("yesterday", "UTC"); err == nil {
+		t.Error("localDate of an invalid timestamp succeeded")
+	}
+}
@@ -0,0 +1,331 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// elapsed is the number of seconds between the start_time and end_time
+// columns of the table alias
+func elapsed(d dialect, alias string) string {
+	return d.epochSeconds(alias+".end_time") + " - " + d.epochSeconds(alias+".start_time")
+}
+
+// historyDuration is the time spent on an exercise history entry, preferring
+// the manual duration override when one was recorded
+func historyDuration(d dialect) string {
+	return `CASE
+		WHEN eh.duration_seconds > 0 THEN eh.duration_seconds
+		ELSE ` + elapsed(d, "eh") + `
+	END`
+}
+
+// sessionDuration is the time spent in a practice session, excluding the
+// pauses of sessions timed with segments
+func sessionDuration(d dialect) string {
+	return `CASE
+		WHEN ps.duration_seconds > 0 THEN ps.duration_seconds
+		ELSE ` + elapsed(d, "ps") + `
+	END`
+}
+
+// Stats computes practice statistics for a single exercise
+func (r *exerciseRepo) Stats(ctx context.Context, exerciseID int32, dates DateRange, loc *time.Location) (*pb.ExerciseStats, error) {
+	d := r.db.dialect
+	stats := &pb.ExerciseStats{ExerciseId: exerciseID}
+
+	loc, err := location(ctx, r.db, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.QueryRowContext(
+		ctx,
+		"SELECT name FROM exercises WHERE id = ? AND user_id = ?",
+		exerciseID, ownerID(ctx),
+	).Scan(&stats.ExerciseName)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "exercise", ID: exerciseID}
+	} else if err != nil {
+		return nil, fmt.Errorf("select exercise: %w", err)
+	}
+
+	// Goals are tracked over the whole history, regardless of the date range
+	stats.Goals, err = goalProgress(ctx, r.db, d, exerciseID, loc, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var where whereClause
+	where.add("eh.exercise_id = ?", exerciseID)
+	if dates.Start != nil {
+		where.add("eh.start_time >= ?", *dates.Start)
+	}
+	if dates.End != nil {
+		where.add("eh.end_time <= ?", *dates.End)
+	}
+
+	err = r.db.QueryRowContext(
+		ctx,
+		`SELECT
+			COUNT(*),
+			COALESCE(SUM(`+elapsed(d, "eh")+`), 0),
+			COALESCE(AVG(eh.rating), 0)
+		FROM exercise_history eh`+where.String(),
+		where.params...,
+	).Scan(&stats.PracticeCount, &stats.TotalPracticeDurationSeconds, &stats.AvgRating)
+	if err != nil {
+		return nil, fmt.Errorf("select practice totals: %w", err)
+	}
+
+	if stats.PracticeCount == 0 {
+		return stats, nil
+	}
+
+	// Aggregate over every BPM value recorded in the JSON arrays
+	err = r.db.QueryRowContext(
+		ctx,
+		`SELECT
+			COALESCE(MAX(CAST(bpm.value AS INTEGER)), 0),
+			COALESCE(MIN(CAST(bpm.value AS INTEGER)), 0),
+			COALESCE(AVG(CAST(bpm.value AS INTEGER)), 0)
+		FROM exercise_history eh, `+d.bpmValues("eh.bpms", "bpm")+where.String(),
+		where.params...,
+	).Scan(&stats.MaxBpm, &stats.MinBpm, &stats.AvgBpm)
+	if err != nil {
+		return nil, fmt.Errorf("select BPM statistics: %w", err)
+	}
+
+	// BPM progress over time, taking the max BPM value per day
+	stats.BpmProgress, err = bpmProgress(ctx, r.db, d, where, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// bpmProgress returns the max BPM value per day in loc of the
+// exercise_history entries (aliased eh) matching where
+func bpmProgress(ctx context.Context, q querier, d dialect, where whereClause, loc *time.Location) ([]*pb.BpmProgressPoint, error) {
+	rows, err := q.QueryContext(
+		ctx,
+		`SELECT `+d.day("eh.start_time", loc)+` AS practice_date, MAX(CAST(bpm.value AS INTEGER))
+		FROM exercise_history eh, `+d.bpmValues("eh.bpms", "bpm")+where.String()+`
+		GROUP BY practice_date
+		ORDER BY practice_date`,
+		where.params...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select BPM progress: %w", err)
+	}
+	defer rows.Close()
+
+	var points []*pb.BpmProgressPoint
+	for rows.Next() {
+		var dateStr string
+		var bpm int32
+		if err := rows.Scan(&dateStr, &bpm); err != nil {
+			return nil, fmt.Errorf("scan BPM progress: %w", err)
+		}
+		date, err := time.ParseInLocation(time.DateOnly, dateStr, loc)
+		if err != nil {
+			return nil, fmt.Errorf("parse date: %w", err)
+		}
+		points = append(points, &pb.BpmProgressPoint{
+			Date: timestamppb.New(date),
+			Bpm:  bpm,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read BPM progress: %w", err)
+	}
+
+	return points, nil
+}
+
+// Stats computes statistics across practice sessions
+func (r *sessionRepo) Stats(ctx context.Context, filter PracticeStatsFilter) (*pb.PracticeStats, error) {
+	d := r.db.dialect
+
+	loc, err := location(ctx, r.db, filter.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every query goes through the sessions of the user in the date range
+	var dates whereClause
+	dates.add("ps.user_id = ?", ownerID(ctx))
+	if filter.Start != nil {
+		dates.add("ps.start_time >= ?", *filter.Start)
+	}
+	if filter.End != nil {
+		dates.add("ps.end_time <= ?", *filter.End)
+	}
+
+	// Session totals, restricted to sessions touching the category if one is given
+	sessionWhere := dates
+	if filter.CategoryID > 0 {
+		sessionWhere = dates.with(`ps.id IN (
+			SELECT eh.session_id
+			FROM exercise_history eh
+			JOIN exercise_tags et ON et.exercise_id = eh.exercise_id
+			JOIN tag_categories tc ON tc.tag_id = et.tag_id
+			WHERE tc.category_id = ?
+		)`, filter.CategoryID)
+	}
+
+	stats := &pb.PracticeStats{}
+	err = r.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(ps.id), COALESCE(SUM("+sessionDuration(d)+"), 0) FROM practice_sessions ps"+sessionWhere.String(),
+		sessionWhere.params...,
+	).Scan(&stats.TotalSessions, &stats.TotalDurationSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("select session statistics: %w", err)
+	}
+
+	if stats.TotalSessions > 0 {
+		stats.AvgSessionDurationSeconds = float64(stats.TotalDurationSeconds) / float64(stats.TotalSessions)
+	}
+
+	// Guard the percentage calculations against an empty range
+	total := max(stats.TotalDurationSeconds, 1)
+
+	// Exercise time distribution
+	exerciseRows, err := r.db.QueryContext(
+		ctx,
+		`SELECT
+			e.id,
+			e.name,
+			COALESCE(SUM(`+historyDuration(d)+`), 0) AS duration,
+			ROUND(COALESCE(SUM(`+historyDuration(d)+`), 0) * 100.0 / ?, 2) AS percentage
+		FROM exercises e
+		JOIN exercise_history eh ON e.id = eh.exercise_id
+		JOIN practice_sessions ps ON eh.session_id = ps.id`+dates.String()+`
+		GROUP BY e.id, e.name
+		ORDER BY duration DESC
+		LIMIT 10`,
+		append([]any{total}, dates.params...)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select exercise distribution: %w", err)
+	}
+	defer exerciseRows.Close()
+
+	for exerciseRows.Next() {
+		var dist pb.ExerciseTimeDistribution
+		if err := exerciseRows.Scan(&dist.ExerciseId, &dist.ExerciseName, &dist.DurationSeconds, &dist.Percentage); err != nil {
+			return nil, fmt.Errorf("scan exercise distribution: %w", err)
+		}
+		stats.ExerciseDistribution = append(stats.ExerciseDistribution, &dist)
+	}
+	if err := exerciseRows.Err(); err != nil {
+		return nil, fmt.Errorf("read exercise distribution: %w", err)
+	}
+
+	// Overall practice frequency by day
+	stats.PracticeFrequency, err = r.dailyPractice(
+		ctx,
+		`SELECT `+d.day("ps.start_time", loc)+` AS practice_date, SUM(`+sessionDuration(d)+`)
+		FROM practice_sessions ps`+dates.String(),
+		dates.params,
+		loc,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Category time distribution with daily breakdown
+	categoryRows, err := r.db.QueryContext(
+		ctx,
+		`SELECT
+			c.id,
+			c.name,
+			COALESCE(SUM(`+historyDuration(d)+`), 0) AS duration,
+			ROUND(COALESCE(SUM(`+historyDuration(d)+`), 0) * 100.0 / ?, 2) AS percentage
+		FROM categories c
+		JOIN tag_categories tc ON tc.category_id = c.id
+		JOIN exercise_tags et ON tc.tag_id = et.tag_id
+		JOIN exercise_history eh ON et.exercise_id = eh.exercise_id
+		JOIN practice_sessions ps ON eh.session_id = ps.id`+dates.String()+`
+		GROUP BY c.id, c.name
+		ORDER BY duration DESC`,
+		append([]any{total}, dates.params...)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select category distribution: %w", err)
+	}
+	defer categoryRows.Close()
+
+	for categoryRows.Next() {
+		var dist pb.CategoryTimeDistribution
+		if err := categoryRows.Scan(&dist.CategoryId, &dist.CategoryName, &dist.DurationSeconds, &dist.Percentage); err != nil {
+			return nil, fmt.Errorf("scan category distribution: %w", err)
+		}
+		stats.CategoryDistribution = append(stats.CategoryDistribution, &dist)
+	}
+	if err := categoryRows.Err(); err != nil {
+		return nil, fmt.Errorf("read category distribution: %w", err)
+	}
+	categoryRows.Close()
+
+	for _, dist := range stats.CategoryDistribution {
+		categoryWhere := dates.with("tc.category_id = ?", dist.CategoryId)
+
+		dist.PracticeFrequency, err = r.dailyPractice(
+			ctx,
+			`SELECT `+d.day("ps.start_time", loc)+` AS practice_date, COALESCE(SUM(`+historyDuration(d)+`), 0)
+			FROM exercise_history eh
+			JOIN practice_sessions ps ON eh.session_id = ps.id
+			JOIN exercise_tags et ON et.exercise_id = eh.exercise_id
+			JOIN tag_categories tc ON et.tag_id = tc.tag_id`+categoryWhere.String(),
+			categoryWhere.params,
+			loc,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stats, nil
+}
+
+// dailyPractice runs a query selecting (practice_date, duration) and groups it
+// by day, the dates are those of loc
+func (r *sessionRepo) dailyPractice(ctx context.Context, query string, params []any, loc *time.Location) ([]*pb.PracticeTimePoint, error) {
+	rows, err := r.db.QueryContext(ctx, query+" GROUP BY practice_date ORDER BY practice_date ASC", params...)
+	if err != nil {
+		return nil, fmt.Errorf("select daily practice: %w", err)
+	}
+	defer rows.Close()
+
+	var points []*pb.PracticeTimePoint
+	for rows.Next() {
+		var dateStr string
+		var durationSeconds int32
+		if err := rows.Scan(&dateStr, &durationSeconds); err != nil {
+			return nil, fmt.Errorf("scan daily practice: %w", err)
+		}
+
+		date, err := time.ParseInLocation(time.DateOnly, dateStr, loc)
+		if err != nil {
+			return nil, fmt.Errorf("parse date: %w", err)
+		}
+
+		points = append(points, &pb.PracticeTimePoint{
+			Date:            timestamppb.New(date),
+			DurationSeconds: durationSeconds,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read daily practice: %w", err)
+	}
+
+	return points, nil
+}
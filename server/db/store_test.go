@@ -0,0 +1,432 @@
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/blobstore"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// forEachDriver runs a test against a freshly migrated store of each
+// supported database. Postgres runs when TEST_POSTGRES_DSN is set, each test
+// in a schema of its own; SQLite needs the sqlite_fts5 build tag, see
+// fts5_test.go.
+func forEachDriver(t *testing.T, test func(t *testing.T, s *Store)) {
+	t.Run(DriverSQLite, func(t *testing.T) {
+		db, err := Open(DriverSQLite, filepath.Join(t.TempDir(), "tempus.db"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+		if err := checkFTS5(db); err != nil {
+			t.Fatal(err)
+		}
+		test(t, migratedStore(t, db, DriverSQLite))
+	})
+
+	t.Run(DriverPostgres, func(t *testing.T) {
+		dsn := os.Getenv("TEST_POSTGRES_DSN")
+		if dsn == "" {
+			t.Skip("set TEST_POSTGRES_DSN to run against Postgres")
+		}
+		test(t, migratedStore(t, postgresSchema(t, dsn), DriverPostgres))
+	})
+}
+
+// postgresSchema creates a schema dropped after the test and returns a
+// database whose connections use it
+func postgresSchema(t *testing.T, dsn string) *sql.DB {
+	admin, err := Open(DriverPostgres, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { admin.Close() })
+
+	schema := "tempus_test_" + strings.ToLower(rand.Text())
+	if _, err := admin.Exec("CREATE SCHEMA " + schema); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	separator := " "
+	if strings.Contains(dsn, "://") {
+		separator = "?"
+		if strings.Contains(dsn, "?") {
+			separator = "&"
+		}
+	}
+	db, err := Open(DriverPostgres, dsn+separator+"search_path="+schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+		if _, err := admin.Exec("DROP SCHEMA " + schema + " CASCADE"); err != nil {
+			t.Errorf("drop schema: %v", err)
+		}
+	})
+	return db
+}
+
+func migratedStore(t *testing.T, db *sql.DB, driver string) *Store {
+	if err := RunMigrations(db, driver); err != nil {
+		t.Fatalf("run migrations: %v", err)
+	}
+	blobs, err := blobstore.NewFS(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewStore(db, driver, blobs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// userContext creates a user and returns a context acting for them
+func userContext(t *testing.T, s *Store, username string) context.Context {
+	user, err := s.Users().Create(context.Background(), username, "hash", false, false)
+	if err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	return WithOwner(context.Background(), user.Id)
+}
+
+func TestCategories(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, s *Store) {
+		ctx := userContext(t, s, "alice")
+		other := userContext(t, s, "bob")
+		categories := s.Categories()
+
+		created, err := categories.Create(ctx, &pb.Category{Name: "Rudiments", Description: "Sticking"})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+
+		got, err := categories.Get(ctx, created.Id)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got.Name != "Rudiments" || got.Description != "Sticking" {
+			t.Errorf("Get = %v", got)
+		}
+
+		// The data of other users is not found
+		var notFound *NotFoundError
+		if _, err := categories.Get(other, created.Id); !errors.As(err, &notFound) {
+			t.Errorf("Get as another user: err = %v, want NotFoundError", err)
+		}
+
+		name := "Grooves"
+		updated, err := categories.Update(ctx, created.Id, CategoryUpdate{Name: &name})
+		if err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		if updated.Name != name || updated.Description != "Sticking" {
+			t.Errorf("Update = %v", updated)
+		}
+
+		page, err := categories.List(ctx, ListOptions{Limit: 10})
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		if len(page.Items) != 1 || page.Items[0].Name != name {
+			t.Errorf("List = %v", page.Items)
+		}
+
+		if err := categories.Delete(other, created.Id); !errors.As(err, &notFound) {
+			t.Errorf("Delete as another user: err = %v, want NotFoundError", err)
+		}
+		if err := categories.Delete(ctx, created.Id); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if _, err := categories.Get(ctx, created.Id); !errors.As(err, &notFound) {
+			t.Errorf("Get after Delete: err = %v, want NotFoundError", err)
+		}
+	})
+}
+
+func TestExerciseStats(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, s *Store) {
+		ctx := userContext(t, s, "alice")
+
+		exercise, err := s.Exercises().Create(ctx, &pb.Exercise{Name: "Paradiddle"})
+		if err != nil {
+			t.Fatalf("create exercise: %v", err)
+		}
+		start := time.Date(2026, 3, 2, 3, 30, 0, 0, time.UTC)
+		session, err := s.Sessions().Create(ctx, &pb.PracticeSession{
+			StartTime: timestamppb.New(start),
+			EndTime:   timestamppb.New(start.Add(14 * time.Hour)),
+		})
+		if err != nil {
+			t.Fatalf("create session: %v", err)
+		}
+
+		// 03:30 UTC is still the evening before in New York
+		entries := []struct {
+			start   time.Time
+			minutes int
+			bpms    []int32
+		}{
+			{start, 10, []int32{100, 120}},
+			{start.Add(12 * time.Hour), 20, []int32{110}},
+		}
+		for _, e := range entries {
+			_, err := s.History().Create(ctx, &pb.ExerciseHistory{
+				ExerciseId: exercise.Id,
+				SessionId:  session.Id,
+				StartTime:  timestamppb.New(e.start),
+				EndTime:    timestamppb.New(e.start.Add(time.Duration(e.minutes) * time.Minute)),
+				Bpms:       e.bpms,
+			})
+			if err != nil {
+				t.Fatalf("create history entry: %v", err)
+			}
+		}
+
+		newYork, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := []struct {
+			loc      *time.Location
+			progress []string
+		}{
+			{time.UTC, []string{"2026-03-02 120"}},
+			{newYork, []string{"2026-03-01 120", "2026-03-02 110"}},
+		}
+		for _, tt := range tests {
+			stats, err := s.Exercises().Stats(ctx, exercise.Id, DateRange{}, tt.loc)
+			if err != nil {
+				t.Fatalf("Stats: %v", err)
+			}
+
+			if stats.PracticeCount != 2 || stats.TotalPracticeDurationSeconds != 30*60 {
+				t.Errorf("count %d, duration %d, want 2 and %d", stats.PracticeCount, stats.TotalPracticeDurationSeconds, 30*60)
+			}
+			if stats.MaxBpm != 120 || stats.MinBpm != 100 || stats.AvgBpm != 110 {
+				t.Errorf("BPMs max %d, min %d, avg %v, want 120, 100 and 110", stats.MaxBpm, stats.MinBpm, stats.AvgBpm)
+			}
+
+			var progress []string
+			for _, point := range stats.BpmProgress {
+				progress = append(progress, fmt.Sprintf("%s %d", point.Date.AsTime().In(tt.loc).Format(time.DateOnly), point.Bpm))
+			}
+			if strings.Join(progress, ", ") != strings.Join(tt.progress, ", ") {
+				t.Errorf("progress in %s = %v, want %v", tt.loc, progress, tt.progress)
+			}
+		}
+	})
+}
+
+func TestImportPreservesIDs(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, s *Store) {
+		ctx := userContext(t, s, "alice")
+
+		archive := &pb.DataArchive{
+			Version: archiveVersion,
+			Categories: []*pb.Category{
+				{Id: 7, Name: "Rudiments"},
+				{Id: 12, Name: "Grooves"},
+			},
+		}
+		if _, err := s.Data().Import(ctx, archive, ImportOptions{}); err != nil {
+			t.Fatalf("Import: %v", err)
+		}
+
+		for _, id := range []int32{7, 12} {
+			if _, err := s.Categories().Get(ctx, id); err != nil {
+				t.Errorf("archived category %d: %v", id, err)
+			}
+		}
+
+		// New rows are numbered after the imported ones
+		created, err := s.Categories().Create(ctx, &pb.Category{Name: "Reading"})
+		if err != nil {
+			t.Fatalf("create after import: %v", err)
+		}
+		if created.Id <= 12 {
+			t.Errorf("created category ID %d, want it past the imported IDs", created.Id)
+		}
+
+		if _, err := s.Data().Import(ctx, archive, ImportOptions{}); !errors.Is(err, ErrNotEmpty) {
+			t.Errorf("second Import: err = %v, want ErrNotEmpty", err)
+		}
+	})
+}
+
+func TestGoalsAchievedByPlayedBPMs(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, s *Store) {
+		ctx := userContext(t, s, "alice")
+
+		exercise, err := s.Exercises().Create(ctx, &pb.Exercise{Name: "Paradiddle"})
+		if err != nil {
+			t.Fatalf("create exercise: %v", err)
+		}
+		start := time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC)
+		session, err := s.Sessions().Create(ctx, &pb.PracticeSession{
+			StartTime: timestamppb.New(start),
+			EndTime:   timestamppb.New(start),
+			Active:    true,
+		})
+		if err != nil {
+			t.Fatalf("create session: %v", err)
+		}
+		reached, err := s.Goals().Create(ctx, &pb.Goal{ExerciseId: exercise.Id, TargetBpm: 120})
+		if err != nil {
+			t.Fatalf("create goal: %v", err)
+		}
+		higher, err := s.Goals().Create(ctx, &pb.Goal{ExerciseId: exercise.Id, TargetBpm: 140})
+		if err != nil {
+			t.Fatalf("create goal: %v", err)
+		}
+
+		achieved := func(goal *pb.Goal) bool {
+			t.Helper()
+			got, err := s.Goals().Get(ctx, goal.Id)
+			if err != nil {
+				t.Fatalf("get goal: %v", err)
+			}
+			return got.AchievedAt != nil
+		}
+
+		// The BPMs of an exercise being started have not been played yet
+		if _, err := s.Sessions().StartExercise(ctx, session.Id, &pb.ExerciseHistory{ExerciseId: exercise.Id, Bpms: []int32{130}}, start); err != nil {
+			t.Fatalf("StartExercise: %v", err)
+		}
+		if achieved(reached) {
+			t.Error("goal achieved when the exercise started")
+		}
+
+		if _, err := s.Sessions().StopExercise(ctx, session.Id, start.Add(5*time.Minute)); err != nil {
+			t.Fatalf("StopExercise: %v", err)
+		}
+		if !achieved(reached) || achieved(higher) {
+			t.Errorf("after stopping at 130 BPM achieved %v and %v, want only the 120 BPM goal", achieved(reached), achieved(higher))
+		}
+
+		page, err := s.History().List(ctx, HistoryFilter{ExerciseID: exercise.Id}, ListOptions{Limit: 1})
+		if err != nil || len(page.Items) != 1 {
+			t.Fatalf("list history: %v, %v", page, err)
+		}
+		entry := page.Items[0]
+
+		notes := "Relaxed"
+		if _, err := s.History().Update(ctx, entry.Id, HistoryUpdate{Notes: &notes}); err != nil {
+			t.Fatalf("update notes: %v", err)
+		}
+		if achieved(higher) {
+			t.Error("goal achieved by updating notes")
+		}
+
+		bpms := []int32{130, 140}
+		if _, err := s.History().Update(ctx, entry.Id, HistoryUpdate{Bpms: &bpms}); err != nil {
+			t.Fatalf("update BPMs: %v", err)
+		}
+		if !achieved(higher) {
+			t.Error("goal not achieved by the BPMs played")
+		}
+	})
+}
+
+func TestSingleActiveSession(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, s *Store) {
+		ctx := userContext(t, s, "alice")
+		other := userContext(t, s, "bob")
+		start := time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC)
+		session := &pb.PracticeSession{StartTime: timestamppb.New(start), EndTime: timestamppb.New(start)}
+
+		if _, err := s.Sessions().Create(ctx, session); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if _, err := s.Sessions().Create(ctx, session); !errors.Is(err, ErrActiveSession) {
+			t.Errorf("second Create: err = %v, want ErrActiveSession", err)
+		}
+		if _, err := s.Sessions().Create(other, session); err != nil {
+			t.Errorf("Create for another user: %v", err)
+		}
+
+		// The index rejects what the check before inserting would miss when
+		// requests race
+		insert := func(active int) error {
+			_, err := s.db.ExecContext(
+				ctx,
+				"INSERT INTO practice_sessions (user_id, start_time, end_time, notes, active) VALUES (?, ?, ?, '', ?)",
+				ownerID(ctx), start, start, active,
+			)
+			return err
+		}
+		if err := insert(0); err != nil {
+			t.Errorf("insert an inactive session: %v", err)
+		}
+		if err := insert(1); !s.db.dialect.uniqueViolation(err) {
+			t.Errorf("insert a second active session: err = %v, want a unique violation", err)
+		}
+	})
+}
+
+func TestUserUpdate(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, s *Store) {
+		ctx := context.Background()
+		users := s.Users()
+		alice, err := users.Create(ctx, "alice", "hash", false, false)
+		if err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+
+		for _, teacher := range []bool{true, false} {
+			updated, err := users.Update(ctx, alice.Id, UserUpdate{Teacher: &teacher})
+			if err != nil {
+				t.Fatalf("Update: %v", err)
+			}
+			if updated.Teacher != teacher || updated.Admin || updated.Username != "alice" {
+				t.Errorf("Update teacher %v = %v", teacher, updated)
+			}
+		}
+
+		var notFound *NotFoundError
+		teacher := true
+		if _, err := users.Update(ctx, alice.Id+100, UserUpdate{Teacher: &teacher}); !errors.As(err, &notFound) {
+			t.Errorf("Update of an unknown user: err = %v, want NotFoundError", err)
+		}
+	})
+}
+
+func TestStudentExercises(t *testing.T) {
+	forEachDriver(t, func(t *testing.T, s *Store) {
+		teacher := userContext(t, s, "teacher")
+		user, err := s.Users().Create(context.Background(), "student", "hash", false, false)
+		if err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+		student := WithOwner(context.Background(), user.Id)
+
+		for _, name := range []string{"Paradiddle", "Flam"} {
+			if _, err := s.Exercises().Create(student, &pb.Exercise{Name: name}); err != nil {
+				t.Fatalf("create exercise: %v", err)
+			}
+		}
+		if _, err := s.Exercises().Create(teacher, &pb.Exercise{Name: "Drag"}); err != nil {
+			t.Fatalf("create exercise: %v", err)
+		}
+
+		page, err := s.Assignments().StudentExercises(teacher, user.Id, ListOptions{Limit: 10})
+		if err != nil {
+			t.Fatalf("StudentExercises: %v", err)
+		}
+		if len(page.Items) != 2 || page.Items[0].Name != "Flam" || page.Items[1].Name != "Paradiddle" {
+			t.Errorf("StudentExercises = %v, want the exercises of the student by name", page.Items)
+		}
+	})
+}
@@ -0,0 +1,229 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// tagRepo is the SQL implementation of TagRepo
+type tagRepo struct {
+	db *conn
+}
+
+// Create inserts a new tag and associates it with the given categories
+func (r *tagRepo) Create(ctx context.Context, name string, categoryIDs []int32) (*pb.Tag, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	var id int32
+	var createdAt time.Time
+
+	err = tx.QueryRowContext(
+		ctx,
+		"INSERT INTO tags (user_id, name) VALUES (?, ?) RETURNING id, created_at",
+		ownerID(ctx), name,
+	).Scan(&id, &createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert tag: %w", err)
+	}
+
+	if err := setTagCategories(ctx, tx, id, categoryIDs); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return &pb.Tag{
+		Id:          id,
+		Name:        name,
+		CreatedAt:   timestamppb.New(createdAt),
+		CategoryIds: categoryIDs,
+	}, nil
+}
+
+// Get retrieves a tag by ID along with its category IDs
+func (r *tagRepo) Get(ctx context.Context, id int32) (*pb.Tag, error) {
+	var tag pb.Tag
+	var createdAt time.Time
+
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT id, name, created_at FROM tags WHERE id = ? AND user_id = ?",
+		id, ownerID(ctx),
+	).Scan(&tag.Id, &tag.Name, &createdAt)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "tag", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select tag: %w", err)
+	}
+
+	tag.CreatedAt = timestamppb.New(createdAt)
+
+	if err := r.addCategoryIDs(ctx, []*pb.Tag{&tag}); err != nil {
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+// List returns a page of tags ordered by name
+func (r *tagRepo) List(ctx context.Context, filter TagFilter, opts ListOptions) (*Page[*pb.Tag], error) {
+	q := &selectQuery{from: "tags t"}
+	q.where.add("t.user_id = ?", ownerID(ctx))
+	if filter.CategoryID > 0 {
+		q.where.add("t.id IN (SELECT tc.tag_id FROM tag_categories tc WHERE tc.category_id = ?)", filter.CategoryID)
+	}
+
+	page, err := listPage(ctx, r.db, q, byName("t"), opts, func(clauses string, params ...any) ([]*pb.Tag, error) {
+		rows, err := r.db.QueryContext(ctx, "SELECT t.id, t.name, t.created_at FROM tags t"+clauses, params...)
+		if err != nil {
+			return nil, fmt.Errorf("select tags: %w", err)
+		}
+		defer rows.Close()
+
+		tags := make([]*pb.Tag, 0, opts.Limit+1)
+		for rows.Next() {
+			var tag pb.Tag
+			var createdAt time.Time
+
+			if err := rows.Scan(&tag.Id, &tag.Name, &createdAt); err != nil {
+				return nil, fmt.Errorf("scan tag: %w", err)
+			}
+
+			tag.CreatedAt = timestamppb.New(createdAt)
+			tags = append(tags, &tag)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("read tags: %w", err)
+		}
+
+		return tags, nil
+	}, (*pb.Tag).GetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.addCategoryIDs(ctx, page.Items); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// Update applies the non-nil fields of upd to a tag
+func (r *tagRepo) Update(ctx context.Context, id int32, upd TagUpdate) (*pb.Tag, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if err := mustExist(ctx, tx, "tags", "tag", id); err != nil {
+		return nil, err
+	}
+
+	if upd.Name != nil {
+		var set setClause
+		set.add("name", *upd.Name)
+		if err := set.exec(ctx, tx, "tags", id); err != nil {
+			return nil, fmt.Errorf("update tag name: %w", err)
+		}
+	}
+
+	if upd.CategoryIDs != nil {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM tag_categories WHERE tag_id = ?", id); err != nil {
+			return nil, fmt.Errorf("delete tag categories: %w", err)
+		}
+		if err := setTagCategories(ctx, tx, id, *upd.CategoryIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Delete removes a tag, junction rows are removed by ON DELETE CASCADE
+func (r *tagRepo) Delete(ctx context.Context, id int32) error {
+	if err := mustExist(ctx, r.db, "tags", "tag", id); err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM tags WHERE id = ? AND user_id = ?", id, ownerID(ctx)); err != nil {
+		return fmt.Errorf("delete tag: %w", err)
+	}
+
+	return nil
+}
+
+// addCategoryIDs fills in the category IDs of the given tags
+func (r *tagRepo) addCategoryIDs(ctx context.Context, tags []*pb.Tag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	tagMap := make(map[int32]*pb.Tag, len(tags))
+	ids := make([]int32, 0, len(tags))
+	for _, tag := range tags {
+		tagMap[tag.Id] = tag
+		ids = append(ids, tag.Id)
+	}
+
+	marks, args := placeholders(ids)
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT tag_id, category_id FROM tag_categories WHERE tag_id IN ("+marks+")",
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("select tag categories: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tagID, categoryID int32
+		if err := rows.Scan(&tagID, &categoryID); err != nil {
+			return fmt.Errorf("scan tag category: %w", err)
+		}
+		if tag, ok := tagMap[tagID]; ok {
+			tag.CategoryIds = append(tag.CategoryIds, categoryID)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("read tag categories: %w", err)
+	}
+
+	return nil
+}
+
+// setTagCategories associates a tag with each of the given categories
+func setTagCategories(ctx context.Context, tx *txConn, tagID int32, categoryIDs []int32) error {
+	for _, categoryID := range categoryIDs {
+		if err := mustExist(ctx, tx, "categories", "category", categoryID); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO tag_categories (tag_id, category_id) VALUES (?, ?)",
+			tagID, categoryID,
+		)
+		if err != nil {
+			return fmt.Errorf("associate tag with category: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+)
+
+// defaultTargetWeeks is the number of weeks reported when no start date is given
+const defaultTargetWeeks = 12
+
+// week is the length of a target period, between civil dates
+const week = 7 * 24 * time.Hour
+
+// TargetProgress compares the weekly practice time of each category with a
+// weekly target against that target. Weeks start on Monday in the time zone of
+// the filter, and every week touched by the date range is reported in full.
+func (r *sessionRepo) TargetProgress(ctx context.Context, filter PracticeStatsFilter) (*pb.TargetProgress, error) {
+	d := r.db.dialect
+	now := time.Now()
+
+	loc, err := location(ctx, r.db, filter.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	// Weeks are tracked as civil dates, so that daylight saving changes do
+	// not shift them
+	end := now
+	if filter.End != nil {
+		end = *filter.End
+	}
+	last := weekStart(civilDate(end.In(loc)))
+	first := last.AddDate(0, 0, -7*(defaultTargetWeeks-1))
+	if filter.Start != nil {
+		first = weekStart(civilDate(filter.Start.In(loc)))
+	}
+
+	var where whereClause
+	where.add("user_id = ?", ownerID(ctx))
+	if filter.CategoryID > 0 {
+		if err := mustExist(ctx, r.db, "categories", "category", filter.CategoryID); err != nil {
+			return nil, err
+		}
+		where.add("id = ?", filter.CategoryID)
+	} else {
+		where.add("weekly_target_minutes > 0")
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT id, name, weekly_target_minutes FROM categories"+where.String()+" ORDER BY name", where.params...)
+	if err != nil {
+		return nil, fmt.Errorf("select category targets: %w", err)
+	}
+	defer rows.Close()
+
+	progress := &pb.TargetProgress{}
+	for rows.Next() {
+		var category pb.CategoryTargetProgress
+		if err := rows.Scan(&category.CategoryId, &category.CategoryName, &category.WeeklyTargetMinutes); err != nil {
+			return nil, fmt.Errorf("scan category target: %w", err)
+		}
+		progress.Categories = append(progress.Categories, &category)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read category targets: %w", err)
+	}
+	rows.Close()
+
+	for _, category := range progress.Categories {
+		// Count each history entry once, even when several of the exercise
+		// tags belong to the category
+		days, err := r.dailyPractice(
+			ctx,
+			`SELECT `+d.day("ps.start_time", loc)+` AS practice_date, COALESCE(SUM(`+historyDuration(d)+`), 0)
+			FROM exercise_history eh
+			JOIN practice_sessions ps ON eh.session_id = ps.id
+			WHERE ps.start_time >= ? AND ps.start_time < ?
+			AND eh.exercise_id IN (
+				SELECT et.exercise_id
+				FROM exercise_tags et
+				JOIN tag_categories tc ON tc.tag_id = et.tag_id
+				WHERE tc.category_id = ?
+			)`,
+			[]any{inLocation(first, loc), inLocation(last.AddDate(0, 0, 7), loc), category.CategoryId},
+			loc,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		seconds := make([]int32, int(last.Sub(first)/week)+1)
+		for _, day := range days {
+			if i := int(civilDate(day.Date.AsTime().In(loc)).Sub(first) / week); i >= 0 && i < len(seconds) {
+				seconds[i] += day.DurationSeconds
+			}
+		}
+
+		var streak int32
+		for i, total := range seconds {
+			w := &pb.WeeklyTargetProgress{
+				WeekStart:     localMidnight(first.AddDate(0, 0, 7*i), loc),
+				ActualMinutes: total / 60,
+				TargetMinutes: category.WeeklyTargetMinutes,
+			}
+			w.DeficitMinutes = max(w.TargetMinutes-w.ActualMinutes, 0)
+			w.Met = w.TargetMinutes > 0 && w.DeficitMinutes == 0
+			category.Weeks = append(category.Weeks, w)
+
+			if w.Met {
+				streak++
+				category.LongestStreak = max(category.LongestStreak, streak)
+			} else {
+				streak = 0
+			}
+		}
+
+		category.CurrentStreak = currentStreak(category.Weeks, loc, now)
+	}
+
+	return progress, nil
+}
+
+// currentStreak counts the consecutive weeks met up to the latest week. A week
+// still in progress only breaks the streak once it is over.
+func currentStreak(weeks []*pb.WeeklyTargetProgress, loc *time.Location, now time.Time) int32 {
+	var streak int32
+	for i := len(weeks) - 1; i >= 0; i-- {
+		if weeks[i].Met {
+			streak++
+			continue
+		}
+		if i == len(weeks)-1 && now.Before(weeks[i].WeekStart.AsTime().In(loc).AddDate(0, 0, 7)) {
+			continue
+		}
+		break
+	}
+	return streak
+}
+
+// weekStart returns the Monday of the week containing a civilDate
+func weekStart(date time.Time) time.Time {
+	offset := (int(date.Weekday()) + 6) % 7
+	return date.AddDate(0, 0, -offset)
+}
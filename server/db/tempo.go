@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/tempo"
+)
+
+// tempoPlan returns the plan when it is set, nil otherwise
+func tempoPlan(plan *pb.TempoPlan) *pb.TempoPlan {
+	if !tempo.Planned(plan) {
+		return nil
+	}
+	return plan
+}
+
+// tempoPlanValues returns the values of tempoPlanColumns for a plan, zeros
+// when there is none
+func tempoPlanValues(plan *pb.TempoPlan) []any {
+	return []any{
+		plan.GetStartBpm(),
+		plan.GetEndBpm(),
+		plan.GetIncrement(),
+		plan.GetBarsPerStep(),
+		plan.GetTimeSignature(),
+		int32(plan.GetSubdivision()),
+		plan.GetAccentPattern(),
+	}
+}
+
+// suggestBPM returns the tempo plan rung to practice an exercise at next,
+// based on its latest history entry, or zero without a tempo plan
+func suggestBPM(ctx context.Context, q querier, exerciseID int32) (int32, error) {
+	exercise, err := scanExercise(q.QueryRowContext(ctx, "SELECT "+exerciseColumns+" FROM exercises e WHERE e.id = ?", exerciseID))
+	if err == sql.ErrNoRows {
+		return 0, &NotFoundError{Entity: "exercise", ID: exerciseID}
+	} else if err != nil {
+		return 0, fmt.Errorf("select exercise: %w", err)
+	}
+	if exercise.TempoPlan == nil {
+		return 0, nil
+	}
+
+	var bpmJSON string
+	var rating int32
+	err = q.QueryRowContext(
+		ctx,
+		`SELECT bpms, COALESCE(rating, 0) FROM exercise_history
+		WHERE exercise_id = ?
+		ORDER BY start_time DESC, id DESC
+		LIMIT 1`,
+		exerciseID,
+	).Scan(&bpmJSON, &rating)
+	if err == sql.ErrNoRows {
+		return tempo.Next(exercise.TempoPlan, nil, 0), nil
+	} else if err != nil {
+		return 0, fmt.Errorf("select latest exercise history entry: %w", err)
+	}
+
+	bpms, err := decodeBPMs(bpmJSON)
+	if err != nil {
+		return 0, err
+	}
+	return tempo.Next(exercise.TempoPlan, bpms, rating), nil
+}
@@ -0,0 +1,485 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// userColumns are the users columns read by scanUser
+const userColumns = "id, username, admin, created_at, updated_at, teacher"
+
+// tokenColumns are the auth_tokens columns read by scanToken
+const tokenColumns = "id, name, created_at, expires_at, last_used_at"
+
+// tokenUseInterval is how stale the last use of a token may get before it is
+// recorded again, so that authenticating does not write on every request
+const tokenUseInterval = time.Minute
+
+// userRepo is the SQL implementation of UserRepo
+type userRepo struct {
+	db *conn
+}
+
+// Create inserts a new user, failing with ErrUsernameTaken when the username
+// is in use
+func (r *userRepo) Create(ctx context.Context, username, passwordHash string, admin, teacher bool) (*pb.User, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	var taken bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE username = ?)", username).Scan(&taken)
+	if err != nil {
+		return nil, fmt.Errorf("check username: %w", err)
+	}
+	if taken {
+		return nil, ErrUsernameTaken
+	}
+
+	var id int32
+	err = tx.QueryRowContext(
+		ctx,
+		"INSERT INTO users (username, password_hash, admin, teacher) VALUES (?, ?, ?, ?) RETURNING id",
+		username, passwordHash, admin, teacher,
+	).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("insert user: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Get retrieves a user by ID
+func (r *userRepo) Get(ctx context.Context, id int32) (*pb.User, error) {
+	user, err := scanUser(r.db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Entity: "user", ID: id}
+	} else if err != nil {
+		return nil, fmt.Errorf("select user: %w", err)
+	}
+
+	return user, nil
+}
+
+// List returns all users ordered by username
+func (r *userRepo) List(ctx context.Context) ([]*pb.User, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+userColumns+" FROM users ORDER BY username")
+	if err != nil {
+		return nil, fmt.Errorf("select users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*pb.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate users: %w", err)
+	}
+
+	return users, nil
+}
+
+// Update changes the roles of a user
+func (r *userRepo) Update(ctx context.Context, id int32, upd UserUpdate) (*pb.User, error) {
+	if err := userExists(ctx, r.db, id); err != nil {
+		return nil, err
+	}
+
+	if upd.Teacher != nil {
+		if _, err := r.db.ExecContext(ctx, "UPDATE users SET teacher = ? WHERE id = ?", *upd.Teacher, id); err != nil {
+			return nil, fmt.Errorf("update teacher flag: %w", err)
+		}
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Delete removes a user along with their tokens and data
+func (r *userRepo) Delete(ctx context.Context, id int32) error {
+	if err := userExists(ctx, r.db, id); err != nil {
+		return err
+	}
+
+	// The data of the user goes with them, image blobs once no image uses them
+	hashes, err := imageHashes(ctx, r.db, "WHERE exercise_id IN (SELECT id FROM exercises WHERE user_id = ?)", id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM users WHERE id = ?", id); err != nil {
+		return fmt.Errorf("delete user: %w", err)
+	}
+
+	return releaseBlobs(ctx, r.db, hashes)
+}
+
+// Count returns the number of users
+func (r *userRepo) Count(ctx context.Context) (int, error) {
+	var count int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users").Scan(&count); err != nil {
+		return 0, fmt.Errorf("count users: %w", err)
+	}
+	return count, nil
+}
+
+// Credentials retrieves a user by username along with their password hash,
+// failing with ErrNotFound when there is no such user
+func (r *userRepo) Credentials(ctx context.Context, username string) (*pb.User, string, error) {
+	var passwordHash string
+	row := r.db.QueryRowContext(ctx, "SELECT "+userColumns+", password_hash FROM users WHERE username = ?", username)
+	user, err := scanUser(row, &passwordHash)
+	if err == sql.ErrNoRows {
+		return nil, "", ErrNotFound
+	} else if err != nil {
+		return nil, "", fmt.Errorf("select user credentials: %w", err)
+	}
+
+	return user, passwordHash, nil
+}
+
+// PasswordHash retrieves the password hash of a user
+func (r *userRepo) PasswordHash(ctx context.Context, id int32) (string, error) {
+	var passwordHash string
+	err := r.db.QueryRowContext(ctx, "SELECT password_hash FROM users WHERE id = ?", id).Scan(&passwordHash)
+	if err == sql.ErrNoRows {
+		return "", &NotFoundError{Entity: "user", ID: id}
+	} else if err != nil {
+		return "", fmt.Errorf("select password hash: %w", err)
+	}
+
+	return passwordHash, nil
+}
+
+// SetPassword replaces the password hash of a user and ends their sessions,
+// except the one of keepSession when it is not empty
+func (r *userRepo) SetPassword(ctx context.Context, id int32, passwordHash, keepSession string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if err := userExists(ctx, tx, id); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE users SET password_hash = ? WHERE id = ?", passwordHash, id); err != nil {
+		return fmt.Errorf("update password: %w", err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"DELETE FROM auth_tokens WHERE user_id = ? AND kind = ? AND token_hash <> ?",
+		id, TokenSession, keepSession,
+	)
+	if err != nil {
+		return fmt.Errorf("delete sessions: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// CreateToken stores a token of a user. Creating a session also removes the
+// expired tokens of the user.
+func (r *userRepo) CreateToken(ctx context.Context, userID int32, token NewToken) (*pb.ApiToken, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	if err := userExists(ctx, tx, userID); err != nil {
+		return nil, err
+	}
+
+	if token.Kind == TokenSession {
+		_, err := tx.ExecContext(ctx, "DELETE FROM auth_tokens WHERE user_id = ? AND expires_at < ?", userID, time.Now().UTC())
+		if err != nil {
+			return nil, fmt.Errorf("delete expired tokens: %w", err)
+		}
+	}
+
+	var expiresAt any
+	if !token.ExpiresAt.IsZero() {
+		expiresAt = token.ExpiresAt.UTC()
+	}
+
+	var id int32
+	err = tx.QueryRowContext(
+		ctx,
+		"INSERT INTO auth_tokens (user_id, kind, name, token_hash, expires_at) VALUES (?, ?, ?, ?, ?) RETURNING id",
+		userID, token.Kind, token.Name, token.Hash, expiresAt,
+	).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("insert token: %w", err)
+	}
+
+	created, err := scanToken(tx.QueryRowContext(ctx, "SELECT "+tokenColumns+" FROM auth_tokens WHERE id = ?", id))
+	if err != nil {
+		return nil, fmt.Errorf("select token: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return created, nil
+}
+
+// ListTokens returns the API tokens of a user, most recent first
+func (r *userRepo) ListTokens(ctx context.Context, userID int32) ([]*pb.ApiToken, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT "+tokenColumns+" FROM auth_tokens WHERE user_id = ? AND kind = ? ORDER BY id DESC",
+		userID, TokenAPI,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []*pb.ApiToken
+	for rows.Next() {
+		token, err := scanToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan token: %w", err)
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// DeleteToken revokes an API token of a user
+func (r *userRepo) DeleteToken(ctx context.Context, userID, id int32) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		"DELETE FROM auth_tokens WHERE id = ? AND user_id = ? AND kind = ?",
+		id, userID, TokenAPI,
+	)
+	if err != nil {
+		return fmt.Errorf("delete token: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return &NotFoundError{Entity: "API token", ID: id}
+	}
+
+	return nil
+}
+
+// DeleteSession ends the session of a token hash, ending an unknown session
+// is not an error
+func (r *userRepo) DeleteSession(ctx context.Context, tokenHash string) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM auth_tokens WHERE token_hash = ? AND kind = ?", tokenHash, TokenSession)
+	if err != nil {
+		return fmt.Errorf("delete session: %w", err)
+	}
+	return nil
+}
+
+// Authenticate returns the user of a token hash, failing with ErrNotFound
+// when the token is unknown or expired, and records the use of the token
+func (r *userRepo) Authenticate(ctx context.Context, tokenHash string) (*pb.User, error) {
+	var tokenID int32
+	var expiresAt, lastUsedAt sql.NullTime
+	row := r.db.QueryRowContext(
+		ctx,
+		`SELECT u.id, u.username, u.admin, u.created_at, u.updated_at, u.teacher, t.id, t.expires_at, t.last_used_at
+		FROM auth_tokens t
+		JOIN users u ON u.id = t.user_id
+		WHERE t.token_hash = ?`,
+		tokenHash,
+	)
+	user, err := scanUser(row, &tokenID, &expiresAt, &lastUsedAt)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("select token user: %w", err)
+	}
+
+	now := time.Now().UTC()
+	if expiresAt.Valid && !expiresAt.Time.After(now) {
+		return nil, ErrNotFound
+	}
+
+	if !lastUsedAt.Valid || now.Sub(lastUsedAt.Time) >= tokenUseInterval {
+		if _, err := r.db.ExecContext(ctx, "UPDATE auth_tokens SET last_used_at = ? WHERE id = ?", now, tokenID); err != nil {
+			return nil, fmt.Errorf("update token last use: %w", err)
+		}
+	}
+
+	return user, nil
+}
+
+// AddStudent makes a user a student of a teacher, adding them again has no
+// effect
+func (r *userRepo) AddStudent(ctx context.Context, teacherID, studentID int32) error {
+	for _, id := range []int32{teacherID, studentID} {
+		if err := userExists(ctx, r.db, id); err != nil {
+			return err
+		}
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		"INSERT INTO teacher_students (teacher_id, student_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+		teacherID, studentID,
+	)
+	if err != nil {
+		return fmt.Errorf("insert student: %w", err)
+	}
+	return nil
+}
+
+// RemoveStudent ends the teaching of a student, deleting the assignments the
+// teacher gave them
+func (r *userRepo) RemoveStudent(ctx context.Context, teacherID, studentID int32) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // Rollback if not committed
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM teacher_students WHERE teacher_id = ? AND student_id = ?", teacherID, studentID)
+	if err != nil {
+		return fmt.Errorf("delete student: %w", err)
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return fmt.Errorf("delete student: %w", err)
+	} else if n == 0 {
+		return &NotFoundError{Entity: "student", ID: studentID}
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM assignments WHERE teacher_id = ? AND student_id = ?", teacherID, studentID); err != nil {
+		return fmt.Errorf("delete assignments: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// IsStudent reports whether a user is a student of a teacher
+func (r *userRepo) IsStudent(ctx context.Context, teacherID, studentID int32) (bool, error) {
+	var found bool
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM teacher_students WHERE teacher_id = ? AND student_id = ?)",
+		teacherID, studentID,
+	).Scan(&found)
+	if err != nil {
+		return false, fmt.Errorf("check student: %w", err)
+	}
+	return found, nil
+}
+
+// Students returns the students of a teacher ordered by username
+func (r *userRepo) Students(ctx context.Context, teacherID int32) ([]*pb.User, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT "+userColumns+" FROM users WHERE id IN (SELECT student_id FROM teacher_students WHERE teacher_id = ?) ORDER BY username",
+		teacherID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select students: %w", err)
+	}
+	defer rows.Close()
+
+	users := []*pb.User{}
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan student: %w", err)
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate students: %w", err)
+	}
+
+	return users, nil
+}
+
+// userExists returns a NotFoundError when the user is missing. Users are not
+// owned, so mustExist does not apply.
+func userExists(ctx context.Context, q querier, id int32) error {
+	var found bool
+	err := q.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM users WHERE id = ?)", id).Scan(&found)
+	if err != nil {
+		return fmt.Errorf("check users existence: %w", err)
+	}
+	if !found {
+		return &NotFoundError{Entity: "user", ID: id}
+	}
+	return nil
+}
+
+// scanUser reads a row of userColumns followed by the extra columns
+func scanUser(row rowScanner, extra ...any) (*pb.User, error) {
+	var user pb.User
+	var createdAt, updatedAt time.Time
+
+	err := row.Scan(append([]any{
+		&user.Id,
+		&user.Username,
+		&user.Admin,
+		&createdAt,
+		&updatedAt,
+		&user.Teacher,
+	}, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	user.CreatedAt = timestamppb.New(createdAt)
+	user.UpdatedAt = timestamppb.New(updatedAt)
+
+	return &user, nil
+}
+
+// scanToken reads a row of tokenColumns
+func scanToken(row rowScanner) (*pb.ApiToken, error) {
+	var token pb.ApiToken
+	var createdAt time.Time
+	var expiresAt, lastUsedAt sql.NullTime
+
+	err := row.Scan(&token.Id, &token.Name, &createdAt, &expiresAt, &lastUsedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	token.CreatedAt = timestamppb.New(createdAt)
+	if expiresAt.Valid {
+		token.ExpiresAt = timestamppb.New(expiresAt.Time)
+	}
+	if lastUsedAt.Valid {
+		token.LastUsedAt = timestamppb.New(lastUsedAt.Time)
+	}
+
+	return &token, nil
+}
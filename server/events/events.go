@@ -0,0 +1,87 @@
+// Package events fans out changes to practice sessions to the clients
+// watching them, within a single server process.
+package events
+
+import (
+	"sync"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// bufferSize is the number of events a subscriber can fall behind by before
+// it is dropped
+const bufferSize = 64
+
+// Broker publishes the session events of each user to their subscribers
+type Broker struct {
+	mu sync.Mutex
+	// subs maps each subscription to the user it is for
+	subs map[chan *pb.SessionEvent]int32
+}
+
+// NewBroker creates a new Broker without subscribers
+func NewBroker() *Broker {
+	return &Broker{subs: make(map[chan *pb.SessionEvent]int32)}
+}
+
+// Subscribe returns a channel receiving every event of a user published from
+// now on and a function ending the subscription. The channel is closed when
+// the subscription ends, or when the subscriber falls too far behind.
+func (b *Broker) Subscribe(userID int32) (<-chan *pb.SessionEvent, func()) {
+	ch := make(chan *pb.SessionEvent, bufferSize)
+
+	b.mu.Lock()
+	b.subs[ch] = userID
+	b.mu.Unlock()
+
+	return ch, func() { b.drop(ch) }
+}
+
+// Publish sends an event to the subscribers of a user without blocking,
+// dropping those with a full buffer. A nil Broker discards events.
+func (b *Broker) Publish(userID int32, event *pb.SessionEvent) {
+	if b == nil {
+		return
+	}
+	if event.Time == nil {
+		event.Time = timestamppb.Now()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch, subscriber := range b.subs {
+		if subscriber != userID {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+			delete(b.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// PublishSession publishes a change to a session of a user
+func (b *Broker) PublishSession(userID int32, eventType pb.SessionEventType, session *pb.PracticeSession) {
+	b.Publish(userID, &pb.SessionEvent{Type: eventType, SessionId: session.Id, Session: session})
+}
+
+// PublishExercise publishes a change to an exercise history entry of a session
+// of a user
+func (b *Broker) PublishExercise(userID int32, eventType pb.SessionEventType, entry *pb.ExerciseHistory) {
+	b.Publish(userID, &pb.SessionEvent{Type: eventType, SessionId: entry.SessionId, Exercise: entry})
+}
+
+// drop ends a subscription unless it already ended
+func (b *Broker) drop(ch chan *pb.SessionEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subs[ch]; ok {
+		delete(b.subs, ch)
+		close(ch)
+	}
+}
@@ -0,0 +1,49 @@
+package events
+
+import (
+	"bytes"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// EventStreamContentType is the media type of server-sent events
+const EventStreamContentType = "text/event-stream"
+
+// EventStream marshals the messages of streaming responses as server-sent
+// events. Register one made by NewEventStream for EventStreamContentType with
+// runtime.WithMarshalerOption so that clients sending
+// Accept: text/event-stream can follow a stream with an EventSource.
+type EventStream struct {
+	runtime.JSONPb
+}
+
+// NewEventStream creates an EventStream with the JSON options of the default
+// gateway marshaler
+func NewEventStream() *EventStream {
+	return &EventStream{JSONPb: runtime.JSONPb{
+		MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+		UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+	}}
+}
+
+// ContentType returns EventStreamContentType
+func (*EventStream) ContentType(any) string {
+	return EventStreamContentType
+}
+
+// Marshal writes v as JSON in the data field of an event
+func (m *EventStream) Marshal(v any) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// Multi-line JSON needs a data field per line
+	return append([]byte("data: "), bytes.ReplaceAll(data, []byte("\n"), []byte("\ndata: "))...), nil
+}
+
+// Delimiter ends an event with a blank line
+func (*EventStream) Delimiter() []byte {
+	return []byte("\n\n")
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/backup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdminHandler implements the AdminService gRPC service
+type AdminHandler struct {
+	pb.UnimplementedAdminServiceServer
+	backups *backup.Manager
+}
+
+// NewAdminHandler creates a new AdminHandler, backups may be nil when the
+// database does not support snapshots
+func NewAdminHandler(backups *backup.Manager) *AdminHandler {
+	return &AdminHandler{backups: backups}
+}
+
+// CreateBackup takes a database snapshot
+func (h *AdminHandler) CreateBackup(ctx context.Context, req *pb.CreateBackupRequest) (*pb.Backup, error) {
+	if h.backups == nil {
+		return nil, status.Error(codes.FailedPrecondition, "backups are only supported for SQLite databases")
+	}
+
+	snapshot, err := h.backups.Snapshot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create backup: %v", err)
+	}
+
+	return backupProto(snapshot), nil
+}
+
+// ListBackups lists the database snapshots
+func (h *AdminHandler) ListBackups(ctx context.Context, req *pb.ListBackupsRequest) (*pb.ListBackupsResponse, error) {
+	if h.backups == nil {
+		return nil, status.Error(codes.FailedPrecondition, "backups are only supported for SQLite databases")
+	}
+
+	snapshots, err := h.backups.List()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list backups: %v", err)
+	}
+
+	backups := make([]*pb.Backup, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		backups = append(backups, backupProto(snapshot))
+	}
+
+	return &pb.ListBackupsResponse{Backups: backups}, nil
+}
+
+func backupProto(snapshot *backup.Snapshot) *pb.Backup {
+	return &pb.Backup{
+		Name:      snapshot.Name,
+		SizeBytes: snapshot.Size,
+		CreatedAt: timestamppb.New(snapshot.CreatedAt),
+	}
+}
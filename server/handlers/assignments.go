@@ -0,0 +1,318 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/auth"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxCommentLength bounds the text of a history comment
+const maxCommentLength = 4000
+
+// AssignmentHandler implements the AssignmentService gRPC service. Teachers
+// give assignments to and review the history of their students, students
+// see and comment on their own.
+type AssignmentHandler struct {
+	pb.UnimplementedAssignmentServiceServer
+	assignments storage.AssignmentRepo
+	users       storage.UserRepo
+}
+
+// NewAssignmentHandler creates a new AssignmentHandler
+func NewAssignmentHandler(assignments storage.AssignmentRepo, users storage.UserRepo) *AssignmentHandler {
+	return &AssignmentHandler{assignments: assignments, users: users}
+}
+
+// requireTeacher returns the authenticated user when they are a teacher
+func requireTeacher(ctx context.Context) (*pb.User, error) {
+	user := auth.UserFromContext(ctx)
+	if !user.Teacher {
+		return nil, status.Error(codes.PermissionDenied, "teacher access required")
+	}
+	return user, nil
+}
+
+// requireStudent checks that a user is a student of the authenticated teacher
+func (h *AssignmentHandler) requireStudent(ctx context.Context, teacher *pb.User, studentID int32) error {
+	ok, err := h.users.IsStudent(ctx, teacher.Id, studentID)
+	if err != nil {
+		return storeError(err, "failed to check student")
+	}
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "user %d is not a student of the teacher", studentID)
+	}
+	return nil
+}
+
+// CreateAssignment assigns an exercise to a student of the teacher
+func (h *AssignmentHandler) CreateAssignment(ctx context.Context, req *pb.CreateAssignmentRequest) (*pb.Assignment, error) {
+	teacher, err := requireTeacher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StudentId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "student ID is required")
+	}
+	if req.ExerciseId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "exercise ID is required")
+	}
+	if req.TargetBpm <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "target BPM must be positive")
+	}
+
+	if err := h.requireStudent(ctx, teacher, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	assignment, err := h.assignments.Create(ctx, &pb.Assignment{
+		StudentId:  req.StudentId,
+		ExerciseId: req.ExerciseId,
+		TargetBpm:  req.TargetBpm,
+		DueDate:    req.DueDate,
+		Notes:      req.Notes,
+	})
+	if err != nil {
+		return nil, storeError(err, "failed to create assignment")
+	}
+
+	return assignment, nil
+}
+
+// GetAssignment retrieves an assignment given by or to the user
+func (h *AssignmentHandler) GetAssignment(ctx context.Context, req *pb.GetAssignmentRequest) (*pb.Assignment, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid assignment ID")
+	}
+
+	assignment, err := h.assignments.Get(ctx, req.Id)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve assignment")
+	}
+
+	return assignment, nil
+}
+
+// ListAssignments lists the assignments given to the user, or those the
+// teacher gave, with their status
+func (h *AssignmentHandler) ListAssignments(ctx context.Context, req *pb.ListAssignmentsRequest) (*pb.ListAssignmentsResponse, error) {
+	if req.Given {
+		if _, err := requireTeacher(ctx); err != nil {
+			return nil, err
+		}
+	} else if req.StudentId != 0 {
+		return nil, status.Error(codes.InvalidArgument, "student ID only applies to given assignments")
+	}
+
+	p, err := newPage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := storage.AssignmentFilter{
+		Given:     req.Given,
+		StudentID: req.StudentId,
+		Status:    req.Status,
+	}
+	page, err := h.assignments.List(ctx, filter, p.options())
+	if err != nil {
+		return nil, storeError(err, "failed to list assignments")
+	}
+
+	return &pb.ListAssignmentsResponse{
+		Assignments:   page.Items,
+		NextPageToken: p.nextToken(page.Next),
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+// UpdateAssignment updates an assignment the teacher gave
+func (h *AssignmentHandler) UpdateAssignment(ctx context.Context, req *pb.UpdateAssignmentRequest) (*pb.Assignment, error) {
+	if _, err := requireTeacher(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid assignment ID")
+	}
+
+	if req.Assignment == nil {
+		return nil, status.Error(codes.InvalidArgument, "assignment data is required")
+	}
+
+	var upd storage.AssignmentUpdate
+	for _, path := range updatePaths(req.UpdateMask, "target_bpm", "due_date", "notes") {
+		switch path {
+		case "target_bpm":
+			if req.Assignment.TargetBpm <= 0 {
+				return nil, status.Error(codes.InvalidArgument, "target BPM must be positive")
+			}
+			upd.TargetBpm = &req.Assignment.TargetBpm
+		case "due_date":
+			// An empty due date clears it
+			upd.DueDate = &time.Time{}
+			if req.Assignment.DueDate != nil {
+				upd.DueDate = optionalTime(req.Assignment.DueDate)
+			}
+		case "notes":
+			upd.Notes = &req.Assignment.Notes
+		}
+	}
+
+	if upd == (storage.AssignmentUpdate{}) {
+		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+	}
+
+	assignment, err := h.assignments.Update(ctx, req.Id, upd)
+	if err != nil {
+		return nil, storeError(err, "failed to update assignment")
+	}
+
+	return assignment, nil
+}
+
+// DeleteAssignment deletes an assignment the teacher gave
+func (h *AssignmentHandler) DeleteAssignment(ctx context.Context, req *pb.DeleteAssignmentRequest) (*emptypb.Empty, error) {
+	if _, err := requireTeacher(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid assignment ID")
+	}
+
+	if err := h.assignments.Delete(ctx, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete assignment")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListAssignmentHistory lists the history of the student for an assigned
+// exercise with the comments on it
+func (h *AssignmentHandler) ListAssignmentHistory(ctx context.Context, req *pb.ListAssignmentHistoryRequest) (*pb.ListAssignmentHistoryResponse, error) {
+	if req.AssignmentId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid assignment ID")
+	}
+
+	assignment, err := h.assignments.Get(ctx, req.AssignmentId)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve assignment")
+	}
+
+	// Teachers review the practice of their students only
+	user := auth.UserFromContext(ctx)
+	if assignment.StudentId != user.Id {
+		teacher, err := requireTeacher(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := h.requireStudent(ctx, teacher, assignment.StudentId); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, comments, err := h.assignments.History(ctx, assignment)
+	if err != nil {
+		return nil, storeError(err, "failed to list assignment history")
+	}
+
+	return &pb.ListAssignmentHistoryResponse{Entries: entries, Comments: comments}, nil
+}
+
+// AddHistoryComment comments on a history entry of the user, or of a student
+// of the teacher for an exercise the teacher assigned them
+func (h *AssignmentHandler) AddHistoryComment(ctx context.Context, req *pb.AddHistoryCommentRequest) (*pb.HistoryComment, error) {
+	if req.HistoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid history ID")
+	}
+	if req.Text == "" {
+		return nil, status.Error(codes.InvalidArgument, "comment text is required")
+	}
+	if len(req.Text) > maxCommentLength {
+		return nil, status.Errorf(codes.InvalidArgument, "comment text must be at most %d characters", maxCommentLength)
+	}
+
+	access, err := h.assignments.HistoryAccess(ctx, req.HistoryId)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve history entry")
+	}
+	if !access.Own && !(access.Assigned && auth.UserFromContext(ctx).Teacher) {
+		// Entries of other users are not found, like the rest of their data
+		return nil, status.Errorf(codes.NotFound, "exercise history entry with ID %d not found", req.HistoryId)
+	}
+
+	comment, err := h.assignments.AddComment(ctx, req.HistoryId, req.Text)
+	if err != nil {
+		return nil, storeError(err, "failed to add comment")
+	}
+
+	return comment, nil
+}
+
+// DeleteHistoryComment deletes a comment of the user
+func (h *AssignmentHandler) DeleteHistoryComment(ctx context.Context, req *pb.DeleteHistoryCommentRequest) (*emptypb.Empty, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid comment ID")
+	}
+
+	if err := h.assignments.DeleteComment(ctx, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete comment")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListStudents lists the students of the teacher
+func (h *AssignmentHandler) ListStudents(ctx context.Context, req *pb.ListStudentsRequest) (*pb.ListStudentsResponse, error) {
+	teacher, err := requireTeacher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	students, err := h.users.Students(ctx, teacher.Id)
+	if err != nil {
+		return nil, storeError(err, "failed to list students")
+	}
+
+	return &pb.ListStudentsResponse{Students: students}, nil
+}
+
+// ListStudentExercises lists the exercises of a student of the teacher, to
+// assign one of them
+func (h *AssignmentHandler) ListStudentExercises(ctx context.Context, req *pb.ListStudentExercisesRequest) (*pb.ListStudentExercisesResponse, error) {
+	teacher, err := requireTeacher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StudentId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "student ID is required")
+	}
+
+	if err := h.requireStudent(ctx, teacher, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	p, err := newPage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.assignments.StudentExercises(ctx, req.StudentId, p.options())
+	if err != nil {
+		return nil, storeError(err, "failed to list student exercises")
+	}
+
+	return &pb.ListStudentExercisesResponse{
+		Exercises:     page.Items,
+		NextPageToken: p.nextToken(page.Next),
+		TotalCount:    page.TotalCount,
+	}, nil
+}
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateAssignment(t *testing.T) {
+	teacher := &pb.User{Id: 1, Username: "teacher", Teacher: true}
+	student := &pb.User{Id: 2, Username: "student"}
+	stranger := &pb.User{Id: 3, Username: "stranger"}
+
+	users := newFakeUsers(teacher, student, stranger)
+	if err := users.AddStudent(t.Context(), teacher.Id, student.Id); err != nil {
+		t.Fatal(err)
+	}
+	teacherCtx := authenticate(t, users, teacher)
+	studentCtx := authenticate(t, users, student)
+
+	tests := []struct {
+		name string
+		as   *pb.User
+		req  *pb.CreateAssignmentRequest
+		code codes.Code
+	}{
+		{"student", teacher, &pb.CreateAssignmentRequest{StudentId: student.Id, ExerciseId: 5, TargetBpm: 120}, codes.OK},
+		{"not a student", teacher, &pb.CreateAssignmentRequest{StudentId: stranger.Id, ExerciseId: 5, TargetBpm: 120}, codes.PermissionDenied},
+		{"not a teacher", student, &pb.CreateAssignmentRequest{StudentId: stranger.Id, ExerciseId: 5, TargetBpm: 120}, codes.PermissionDenied},
+		{"missing student", teacher, &pb.CreateAssignmentRequest{ExerciseId: 5, TargetBpm: 120}, codes.InvalidArgument},
+		{"missing exercise", teacher, &pb.CreateAssignmentRequest{StudentId: student.Id, TargetBpm: 120}, codes.InvalidArgument},
+		{"missing target", teacher, &pb.CreateAssignmentRequest{StudentId: student.Id, ExerciseId: 5}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignments := newFakeAssignments()
+			h := NewAssignmentHandler(assignments, users)
+
+			ctx := teacherCtx
+			if tt.as == student {
+				ctx = studentCtx
+			}
+			assignment, err := h.CreateAssignment(ctx, tt.req)
+			wantCode(t, err, tt.code)
+			if err != nil {
+				if len(assignments.rows) != 0 {
+					t.Errorf("stored %d assignments of a rejected request", len(assignments.rows))
+				}
+				return
+			}
+			if assignment.TeacherId != teacher.Id || assignment.StudentId != student.Id || assignment.TargetBpm != 120 {
+				t.Errorf("CreateAssignment = %v", assignment)
+			}
+		})
+	}
+}
+
+func TestListAssignmentHistory(t *testing.T) {
+	teacher := &pb.User{Id: 1, Username: "teacher", Teacher: true}
+	student := &pb.User{Id: 2, Username: "student"}
+	other := &pb.User{Id: 3, Username: "other", Teacher: true}
+
+	users := newFakeUsers(teacher, student, other)
+	if err := users.AddStudent(t.Context(), teacher.Id, student.Id); err != nil {
+		t.Fatal(err)
+	}
+	assignments := newFakeAssignments()
+	h := NewAssignmentHandler(assignments, users)
+
+	teacherCtx := authenticate(t, users, teacher)
+	assignment, err := h.CreateAssignment(teacherCtx, &pb.CreateAssignmentRequest{StudentId: student.Id, ExerciseId: 5, TargetBpm: 120})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assignments.history[10] = &fakeHistoryEntry{ownerID: student.Id, exerciseID: 5}
+	assignments.history[11] = &fakeHistoryEntry{ownerID: student.Id, exerciseID: 6}
+
+	tests := []struct {
+		name    string
+		as      *pb.User
+		code    codes.Code
+		entries int
+	}{
+		{"teacher", teacher, codes.OK, 1},
+		{"student", student, codes.OK, 1},
+		// Assignments of other teachers are not found
+		{"other teacher", other, codes.NotFound, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := authenticate(t, users, tt.as)
+			resp, err := h.ListAssignmentHistory(ctx, &pb.ListAssignmentHistoryRequest{AssignmentId: assignment.Id})
+			wantCode(t, err, tt.code)
+			if err == nil && (len(resp.Entries) != tt.entries || resp.Entries[0].Id != 10) {
+				t.Errorf("entries %v, want entry 10 of the assigned exercise", resp.Entries)
+			}
+		})
+	}
+
+	// Teachers lose access to the history of students they stopped teaching
+	delete(users.students, [2]int32{teacher.Id, student.Id})
+	_, err = h.ListAssignmentHistory(teacherCtx, &pb.ListAssignmentHistoryRequest{AssignmentId: assignment.Id})
+	wantCode(t, err, codes.PermissionDenied)
+}
+
+func TestAddHistoryComment(t *testing.T) {
+	teacher := &pb.User{Id: 1, Username: "teacher", Teacher: true}
+	student := &pb.User{Id: 2, Username: "student"}
+
+	users := newFakeUsers(teacher, student)
+	if err := users.AddStudent(t.Context(), teacher.Id, student.Id); err != nil {
+		t.Fatal(err)
+	}
+	assignments := newFakeAssignments()
+	h := NewAssignmentHandler(assignments, users)
+
+	teacherCtx := authenticate(t, users, teacher)
+	studentCtx := authenticate(t, users, student)
+	if _, err := h.CreateAssignment(teacherCtx, &pb.CreateAssignmentRequest{StudentId: student.Id, ExerciseId: 5, TargetBpm: 120}); err != nil {
+		t.Fatal(err)
+	}
+	assignments.history[10] = &fakeHistoryEntry{ownerID: student.Id, exerciseID: 5}
+	assignments.history[11] = &fakeHistoryEntry{ownerID: student.Id, exerciseID: 6}
+
+	tests := []struct {
+		name string
+		as   *pb.User
+		req  *pb.AddHistoryCommentRequest
+		code codes.Code
+	}{
+		{"student on their entry", student, &pb.AddHistoryCommentRequest{HistoryId: 11, Text: "Tired"}, codes.OK},
+		{"teacher on an assigned entry", teacher, &pb.AddHistoryCommentRequest{HistoryId: 10, Text: "Relax the wrists"}, codes.OK},
+		{"teacher on an entry not assigned", teacher, &pb.AddHistoryCommentRequest{HistoryId: 11, Text: "Hm"}, codes.NotFound},
+		{"unknown entry", student, &pb.AddHistoryCommentRequest{HistoryId: 99, Text: "Hm"}, codes.NotFound},
+		{"empty text", student, &pb.AddHistoryCommentRequest{HistoryId: 11}, codes.InvalidArgument},
+		{"text too long", student, &pb.AddHistoryCommentRequest{HistoryId: 11, Text: strings.Repeat("a", maxCommentLength+1)}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := studentCtx
+			if tt.as == teacher {
+				ctx = teacherCtx
+			}
+			comment, err := h.AddHistoryComment(ctx, tt.req)
+			wantCode(t, err, tt.code)
+			if err == nil && (comment.AuthorId != tt.as.Id || comment.HistoryId != tt.req.HistoryId) {
+				t.Errorf("AddHistoryComment = %v", comment)
+			}
+		})
+	}
+
+	if len(assignments.comments) != 2 {
+		t.Errorf("stored %d comments, want 2", len(assignments.comments))
+	}
+}
+
+func TestListStudentExercises(t *testing.T) {
+	teacher := &pb.User{Id: 1, Username: "teacher", Teacher: true}
+	student := &pb.User{Id: 2, Username: "student"}
+	stranger := &pb.User{Id: 3, Username: "stranger"}
+	other := &pb.User{Id: 4, Username: "other", Teacher: true}
+
+	users := newFakeUsers(teacher, student, stranger, other)
+	if err := users.AddStudent(t.Context(), teacher.Id, student.Id); err != nil {
+		t.Fatal(err)
+	}
+	assignments := newFakeAssignments()
+	assignments.exercises[student.Id] = []*pb.Exercise{{Id: 5, Name: "Paradiddle"}, {Id: 6, Name: "Flam"}}
+	assignments.exercises[stranger.Id] = []*pb.Exercise{{Id: 7, Name: "Drag"}}
+	h := NewAssignmentHandler(assignments, users)
+
+	tests := []struct {
+		name      string
+		as        *pb.User
+		req       *pb.ListStudentExercisesRequest
+		code      codes.Code
+		exercises int
+	}{
+		{"student", teacher, &pb.ListStudentExercisesRequest{StudentId: student.Id}, codes.OK, 2},
+		{"not a student", teacher, &pb.ListStudentExercisesRequest{StudentId: stranger.Id}, codes.PermissionDenied, 0},
+		{"student of another teacher", other, &pb.ListStudentExercisesRequest{StudentId: student.Id}, codes.PermissionDenied, 0},
+		{"not a teacher", student, &pb.ListStudentExercisesRequest{StudentId: student.Id}, codes.PermissionDenied, 0},
+		{"missing student", teacher, &pb.ListStudentExercisesRequest{}, codes.InvalidArgument, 0},
+		{"invalid page token", teacher, &pb.ListStudentExercisesRequest{StudentId: student.Id, PageToken: "nope"}, codes.InvalidArgument, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := h.ListStudentExercises(authenticate(t, users, tt.as), tt.req)
+			wantCode(t, err, tt.code)
+			if err == nil && len(resp.Exercises) != tt.exercises {
+				t.Errorf("listed %d exercises, want %d", len(resp.Exercises), tt.exercises)
+			}
+		})
+	}
+}
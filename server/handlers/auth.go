@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/auth"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sessionDuration is how long a login lasts
+const sessionDuration = 30 * 24 * time.Hour
+
+// AuthHandler implements the AuthService gRPC service
+type AuthHandler struct {
+	pb.UnimplementedAuthServiceServer
+	users storage.UserRepo
+}
+
+// NewAuthHandler creates a new AuthHandler
+func NewAuthHandler(users storage.UserRepo) *AuthHandler {
+	return &AuthHandler{users: users}
+}
+
+// Login checks a password and starts a session
+func (h *AuthHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	if req.Username == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "username and password are required")
+	}
+
+	// Unknown users are checked against an empty hash, which takes as long
+	user, passwordHash, err := h.users.Credentials(ctx, req.Username)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, storeError(err, "failed to retrieve user")
+	}
+	if !auth.CheckPassword(passwordHash, req.Password) {
+		return nil, status.Error(codes.Unauthenticated, "invalid username or password")
+	}
+
+	token, tokenHash, err := auth.NewToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate session token: %v", err)
+	}
+
+	expiresAt := time.Now().Add(sessionDuration)
+	_, err = h.users.CreateToken(ctx, user.Id, storage.NewToken{
+		Kind:      storage.TokenSession,
+		Hash:      tokenHash,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, storeError(err, "failed to create session")
+	}
+
+	return &pb.LoginResponse{
+		User:         user,
+		SessionToken: token,
+		ExpiresAt:    timestamppb.New(expiresAt),
+	}, nil
+}
+
+// Logout ends the session of the request, requests authenticated with an API
+// token keep it
+func (h *AuthHandler) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	if err := h.users.DeleteSession(ctx, auth.TokenHashFromContext(ctx)); err != nil {
+		return nil, storeError(err, "failed to end session")
+	}
+
+	return &pb.LogoutResponse{}, nil
+}
+
+// GetCurrentUser retrieves the authenticated user
+func (h *AuthHandler) GetCurrentUser(ctx context.Context, req *pb.GetCurrentUserRequest) (*pb.User, error) {
+	return auth.UserFromContext(ctx), nil
+}
+
+// ChangePassword changes the password of the authenticated user, ending their
+// other sessions
+func (h *AuthHandler) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*emptypb.Empty, error) {
+	user := auth.UserFromContext(ctx)
+
+	passwordHash, err := h.users.PasswordHash(ctx, user.Id)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve user")
+	}
+	if !auth.CheckPassword(passwordHash, req.CurrentPassword) {
+		return nil, status.Error(codes.InvalidArgument, "current password is incorrect")
+	}
+
+	newHash, err := hashPassword(req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.users.SetPassword(ctx, user.Id, newHash, auth.TokenHashFromContext(ctx)); err != nil {
+		return nil, storeError(err, "failed to change password")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CreateApiToken creates an API token for the authenticated user
+func (h *AuthHandler) CreateApiToken(ctx context.Context, req *pb.CreateApiTokenRequest) (*pb.CreateApiTokenResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "token name is required")
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt.AsTime()
+		if !expiresAt.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expiry must be in the future")
+		}
+	}
+
+	secret, tokenHash, err := auth.NewToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+
+	token, err := h.users.CreateToken(ctx, auth.UserFromContext(ctx).Id, storage.NewToken{
+		Kind:      storage.TokenAPI,
+		Name:      req.Name,
+		Hash:      tokenHash,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, storeError(err, "failed to create token")
+	}
+
+	return &pb.CreateApiTokenResponse{Token: token, Secret: secret}, nil
+}
+
+// ListApiTokens lists the API tokens of the authenticated user
+func (h *AuthHandler) ListApiTokens(ctx context.Context, req *pb.ListApiTokensRequest) (*pb.ListApiTokensResponse, error) {
+	tokens, err := h.users.ListTokens(ctx, auth.UserFromContext(ctx).Id)
+	if err != nil {
+		return nil, storeError(err, "failed to list tokens")
+	}
+
+	return &pb.ListApiTokensResponse{Tokens: tokens}, nil
+}
+
+// DeleteApiToken revokes an API token of the authenticated user
+func (h *AuthHandler) DeleteApiToken(ctx context.Context, req *pb.DeleteApiTokenRequest) (*emptypb.Empty, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid token ID")
+	}
+
+	if err := h.users.DeleteToken(ctx, auth.UserFromContext(ctx).Id, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete token")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// hashPassword hashes a new password, rejecting passwords out of bounds
+func hashPassword(password string) (string, error) {
+	hash, err := auth.HashPassword(password)
+	if errors.Is(err, auth.ErrPasswordLength) {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to hash password: %v", err)
+	}
+	return hash, nil
+}
@@ -2,26 +2,23 @@ package handlers
 
 import (
 	"context"
-	"database/sql"
-	"strconv"
-	"time"
 
 	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	storage "github.com/Zach-Johnson/tempus/server/db"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // CategoryHandler implements the CategoryService gRPC service
 type CategoryHandler struct {
 	pb.UnimplementedCategoryServiceServer
-	db *sql.DB
+	categories storage.CategoryRepo
 }
 
 // NewCategoryHandler creates a new CategoryHandler
-func NewCategoryHandler(db *sql.DB) *CategoryHandler {
-	return &CategoryHandler{db: db}
+func NewCategoryHandler(categories storage.CategoryRepo) *CategoryHandler {
+	return &CategoryHandler{categories: categories}
 }
 
 // CreateCategory creates a new category
@@ -30,40 +27,20 @@ func (h *CategoryHandler) CreateCategory(ctx context.Context, req *pb.CreateCate
 		return nil, status.Error(codes.InvalidArgument, "category name is required")
 	}
 
-	var id int64
-	var createdAt, updatedAt time.Time
-
-	// Insert the category into the database
-	query := "INSERT INTO categories (name, description) VALUES (?, ?)"
-	result, err := h.db.ExecContext(ctx, query, req.Name, req.Description)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create category: %v", err)
-	}
-
-	// Get the generated ID
-	id, err = result.LastInsertId()
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get generated ID: %v", err)
+	if req.WeeklyTargetMinutes < 0 {
+		return nil, status.Error(codes.InvalidArgument, "weekly target minutes cannot be negative")
 	}
 
-	// Fetch the created category to get timestamps
-	err = h.db.QueryRowContext(
-		ctx,
-		"SELECT created_at, updated_at FROM categories WHERE id = ?",
-		id,
-	).Scan(&createdAt, &updatedAt)
+	category, err := h.categories.Create(ctx, &pb.Category{
+		Name:                req.Name,
+		Description:         req.Description,
+		WeeklyTargetMinutes: req.WeeklyTargetMinutes,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to fetch created category: %v", err)
+		return nil, storeError(err, "failed to create category")
 	}
 
-	// Return the created category
-	return &pb.Category{
-		Id:          int32(id),
-		Name:        req.Name,
-		Description: req.Description,
-		CreatedAt:   timestamppb.New(createdAt),
-		UpdatedAt:   timestamppb.New(updatedAt),
-	}, nil
+	return category, nil
 }
 
 // GetCategory retrieves a category by ID
@@ -72,103 +49,30 @@ func (h *CategoryHandler) GetCategory(ctx context.Context, req *pb.GetCategoryRe
 		return nil, status.Error(codes.InvalidArgument, "invalid category ID")
 	}
 
-	var category pb.Category
-	var createdAt, updatedAt time.Time
-
-	// Query the database for the category
-	err := h.db.QueryRowContext(
-		ctx,
-		"SELECT id, name, description, created_at, updated_at FROM categories WHERE id = ?",
-		req.Id,
-	).Scan(&category.Id, &category.Name, &category.Description, &createdAt, &updatedAt)
-
-	if err == sql.ErrNoRows {
-		return nil, status.Errorf(codes.NotFound, "category with ID %d not found", req.Id)
-	} else if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve category: %v", err)
+	category, err := h.categories.Get(ctx, req.Id)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve category")
 	}
 
-	category.CreatedAt = timestamppb.New(createdAt)
-	category.UpdatedAt = timestamppb.New(updatedAt)
-
-	return &category, nil
+	return category, nil
 }
 
 // ListCategories lists all categories with pagination
 func (h *CategoryHandler) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
-	pageSize := int(req.PageSize)
-	if pageSize <= 0 {
-		pageSize = 50 // Default page size
-	}
-
-	// Parse the page token, which is just an offset
-	offset := 0
-	if req.PageToken != "" {
-		var err error
-		offset, err = strconv.Atoi(req.PageToken)
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "invalid page token")
-		}
-	}
-
-	// Query total count
-	var totalCount int32
-	err := h.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM categories").Scan(&totalCount)
+	p, err := newPage(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to count categories: %v", err)
+		return nil, err
 	}
 
-	// Query categories with pagination
-	rows, err := h.db.QueryContext(
-		ctx,
-		"SELECT id, name, description, created_at, updated_at FROM categories ORDER BY name LIMIT ? OFFSET ?",
-		pageSize+1, // Query one more to check if there are more pages
-		offset,
-	)
+	page, err := h.categories.List(ctx, p.options())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list categories: %v", err)
-	}
-	defer rows.Close()
-
-	// Parse the results
-	categories := make([]*pb.Category, 0, pageSize)
-	count := 0
-	hasMorePages := false
-
-	for rows.Next() {
-		if count >= pageSize {
-			hasMorePages = true
-			break
-		}
-
-		var category pb.Category
-		var createdAt, updatedAt time.Time
-
-		err := rows.Scan(&category.Id, &category.Name, &category.Description, &createdAt, &updatedAt)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse category: %v", err)
-		}
-
-		category.CreatedAt = timestamppb.New(createdAt)
-		category.UpdatedAt = timestamppb.New(updatedAt)
-		categories = append(categories, &category)
-		count++
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading categories: %v", err)
-	}
-
-	// Calculate next page token
-	nextPageToken := ""
-	if hasMorePages {
-		nextPageToken = strconv.Itoa(offset + pageSize)
+		return nil, storeError(err, "failed to list categories")
 	}
 
 	return &pb.ListCategoriesResponse{
-		Categories:    categories,
-		NextPageToken: nextPageToken,
-		TotalCount:    totalCount,
+		Categories:    page.Items,
+		NextPageToken: p.nextToken(page.Next),
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
@@ -182,72 +86,34 @@ func (h *CategoryHandler) UpdateCategory(ctx context.Context, req *pb.UpdateCate
 		return nil, status.Error(codes.InvalidArgument, "category data is required")
 	}
 
-	// Check if the category exists
-	var exists bool
-	err := h.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM categories WHERE id = ?)", req.Id).Scan(&exists)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check category existence: %v", err)
-	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "category with ID %d not found", req.Id)
-	}
-
-	// Parse update mask
-	updateName := false
-	updateDescription := false
-
-	if req.UpdateMask == nil || len(req.UpdateMask.Paths) == 0 {
-		// If no update mask is provided, update all fields
-		updateName = true
-		updateDescription = true
-	} else {
-		for _, path := range req.UpdateMask.Paths {
-			switch path {
-			case "name":
-				updateName = true
-			case "description":
-				updateDescription = true
+	var upd storage.CategoryUpdate
+	for _, path := range updatePaths(req.UpdateMask, "name", "description", "weekly_target_minutes") {
+		switch path {
+		case "name":
+			if req.Category.Name == "" {
+				return nil, status.Error(codes.InvalidArgument, "category name cannot be empty")
 			}
+			upd.Name = &req.Category.Name
+		case "description":
+			upd.Description = &req.Category.Description
+		case "weekly_target_minutes":
+			if req.Category.WeeklyTargetMinutes < 0 {
+				return nil, status.Error(codes.InvalidArgument, "weekly target minutes cannot be negative")
+			}
+			upd.WeeklyTargetMinutes = &req.Category.WeeklyTargetMinutes
 		}
 	}
 
-	// Build update SQL
-	sql := "UPDATE categories SET"
-	params := []any{}
-	first := true
-
-	if updateName {
-		if req.Category.Name == "" {
-			return nil, status.Error(codes.InvalidArgument, "category name cannot be empty")
-		}
-		sql += " name = ?"
-		params = append(params, req.Category.Name)
-		first = false
-	}
-
-	if updateDescription {
-		if !first {
-			sql += ","
-		}
-		sql += " description = ?"
-		params = append(params, req.Category.Description)
-	}
-
-	if len(params) == 0 {
+	if upd == (storage.CategoryUpdate{}) {
 		return nil, status.Error(codes.InvalidArgument, "no fields to update")
 	}
 
-	sql += " WHERE id = ?"
-	params = append(params, req.Id)
-
-	// Execute the update
-	_, err = h.db.ExecContext(ctx, sql, params...)
+	category, err := h.categories.Update(ctx, req.Id, upd)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update category: %v", err)
+		return nil, storeError(err, "failed to update category")
 	}
 
-	// Retrieve the updated category
-	return h.GetCategory(ctx, &pb.GetCategoryRequest{Id: req.Id})
+	return category, nil
 }
 
 // DeleteCategory deletes a category
@@ -256,20 +122,8 @@ func (h *CategoryHandler) DeleteCategory(ctx context.Context, req *pb.DeleteCate
 		return nil, status.Error(codes.InvalidArgument, "invalid category ID")
 	}
 
-	// Check if the category exists
-	var exists bool
-	err := h.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM categories WHERE id = ?)", req.Id).Scan(&exists)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check category existence: %v", err)
-	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "category with ID %d not found", req.Id)
-	}
-
-	// Delete the category
-	_, err = h.db.ExecContext(ctx, "DELETE FROM categories WHERE id = ?", req.Id)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete category: %v", err)
+	if err := h.categories.Delete(ctx, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete category")
 	}
 
 	return &emptypb.Empty{}, nil
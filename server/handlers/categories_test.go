@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// wantCode fails the test unless err is a status with the given code
+func wantCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != code {
+		t.Errorf("code = %v (%v), want %v", got, err, code)
+	}
+}
+
+func TestCreateCategory(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.CreateCategoryRequest
+		code codes.Code
+	}{
+		{"valid", &pb.CreateCategoryRequest{Name: "Rudiments", WeeklyTargetMinutes: 60}, codes.OK},
+		{"missing name", &pb.CreateCategoryRequest{Description: "Sticking"}, codes.InvalidArgument},
+		{"negative target", &pb.CreateCategoryRequest{Name: "Rudiments", WeeklyTargetMinutes: -1}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categories := newFakeCategories()
+			h := NewCategoryHandler(categories)
+
+			category, err := h.CreateCategory(context.Background(), tt.req)
+			wantCode(t, err, tt.code)
+			if err != nil {
+				if len(categories.rows) != 0 {
+					t.Errorf("stored %d categories of an invalid request", len(categories.rows))
+				}
+				return
+			}
+			if category.Id == 0 || category.Name != tt.req.Name || category.WeeklyTargetMinutes != tt.req.WeeklyTargetMinutes {
+				t.Errorf("CreateCategory = %v", category)
+			}
+		})
+	}
+}
+
+func TestUpdateCategory(t *testing.T) {
+	ctx := context.Background()
+	categories := newFakeCategories()
+	h := NewCategoryHandler(categories)
+
+	created, err := h.CreateCategory(ctx, &pb.CreateCategoryRequest{Name: "Rudiments", Description: "Sticking", WeeklyTargetMinutes: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the fields of the mask change
+	updated, err := h.UpdateCategory(ctx, &pb.UpdateCategoryRequest{
+		Id:         created.Id,
+		Category:   &pb.Category{Description: "Singles and doubles"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateCategory: %v", err)
+	}
+	if updated.Name != "Rudiments" || updated.Description != "Singles and doubles" || updated.WeeklyTargetMinutes != 30 {
+		t.Errorf("UpdateCategory = %v", updated)
+	}
+
+	tests := []struct {
+		name string
+		req  *pb.UpdateCategoryRequest
+		code codes.Code
+	}{
+		{"invalid ID", &pb.UpdateCategoryRequest{Category: &pb.Category{Name: "x"}}, codes.InvalidArgument},
+		{"missing category", &pb.UpdateCategoryRequest{Id: created.Id}, codes.InvalidArgument},
+		{"empty name without a mask", &pb.UpdateCategoryRequest{Id: created.Id, Category: &pb.Category{}}, codes.InvalidArgument},
+		{
+			name: "negative target",
+			req: &pb.UpdateCategoryRequest{
+				Id:         created.Id,
+				Category:   &pb.Category{WeeklyTargetMinutes: -5},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"weekly_target_minutes"}},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown fields only",
+			req: &pb.UpdateCategoryRequest{
+				Id:         created.Id,
+				Category:   &pb.Category{Name: "x"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"id"}},
+			},
+			code: codes.InvalidArgument,
+		},
+		{"not found", &pb.UpdateCategoryRequest{Id: 99, Category: &pb.Category{Name: "x"}}, codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.UpdateCategory(ctx, tt.req)
+			wantCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestDeleteCategory(t *testing.T) {
+	ctx := context.Background()
+	h := NewCategoryHandler(newFakeCategories())
+
+	created, err := h.CreateCategory(ctx, &pb.CreateCategoryRequest{Name: "Rudiments"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := h.DeleteCategory(ctx, &pb.DeleteCategoryRequest{Id: created.Id}); err != nil {
+		t.Fatalf("DeleteCategory: %v", err)
+	}
+	_, err = h.GetCategory(ctx, &pb.GetCategoryRequest{Id: created.Id})
+	wantCode(t, err, codes.NotFound)
+	_, err = h.DeleteCategory(ctx, &pb.DeleteCategoryRequest{Id: created.Id})
+	wantCode(t, err, codes.NotFound)
+	_, err = h.GetCategory(ctx, &pb.GetCategoryRequest{})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestListCategoriesPages(t *testing.T) {
+	ctx := context.Background()
+	h := NewCategoryHandler(newFakeCategories())
+
+	for _, name := range []string{"Rudiments", "Grooves", "Reading", "Fills", "Independence"} {
+		if _, err := h.CreateCategory(ctx, &pb.CreateCategoryRequest{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var names []string
+	req := &pb.ListCategoriesRequest{PageSize: 2, IncludeTotalCount: true}
+	for pages := 1; ; pages++ {
+		resp, err := h.ListCategories(ctx, req)
+		if err != nil {
+			t.Fatalf("page %d: %v", pages, err)
+		}
+		if resp.TotalCount != 5 {
+			t.Errorf("page %d: total count %d, want 5", pages, resp.TotalCount)
+		}
+		for _, category := range resp.Categories {
+			names = append(names, category.Name)
+		}
+		if resp.NextPageToken == "" {
+			if pages != 3 {
+				t.Errorf("listed %d pages, want 3", pages)
+			}
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	want := "Rudiments Grooves Reading Fills Independence"
+	if got := strings.Join(names, " "); got != want {
+		t.Errorf("listed %q, want %q", got, want)
+	}
+
+	_, err := h.ListCategories(ctx, &pb.ListCategoriesRequest{PageToken: "forged"})
+	wantCode(t, err, codes.InvalidArgument)
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DataHandler implements the DataService gRPC service
+type DataHandler struct {
+	pb.UnimplementedDataServiceServer
+	data storage.DataRepo
+}
+
+// NewDataHandler creates a new DataHandler
+func NewDataHandler(data storage.DataRepo) *DataHandler {
+	return &DataHandler{data: data}
+}
+
+// ExportAll exports all data as a single archive
+func (h *DataHandler) ExportAll(ctx context.Context, req *pb.ExportAllRequest) (*pb.DataArchive, error) {
+	archive, err := h.data.Export(ctx)
+	if err != nil {
+		return nil, storeError(err, "failed to export data")
+	}
+
+	return archive, nil
+}
+
+// ImportAll imports a data archive
+func (h *DataHandler) ImportAll(ctx context.Context, req *pb.ImportAllRequest) (*pb.ImportAllResponse, error) {
+	if req.Archive == nil {
+		return nil, status.Error(codes.InvalidArgument, "archive is required")
+	}
+
+	summary, err := h.data.Import(ctx, req.Archive, storage.ImportOptions{RemapIDs: req.RemapIds})
+	if errors.Is(err, storage.ErrNotEmpty) {
+		return nil, status.Error(codes.FailedPrecondition, "you already have data, import with remap_ids to merge the archive")
+	} else if err != nil {
+		return nil, storeError(err, "failed to import data")
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"errors"
+
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"github.com/Zach-Johnson/tempus/server/imaging"
+	"github.com/Zach-Johnson/tempus/server/notation"
+	"github.com/Zach-Johnson/tempus/server/pack"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// storeError converts an error returned by a repository into a gRPC status,
+// unexpected failures are reported as Internal and prefixed with msg
+func storeError(err error, msg string) error {
+	var notFound *storage.NotFoundError
+	switch {
+	case errors.As(err, &notFound):
+		return status.Error(codes.NotFound, notFound.Error())
+	case errors.Is(err, storage.ErrUsernameTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, storage.ErrActiveSession),
+		errors.Is(err, storage.ErrSessionNotActive),
+		errors.Is(err, storage.ErrSessionPaused),
+		errors.Is(err, storage.ErrSessionNotPaused),
+		errors.Is(err, storage.ErrNoExerciseInProgress):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, storage.ErrInvalidArchive),
+		errors.Is(err, imaging.ErrInvalid),
+		errors.Is(err, notation.ErrInvalid),
+		errors.Is(err, pack.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
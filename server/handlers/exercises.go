@@ -2,27 +2,27 @@ package handlers
 
 import (
 	"context"
-	"database/sql"
-	"encoding/json"
 	"strconv"
-	"time"
+	"strings"
 
 	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"github.com/Zach-Johnson/tempus/server/tempo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ExerciseHandler implements the ExerciseService gRPC service
 type ExerciseHandler struct {
 	pb.UnimplementedExerciseServiceServer
-	db *sql.DB
+	exercises storage.ExerciseRepo
+	history   storage.HistoryRepo
 }
 
 // NewExerciseHandler creates a new ExerciseHandler
-func NewExerciseHandler(db *sql.DB) *ExerciseHandler {
-	return &ExerciseHandler{db: db}
+func NewExerciseHandler(exercises storage.ExerciseRepo, history storage.HistoryRepo) *ExerciseHandler {
+	return &ExerciseHandler{exercises: exercises, history: history}
 }
 
 // CreateExercise creates a new exercise
@@ -31,155 +31,23 @@ func (h *ExerciseHandler) CreateExercise(ctx context.Context, req *pb.CreateExer
 		return nil, status.Error(codes.InvalidArgument, "exercise name is required")
 	}
 
-	// Start a transaction
-	tx, err := h.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to start transaction: %v", err)
-	}
-	defer tx.Rollback() // Rollback if not committed
-
-	// Insert the exercise
-	result, err := tx.ExecContext(
-		ctx,
-		"INSERT INTO exercises (name, description) VALUES (?, ?)",
-		req.Name, req.Description,
-	)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create exercise: %v", err)
-	}
-
-	// Get the exercise ID
-	id, err := result.LastInsertId()
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get exercise ID: %v", err)
-	}
-
-	// Associate exercise with tags if provided
-	for _, tagID := range req.TagIds {
-		// Check if tag exists
-		var exists bool
-		err := tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM tags WHERE id = ?)", tagID).Scan(&exists)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to check tag existence: %v", err)
-		}
-		if !exists {
-			return nil, status.Errorf(codes.NotFound, "tag with ID %d not found", tagID)
-		}
-
-		// Create exercise-tag relationship
-		_, err = tx.ExecContext(
-			ctx,
-			"INSERT INTO exercise_tags (exercise_id, tag_id) VALUES (?, ?)",
-			id, tagID,
-		)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to associate exercise with tag: %v", err)
-		}
-	}
-
-	// Add images if provided, the actual image data is not included here to avoid giant responses.
-	images := make([]*pb.ExerciseImage, 0, len(req.Images))
-	for _, imageReq := range req.Images {
-		result, err := tx.ExecContext(
-			ctx,
-			`INSERT INTO exercise_images (exercise_id, image_data, filename, mime_type, description) 
-             VALUES (?, ?, ?, ?, ?)`,
-			id, imageReq.ImageData, imageReq.Filename, imageReq.MimeType, imageReq.Description,
-		)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to add image to exercise: %v", err)
-		}
-
-		imageID, err := result.LastInsertId()
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get image ID: %v", err)
-		}
-
-		var imageCreatedAt time.Time
-		err = tx.QueryRowContext(
-			ctx,
-			"SELECT created_at FROM exercise_images WHERE id = ?",
-			imageID,
-		).Scan(&imageCreatedAt)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get image creation time: %v", err)
-		}
-
-		images = append(images, &pb.ExerciseImage{
-			Id:          int32(imageID),
-			ExerciseId:  int32(id),
-			ImageData:   imageReq.ImageData,
-			Filename:    imageReq.Filename,
-			MimeType:    imageReq.MimeType,
-			Description: imageReq.Description,
-			CreatedAt:   timestamppb.New(imageCreatedAt),
-		})
+	if err := validateTempoPlan(req.TempoPlan); err != nil {
+		return nil, err
 	}
 
-	// Add links if provided
-	links := make([]*pb.ExerciseLink, 0, len(req.Links))
-	for _, linkReq := range req.Links {
-		result, err := tx.ExecContext(
-			ctx,
-			`INSERT INTO exercise_links (exercise_id, url, description) 
-             VALUES (?, ?, ?)`,
-			id, linkReq.Url, linkReq.Description,
-		)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to add link to exercise: %v", err)
-		}
-
-		linkID, err := result.LastInsertId()
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get link ID: %v", err)
-		}
-
-		var linkCreatedAt time.Time
-		err = tx.QueryRowContext(
-			ctx,
-			"SELECT created_at FROM exercise_links WHERE id = ?",
-			linkID,
-		).Scan(&linkCreatedAt)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get link creation time: %v", err)
-		}
-
-		links = append(links, &pb.ExerciseLink{
-			Id:          int32(linkID),
-			ExerciseId:  int32(id),
-			Url:         linkReq.Url,
-			Description: linkReq.Description,
-			CreatedAt:   timestamppb.New(linkCreatedAt),
-		})
-	}
-
-	// Get the exercise creation and update times
-	var createdAt, updatedAt time.Time
-	err = tx.QueryRowContext(
-		ctx,
-		"SELECT created_at, updated_at FROM exercises WHERE id = ?",
-		id,
-	).Scan(&createdAt, &updatedAt)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get exercise creation time: %v", err)
-	}
-
-	// Commit the transaction
-	if err := tx.Commit(); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
-	}
-
-	// Return the created exercise
-	return &pb.Exercise{
-		Id:          int32(id),
+	exercise, err := h.exercises.Create(ctx, &pb.Exercise{
 		Name:        req.Name,
 		Description: req.Description,
-		CreatedAt:   timestamppb.New(createdAt),
-		UpdatedAt:   timestamppb.New(updatedAt),
 		TagIds:      req.TagIds,
-		Images:      images,
-		Links:       links,
-	}, nil
+		Images:      req.Images,
+		Links:       req.Links,
+		TempoPlan:   req.TempoPlan,
+	})
+	if err != nil {
+		return nil, storeError(err, "failed to create exercise")
+	}
+
+	return exercise, nil
 }
 
 // GetExercise retrieves an exercise by ID
@@ -188,434 +56,85 @@ func (h *ExerciseHandler) GetExercise(ctx context.Context, req *pb.GetExerciseRe
 		return nil, status.Error(codes.InvalidArgument, "invalid exercise ID")
 	}
 
-	// Query the exercise
-	var (
-		exercise             pb.Exercise
-		createdAt, updatedAt time.Time
-		histCount            int
-	)
-
-	err := h.db.QueryRowContext(
-		ctx,
-		`SELECT id, name, description, created_at, updated_at, (
-			SELECT COUNT(1) FROM exercise_history WHERE exercise_id = $1
-		)
-		FROM exercises
-		WHERE id = $1`,
-		req.Id,
-	).Scan(&exercise.Id, &exercise.Name, &exercise.Description, &createdAt, &updatedAt, &histCount)
-
-	if err == sql.ErrNoRows {
-		return nil, status.Errorf(codes.NotFound, "exercise with ID %d not found", req.Id)
-	} else if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve exercise: %v", err)
-	}
-
-	if histCount > 0 {
-		var (
-			lastPractice time.Time
-			lastBPMJSON  string
-		)
-		err := h.db.QueryRowContext(
-			ctx,
-			`SELECT start_time, bpms, notes
-		FROM exercise_history
-		WHERE exercise_id = ?
-		ORDER BY start_time DESC
-		LIMIT 1`,
-			req.Id,
-		).Scan(&lastPractice, &lastBPMJSON, &exercise.LastNotes)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to retrieve exercise history: %v", err)
-		}
-
-		if lastBPMJSON != "" {
-			var bpms []int32
-			if err := json.Unmarshal([]byte(lastBPMJSON), &bpms); err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to unmarshal BPM values: %v", err)
-			}
-			exercise.LastBpms = bpms
-		}
-
-		exercise.LastPractice = timestamppb.New(lastPractice)
-	}
-
-	exercise.CreatedAt = timestamppb.New(createdAt)
-	exercise.UpdatedAt = timestamppb.New(updatedAt)
-
-	// Get associated tag IDs
-	tagRows, err := h.db.QueryContext(
-		ctx,
-		"SELECT tag_id FROM exercise_tags WHERE exercise_id = ?",
-		req.Id,
-	)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve exercise tags: %v", err)
-	}
-	defer tagRows.Close()
-
-	var tagIDs []int32
-	for tagRows.Next() {
-		var tagID int32
-		if err := tagRows.Scan(&tagID); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse tag ID: %v", err)
-		}
-		tagIDs = append(tagIDs, tagID)
-	}
-	if err = tagRows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading exercise tags: %v", err)
-	}
-	exercise.TagIds = tagIDs
-
-	// Get images
-	imageRows, err := h.db.QueryContext(
-		ctx,
-		`SELECT id, filename, mime_type, description, created_at 
-         FROM exercise_images WHERE exercise_id = ?`,
-		req.Id,
-	)
+	exercise, err := h.exercises.Get(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve exercise images: %v", err)
+		return nil, storeError(err, "failed to retrieve exercise")
 	}
-	defer imageRows.Close()
 
-	var images []*pb.ExerciseImage
-	for imageRows.Next() {
-		var image pb.ExerciseImage
-		var imageCreatedAt time.Time
-		if err := imageRows.Scan(
-			&image.Id, &image.Filename, &image.MimeType, &image.Description, &imageCreatedAt,
-		); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse exercise image: %v", err)
-		}
-		image.ExerciseId = req.Id
-		image.CreatedAt = timestamppb.New(imageCreatedAt)
-		images = append(images, &image)
-	}
-	if err = imageRows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading exercise images: %v", err)
-	}
-	exercise.Images = images
-
-	// Get links
-	linkRows, err := h.db.QueryContext(
-		ctx,
-		`SELECT id, url, description, created_at 
-         FROM exercise_links WHERE exercise_id = ?`,
-		req.Id,
-	)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve exercise links: %v", err)
-	}
-	defer linkRows.Close()
-
-	var links []*pb.ExerciseLink
-	for linkRows.Next() {
-		var link pb.ExerciseLink
-		var linkCreatedAt time.Time
-		if err := linkRows.Scan(&link.Id, &link.Url, &link.Description, &linkCreatedAt); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse exercise link: %v", err)
-		}
-		link.ExerciseId = req.Id
-		link.CreatedAt = timestamppb.New(linkCreatedAt)
-		links = append(links, &link)
-	}
-	if err = linkRows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading exercise links: %v", err)
-	}
-	exercise.Links = links
-
-	return &exercise, nil
+	return exercise, nil
 }
 
 // ListExercises lists exercises with optional filtering and pagination
 func (h *ExerciseHandler) ListExercises(ctx context.Context, req *pb.ListExercisesRequest) (*pb.ListExercisesResponse, error) {
-	pageSize := int(req.PageSize)
-	if pageSize <= 0 {
-		pageSize = 50 // Default page size
-	}
-
-	// Parse the page token, which is just an offset
-	offset := 0
-	if req.PageToken != "" {
-		var err error
-		offset, err = strconv.Atoi(req.PageToken)
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "invalid page token")
-		}
-	}
-
-	// Build the query based on filters
-	baseQuery := `
-        SELECT DISTINCT e.id, e.name, e.description, e.created_at, e.updated_at
-        FROM exercises e
-    `
-	countQuery := `
-        SELECT COUNT(DISTINCT e.id)
-        FROM exercises e
-    `
-
-	var whereClause string
-	var queryParams []any
-
-	if req.CategoryId > 0 && req.TagId > 0 {
-		// Filter by both category and tag
-		// For category, we need to find tags in that category first
-		whereClause = `
-            WHERE e.id IN (
-                SELECT et.exercise_id 
-                FROM exercise_tags et
-                JOIN tag_categories tc ON et.tag_id = tc.tag_id 
-                WHERE tc.category_id = ?
-            ) AND e.id IN (
-                SELECT et.exercise_id 
-                FROM exercise_tags et 
-                WHERE et.tag_id = ?
-            )
-        `
-		queryParams = append(queryParams, req.CategoryId, req.TagId)
-	} else if req.CategoryId > 0 {
-		// Filter by category only - now uses tag_categories junction table
-		whereClause = `
-            WHERE e.id IN (
-                SELECT DISTINCT et.exercise_id 
-                FROM exercise_tags et
-                JOIN tag_categories tc ON et.tag_id = tc.tag_id
-                WHERE tc.category_id = ?
-            )
-        `
-		queryParams = append(queryParams, req.CategoryId)
-	} else if req.TagId > 0 {
-		// Filter by tag only
-		whereClause = `
-            WHERE e.id IN (
-                SELECT et.exercise_id 
-                FROM exercise_tags et 
-                WHERE et.tag_id = ?
-            )
-        `
-		queryParams = append(queryParams, req.TagId)
-	}
-
-	// Add order by, limit, and offset
-	fullQuery := baseQuery + whereClause + " ORDER BY e.name LIMIT ? OFFSET ?"
-	queryParams = append(queryParams, pageSize+1, offset) // Query one more to check if there are more pages
-
-	// Query total count
-	var totalCount int32
-	countQueryParams := make([]any, len(queryParams)-2) // Exclude limit and offset
-	copy(countQueryParams, queryParams[:len(queryParams)-2])
-
-	err := h.db.QueryRowContext(ctx, countQuery+whereClause, countQueryParams...).Scan(&totalCount)
+	p, err := newPage(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to count exercises: %v", err)
+		return nil, err
 	}
 
-	// Query exercises
-	rows, err := h.db.QueryContext(ctx, fullQuery, queryParams...)
+	filter, err := exerciseFilter(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list exercises: %v", err)
-	}
-	defer rows.Close()
-
-	// Parse the results
-	exercises := make([]*pb.Exercise, 0, pageSize)
-	count := 0
-	hasMorePages := false
-	var exerciseIDs []int32
-
-	for rows.Next() {
-		if count >= pageSize {
-			hasMorePages = true
-			break
-		}
-
-		var exercise pb.Exercise
-		var createdAt, updatedAt time.Time
-
-		err := rows.Scan(&exercise.Id, &exercise.Name, &exercise.Description, &createdAt, &updatedAt)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse exercise: %v", err)
-		}
-
-		exercise.CreatedAt = timestamppb.New(createdAt)
-		exercise.UpdatedAt = timestamppb.New(updatedAt)
-		exercises = append(exercises, &exercise)
-		exerciseIDs = append(exerciseIDs, exercise.Id)
-		count++
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading exercises: %v", err)
+		return nil, err
 	}
 
-	// For each exercise, get tags, images, and links
-	if len(exerciseIDs) > 0 {
-		if err := h.addRelatedData(ctx, exercises); err != nil {
-			return nil, err
-		}
+	if _, ok := pb.ExerciseSort_name[int32(req.Sort)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid sort")
 	}
+	order := storage.ExerciseOrder{By: req.Sort, Descending: req.Descending}
 
-	// Calculate next page token
-	nextPageToken := ""
-	if hasMorePages {
-		nextPageToken = strconv.Itoa(offset + pageSize)
+	page, err := h.exercises.List(ctx, filter, order, p.options())
+	if err != nil {
+		return nil, storeError(err, "failed to list exercises")
 	}
 
 	return &pb.ListExercisesResponse{
-		Exercises:     exercises,
-		NextPageToken: nextPageToken,
-		TotalCount:    totalCount,
+		Exercises:     page.Items,
+		NextPageToken: p.nextToken(page.Next),
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
-// addRelatedData adds tags, images, and links to the exercises
-func (h *ExerciseHandler) addRelatedData(ctx context.Context, exercises []*pb.Exercise) error {
-	// Map for quick lookup of exercises by ID
-	exerciseMap := make(map[int32]*pb.Exercise)
-	exerciseIDs := make([]any, 0, len(exercises))
-
-	// Build query params and map
-	placeholders := ""
-	for i, ex := range exercises {
-		exerciseMap[ex.Id] = ex
-		exerciseIDs = append(exerciseIDs, ex.Id)
-
-		if i > 0 {
-			placeholders += ","
-		}
-		placeholders += "?"
+// exerciseFilter validates the filters of a ListExercises request
+func exerciseFilter(req *pb.ListExercisesRequest) (storage.ExerciseFilter, error) {
+	filter := storage.ExerciseFilter{
+		CategoryID:    req.CategoryId,
+		TagIDs:        req.TagIds,
+		ExcludeTagIDs: req.ExcludeTagIds,
+		MinLastBPM:    req.MinLastBpm,
+		MaxLastBPM:    req.MaxLastBpm,
+		MinRating:     req.MinRating,
+		MaxRating:     req.MaxRating,
 	}
-
-	// Get tags for all exercises
-	tagQuery := "SELECT exercise_id, tag_id FROM exercise_tags WHERE exercise_id IN (" + placeholders + ")"
-	tagRows, err := h.db.QueryContext(ctx, tagQuery, exerciseIDs...)
-	if err != nil {
-		return status.Errorf(codes.Internal, "failed to retrieve exercise tags: %v", err)
+	if req.TagId > 0 {
+		filter.TagIDs = append(filter.TagIDs, req.TagId)
 	}
-	defer tagRows.Close()
 
-	for tagRows.Next() {
-		var exerciseID, tagID int32
-		if err := tagRows.Scan(&exerciseID, &tagID); err != nil {
-			return status.Errorf(codes.Internal, "failed to parse exercise tag: %v", err)
-		}
-
-		if exercise, ok := exerciseMap[exerciseID]; ok {
-			exercise.TagIds = append(exercise.TagIds, tagID)
-		}
-	}
-	if err = tagRows.Err(); err != nil {
-		return status.Errorf(codes.Internal, "error reading exercise tags: %v", err)
+	switch req.TagMatch {
+	case pb.TagMatch_TAG_MATCH_UNSPECIFIED, pb.TagMatch_TAG_MATCH_ANY:
+	case pb.TagMatch_TAG_MATCH_ALL:
+		filter.AllTags = true
+	default:
+		return filter, status.Error(codes.InvalidArgument, "invalid tag match")
 	}
 
-	// Get images for all exercises, the actual image data is not included here to avoid giant responses.
-	imageQuery := `SELECT id, exercise_id, filename, mime_type, description, created_at
-       FROM exercise_images WHERE exercise_id IN (` + placeholders + `)`
-	imageRows, err := h.db.QueryContext(ctx, imageQuery, exerciseIDs...)
-	if err != nil {
-		return status.Errorf(codes.Internal, "failed to retrieve exercise images: %v", err)
+	if req.NotPracticedSince != nil {
+		since := req.NotPracticedSince.AsTime()
+		filter.NotPracticedSince = &since
 	}
-	defer imageRows.Close()
-
-	for imageRows.Next() {
-		var (
-			image     pb.ExerciseImage
-			createdAt time.Time
-		)
-		if err := imageRows.Scan(&image.Id, &image.ExerciseId, &image.Filename, &image.MimeType, &image.Description, &createdAt); err != nil {
-			return status.Errorf(codes.Internal, "failed to parse exercise image: %v", err)
-		}
-		image.CreatedAt = timestamppb.New(createdAt)
 
-		if exercise, ok := exerciseMap[image.ExerciseId]; ok {
-			exercise.Images = append(exercise.Images, &image)
-		}
-	}
-	if err = imageRows.Err(); err != nil {
-		return status.Errorf(codes.Internal, "error reading exercise images: %v", err)
-	}
-
-	// Get links for all exercises
-	linkQuery := "SELECT id, exercise_id, url, description, created_at FROM exercise_links WHERE exercise_id IN (" + placeholders + ")"
-	linkRows, err := h.db.QueryContext(ctx, linkQuery, exerciseIDs...)
-	if err != nil {
-		return status.Errorf(codes.Internal, "failed to retrieve exercise links: %v", err)
-	}
-	defer linkRows.Close()
-
-	for linkRows.Next() {
-		var link pb.ExerciseLink
-		var linkCreatedAt time.Time
-
-		if err := linkRows.Scan(&link.Id, &link.ExerciseId, &link.Url, &link.Description, &linkCreatedAt); err != nil {
-			return status.Errorf(codes.Internal, "failed to parse exercise link: %v", err)
-		}
-
-		link.CreatedAt = timestamppb.New(linkCreatedAt)
-
-		if exercise, ok := exerciseMap[link.ExerciseId]; ok {
-			exercise.Links = append(exercise.Links, &link)
-		}
-	}
-	if err = linkRows.Err(); err != nil {
-		return status.Errorf(codes.Internal, "error reading exercise links: %v", err)
+	if filter.MinLastBPM < 0 || filter.MaxLastBPM < 0 {
+		return filter, status.Error(codes.InvalidArgument, "BPM bounds cannot be negative")
 	}
-
-	// Get last practice
-	lastPracticeQuery := `SELECT eh.exercise_id, latest.latest_start_time, latest.bpms, latest.notes
-	FROM exercise_history eh
-	INNER JOIN (
-		SELECT exercise_id, MAX(start_time) AS latest_start_time, bpms, notes
-		FROM exercise_history
-		WHERE exercise_id IN (` + placeholders + `)
-		GROUP BY exercise_id
-	) latest ON eh.exercise_id = latest.exercise_id AND eh.start_time = latest.latest_start_time
-	`
-	lastPracticeRows, err := h.db.QueryContext(ctx, lastPracticeQuery, exerciseIDs...)
-	if err != nil {
-		return status.Errorf(codes.Internal, "failed to retrieve exercise last practice: %v", err)
+	if filter.MaxLastBPM > 0 && filter.MinLastBPM > filter.MaxLastBPM {
+		return filter, status.Error(codes.InvalidArgument, "minimum BPM cannot exceed the maximum")
 	}
-	defer lastPracticeRows.Close()
-
-	for lastPracticeRows.Next() {
-		var (
-			lastPracticeS string
-			exerciseID    int32
-			lastBPMJSON   string
-			lastNotes     string
-		)
-
-		if err := lastPracticeRows.Scan(&exerciseID, &lastPracticeS, &lastBPMJSON, &lastNotes); err != nil {
-			return status.Errorf(codes.Internal, "failed to parse exercise last practice: %v", err)
-		}
-
-		const layout = "2006-01-02 15:04:05.999+00:00"
-
-		// Parse the string into a time.Time
-		parsedTime, err := time.Parse(layout, lastPracticeS)
-		if err != nil {
-			return status.Errorf(codes.Internal, "failed to parse last practice time: %v", err)
-		}
-
-		if exercise, ok := exerciseMap[exerciseID]; ok {
-			exercise.LastPractice = timestamppb.New(parsedTime)
-			exercise.LastNotes = lastNotes
-
-			if lastBPMJSON != "" {
-				var bpms []int32
-				if err := json.Unmarshal([]byte(lastBPMJSON), &bpms); err != nil {
-					return status.Errorf(codes.Internal, "failed to unmarshal BPM values: %v", err)
-				}
-				exercise.LastBpms = bpms
-			}
-		}
+	if filter.MinRating < 0 || filter.MinRating > 5 || filter.MaxRating < 0 || filter.MaxRating > 5 {
+		return filter, status.Error(codes.InvalidArgument, "rating bounds must be between 1 and 5")
 	}
-	if err = lastPracticeRows.Err(); err != nil {
-		return status.Errorf(codes.Internal, "error reading exercise last practice: %v", err)
+	if filter.MaxRating > 0 && filter.MinRating > filter.MaxRating {
+		return filter, status.Error(codes.InvalidArgument, "minimum rating cannot exceed the maximum")
 	}
 
-	return nil
+	return filter, nil
 }
 
 // UpdateExercise updates an exercise
@@ -628,117 +147,36 @@ func (h *ExerciseHandler) UpdateExercise(ctx context.Context, req *pb.UpdateExer
 		return nil, status.Error(codes.InvalidArgument, "exercise data is required")
 	}
 
-	// Start transaction
-	tx, err := h.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to start transaction: %v", err)
-	}
-	defer tx.Rollback()
-
-	// Check if exercise exists
-	var exists bool
-	err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM exercises WHERE id = ?)", req.Id).Scan(&exists)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check exercise existence: %v", err)
-	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "exercise with ID %d not found", req.Id)
-	}
-
-	// Parse update mask
-	updateName := false
-	updateDescription := false
-	updateTags := false
-
-	if req.UpdateMask == nil || len(req.UpdateMask.Paths) == 0 {
-		// If no update mask is provided, update all fields
-		updateName = true
-		updateDescription = true
-		updateTags = true
-	} else {
-		for _, path := range req.UpdateMask.Paths {
-			switch path {
-			case "name":
-				updateName = true
-			case "description":
-				updateDescription = true
-			case "tag_ids":
-				updateTags = true
-			}
-		}
-	}
-
-	// Update exercise fields
-	if updateName || updateDescription {
-		sql := "UPDATE exercises SET"
-		params := []any{}
-		needsComma := false
-
-		if updateName {
+	var upd storage.ExerciseUpdate
+	for _, path := range updatePaths(req.UpdateMask, "name", "description", "tag_ids", "tempo_plan") {
+		switch path {
+		case "name":
 			if req.Exercise.Name == "" {
 				return nil, status.Error(codes.InvalidArgument, "exercise name cannot be empty")
 			}
-			sql += " name = ?"
-			params = append(params, req.Exercise.Name)
-			needsComma = true
-		}
-
-		if updateDescription {
-			if needsComma {
-				sql += ","
+			upd.Name = &req.Exercise.Name
+		case "description":
+			upd.Description = &req.Exercise.Description
+		case "tag_ids":
+			upd.TagIDs = &req.Exercise.TagIds
+		case "tempo_plan":
+			if err := validateTempoPlan(req.Exercise.TempoPlan); err != nil {
+				return nil, err
 			}
-			sql += " description = ?"
-			params = append(params, req.Exercise.Description)
-		}
-
-		sql += " WHERE id = ?"
-		params = append(params, req.Id)
-
-		_, err = tx.ExecContext(ctx, sql, params...)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to update exercise: %v", err)
-		}
-	}
-
-	// Update tags if requested
-	if updateTags {
-		// Delete existing tag associations
-		_, err = tx.ExecContext(ctx, "DELETE FROM exercise_tags WHERE exercise_id = ?", req.Id)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to remove existing tag associations: %v", err)
-		}
-
-		// Add new tag associations
-		for _, tagID := range req.Exercise.TagIds {
-			// Check if tag exists
-			var exists bool
-			err := tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM tags WHERE id = ?)", tagID).Scan(&exists)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to check tag existence: %v", err)
-			}
-			if !exists {
-				return nil, status.Errorf(codes.NotFound, "tag with ID %d not found", tagID)
-			}
-
-			// Create association
-			_, err = tx.ExecContext(
-				ctx,
-				"INSERT INTO exercise_tags (exercise_id, tag_id) VALUES (?, ?)",
-				req.Id, tagID,
-			)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to associate exercise with tag: %v", err)
+			// Without a plan the stored plan is removed
+			upd.TempoPlan = &pb.TempoPlan{}
+			if tempo.Planned(req.Exercise.TempoPlan) {
+				upd.TempoPlan = req.Exercise.TempoPlan
 			}
 		}
 	}
 
-	// Commit the transaction
-	if err := tx.Commit(); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	exercise, err := h.exercises.Update(ctx, req.Id, upd)
+	if err != nil {
+		return nil, storeError(err, "failed to update exercise")
 	}
 
-	// Retrieve the updated exercise
-	return h.GetExercise(ctx, &pb.GetExerciseRequest{Id: req.Id})
+	return exercise, nil
 }
 
 // DeleteExercise deletes an exercise
@@ -747,20 +185,8 @@ func (h *ExerciseHandler) DeleteExercise(ctx context.Context, req *pb.DeleteExer
 		return nil, status.Error(codes.InvalidArgument, "invalid exercise ID")
 	}
 
-	// Check if the exercise exists
-	var exists bool
-	err := h.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM exercises WHERE id = ?)", req.Id).Scan(&exists)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check exercise existence: %v", err)
-	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "exercise with ID %d not found", req.Id)
-	}
-
-	// Delete the exercise (associated records in junction tables will be deleted by ON DELETE CASCADE)
-	_, err = h.db.ExecContext(ctx, "DELETE FROM exercises WHERE id = ?", req.Id)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete exercise: %v", err)
+	if err := h.exercises.Delete(ctx, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete exercise")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -776,54 +202,18 @@ func (h *ExerciseHandler) AddExerciseImage(ctx context.Context, req *pb.AddExerc
 		return nil, status.Error(codes.InvalidArgument, "image data is required")
 	}
 
-	// Check if exercise exists
-	var exists bool
-	err := h.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM exercises WHERE id = ?)", req.ExerciseId).Scan(&exists)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check exercise existence: %v", err)
-	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "exercise with ID %d not found", req.ExerciseId)
-	}
-
-	// Insert the image
-	result, err := h.db.ExecContext(
-		ctx,
-		`INSERT INTO exercise_images (exercise_id, image_data, filename, mime_type, description) 
-         VALUES (?, ?, ?, ?, ?)`,
-		req.ExerciseId, req.ImageData, req.Filename, req.MimeType, req.Description,
-	)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to add image to exercise: %v", err)
-	}
-
-	// Get the image ID
-	imageID, err := result.LastInsertId()
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get image ID: %v", err)
-	}
-
-	// Get image creation time
-	var createdAt time.Time
-	err = h.db.QueryRowContext(
-		ctx,
-		"SELECT created_at FROM exercise_images WHERE id = ?",
-		imageID,
-	).Scan(&createdAt)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get image creation time: %v", err)
-	}
-
-	// Return the created image
-	return &pb.ExerciseImage{
-		Id:          int32(imageID),
+	image, err := h.exercises.AddImage(ctx, &pb.ExerciseImage{
 		ExerciseId:  req.ExerciseId,
 		ImageData:   req.ImageData,
 		Filename:    req.Filename,
 		MimeType:    req.MimeType,
 		Description: req.Description,
-		CreatedAt:   timestamppb.New(createdAt),
-	}, nil
+	})
+	if err != nil {
+		return nil, storeError(err, "failed to add image to exercise")
+	}
+
+	return image, nil
 }
 
 // GetExerciseImage gets an image
@@ -832,32 +222,12 @@ func (h *ExerciseHandler) GetExerciseImage(ctx context.Context, req *pb.GetExerc
 		return nil, status.Error(codes.InvalidArgument, "invalid ID")
 	}
 
-	image := pb.ExerciseImage{
-		Id:         req.ImageId,
-		ExerciseId: req.ExerciseId,
-	}
-	createdAt := time.Time{}
-
-	err := h.db.QueryRowContext(ctx, `
-	SELECT image_data, filename, mime_type, description, created_at 
-	FROM exercise_images WHERE id = ? AND exercise_id = ?`,
-		req.ImageId, req.ExerciseId).
-		Scan(
-			&image.ImageData,
-			&image.Filename,
-			&image.MimeType,
-			&image.Description,
-			&createdAt,
-		)
-	if err == sql.ErrNoRows {
-		return nil, status.Errorf(codes.NotFound, "image with ID %d not found", req.ImageId)
-	} else if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve image: %v", err)
+	image, err := h.exercises.GetImage(ctx, req.ExerciseId, req.ImageId)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve image")
 	}
 
-	image.CreatedAt = timestamppb.New(createdAt)
-
-	return &image, nil
+	return image, nil
 }
 
 // DeleteExerciseImage deletes an image from an exercise
@@ -866,20 +236,8 @@ func (h *ExerciseHandler) DeleteExerciseImage(ctx context.Context, req *pb.Delet
 		return nil, status.Error(codes.InvalidArgument, "invalid image ID")
 	}
 
-	// Check if the image exists
-	var exists bool
-	err := h.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM exercise_images WHERE id = ?)", req.Id).Scan(&exists)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check image existence: %v", err)
-	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "image with ID %d not found", req.Id)
-	}
-
-	// Delete the image
-	_, err = h.db.ExecContext(ctx, "DELETE FROM exercise_images WHERE id = ?", req.Id)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete image: %v", err)
+	if err := h.exercises.DeleteImage(ctx, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete image")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -895,51 +253,16 @@ func (h *ExerciseHandler) AddExerciseLink(ctx context.Context, req *pb.AddExerci
 		return nil, status.Error(codes.InvalidArgument, "url is required")
 	}
 
-	// Check if exercise exists
-	var exists bool
-	err := h.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM exercises WHERE id = ?)", req.ExerciseId).Scan(&exists)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check exercise existence: %v", err)
-	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "exercise with ID %d not found", req.ExerciseId)
-	}
-
-	// Insert the link
-	result, err := h.db.ExecContext(
-		ctx,
-		`INSERT INTO exercise_links (exercise_id, url, description) VALUES (?, ?, ?)`,
-		req.ExerciseId, req.Url, req.Description,
-	)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to add link to exercise: %v", err)
-	}
-
-	// Get the link ID
-	linkID, err := result.LastInsertId()
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get link ID: %v", err)
-	}
-
-	// Get link creation time
-	var createdAt time.Time
-	err = h.db.QueryRowContext(
-		ctx,
-		"SELECT created_at FROM exercise_links WHERE id = ?",
-		linkID,
-	).Scan(&createdAt)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get link creation time: %v", err)
-	}
-
-	// Return the created link
-	return &pb.ExerciseLink{
-		Id:          int32(linkID),
+	link, err := h.exercises.AddLink(ctx, &pb.ExerciseLink{
 		ExerciseId:  req.ExerciseId,
 		Url:         req.Url,
 		Description: req.Description,
-		CreatedAt:   timestamppb.New(createdAt),
-	}, nil
+	})
+	if err != nil {
+		return nil, storeError(err, "failed to add link to exercise")
+	}
+
+	return link, nil
 }
 
 // DeleteExerciseLink deletes a link from an exercise
@@ -948,20 +271,8 @@ func (h *ExerciseHandler) DeleteExerciseLink(ctx context.Context, req *pb.Delete
 		return nil, status.Error(codes.InvalidArgument, "invalid link ID")
 	}
 
-	// Check if the link exists
-	var exists bool
-	err := h.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM exercise_links WHERE id = ?)", req.Id).Scan(&exists)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check link existence: %v", err)
-	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "link with ID %d not found", req.Id)
-	}
-
-	// Delete the link
-	_, err = h.db.ExecContext(ctx, "DELETE FROM exercise_links WHERE id = ?", req.Id)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete link: %v", err)
+	if err := h.exercises.DeleteLink(ctx, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete link")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -973,189 +284,64 @@ func (h *ExerciseHandler) GetExerciseStats(ctx context.Context, req *pb.GetExerc
 		return nil, status.Error(codes.InvalidArgument, "invalid exercise ID")
 	}
 
-	// Check if exercise exists and get its name
-	var exerciseName string
-	err := h.db.QueryRowContext(
-		ctx,
-		"SELECT name FROM exercises WHERE id = ?",
-		req.ExerciseId,
-	).Scan(&exerciseName)
-	if err == sql.ErrNoRows {
-		return nil, status.Errorf(codes.NotFound, "exercise with ID %d not found", req.ExerciseId)
-	} else if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve exercise: %v", err)
-	}
-
-	// Build date filter if provided
-	dateFilter := ""
-	dateParams := []any{}
-	if req.StartDate != nil {
-		dateFilter += " AND start_time >= ?"
-		dateParams = append(dateParams, req.StartDate.AsTime())
-	}
-	if req.EndDate != nil {
-		dateFilter += " AND end_time <= ?"
-		dateParams = append(dateParams, req.EndDate.AsTime())
-	}
-
-	// Get practice count
-	var practiceCount int32
-	practiceCountQuery := `
-		SELECT COUNT(*) 
-		FROM exercise_history 
-		WHERE exercise_id = ?` + dateFilter
-	params := append([]any{req.ExerciseId}, dateParams...)
-	err = h.db.QueryRowContext(ctx, practiceCountQuery, params...).Scan(&practiceCount)
+	loc, err := timeZone(req.TimeZone)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get practice count: %v", err)
+		return nil, err
 	}
 
-	// Get total duration
-	var totalDurationSeconds int32
-	durationQuery := `
-		SELECT COALESCE(SUM(strftime('%s', end_time) - strftime('%s', start_time)), 0) 
-		FROM exercise_history
-		WHERE exercise_id = ?` + dateFilter
-	err = h.db.QueryRowContext(ctx, durationQuery, params...).Scan(&totalDurationSeconds)
+	stats, err := h.exercises.Stats(ctx, req.ExerciseId, dateRange(req.StartDate, req.EndDate), loc)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get total duration: %v", err)
+		return nil, storeError(err, "failed to retrieve exercise statistics")
 	}
 
-	// Get BPM statistics and ratings
-	var maxBPM, minBPM int32
-	var avgBPM, avgRating float64
-
-	if practiceCount == 0 {
-		maxBPM = 0
-		minBPM = 0
-		avgBPM = 0
-		avgRating = 0
-	} else {
-		// Get max BPM - using SQLite JSON functions to extract max value from BPM arrays
-		maxBPMQuery := `
-			SELECT COALESCE(
-				(
-					SELECT MAX(value)
-					FROM (
-						SELECT CAST(json_each.value AS INTEGER) AS value
-						FROM exercise_history, json_each(bpms)
-						WHERE exercise_id = ? 
-						AND bpms IS NOT NULL 
-						AND json_valid(bpms)
-						` + dateFilter + `
-					)
-				), 
-				0
-			) AS max_bpm`
-		err = h.db.QueryRowContext(ctx, maxBPMQuery, params...).Scan(&maxBPM)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get max BPM: %v", err)
-		}
-
-		// Get min BPM
-		minBPMQuery := `
-			SELECT COALESCE(
-				(
-					SELECT MIN(value) 
-					FROM (
-						SELECT CAST(json_each.value AS INTEGER) AS value
-						FROM exercise_history, json_each(bpms)
-						WHERE exercise_id = ? 
-						AND bpms IS NOT NULL 
-						AND json_valid(bpms)
-						` + dateFilter + `
-					)
-				),
-				0
-			) AS min_bpm`
-		err = h.db.QueryRowContext(ctx, minBPMQuery, params...).Scan(&minBPM)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get min BPM: %v", err)
-		}
-
-		// Get avg BPM
-		avgBPMQuery := `
-			SELECT COALESCE(
-				(
-					SELECT AVG(value) 
-					FROM (
-						SELECT CAST(json_each.value AS INTEGER) AS value
-						FROM exercise_history, json_each(bpms)
-						WHERE exercise_id = ? 
-						AND bpms IS NOT NULL 
-						AND json_valid(bpms)
-						` + dateFilter + `
-					)
-				),
-				0
-			) AS avg_bpm`
-		err = h.db.QueryRowContext(ctx, avgBPMQuery, params...).Scan(&avgBPM)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get avg BPM: %v", err)
-		}
+	return stats, nil
+}
 
-		// Get avg rating from exercise_history
-		historyParams := append([]any{req.ExerciseId}, dateParams...)
-		avgRatingQuery := `
-			SELECT COALESCE(AVG(rating), 0) 
-			FROM exercise_history 
-			WHERE exercise_id = ? AND rating IS NOT NULL` + dateFilter
-		err = h.db.QueryRowContext(ctx, avgRatingQuery, historyParams...).Scan(&avgRating)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get avg rating: %v", err)
+// validateTempoPlan checks the tempo ladder of an exercise, a nil plan or one
+// without a start BPM means the exercise has no plan
+func validateTempoPlan(plan *pb.TempoPlan) error {
+	if !tempo.Planned(plan) {
+		if plan.GetStartBpm() < 0 {
+			return status.Error(codes.InvalidArgument, "start BPM cannot be negative")
 		}
+		return nil
 	}
 
-	// Get BPM progress over time - taking the max BPM value per day
-	bpmProgressQuery := `
-		SELECT date, COALESCE(MAX(bpm_value), 0) as bpm
-		FROM (
-			SELECT
-				CAST(strftime('%Y-%m-%d', start_time) AS TEXT) as date,
-				CAST(json_extract(value, '$') AS INTEGER) AS bpm_value
-			FROM exercise_history, json_each(bpms)
-			WHERE exercise_id = ? AND bpms IS NOT NULL` + dateFilter + `
-		) 
-		GROUP BY date
-		ORDER BY date
-	`
-	bpmRows, err := h.db.QueryContext(ctx, bpmProgressQuery, params...)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get BPM progress: %v", err)
+	if plan.EndBpm < plan.StartBpm {
+		return status.Error(codes.InvalidArgument, "end BPM cannot be below the start BPM")
 	}
-	defer bpmRows.Close()
-
-	var bpmProgress []*pb.BpmProgressPoint
-	for bpmRows.Next() {
-		var point pb.BpmProgressPoint
-		var dateStr string
-		var bpmValue int32
-		if err := bpmRows.Scan(&dateStr, &bpmValue); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse BPM progress: %v", err)
-		}
-		// Convert date string to timestamp
-		dateTime, err := time.Parse("2006-01-02", dateStr)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse date: %v", err)
-		}
-		point.Date = timestamppb.New(dateTime)
-		point.Bpm = bpmValue
-		bpmProgress = append(bpmProgress, &point)
+	if plan.Increment <= 0 && plan.EndBpm != plan.StartBpm {
+		return status.Error(codes.InvalidArgument, "increment must be positive")
+	}
+	if plan.BarsPerStep < 1 {
+		return status.Error(codes.InvalidArgument, "bars per step must be at least 1")
+	}
+	if plan.TimeSignature != "" && !validTimeSignature(plan.TimeSignature) {
+		return status.Error(codes.InvalidArgument, "time signature must look like 4/4")
 	}
-	if err = bpmRows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading BPM progress: %v", err)
+	if _, ok := pb.Subdivision_name[int32(plan.Subdivision)]; !ok {
+		return status.Error(codes.InvalidArgument, "unknown subdivision")
 	}
+	if strings.Trim(plan.AccentPattern, ">.") != "" {
+		return status.Error(codes.InvalidArgument, "accent pattern may only contain '>' for accents and '.' for unaccented notes")
+	}
+	return nil
+}
 
-	// Return the statistics
-	return &pb.ExerciseStats{
-		ExerciseId:                   req.ExerciseId,
-		ExerciseName:                 exerciseName,
-		PracticeCount:                practiceCount,
-		TotalPracticeDurationSeconds: totalDurationSeconds,
-		AvgRating:                    avgRating,
-		MaxBpm:                       maxBPM,
-		MinBpm:                       minBPM,
-		AvgBpm:                       avgBPM,
-		BpmProgress:                  bpmProgress,
-	}, nil
+// validTimeSignature reports whether s is a time signature such as 7/8, with
+// a note value that is a power of two up to 32
+func validTimeSignature(s string) bool {
+	beats, value, ok := strings.Cut(s, "/")
+	if !ok {
+		return false
+	}
+	n, err := strconv.Atoi(beats)
+	if err != nil || n < 1 {
+		return false
+	}
+	d, err := strconv.Atoi(value)
+	if err != nil || d < 1 || d > 32 {
+		return false
+	}
+	return d&(d-1) == 0
 }
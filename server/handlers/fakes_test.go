@@ -0,0 +1,508 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"testing"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/auth"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The fakes below keep their rows in memory. Those embedding a repository
+// interface only implement the methods the tests call, the others panic.
+
+// fakeCategories is a CategoryRepo listing categories by ID
+type fakeCategories struct {
+	mu     sync.Mutex
+	rows   map[int32]*pb.Category
+	lastID int32
+}
+
+func newFakeCategories() *fakeCategories {
+	return &fakeCategories{rows: make(map[int32]*pb.Category)}
+}
+
+func (f *fakeCategories) Create(ctx context.Context, category *pb.Category) (*pb.Category, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.lastID++
+	category.Id = f.lastID
+	f.rows[category.Id] = category
+	return category, nil
+}
+
+func (f *fakeCategories) Get(ctx context.Context, id int32) (*pb.Category, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	category, ok := f.rows[id]
+	if !ok {
+		return nil, &storage.NotFoundError{Entity: "category", ID: id}
+	}
+	return category, nil
+}
+
+func (f *fakeCategories) List(ctx context.Context, opts storage.ListOptions) (*storage.Page[*pb.Category], error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ids := make([]int32, 0, len(f.rows))
+	for id := range f.rows {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	page := &storage.Page[*pb.Category]{}
+	if opts.Count {
+		page.TotalCount = int32(len(ids))
+	}
+	for _, id := range ids {
+		if opts.After != nil && id <= opts.After.ID {
+			continue
+		}
+		if len(page.Items) == opts.Limit {
+			last := page.Items[len(page.Items)-1]
+			page.Next = &storage.Cursor{Key: int64(last.Id), ID: last.Id}
+			break
+		}
+		page.Items = append(page.Items, f.rows[id])
+	}
+	return page, nil
+}
+
+func (f *fakeCategories) Update(ctx context.Context, id int32, upd storage.CategoryUpdate) (*pb.Category, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	category, ok := f.rows[id]
+	if !ok {
+		return nil, &storage.NotFoundError{Entity: "category", ID: id}
+	}
+	if upd.Name != nil {
+		category.Name = *upd.Name
+	}
+	if upd.Description != nil {
+		category.Description = *upd.Description
+	}
+	if upd.WeeklyTargetMinutes != nil {
+		category.WeeklyTargetMinutes = *upd.WeeklyTargetMinutes
+	}
+	return category, nil
+}
+
+func (f *fakeCategories) Delete(ctx context.Context, id int32) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.rows[id]; !ok {
+		return &storage.NotFoundError{Entity: "category", ID: id}
+	}
+	delete(f.rows, id)
+	return nil
+}
+
+// fakeSessions is a SessionRepo allowing one active session at a time
+type fakeSessions struct {
+	storage.SessionRepo
+
+	mu     sync.Mutex
+	rows   map[int32]*pb.PracticeSession
+	lastID int32
+}
+
+func newFakeSessions() *fakeSessions {
+	return &fakeSessions{rows: make(map[int32]*pb.PracticeSession)}
+}
+
+func (f *fakeSessions) Create(ctx context.Context, session *pb.PracticeSession) (*pb.PracticeSession, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if session.Active && f.active() {
+		return nil, storage.ErrActiveSession
+	}
+	f.lastID++
+	session.Id = f.lastID
+	f.rows[session.Id] = session
+	return session, nil
+}
+
+func (f *fakeSessions) Get(ctx context.Context, id int32) (*pb.PracticeSession, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	session, ok := f.rows[id]
+	if !ok {
+		return nil, &storage.NotFoundError{Entity: "practice session", ID: id}
+	}
+	return session, nil
+}
+
+func (f *fakeSessions) Update(ctx context.Context, id int32, upd storage.SessionUpdate) (*pb.PracticeSession, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	session, ok := f.rows[id]
+	if !ok {
+		return nil, &storage.NotFoundError{Entity: "practice session", ID: id}
+	}
+	if upd.Active != nil && *upd.Active && !session.Active && f.active() {
+		return nil, storage.ErrActiveSession
+	}
+
+	if upd.StartTime != nil {
+		session.StartTime = timestamppb.New(*upd.StartTime)
+	}
+	if upd.EndTime != nil {
+		session.EndTime = timestamppb.New(*upd.EndTime)
+	}
+	if upd.Notes != nil {
+		session.Notes = *upd.Notes
+	}
+	if upd.Active != nil {
+		session.Active = *upd.Active
+	}
+	return session, nil
+}
+
+func (f *fakeSessions) Delete(ctx context.Context, id int32) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.rows[id]; !ok {
+		return &storage.NotFoundError{Entity: "practice session", ID: id}
+	}
+	delete(f.rows, id)
+	return nil
+}
+
+// active reports whether a session is active, the lock must be held
+func (f *fakeSessions) active() bool {
+	for _, session := range f.rows {
+		if session.Active {
+			return true
+		}
+	}
+	return false
+}
+
+// fakeUsers is a UserRepo holding users, their tokens and their students
+type fakeUsers struct {
+	storage.UserRepo
+
+	mu       sync.Mutex
+	rows     map[int32]*pb.User
+	tokens   map[string]int32
+	students map[[2]int32]bool
+}
+
+func newFakeUsers(users ...*pb.User) *fakeUsers {
+	f := &fakeUsers{
+		rows:     make(map[int32]*pb.User),
+		tokens:   make(map[string]int32),
+		students: make(map[[2]int32]bool),
+	}
+	for _, user := range users {
+		f.rows[user.Id] = user
+	}
+	return f
+}
+
+func (f *fakeUsers) Get(ctx context.Context, id int32) (*pb.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	user, ok := f.rows[id]
+	if !ok {
+		return nil, &storage.NotFoundError{Entity: "user", ID: id}
+	}
+	return user, nil
+}
+
+func (f *fakeUsers) Update(ctx context.Context, id int32, upd storage.UserUpdate) (*pb.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	user, ok := f.rows[id]
+	if !ok {
+		return nil, &storage.NotFoundError{Entity: "user", ID: id}
+	}
+	// Stored users are replaced, the tests keep the ones they created
+	user = proto.Clone(user).(*pb.User)
+	if upd.Teacher != nil {
+		user.Teacher = *upd.Teacher
+	}
+	f.rows[id] = user
+	return user, nil
+}
+
+func (f *fakeUsers) Authenticate(ctx context.Context, tokenHash string) (*pb.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id, ok := f.tokens[tokenHash]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return f.rows[id], nil
+}
+
+func (f *fakeUsers) AddStudent(ctx context.Context, teacherID, studentID int32) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.rows[studentID]; !ok {
+		return &storage.NotFoundError{Entity: "user", ID: studentID}
+	}
+	f.students[[2]int32{teacherID, studentID}] = true
+	return nil
+}
+
+func (f *fakeUsers) IsStudent(ctx context.Context, teacherID, studentID int32) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.students[[2]int32{teacherID, studentID}], nil
+}
+
+func (f *fakeUsers) Students(ctx context.Context, teacherID int32) ([]*pb.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var students []*pb.User
+	for pair := range f.students {
+		if pair[0] == teacherID {
+			students = append(students, f.rows[pair[1]])
+		}
+	}
+	slices.SortFunc(students, func(a, b *pb.User) int { return int(a.Id - b.Id) })
+	return students, nil
+}
+
+// fakeAssignments is an AssignmentRepo showing assignments to the teacher who
+// gave them and to their student. History entries belong to the user of
+// their owner ID, and are assigned when an assignment of their student has
+// their exercise. Exercises are listed by the user they belong to.
+type fakeAssignments struct {
+	storage.AssignmentRepo
+
+	mu        sync.Mutex
+	rows      map[int32]*pb.Assignment
+	history   map[int32]*fakeHistoryEntry
+	comments  []*pb.HistoryComment
+	exercises map[int32][]*pb.Exercise
+	lastID    int32
+}
+
+// fakeHistoryEntry is an exercise history entry and the user it belongs to
+type fakeHistoryEntry struct {
+	ownerID    int32
+	exerciseID int32
+}
+
+func newFakeAssignments() *fakeAssignments {
+	return &fakeAssignments{
+		rows:      make(map[int32]*pb.Assignment),
+		history:   make(map[int32]*fakeHistoryEntry),
+		exercises: make(map[int32][]*pb.Exercise),
+	}
+}
+
+func (f *fakeAssignments) Create(ctx context.Context, assignment *pb.Assignment) (*pb.Assignment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.lastID++
+	assignment.Id = f.lastID
+	assignment.TeacherId = auth.UserFromContext(ctx).Id
+	f.rows[assignment.Id] = assignment
+	return assignment, nil
+}
+
+func (f *fakeAssignments) Get(ctx context.Context, id int32) (*pb.Assignment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	user := auth.UserFromContext(ctx)
+	assignment, ok := f.rows[id]
+	if !ok || (assignment.TeacherId != user.Id && assignment.StudentId != user.Id) {
+		return nil, &storage.NotFoundError{Entity: "assignment", ID: id}
+	}
+	return assignment, nil
+}
+
+func (f *fakeAssignments) History(ctx context.Context, assignment *pb.Assignment) ([]*pb.ExerciseHistory, []*pb.HistoryComment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var entries []*pb.ExerciseHistory
+	for id, entry := range f.history {
+		if entry.ownerID == assignment.StudentId && entry.exerciseID == assignment.ExerciseId {
+			entries = append(entries, &pb.ExerciseHistory{Id: id, ExerciseId: entry.exerciseID})
+		}
+	}
+	return entries, nil, nil
+}
+
+func (f *fakeAssignments) StudentExercises(ctx context.Context, studentID int32, opts storage.ListOptions) (*storage.Page[*pb.Exercise], error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return &storage.Page[*pb.Exercise]{Items: f.exercises[studentID]}, nil
+}
+
+func (f *fakeAssignments) HistoryAccess(ctx context.Context, historyID int32) (storage.HistoryAccess, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	entry, ok := f.history[historyID]
+	if !ok {
+		return storage.HistoryAccess{}, &storage.NotFoundError{Entity: "exercise history entry", ID: historyID}
+	}
+
+	user := auth.UserFromContext(ctx)
+	access := storage.HistoryAccess{Own: entry.ownerID == user.Id}
+	for _, assignment := range f.rows {
+		if assignment.TeacherId == user.Id && assignment.StudentId == entry.ownerID && assignment.ExerciseId == entry.exerciseID {
+			access.Assigned = true
+		}
+	}
+	return access, nil
+}
+
+func (f *fakeAssignments) AddComment(ctx context.Context, historyID int32, text string) (*pb.HistoryComment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	comment := &pb.HistoryComment{
+		Id:        int32(len(f.comments) + 1),
+		HistoryId: historyID,
+		AuthorId:  auth.UserFromContext(ctx).Id,
+		Text:      text,
+	}
+	f.comments = append(f.comments, comment)
+	return comment, nil
+}
+
+// authenticate returns the context of a call by a user, authenticated by the
+// interceptor of the server with a token added to the fake users
+func authenticate(t *testing.T, users *fakeUsers, user *pb.User) context.Context {
+	t.Helper()
+
+	users.mu.Lock()
+	token := fmt.Sprintf("token-%d", user.Id)
+	users.rows[user.Id] = user
+	users.tokens[auth.HashToken(token)] = user.Id
+	users.mu.Unlock()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	info := &grpc.UnaryServerInfo{FullMethod: pb.CategoryService_ListCategories_FullMethodName}
+
+	var authenticated context.Context
+	_, err := auth.NewAuthenticator(users).UnaryInterceptor()(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+		authenticated = ctx
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("authenticate %s: %v", user.Username, err)
+	}
+	return authenticated
+}
+
+// fakeHistory is a HistoryRepo holding the recordings of history entries,
+// every history entry exists
+type fakeHistory struct {
+	storage.HistoryRepo
+
+	mu         sync.Mutex
+	recordings map[int32]*pb.ExerciseHistoryRecording
+	audio      map[int32][]byte
+	lastID     int32
+}
+
+func newFakeHistory() *fakeHistory {
+	return &fakeHistory{
+		recordings: make(map[int32]*pb.ExerciseHistoryRecording),
+		audio:      make(map[int32][]byte),
+	}
+}
+
+func (f *fakeHistory) Get(ctx context.Context, id int32) (*pb.ExerciseHistory, error) {
+	return &pb.ExerciseHistory{Id: id}, nil
+}
+
+func (f *fakeHistory) AddRecording(ctx context.Context, recording *pb.ExerciseHistoryRecording, audio io.ReadSeeker) (*pb.ExerciseHistoryRecording, error) {
+	data, err := io.ReadAll(audio)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.lastID++
+	recording.Id = f.lastID
+	recording.SizeBytes = int64(len(data))
+	recording.CreatedAt = timestamppb.Now()
+	f.recordings[recording.Id] = recording
+	f.audio[recording.Id] = data
+	return recording, nil
+}
+
+func (f *fakeHistory) OpenRecording(ctx context.Context, id int32) (*pb.ExerciseHistoryRecording, io.ReadSeekCloser, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	recording, ok := f.recordings[id]
+	if !ok {
+		return nil, nil, &storage.NotFoundError{Entity: "recording", ID: id}
+	}
+	return recording, nopCloser{bytes.NewReader(f.audio[id])}, nil
+}
+
+// nopCloser adds a Close method doing nothing to a reader
+type nopCloser struct {
+	io.ReadSeeker
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// fakeUploadStream is a client stream of recording upload messages
+type fakeUploadStream struct {
+	grpc.ServerStream
+
+	ctx      context.Context
+	requests []*pb.UploadRecordingRequest
+	response *pb.ExerciseHistoryRecording
+}
+
+func (f *fakeUploadStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeUploadStream) Recv() (*pb.UploadRecordingRequest, error) {
+	if len(f.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := f.requests[0]
+	f.requests = f.requests[1:]
+	return req, nil
+}
+
+func (f *fakeUploadStream) SendAndClose(recording *pb.ExerciseHistoryRecording) error {
+	f.response = recording
+	return nil
+}
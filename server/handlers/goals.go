@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GoalHandler implements the GoalService gRPC service
+type GoalHandler struct {
+	pb.UnimplementedGoalServiceServer
+	goals storage.GoalRepo
+}
+
+// NewGoalHandler creates a new GoalHandler
+func NewGoalHandler(goals storage.GoalRepo) *GoalHandler {
+	return &GoalHandler{goals: goals}
+}
+
+// CreateGoal creates a new goal
+func (h *GoalHandler) CreateGoal(ctx context.Context, req *pb.CreateGoalRequest) (*pb.Goal, error) {
+	if req.ExerciseId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "exercise ID is required")
+	}
+
+	if req.TargetBpm <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "target BPM must be positive")
+	}
+
+	goal, err := h.goals.Create(ctx, &pb.Goal{
+		ExerciseId:    req.ExerciseId,
+		TargetBpm:     req.TargetBpm,
+		TargetDate:    req.TargetDate,
+		TimeSignature: req.TimeSignature,
+	})
+	if err != nil {
+		return nil, storeError(err, "failed to create goal")
+	}
+
+	return goal, nil
+}
+
+// GetGoal retrieves a goal by ID
+func (h *GoalHandler) GetGoal(ctx context.Context, req *pb.GetGoalRequest) (*pb.Goal, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid goal ID")
+	}
+
+	goal, err := h.goals.Get(ctx, req.Id)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve goal")
+	}
+
+	return goal, nil
+}
+
+// ListGoals lists goals with optional filtering and pagination
+func (h *GoalHandler) ListGoals(ctx context.Context, req *pb.ListGoalsRequest) (*pb.ListGoalsResponse, error) {
+	p, err := newPage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.goals.List(ctx, storage.GoalFilter{ExerciseID: req.ExerciseId}, p.options())
+	if err != nil {
+		return nil, storeError(err, "failed to list goals")
+	}
+
+	return &pb.ListGoalsResponse{
+		Goals:         page.Items,
+		NextPageToken: p.nextToken(page.Next),
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+// UpdateGoal updates a goal
+func (h *GoalHandler) UpdateGoal(ctx context.Context, req *pb.UpdateGoalRequest) (*pb.Goal, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid goal ID")
+	}
+
+	if req.Goal == nil {
+		return nil, status.Error(codes.InvalidArgument, "goal data is required")
+	}
+
+	var upd storage.GoalUpdate
+	for _, path := range updatePaths(req.UpdateMask, "target_bpm", "target_date", "time_signature") {
+		switch path {
+		case "target_bpm":
+			if req.Goal.TargetBpm <= 0 {
+				return nil, status.Error(codes.InvalidArgument, "target BPM must be positive")
+			}
+			upd.TargetBpm = &req.Goal.TargetBpm
+		case "target_date":
+			// An empty target date clears it
+			upd.TargetDate = &time.Time{}
+			if req.Goal.TargetDate != nil {
+				upd.TargetDate = optionalTime(req.Goal.TargetDate)
+			}
+		case "time_signature":
+			upd.TimeSignature = &req.Goal.TimeSignature
+		}
+	}
+
+	if upd == (storage.GoalUpdate{}) {
+		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+	}
+
+	goal, err := h.goals.Update(ctx, req.Id, upd)
+	if err != nil {
+		return nil, storeError(err, "failed to update goal")
+	}
+
+	return goal, nil
+}
+
+// DeleteGoal deletes a goal
+func (h *GoalHandler) DeleteGoal(ctx context.Context, req *pb.DeleteGoalRequest) (*emptypb.Empty, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid goal ID")
+	}
+
+	if err := h.goals.Delete(ctx, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete goal")
+	}
+
+	return &emptypb.Empty{}, nil
+}
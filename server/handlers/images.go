@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	storage "github.com/Zach-Johnson/tempus/server/db"
+)
+
+// ImageDownloadHandler serves exercise images over plain HTTP. An image never
+// changes, so responses are cached for good and revalidated by the content
+// hash as ETag.
+type ImageDownloadHandler struct {
+	exercises storage.ExerciseRepo
+}
+
+// NewImageDownloadHandler creates a new ImageDownloadHandler, routed with an
+// {id} path value
+func NewImageDownloadHandler(exercises storage.ExerciseRepo) *ImageDownloadHandler {
+	return &ImageDownloadHandler{exercises: exercises}
+}
+
+// ServeHTTP implements http.Handler, serving a thumbnail when routed with a
+// {size} path value as well
+func (h *ImageDownloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+	if err != nil || id <= 0 {
+		http.Error(w, "invalid image ID", http.StatusBadRequest)
+		return
+	}
+
+	if size := r.PathValue("size"); size != "" {
+		h.serveThumbnail(w, r, int32(id), size)
+		return
+	}
+
+	image, err := h.exercises.ImageInfo(r.Context(), int32(id))
+	if !lookedUp(w, err, "image", id) {
+		return
+	}
+	if notModified(w, r, image.ContentHash, image.MimeType) {
+		return
+	}
+
+	image, err = h.exercises.GetImage(r.Context(), image.ExerciseId, image.Id)
+	if err != nil {
+		log.Printf("Failed to read image %d: %v", id, err)
+		http.Error(w, "failed to read image", http.StatusInternalServerError)
+		return
+	}
+
+	http.ServeContent(w, r, image.Filename, image.CreatedAt.AsTime(), bytes.NewReader(image.ImageData))
+}
+
+// serveThumbnail serves a thumbnail of an image
+func (h *ImageDownloadHandler) serveThumbnail(w http.ResponseWriter, r *http.Request, id int32, size string) {
+	thumbnail, err := h.exercises.ThumbnailInfo(r.Context(), id, size)
+	if !lookedUp(w, err, "thumbnail", int64(id)) {
+		return
+	}
+	if notModified(w, r, thumbnail.ContentHash, thumbnail.MimeType) {
+		return
+	}
+
+	_, data, err := h.exercises.GetThumbnail(r.Context(), id, size)
+	if err != nil {
+		log.Printf("Failed to read %s thumbnail of image %d: %v", size, id, err)
+		http.Error(w, "failed to read thumbnail", http.StatusInternalServerError)
+		return
+	}
+
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+}
+
+// lookedUp reports whether looking up what to serve succeeded, writing the
+// error response otherwise
+func lookedUp(w http.ResponseWriter, err error, what string, id int64) bool {
+	var notFound *storage.NotFoundError
+	if errors.As(err, &notFound) {
+		http.Error(w, notFound.Error(), http.StatusNotFound)
+		return false
+	} else if err != nil {
+		log.Printf("Failed to look up %s %d: %v", what, id, err)
+		http.Error(w, "failed to look up "+what, http.StatusInternalServerError)
+		return false
+	}
+	return true
+}
+
+// notModified sets the caching headers of content stored under a hash and
+// answers revalidation, which does not need the data. It reports whether the
+// response is complete.
+func notModified(w http.ResponseWriter, r *http.Request, hash, mimeType string) bool {
+	w.Header().Set("ETag", `"`+hash+`"`)
+	w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+	if mimeType != "" {
+		w.Header().Set("Content-Type", mimeType)
+	}
+
+	if match := r.Header.Get("If-None-Match"); match != "" && match == w.Header().Get("ETag") {
+		w.WriteHeader(http.StatusNotModified)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"github.com/Zach-Johnson/tempus/server/midi"
+	"github.com/Zach-Johnson/tempus/server/tempo"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxMidiExercises bounds the exercises of a single click track
+	maxMidiExercises = 50
+
+	// maxBarsPerStep bounds the bars played at each tempo
+	maxBarsPerStep = 64
+
+	// defaultBarsPerStep applies when neither the request nor the tempo plan
+	// sets the bars per step
+	defaultBarsPerStep = 4
+)
+
+// ExportMidi renders a click track of exercises as a Standard MIDI File. Each
+// exercise is played at the BPMs of its latest history entry, or the rungs of
+// its tempo plan for a ladder.
+func (h *ExerciseHandler) ExportMidi(ctx context.Context, req *pb.ExportMidiRequest) (*httpbody.HttpBody, error) {
+	if len(req.ExerciseIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one exercise ID is required")
+	}
+	if len(req.ExerciseIds) > maxMidiExercises {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d exercises can be exported at once", maxMidiExercises)
+	}
+	if req.BarsPerStep < 0 || req.BarsPerStep > maxBarsPerStep {
+		return nil, status.Errorf(codes.InvalidArgument, "bars per step must be between 0 and %d (0 uses the plan default)", maxBarsPerStep)
+	}
+
+	sections := make([]midi.Section, 0, len(req.ExerciseIds))
+	for _, id := range req.ExerciseIds {
+		if id <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid exercise ID")
+		}
+
+		section, err := h.midiSection(ctx, id, req.Ladder)
+		if err != nil {
+			return nil, err
+		}
+		if req.BarsPerStep > 0 {
+			section.BarsPerStep = int(req.BarsPerStep)
+		}
+		sections = append(sections, section)
+	}
+
+	file, err := midi.ClickTrack("Tempus click track", sections)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "audio/midi",
+		Data:        file.Bytes(),
+	}, nil
+}
+
+// midiSection works out the tempos and feel of an exercise in a click track
+func (h *ExerciseHandler) midiSection(ctx context.Context, id int32, ladder bool) (midi.Section, error) {
+	exercise, err := h.exercises.Get(ctx, id)
+	if err != nil {
+		return midi.Section{}, storeError(err, "failed to retrieve exercise")
+	}
+
+	plan := exercise.TempoPlan
+	section := midi.Section{
+		Name:          exercise.Name,
+		TimeSignature: plan.GetTimeSignature(),
+		BarsPerStep:   int(plan.GetBarsPerStep()),
+		NotesPerBeat:  midi.NotesPerBeat(plan.GetSubdivision()),
+		Accents:       plan.GetAccentPattern(),
+	}
+	if section.BarsPerStep == 0 {
+		section.BarsPerStep = defaultBarsPerStep
+	}
+
+	if ladder {
+		if !tempo.Planned(plan) {
+			return midi.Section{}, status.Errorf(codes.FailedPrecondition, "exercise %q has no tempo plan", exercise.Name)
+		}
+		section.BPMs = tempo.Rungs(plan)
+		return section, nil
+	}
+
+	latest, err := h.history.List(ctx, storage.HistoryFilter{ExerciseID: id}, storage.ListOptions{Limit: 1})
+	if err != nil {
+		return midi.Section{}, storeError(err, "failed to retrieve exercise history")
+	}
+
+	entries := latest.Items
+	switch {
+	case len(entries) > 0 && len(entries[0].Bpms) > 0:
+		section.BPMs = entries[0].Bpms
+		if entries[0].TimeSignature != "" {
+			section.TimeSignature = entries[0].TimeSignature
+		}
+	case exercise.SuggestedBpm > 0:
+		// Not practiced yet, start where the tempo plan does
+		section.BPMs = []int32{exercise.SuggestedBpm}
+	default:
+		return midi.Section{}, status.Errorf(codes.FailedPrecondition, "exercise %q has no BPMs to play", exercise.Name)
+	}
+	return section, nil
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"github.com/Zach-Johnson/tempus/server/notation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AddExerciseNotation attaches notation to an exercise
+func (h *ExerciseHandler) AddExerciseNotation(ctx context.Context, req *pb.AddExerciseNotationRequest) (*pb.ExerciseNotation, error) {
+	if req.ExerciseId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid exercise ID")
+	}
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "notation data is required")
+	}
+
+	if _, ok := pb.NotationFormat_name[int32(req.Format)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid notation format")
+	}
+
+	n, err := h.exercises.AddNotation(ctx, &pb.ExerciseNotation{
+		ExerciseId: req.ExerciseId,
+		Format:     req.Format,
+		Data:       req.Data,
+		Filename:   req.Filename,
+	})
+	if err != nil {
+		return nil, storeError(err, "failed to add notation to exercise")
+	}
+
+	return n, nil
+}
+
+// GetExerciseNotation gets notation with its data
+func (h *ExerciseHandler) GetExerciseNotation(ctx context.Context, req *pb.GetExerciseNotationRequest) (*pb.ExerciseNotation, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid notation ID")
+	}
+
+	n, err := h.exercises.GetNotation(ctx, req.Id)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve notation")
+	}
+
+	return n, nil
+}
+
+// DeleteExerciseNotation deletes notation from an exercise
+func (h *ExerciseHandler) DeleteExerciseNotation(ctx context.Context, req *pb.DeleteExerciseNotationRequest) (*emptypb.Empty, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid notation ID")
+	}
+
+	if err := h.exercises.DeleteNotation(ctx, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete notation")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// NotationSVGHandler serves SVG previews of exercise notation over plain
+// HTTP. Previews are rendered on request, so they are revalidated by an ETag
+// of the rendering rather than cached for good.
+type NotationSVGHandler struct {
+	exercises storage.ExerciseRepo
+}
+
+// NewNotationSVGHandler creates a new NotationSVGHandler, routed with an {id}
+// path value
+func NewNotationSVGHandler(exercises storage.ExerciseRepo) *NotationSVGHandler {
+	return &NotationSVGHandler{exercises: exercises}
+}
+
+// ServeHTTP implements http.Handler
+func (h *NotationSVGHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+	if err != nil || id <= 0 {
+		http.Error(w, "invalid notation ID", http.StatusBadRequest)
+		return
+	}
+
+	n, err := h.exercises.GetNotation(r.Context(), int32(id))
+	if !lookedUp(w, err, "notation", id) {
+		return
+	}
+
+	score, _, err := notation.Parse(n.Format, n.Data)
+	if err != nil {
+		log.Printf("Failed to read notation %d: %v", id, err)
+		http.Error(w, "failed to read notation", http.StatusInternalServerError)
+		return
+	}
+	svg := notation.SVG(score)
+
+	sum := sha256.Sum256(svg)
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.Header().Set("Content-Type", "image/svg+xml")
+	// Previews are only ever shown as images
+	w.Header().Set("Content-Security-Policy", "default-src 'none'")
+
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(svg))
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/pack"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPackExercises bounds the exercises of a single exercise pack
+const maxPackExercises = 500
+
+// ExportExercisePack exports exercises as an exercise pack zip archive
+func (h *ExerciseHandler) ExportExercisePack(ctx context.Context, req *pb.ExportExercisePackRequest) (*httpbody.HttpBody, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "pack name is required")
+	}
+	if len(req.ExerciseIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one exercise ID is required")
+	}
+	if len(req.ExerciseIds) > maxPackExercises {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d exercises can be exported at once", maxPackExercises)
+	}
+
+	seen := make(map[int32]bool, len(req.ExerciseIds))
+	for _, id := range req.ExerciseIds {
+		if id <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid exercise ID")
+		}
+		if seen[id] {
+			return nil, status.Errorf(codes.InvalidArgument, "exercise %d is listed more than once", id)
+		}
+		seen[id] = true
+	}
+
+	p, err := h.exercises.ExportPack(ctx, req.ExerciseIds, req.Name, req.Description)
+	if err != nil {
+		return nil, storeError(err, "failed to export exercise pack")
+	}
+
+	data, err := p.Bytes()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write exercise pack: %v", err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "application/zip",
+		Data:        data,
+	}, nil
+}
+
+// ImportExercisePack imports an exercise pack zip archive
+func (h *ExerciseHandler) ImportExercisePack(ctx context.Context, req *pb.ImportExercisePackRequest) (*pb.ImportExercisePackResponse, error) {
+	if len(req.Pack) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pack is required")
+	}
+
+	p, err := pack.Read(req.Pack)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	summary, err := h.exercises.ImportPack(ctx, p)
+	if err != nil {
+		return nil, storeError(err, "failed to import exercise pack")
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// defaultPageSize is used when a List request does not specify a page size
+const defaultPageSize = 50
+
+// pageTokenKey signs page tokens, see SetPageTokenKey
+var pageTokenKey = func() []byte {
+	key := make([]byte, 32)
+	rand.Read(key)
+	return key
+}()
+
+// SetPageTokenKey sets the key page tokens are signed with. Without one a
+// random key is used and tokens stop working when the server restarts.
+func SetPageTokenKey(key []byte) {
+	pageTokenKey = key
+}
+
+// listRequest is a request of a List RPC
+type listRequest interface {
+	proto.Message
+	GetPageSize() int32
+	GetPageToken() string
+	GetIncludeTotalCount() bool
+}
+
+// pagingFields are the fields of a List request that change from one page to
+// the next, the others make up the scope of its page tokens
+var pagingFields = []protoreflect.Name{"page_size", "page_token", "include_total_count"}
+
+// page is the window of rows requested by a List RPC
+type page struct {
+	size  int
+	after *storage.Cursor
+	count bool
+	scope string
+}
+
+// pageToken is the signed content of a page token: the cursor of the last row
+// of the previous page and the scope of the request it was issued for
+type pageToken struct {
+	Scope  string `json:"s"`
+	Key    string `json:"k,omitempty"`
+	Number *int64 `json:"n,omitempty"`
+	ID     int32  `json:"i"`
+}
+
+// newPage parses the page size and token of a List request. Tokens are only
+// accepted with the filters and order of the request they were issued for.
+func newPage(req listRequest) (page, error) {
+	p := page{
+		size:  int(req.GetPageSize()),
+		count: req.GetIncludeTotalCount(),
+		scope: pageScope(req),
+	}
+	if p.size <= 0 {
+		p.size = defaultPageSize
+	}
+
+	if req.GetPageToken() == "" {
+		return p, nil
+	}
+
+	token, ok := readPageToken(req.GetPageToken())
+	if !ok {
+		return page{}, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	if token.Scope != p.scope {
+		return page{}, status.Error(codes.InvalidArgument, "page token does not match the request")
+	}
+
+	p.after = &storage.Cursor{Key: token.Key, ID: token.ID}
+	if token.Number != nil {
+		p.after.Key = *token.Number
+	}
+	return p, nil
+}
+
+// options returns the list options for the page
+func (p page) options() storage.ListOptions {
+	return storage.ListOptions{Limit: p.size, After: p.after, Count: p.count}
+}
+
+// nextToken returns the token of the page continuing after a cursor, or an
+// empty token on the last page
+func (p page) nextToken(next *storage.Cursor) string {
+	if next == nil {
+		return ""
+	}
+
+	token := pageToken{Scope: p.scope, ID: next.ID}
+	switch key := next.Key.(type) {
+	case int64:
+		token.Number = &key
+	case string:
+		token.Key = key
+	}
+
+	payload, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(signPageToken(payload))
+}
+
+// readPageToken verifies the signature of a page token and decodes it
+func readPageToken(s string) (pageToken, bool) {
+	var token pageToken
+
+	encoded, encodedMAC, ok := strings.Cut(s, ".")
+	if !ok {
+		return token, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return token, false
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil || !hmac.Equal(mac, signPageToken(payload)) {
+		return token, false
+	}
+
+	if err := json.Unmarshal(payload, &token); err != nil {
+		return token, false
+	}
+	return token, true
+}
+
+func signPageToken(payload []byte) []byte {
+	mac := hmac.New(sha256.New, pageTokenKey)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// pageScope identifies the listing of a request, a hash of its type and of
+// its fields besides the paging ones
+func pageScope(req listRequest) string {
+	scoped := proto.Clone(req).ProtoReflect()
+	fields := scoped.Descriptor().Fields()
+	for _, name := range pagingFields {
+		if field := fields.ByName(name); field != nil {
+			scoped.Clear(field)
+		}
+	}
+
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(scoped.Interface())
+	sum := sha256.Sum256(append([]byte(scoped.Descriptor().FullName()+"\n"), data...))
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"github.com/Zach-Johnson/tempus/server/recommend"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecommendationHandler implements the RecommendationService gRPC service
+type RecommendationHandler struct {
+	pb.UnimplementedRecommendationServiceServer
+	exercises storage.ExerciseRepo
+	sessions  storage.SessionRepo
+}
+
+// NewRecommendationHandler creates a new RecommendationHandler
+func NewRecommendationHandler(exercises storage.ExerciseRepo, sessions storage.SessionRepo) *RecommendationHandler {
+	return &RecommendationHandler{exercises: exercises, sessions: sessions}
+}
+
+// GetPracticePlan ranks the exercises to practice within the available time
+func (h *RecommendationHandler) GetPracticePlan(ctx context.Context, req *pb.GetPracticePlanRequest) (*pb.PracticePlan, error) {
+	if req.AvailableMinutes <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "available minutes must be positive")
+	}
+
+	activity, err := h.exercises.Activity(ctx, storage.ExerciseFilter{CategoryID: req.CategoryId}, recommend.RecentEntries)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve exercise activity")
+	}
+
+	// Category balance is measured against the current week
+	now := time.Now()
+	progress, err := h.sessions.TargetProgress(ctx, storage.PracticeStatsFilter{
+		DateRange: storage.DateRange{Start: &now, End: &now},
+	})
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve target progress")
+	}
+
+	targets := make(map[int32]recommend.CategoryTarget, len(progress.Categories))
+	for _, category := range progress.Categories {
+		if len(category.Weeks) == 0 {
+			continue
+		}
+		week := category.Weeks[len(category.Weeks)-1]
+		targets[category.CategoryId] = recommend.CategoryTarget{
+			Name:          category.CategoryName,
+			TargetMinutes: week.TargetMinutes,
+			ActualMinutes: week.ActualMinutes,
+		}
+	}
+
+	return recommend.Plan(activity, targets, req.AvailableMinutes, recommend.DefaultWeights, now), nil
+}
@@ -0,0 +1,200 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/auth"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxRecordingSize bounds the audio of a single recording
+const maxRecordingSize = 100 << 20
+
+// UploadRecording stores a recording sent as its metadata followed by chunks
+// of audio
+func (h *ExerciseHistoryHandler) UploadRecording(stream grpc.ClientStreamingServer[pb.UploadRecordingRequest, pb.ExerciseHistoryRecording]) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "recording metadata is required")
+	} else if err != nil {
+		return err
+	}
+
+	meta := first.GetMetadata()
+	if meta == nil {
+		return status.Error(codes.InvalidArgument, "the first message must carry the recording metadata")
+	}
+	if err := validateRecording(meta); err != nil {
+		return err
+	}
+
+	// The audio is spooled to a temporary file rather than held in memory,
+	// the store reads it twice to hash it before streaming it to the blob
+	// store
+	audio, err := os.CreateTemp("", "tempus-recording-*")
+	if err != nil {
+		log.Printf("Failed to create recording upload file: %v", err)
+		return status.Error(codes.Internal, "failed to upload recording")
+	}
+	defer os.Remove(audio.Name())
+	defer audio.Close()
+
+	var size int
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if req.GetMetadata() != nil {
+			return status.Error(codes.InvalidArgument, "recording metadata can only be sent once")
+		}
+		if size+len(req.GetChunk()) > maxRecordingSize {
+			return status.Errorf(codes.InvalidArgument, "recording exceeds %d MB", maxRecordingSize>>20)
+		}
+		if _, err := audio.Write(req.GetChunk()); err != nil {
+			log.Printf("Failed to write recording upload file: %v", err)
+			return status.Error(codes.Internal, "failed to upload recording")
+		}
+		size += len(req.GetChunk())
+	}
+	if size == 0 {
+		return status.Error(codes.InvalidArgument, "recording audio is required")
+	}
+	if _, err := audio.Seek(0, io.SeekStart); err != nil {
+		log.Printf("Failed to read recording upload file: %v", err)
+		return status.Error(codes.Internal, "failed to upload recording")
+	}
+
+	ctx := stream.Context()
+	recording, err := h.history.AddRecording(ctx, &pb.ExerciseHistoryRecording{
+		HistoryId:       meta.HistoryId,
+		Filename:        meta.Filename,
+		MimeType:        meta.MimeType,
+		DurationSeconds: meta.DurationSeconds,
+	}, audio)
+	if err != nil {
+		return storeError(err, "failed to upload recording")
+	}
+
+	h.publishEntry(ctx, recording.HistoryId)
+
+	return stream.SendAndClose(recording)
+}
+
+// GetRecording retrieves the details of a recording
+func (h *ExerciseHistoryHandler) GetRecording(ctx context.Context, req *pb.GetRecordingRequest) (*pb.ExerciseHistoryRecording, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid recording ID")
+	}
+
+	recording, err := h.history.GetRecording(ctx, req.Id)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve recording")
+	}
+
+	return recording, nil
+}
+
+// DeleteRecording deletes a recording
+func (h *ExerciseHistoryHandler) DeleteRecording(ctx context.Context, req *pb.DeleteRecordingRequest) (*emptypb.Empty, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid recording ID")
+	}
+
+	recording, err := h.history.GetRecording(ctx, req.Id)
+	if err != nil {
+		return nil, storeError(err, "failed to delete recording")
+	}
+
+	if err := h.history.DeleteRecording(ctx, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete recording")
+	}
+
+	h.publishEntry(ctx, recording.HistoryId)
+
+	return &emptypb.Empty{}, nil
+}
+
+// publishEntry tells the watchers of a session that the recordings of one of
+// its history entries changed
+func (h *ExerciseHistoryHandler) publishEntry(ctx context.Context, historyID int32) {
+	entry, err := h.history.Get(ctx, historyID)
+	if err != nil {
+		log.Printf("Failed to read exercise history entry %d for watchers: %v", historyID, err)
+		return
+	}
+	h.events.PublishExercise(auth.UserFromContext(ctx).Id, pb.SessionEventType_SESSION_EVENT_TYPE_EXERCISE_UPDATED, entry)
+}
+
+// validateRecording checks the metadata of an uploaded recording
+func validateRecording(meta *pb.RecordingMetadata) error {
+	if meta.HistoryId <= 0 {
+		return status.Error(codes.InvalidArgument, "invalid history entry ID")
+	}
+	mediaType, _, err := mime.ParseMediaType(meta.MimeType)
+	if err != nil || !strings.HasPrefix(mediaType, "audio/") {
+		return status.Error(codes.InvalidArgument, "mime type must be an audio type")
+	}
+	if meta.DurationSeconds < 0 {
+		return status.Error(codes.InvalidArgument, "duration cannot be negative")
+	}
+	return nil
+}
+
+// RecordingDownloadHandler serves the audio of recordings over plain HTTP,
+// with support for range requests so that players can seek without
+// downloading a whole recording
+type RecordingDownloadHandler struct {
+	history storage.HistoryRepo
+}
+
+// NewRecordingDownloadHandler creates a new RecordingDownloadHandler, routed
+// with an {id} path value
+func NewRecordingDownloadHandler(history storage.HistoryRepo) *RecordingDownloadHandler {
+	return &RecordingDownloadHandler{history: history}
+}
+
+// ServeHTTP implements http.Handler
+func (h *RecordingDownloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+	if err != nil || id <= 0 {
+		http.Error(w, "invalid recording ID", http.StatusBadRequest)
+		return
+	}
+
+	recording, audio, err := h.history.OpenRecording(r.Context(), int32(id))
+	var notFound *storage.NotFoundError
+	if errors.As(err, &notFound) {
+		http.Error(w, notFound.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Printf("Failed to open recording %d: %v", id, err)
+		http.Error(w, "failed to open recording", http.StatusInternalServerError)
+		return
+	}
+	defer audio.Close()
+
+	// Browsers must not sniff uploaded audio into something they would run
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Type", recording.MimeType)
+	if recording.Filename != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": recording.Filename}))
+	}
+	http.ServeContent(w, r, recording.Filename, recording.CreatedAt.AsTime(), audio)
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/events"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUploadRecording(t *testing.T) {
+	meta := func(m *pb.RecordingMetadata) *pb.UploadRecordingRequest {
+		return &pb.UploadRecordingRequest{Payload: &pb.UploadRecordingRequest_Metadata{Metadata: m}}
+	}
+	chunk := func(data string) *pb.UploadRecordingRequest {
+		return &pb.UploadRecordingRequest{Payload: &pb.UploadRecordingRequest_Chunk{Chunk: []byte(data)}}
+	}
+	valid := &pb.RecordingMetadata{HistoryId: 1, Filename: "take.webm", MimeType: "audio/webm"}
+
+	tests := []struct {
+		name     string
+		requests []*pb.UploadRecordingRequest
+		code     codes.Code
+		audio    string
+	}{
+		{"chunks", []*pb.UploadRecordingRequest{meta(valid), chunk("RIFF"), chunk(" audio")}, codes.OK, "RIFF audio"},
+		{"no messages", nil, codes.InvalidArgument, ""},
+		{"chunk first", []*pb.UploadRecordingRequest{chunk("RIFF"), meta(valid)}, codes.InvalidArgument, ""},
+		{"metadata twice", []*pb.UploadRecordingRequest{meta(valid), chunk("RIFF"), meta(valid)}, codes.InvalidArgument, ""},
+		{"no audio", []*pb.UploadRecordingRequest{meta(valid)}, codes.InvalidArgument, ""},
+		{"not audio", []*pb.UploadRecordingRequest{meta(&pb.RecordingMetadata{HistoryId: 1, MimeType: "text/html"}), chunk("<script>")}, codes.InvalidArgument, ""},
+		{"no history entry", []*pb.UploadRecordingRequest{meta(&pb.RecordingMetadata{MimeType: "audio/webm"}), chunk("RIFF")}, codes.InvalidArgument, ""},
+	}
+
+	user := &pb.User{Id: 1, Username: "alice"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := newFakeHistory()
+			h := NewExerciseHistoryHandler(history, events.NewBroker())
+
+			stream := &fakeUploadStream{ctx: authenticate(t, newFakeUsers(), user), requests: tt.requests}
+			err := h.UploadRecording(stream)
+			wantCode(t, err, tt.code)
+			if err != nil {
+				if len(history.recordings) != 0 {
+					t.Errorf("stored %d recordings of an invalid upload", len(history.recordings))
+				}
+				return
+			}
+
+			if stream.response == nil || stream.response.Id == 0 {
+				t.Fatalf("UploadRecording responded %v", stream.response)
+			}
+			if got := string(history.audio[stream.response.Id]); got != tt.audio {
+				t.Errorf("stored audio %q, want %q", got, tt.audio)
+			}
+		})
+	}
+}
+
+func TestRecordingDownload(t *testing.T) {
+	history := newFakeHistory()
+	recording, err := history.AddRecording(context.Background(), &pb.ExerciseHistoryRecording{
+		HistoryId: 1,
+		Filename:  "take.webm",
+		MimeType:  "audio/webm",
+	}, bytes.NewReader([]byte("RIFF audio")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewRecordingDownloadHandler(history)
+
+	tests := []struct {
+		name   string
+		id     string
+		rng    string
+		status int
+		body   string
+	}{
+		{"whole", "1", "", http.StatusOK, "RIFF audio"},
+		{"range", "1", "bytes=5-", http.StatusPartialContent, "audio"},
+		{"not found", "2", "", http.StatusNotFound, ""},
+		{"invalid ID", "one", "", http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/recordings/"+tt.id+"/audio", nil)
+			req.SetPathValue("id", tt.id)
+			if tt.rng != "" {
+				req.Header.Set("Range", tt.rng)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("status %d, want %d", resp.StatusCode, tt.status)
+			}
+			if tt.body == "" {
+				return
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			if string(body) != tt.body {
+				t.Errorf("body %q, want %q", body, tt.body)
+			}
+			if got := resp.Header.Get("X-Content-Type-Options"); got != "nosniff" {
+				t.Errorf("X-Content-Type-Options = %q, want nosniff", got)
+			}
+			if got := resp.Header.Get("Content-Type"); got != recording.MimeType {
+				t.Errorf("Content-Type = %q, want %q", got, recording.MimeType)
+			}
+		})
+	}
+}
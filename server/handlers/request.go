@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"time"
+
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// updatePaths returns the paths of an update mask, if no update mask is
+// provided all of the given fields are updated
+func updatePaths(mask *fieldmaskpb.FieldMask, all ...string) []string {
+	if mask == nil || len(mask.Paths) == 0 {
+		return all
+	}
+	return mask.Paths
+}
+
+// dateRange converts optional request timestamps into a storage date range
+func dateRange(start, end *timestamppb.Timestamp) storage.DateRange {
+	return storage.DateRange{
+		Start: optionalTime(start),
+		End:   optionalTime(end),
+	}
+}
+
+// localDateRange converts optional request timestamps into a date range
+// covering the whole days they fall on in loc, or as is when loc is nil. The
+// bounds stay in UTC like the stored timestamps.
+func localDateRange(start, end *timestamppb.Timestamp, loc *time.Location) storage.DateRange {
+	dates := dateRange(start, end)
+	if loc == nil {
+		return dates
+	}
+
+	if dates.Start != nil {
+		s := dates.Start.In(loc)
+		midnight := time.Date(s.Year(), s.Month(), s.Day(), 0, 0, 0, 0, loc).UTC()
+		dates.Start = &midnight
+	}
+	if dates.End != nil {
+		e := dates.End.In(loc)
+		midnight := time.Date(e.Year(), e.Month(), e.Day()+1, 0, 0, 0, 0, loc).UTC()
+		dates.End = &midnight
+	}
+	return dates
+}
+
+// timeZone loads an optional IANA time zone, nil when unset so the time zone
+// of the settings applies
+func timeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	// Local is the time zone of the server, not a zone the database knows
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time zone %q", name)
+	}
+	return loc, nil
+}
+
+// optionalTime converts a timestamp that may be unset
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/auth"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"github.com/Zach-Johnson/tempus/server/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RoutineHandler implements the RoutineService gRPC service
+type RoutineHandler struct {
+	pb.UnimplementedRoutineServiceServer
+	routines storage.RoutineRepo
+	events   *events.Broker
+}
+
+// NewRoutineHandler creates a new RoutineHandler publishing the sessions it
+// starts to broker
+func NewRoutineHandler(routines storage.RoutineRepo, broker *events.Broker) *RoutineHandler {
+	return &RoutineHandler{routines: routines, events: broker}
+}
+
+// CreateRoutine creates a new routine
+func (h *RoutineHandler) CreateRoutine(ctx context.Context, req *pb.CreateRoutineRequest) (*pb.Routine, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "routine name is required")
+	}
+
+	if err := validateSteps(req.Steps); err != nil {
+		return nil, err
+	}
+
+	routine, err := h.routines.Create(ctx, &pb.Routine{
+		Name:        req.Name,
+		Description: req.Description,
+		Steps:       req.Steps,
+	})
+	if err != nil {
+		return nil, storeError(err, "failed to create routine")
+	}
+
+	return routine, nil
+}
+
+// GetRoutine retrieves a routine by ID
+func (h *RoutineHandler) GetRoutine(ctx context.Context, req *pb.GetRoutineRequest) (*pb.Routine, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid routine ID")
+	}
+
+	routine, err := h.routines.Get(ctx, req.Id)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve routine")
+	}
+
+	return routine, nil
+}
+
+// ListRoutines lists all routines with pagination
+func (h *RoutineHandler) ListRoutines(ctx context.Context, req *pb.ListRoutinesRequest) (*pb.ListRoutinesResponse, error) {
+	p, err := newPage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.routines.List(ctx, p.options())
+	if err != nil {
+		return nil, storeError(err, "failed to list routines")
+	}
+
+	return &pb.ListRoutinesResponse{
+		Routines:      page.Items,
+		NextPageToken: p.nextToken(page.Next),
+		TotalCount:    page.TotalCount,
+	}, nil
+}
+
+// UpdateRoutine updates an existing routine
+func (h *RoutineHandler) UpdateRoutine(ctx context.Context, req *pb.UpdateRoutineRequest) (*pb.Routine, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid routine ID")
+	}
+
+	if req.Routine == nil {
+		return nil, status.Error(codes.InvalidArgument, "routine data is required")
+	}
+
+	var upd storage.RoutineUpdate
+	for _, path := range updatePaths(req.UpdateMask, "name", "description", "steps") {
+		switch path {
+		case "name":
+			if req.Routine.Name == "" {
+				return nil, status.Error(codes.InvalidArgument, "routine name cannot be empty")
+			}
+			upd.Name = &req.Routine.Name
+		case "description":
+			upd.Description = &req.Routine.Description
+		case "steps":
+			if err := validateSteps(req.Routine.Steps); err != nil {
+				return nil, err
+			}
+			// An empty list removes all steps
+			upd.Steps = append([]*pb.RoutineStep{}, req.Routine.Steps...)
+		}
+	}
+
+	if upd.Name == nil && upd.Description == nil && upd.Steps == nil {
+		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+	}
+
+	routine, err := h.routines.Update(ctx, req.Id, upd)
+	if err != nil {
+		return nil, storeError(err, "failed to update routine")
+	}
+
+	return routine, nil
+}
+
+// DeleteRoutine deletes a routine
+func (h *RoutineHandler) DeleteRoutine(ctx context.Context, req *pb.DeleteRoutineRequest) (*emptypb.Empty, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid routine ID")
+	}
+
+	if err := h.routines.Delete(ctx, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete routine")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// StartSessionFromRoutine starts an active practice session and returns the
+// routine steps with history entries pre-filled from the plan
+func (h *RoutineHandler) StartSessionFromRoutine(ctx context.Context, req *pb.StartSessionFromRoutineRequest) (*pb.StartSessionFromRoutineResponse, error) {
+	if req.RoutineId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid routine ID")
+	}
+
+	startTime := time.Now()
+	if req.StartTime != nil {
+		startTime = req.StartTime.AsTime()
+	}
+
+	session, routine, err := h.routines.Start(ctx, req.RoutineId, startTime)
+	if err != nil {
+		return nil, storeError(err, "failed to start session from routine")
+	}
+
+	h.events.PublishSession(auth.UserFromContext(ctx).Id, pb.SessionEventType_SESSION_EVENT_TYPE_STARTED, session)
+
+	steps := make([]*pb.PlannedStep, 0, len(routine.Steps))
+	for _, step := range routine.Steps {
+		entry := &pb.CreateExerciseHistoryRequest{
+			ExerciseId:    step.ExerciseId,
+			SessionId:     session.Id,
+			TimeSignature: step.TimeSignature,
+		}
+		if step.StartBpm > 0 {
+			entry.Bpms = []int32{step.StartBpm}
+		}
+		steps = append(steps, &pb.PlannedStep{Step: step, Entry: entry})
+	}
+
+	return &pb.StartSessionFromRoutineResponse{
+		Session: session,
+		Steps:   steps,
+	}, nil
+}
+
+// validateSteps checks the steps of a routine
+func validateSteps(steps []*pb.RoutineStep) error {
+	for _, step := range steps {
+		if step.ExerciseId <= 0 {
+			return status.Error(codes.InvalidArgument, "exercise ID is required for every step")
+		}
+		if step.PlannedDurationSeconds < 0 {
+			return status.Error(codes.InvalidArgument, "planned duration cannot be negative")
+		}
+		if step.StartBpm < 0 {
+			return status.Error(codes.InvalidArgument, "start BPM cannot be negative")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Number of results of each type a search returns
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+)
+
+// SearchHandler implements the SearchService gRPC service
+type SearchHandler struct {
+	pb.UnimplementedSearchServiceServer
+	search storage.SearchRepo
+}
+
+// NewSearchHandler creates a new SearchHandler
+func NewSearchHandler(search storage.SearchRepo) *SearchHandler {
+	return &SearchHandler{search: search}
+}
+
+// Search finds exercises, links, sessions and exercise history by their text
+func (h *SearchHandler) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, status.Error(codes.InvalidArgument, "search query is required")
+	}
+
+	for _, t := range req.Types {
+		if _, ok := pb.SearchEntityType_name[int32(t)]; !ok || t == pb.SearchEntityType_SEARCH_ENTITY_TYPE_UNSPECIFIED {
+			return nil, status.Error(codes.InvalidArgument, "invalid search type")
+		}
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	groups, err := h.search.Search(ctx, storage.SearchQuery{
+		Text:  req.Query,
+		Types: req.Types,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, storeError(err, "failed to search")
+	}
+
+	return &pb.SearchResponse{Groups: groups}, nil
+}
@@ -2,28 +2,29 @@ package handlers
 
 import (
 	"context"
-	"database/sql"
-	"encoding/json"
-	"strconv"
-	"strings"
+	"errors"
 	"time"
 
 	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/auth"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"github.com/Zach-Johnson/tempus/server/events"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // PracticeSessionHandler implements the PracticeSessionService gRPC service
 type PracticeSessionHandler struct {
 	pb.UnimplementedPracticeSessionServiceServer
-	db *sql.DB
+	sessions storage.SessionRepo
+	events   *events.Broker
 }
 
-// NewPracticeSessionHandler creates a new PracticeSessionHandler
-func NewPracticeSessionHandler(db *sql.DB) *PracticeSessionHandler {
-	return &PracticeSessionHandler{db: db}
+// NewPracticeSessionHandler creates a new PracticeSessionHandler publishing
+// the session changes to broker
+func NewPracticeSessionHandler(sessions storage.SessionRepo, broker *events.Broker) *PracticeSessionHandler {
+	return &PracticeSessionHandler{sessions: sessions, events: broker}
 }
 
 // CreatePracticeSession creates a new practice session
@@ -36,70 +37,24 @@ func (h *PracticeSessionHandler) CreatePracticeSession(ctx context.Context, req
 		return nil, status.Error(codes.InvalidArgument, "end time is required")
 	}
 
-	startTime := req.StartTime.AsTime()
-	endTime := req.EndTime.AsTime()
-
-	if startTime.After(endTime) {
+	if req.StartTime.AsTime().After(req.EndTime.AsTime()) {
 		return nil, status.Error(codes.InvalidArgument, "start time cannot be after end time")
 	}
 
-	// Start a transaction
-	tx, err := h.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to start transaction: %v", err)
-	}
-	defer tx.Rollback() // Rollback if not committed
-
-	var activeCount int
-	if err := tx.QueryRowContext(ctx, "SELECT COUNT(1) FROM practice_sessions WHERE active = 1").Scan(&activeCount); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check for active practice session: %v", err)
-	}
-	if activeCount > 0 {
-		return nil, status.Error(codes.AlreadyExists, "cannot create session while there is a currently active one")
-	}
-
-	// Insert the practice session, set active to true
-	result, err := tx.ExecContext(
-		ctx,
-		"INSERT INTO practice_sessions (start_time, end_time, notes, active) VALUES (?, ?, ?, 1)",
-		startTime, endTime, req.Notes,
-	)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create practice session: %v", err)
-	}
-
-	// Get the session ID
-	sessionID, err := result.LastInsertId()
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get session ID: %v", err)
-	}
-
-	// Get the session creation and update times
-	var createdAt, updatedAt time.Time
-	err = tx.QueryRowContext(
-		ctx,
-		"SELECT created_at, updated_at FROM practice_sessions WHERE id = ?",
-		sessionID,
-	).Scan(&createdAt, &updatedAt)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get session times: %v", err)
-	}
-
-	// Commit the transaction
-	if err := tx.Commit(); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
-	}
-
-	// Return the created session
-	return &pb.PracticeSession{
-		Id:        int32(sessionID),
+	session, err := h.sessions.Create(ctx, &pb.PracticeSession{
 		StartTime: req.StartTime,
 		EndTime:   req.EndTime,
 		Notes:     req.Notes,
-		CreatedAt: timestamppb.New(createdAt),
-		UpdatedAt: timestamppb.New(updatedAt),
-		Active:    true,
-	}, nil
+	})
+	if errors.Is(err, storage.ErrActiveSession) {
+		return nil, status.Error(codes.FailedPrecondition, "cannot create session while there is a currently active one")
+	} else if err != nil {
+		return nil, storeError(err, "failed to create practice session")
+	}
+
+	h.events.PublishSession(auth.UserFromContext(ctx).Id, pb.SessionEventType_SESSION_EVENT_TYPE_STARTED, session)
+
+	return session, nil
 }
 
 // GetPracticeSession retrieves a practice session by ID
@@ -108,240 +63,40 @@ func (h *PracticeSessionHandler) GetPracticeSession(ctx context.Context, req *pb
 		return nil, status.Error(codes.InvalidArgument, "invalid session ID")
 	}
 
-	// Start a transaction to ensure consistency across queries
-	tx, err := h.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	session, err := h.sessions.Get(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to start transaction: %v", err)
-	}
-	defer tx.Rollback() // Rollback if not committed
-
-	// Query the session
-	var session pb.PracticeSession
-	var startTime, endTime, createdAt, updatedAt time.Time
-	var active int
-
-	err = tx.QueryRowContext(
-		ctx,
-		"SELECT id, start_time, end_time, notes, created_at, updated_at, active FROM practice_sessions WHERE id = ?",
-		req.Id,
-	).Scan(&session.Id, &startTime, &endTime, &session.Notes, &createdAt, &updatedAt, &active)
-
-	if err == sql.ErrNoRows {
-		return nil, status.Errorf(codes.NotFound, "practice session with ID %d not found", req.Id)
-	} else if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve practice session: %v", err)
-	}
-
-	session.Active = active == 1
-	session.StartTime = timestamppb.New(startTime)
-	session.EndTime = timestamppb.New(endTime)
-	session.CreatedAt = timestamppb.New(createdAt)
-	session.UpdatedAt = timestamppb.New(updatedAt)
-
-	// Query exercise history
-	exerciseRows, err := tx.QueryContext(
-		ctx,
-		`SELECT id, exercise_id, start_time, end_time, bpms, time_signature, notes, COALESCE(duration_seconds, 0)
-         FROM exercise_history
-         WHERE session_id = ?
-         ORDER BY start_time`,
-		req.Id,
-	)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve session exercises: %v", err)
-	}
-	defer exerciseRows.Close()
-
-	// Parse exercises
-	var exercises []*pb.ExerciseHistory
-	exerciseIDMap := make(map[int32]int) // Maps exercise ID to index in exercises slice
-
-	for exerciseRows.Next() {
-		var sessionExercise pb.ExerciseHistory
-		var exerciseId int32
-		var startTime, endTime time.Time
-		var bpmJSON string
-
-		err := exerciseRows.Scan(
-			&sessionExercise.Id,
-			&exerciseId,
-			&startTime,
-			&endTime,
-			&bpmJSON,
-			&sessionExercise.TimeSignature,
-			&sessionExercise.Notes,
-			&sessionExercise.DurationSeconds,
-		)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse session exercise: %v", err)
-		}
-
-		if bpmJSON != "" {
-			var bpms []int32
-			if err := json.Unmarshal([]byte(bpmJSON), &bpms); err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to unmarshal BPM values: %v", err)
-			}
-			sessionExercise.Bpms = bpms
-		}
-
-		sessionExercise.SessionId = req.Id
-		sessionExercise.ExerciseId = exerciseId
-		sessionExercise.StartTime = timestamppb.New(startTime)
-		sessionExercise.EndTime = timestamppb.New(endTime)
-
-		// Fetch exercise details
-		exercise, err := h.getExerciseDetails(ctx, tx, exerciseId)
-		if err != nil {
-			return nil, err
-		}
-		sessionExercise.Exercise = exercise
-
-		exercises = append(exercises, &sessionExercise)
-		exerciseIDMap[exerciseId] = len(exercises) - 1
-	}
-
-	if err = exerciseRows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading session exercises: %v", err)
-	}
-
-	session.Exercises = exercises
-
-	// Commit the transaction
-	if err := tx.Commit(); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+		return nil, storeError(err, "failed to retrieve practice session")
 	}
 
-	return &session, nil
+	return session, nil
 }
 
 // ListPracticeSessions lists practice sessions with optional filtering and pagination
 func (h *PracticeSessionHandler) ListPracticeSessions(ctx context.Context, req *pb.ListPracticeSessionsRequest) (*pb.ListPracticeSessionsResponse, error) {
-	pageSize := int(req.PageSize)
-	if pageSize <= 0 {
-		pageSize = 50 // Default page size
-	}
-
-	// Parse the page token, which is just an offset
-	offset := 0
-	if req.PageToken != "" {
-		var err error
-		offset, err = strconv.Atoi(req.PageToken)
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "invalid page token")
-		}
-	}
-
-	// Build the query based on filters
-	baseQuery := `
-        SELECT id, start_time, end_time, notes, created_at, updated_at, active
-        FROM practice_sessions
-    `
-	countQuery := `
-        SELECT COUNT(*)
-        FROM practice_sessions
-    `
-
-	var whereClause string
-	var queryParams []any
-
-	// Add filter by date range if provided
-	if req.StartDate != nil && req.EndDate != nil {
-		whereClause = " WHERE start_time >= ? AND end_time <= ?"
-		queryParams = append(queryParams, req.StartDate.AsTime(), req.EndDate.AsTime())
-	} else if req.StartDate != nil {
-		whereClause = " WHERE start_time >= ?"
-		queryParams = append(queryParams, req.StartDate.AsTime())
-	} else if req.EndDate != nil {
-		whereClause = " WHERE end_time <= ?"
-		queryParams = append(queryParams, req.EndDate.AsTime())
-	}
-
-	// Add filter by exercise if provided
-	if req.ExerciseId > 0 {
-		if whereClause == "" {
-			whereClause = " WHERE"
-		} else {
-			whereClause += " AND"
-		}
-		whereClause += " id IN (SELECT session_id FROM exercise_history WHERE exercise_id = ?)"
-		queryParams = append(queryParams, req.ExerciseId)
-	}
-
-	if req.Active {
-		if whereClause == "" {
-			whereClause = " WHERE"
-		} else {
-			whereClause += " AND"
-		}
-		whereClause += " active = 1"
-	}
-
-	// Add order by, limit, and offset
-	fullQuery := baseQuery + whereClause + " ORDER BY start_time DESC LIMIT ? OFFSET ?"
-	queryParams = append(queryParams, pageSize+1, offset) // Query one more to check if there are more pages
-
-	// Query total count
-	var totalCount int32
-	countQueryParams := make([]any, len(queryParams)-2) // Exclude limit and offset
-	copy(countQueryParams, queryParams[:len(queryParams)-2])
-
-	err := h.db.QueryRowContext(ctx, countQuery+whereClause, countQueryParams...).Scan(&totalCount)
+	p, err := newPage(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to count practice sessions: %v", err)
+		return nil, err
 	}
 
-	// Query sessions
-	rows, err := h.db.QueryContext(ctx, fullQuery, queryParams...)
+	loc, err := timeZone(req.TimeZone)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list practice sessions: %v", err)
+		return nil, err
 	}
-	defer rows.Close()
-
-	// Parse the results
-	sessions := make([]*pb.PracticeSession, 0, pageSize)
-	count := 0
-	hasMorePages := false
-
-	for rows.Next() {
-		if count >= pageSize {
-			hasMorePages = true
-			break
-		}
-
-		var session pb.PracticeSession
-		var startTime, endTime, createdAt, updatedAt time.Time
-		var active int
-
-		err := rows.Scan(&session.Id, &startTime, &endTime, &session.Notes, &createdAt, &updatedAt, &active)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse practice session: %v", err)
-		}
-
-		session.Active = active == 1
-		session.StartTime = timestamppb.New(startTime)
-		session.EndTime = timestamppb.New(endTime)
-		session.CreatedAt = timestamppb.New(createdAt)
-		session.UpdatedAt = timestamppb.New(updatedAt)
-		session.Exercises = []*pb.ExerciseHistory{}
 
-		sessions = append(sessions, &session)
-		count++
+	filter := storage.SessionFilter{
+		DateRange:  localDateRange(req.StartDate, req.EndDate, loc),
+		ExerciseID: req.ExerciseId,
+		ActiveOnly: req.Active,
 	}
-
-	if err = rows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading practice sessions: %v", err)
-	}
-
-	// Calculate next page token
-	nextPageToken := ""
-	if hasMorePages {
-		nextPageToken = strconv.Itoa(offset + pageSize)
+	page, err := h.sessions.List(ctx, filter, p.options())
+	if err != nil {
+		return nil, storeError(err, "failed to list practice sessions")
 	}
 
 	return &pb.ListPracticeSessionsResponse{
-		Sessions:      sessions,
-		NextPageToken: nextPageToken,
-		TotalCount:    totalCount,
+		Sessions:      page.Items,
+		NextPageToken: p.nextToken(page.Next),
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
@@ -355,154 +110,66 @@ func (h *PracticeSessionHandler) UpdatePracticeSession(ctx context.Context, req
 		return nil, status.Error(codes.InvalidArgument, "session data is required")
 	}
 
-	// Check if the session exists
-	var exists bool
-	err := h.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM practice_sessions WHERE id = ?)", req.Id).Scan(&exists)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check session existence: %v", err)
-	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "session with ID %d not found", req.Id)
-	}
-
-	// Parse update mask
-	updateStartTime := false
-	updateEndTime := false
-	updateNotes := false
-	updateActive := false
-
-	if req.UpdateMask == nil || len(req.UpdateMask.Paths) == 0 {
-		// If no update mask is provided, update all fields
-		updateStartTime = true
-		updateEndTime = true
-		updateNotes = true
-		updateActive = true
-	} else {
-		for _, path := range req.UpdateMask.Paths {
-			switch path {
-			case "start_time":
-				updateStartTime = true
-			case "end_time":
-				updateEndTime = true
-			case "notes":
-				updateNotes = true
-			case "active":
-				updateActive = true
+	var upd storage.SessionUpdate
+	for _, path := range updatePaths(req.UpdateMask, "start_time", "end_time", "notes", "active") {
+		switch path {
+		case "start_time":
+			if req.Session.StartTime == nil {
+				return nil, status.Error(codes.InvalidArgument, "start time cannot be empty")
 			}
+			upd.StartTime = optionalTime(req.Session.StartTime)
+		case "end_time":
+			if req.Session.EndTime == nil {
+				return nil, status.Error(codes.InvalidArgument, "end time cannot be empty")
+			}
+			upd.EndTime = optionalTime(req.Session.EndTime)
+		case "notes":
+			upd.Notes = &req.Session.Notes
+		case "active":
+			upd.Active = &req.Session.Active
 		}
 	}
 
-	// Validate times if updating
-	if updateStartTime && updateEndTime {
-		startTime := req.Session.StartTime.AsTime()
-		endTime := req.Session.EndTime.AsTime()
-		if startTime.After(endTime) {
-			return nil, status.Error(codes.InvalidArgument, "start time cannot be after end time")
-		}
-	} else if updateStartTime {
-		// If only updating start time, check against existing end time
-		var endTime time.Time
-		err := h.db.QueryRowContext(ctx, "SELECT end_time FROM practice_sessions WHERE id = ?", req.Id).Scan(&endTime)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get existing end time: %v", err)
-		}
-
-		startTime := req.Session.StartTime.AsTime()
-		if startTime.After(endTime) {
-			return nil, status.Error(codes.InvalidArgument, "start time cannot be after end time")
-		}
-	} else if updateEndTime {
-		// If only updating end time, check against existing start time
-		var startTime time.Time
-		err := h.db.QueryRowContext(ctx, "SELECT start_time FROM practice_sessions WHERE id = ?", req.Id).Scan(&startTime)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get existing start time: %v", err)
-		}
-
-		endTime := req.Session.EndTime.AsTime()
-		if startTime.After(endTime) {
-			return nil, status.Error(codes.InvalidArgument, "start time cannot be after end time")
-		}
-	}
-
-	tx, err := h.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to start transaction: %v", err)
-	}
-	defer tx.Rollback() // Rollback if not committed
-
-	// Check for other active sessions if attempting to activate this one.
-	if updateActive && req.Session.Active {
-		var activeCount int
-		if err := tx.QueryRowContext(ctx, "SELECT COUNT(1) FROM practice_sessions WHERE active = 1").Scan(&activeCount); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to check for active practice session: %v", err)
-		}
-		if activeCount > 0 {
-			return nil, status.Error(codes.AlreadyExists, "cannot activate session while there is a currently active one")
-		}
-	}
-
-	// Build update SQL
-	sql := "UPDATE practice_sessions SET"
-	params := []interface{}{}
-	first := true
-
-	if updateStartTime {
-		if req.Session.StartTime == nil {
-			return nil, status.Error(codes.InvalidArgument, "start time cannot be empty")
-		}
-		sql += " start_time = ?"
-		params = append(params, req.Session.StartTime.AsTime())
-		first = false
-	}
-
-	if updateEndTime {
-		if req.Session.EndTime == nil {
-			return nil, status.Error(codes.InvalidArgument, "end time cannot be empty")
-		}
-		if !first {
-			sql += ","
-		}
-		sql += " end_time = ?"
-		params = append(params, req.Session.EndTime.AsTime())
-		first = false
-	}
-
-	if updateNotes {
-		if !first {
-			sql += ","
-		}
-		sql += " notes = ?"
-		params = append(params, req.Session.Notes)
+	if upd == (storage.SessionUpdate{}) {
+		return nil, status.Error(codes.InvalidArgument, "no fields to update")
 	}
 
-	if updateActive {
-		val := 0
-		if req.Session.Active {
-			val = 1
+	// Validate times, checking against the existing values when only one changes
+	if upd.StartTime != nil || upd.EndTime != nil {
+		startTime, endTime := upd.StartTime, upd.EndTime
+		if startTime == nil || endTime == nil {
+			existing, err := h.sessions.Get(ctx, req.Id)
+			if err != nil {
+				return nil, storeError(err, "failed to get existing session times")
+			}
+			if startTime == nil {
+				startTime = optionalTime(existing.StartTime)
+			}
+			if endTime == nil {
+				endTime = optionalTime(existing.EndTime)
+			}
 		}
-		if !first {
-			sql += ","
+		if err := validateTimes(*startTime, *endTime); err != nil {
+			return nil, err
 		}
-		sql += " active = ?"
-		params = append(params, val)
 	}
 
-	if len(params) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+	session, err := h.sessions.Update(ctx, req.Id, upd)
+	if errors.Is(err, storage.ErrActiveSession) {
+		return nil, status.Error(codes.FailedPrecondition, "cannot activate session while there is a currently active one")
+	} else if err != nil {
+		return nil, storeError(err, "failed to update practice session")
 	}
 
-	sql += " WHERE id = ?"
-	params = append(params, req.Id)
-
-	// Execute the update
-	_, err = h.db.ExecContext(ctx, sql, params...)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update practice session: %v", err)
+	eventType := pb.SessionEventType_SESSION_EVENT_TYPE_UPDATED
+	if upd.Active != nil && *upd.Active {
+		eventType = pb.SessionEventType_SESSION_EVENT_TYPE_STARTED
+	} else if upd.Active != nil {
+		eventType = pb.SessionEventType_SESSION_EVENT_TYPE_ENDED
 	}
+	h.events.PublishSession(auth.UserFromContext(ctx).Id, eventType, session)
 
-	// Retrieve the updated session
-	return h.GetPracticeSession(ctx, &pb.GetPracticeSessionRequest{Id: req.Id})
+	return session, nil
 }
 
 // DeletePracticeSession deletes a practice session
@@ -511,397 +178,204 @@ func (h *PracticeSessionHandler) DeletePracticeSession(ctx context.Context, req
 		return nil, status.Error(codes.InvalidArgument, "invalid session ID")
 	}
 
-	// Check if the session exists
-	var exists bool
-	err := h.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM practice_sessions WHERE id = ?)", req.Id).Scan(&exists)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check session existence: %v", err)
-	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "session with ID %d not found", req.Id)
+	if err := h.sessions.Delete(ctx, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete practice session")
 	}
 
-	// Delete the session (associated exercises will be deleted by ON DELETE CASCADE)
-	_, err = h.db.ExecContext(ctx, "DELETE FROM practice_sessions WHERE id = ?", req.Id)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete practice session: %v", err)
-	}
+	h.events.Publish(auth.UserFromContext(ctx).Id, &pb.SessionEvent{Type: pb.SessionEventType_SESSION_EVENT_TYPE_DELETED, SessionId: req.Id})
 
 	return &emptypb.Empty{}, nil
 }
 
 // GetPracticeStats returns statistics for practice sessions
 func (h *PracticeSessionHandler) GetPracticeStats(ctx context.Context, req *pb.GetPracticeStatsRequest) (*pb.PracticeStats, error) {
-	// Build query filters based on request parameters
-	var whereClause string
-	var queryParams []any
-
-	// Add filter by date range if provided
-	if req.StartDate != nil && req.EndDate != nil {
-		whereClause = " WHERE ps.start_time >= ? AND ps.end_time <= ?"
-		queryParams = append(queryParams, req.StartDate.AsTime(), req.EndDate.AsTime())
-	} else if req.StartDate != nil {
-		whereClause = " WHERE ps.start_time >= ?"
-		queryParams = append(queryParams, req.StartDate.AsTime())
-	} else if req.EndDate != nil {
-		whereClause = " WHERE ps.end_time <= ?"
-		queryParams = append(queryParams, req.EndDate.AsTime())
-	}
-
-	// Add category filter if provided
-	var categoryJoin, categoryFilter string
-	if req.CategoryId > 0 {
-		categoryJoin = `JOIN exercise_history eh ON eh.session_id = ps.id 
-						JOIN exercise_tags ec ON eh.exercise_id = ec.exercise_id
-						JOIN tag_categories tc ON tc.tag_id = ec.tag_id`
-		if whereClause == "" {
-			categoryFilter = " WHERE tc.category_id = ?"
-		} else {
-			categoryFilter = " AND tc.category_id = ?"
-		}
-		queryParams = append(queryParams, req.CategoryId)
+	loc, err := timeZone(req.TimeZone)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get total sessions and duration
-	var totalSessions int32
-	var totalDurationSeconds int32
-
-	sessionQuery := "SELECT COUNT(DISTINCT ps.id), COALESCE(SUM(strftime('%s', ps.end_time) - strftime('%s', ps.start_time)), 0) FROM practice_sessions ps" + categoryJoin + whereClause + categoryFilter
-	err := h.db.QueryRowContext(ctx, sessionQuery, queryParams...).Scan(&totalSessions, &totalDurationSeconds)
+	stats, err := h.sessions.Stats(ctx, storage.PracticeStatsFilter{
+		DateRange:  dateRange(req.StartDate, req.EndDate),
+		CategoryID: req.CategoryId,
+		Location:   loc,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve session statistics: %v", err)
-	}
-
-	// Calculate average session duration
-	var avgSessionDurationSeconds float64
-	if totalSessions > 0 {
-		avgSessionDurationSeconds = float64(totalDurationSeconds) / float64(totalSessions)
-	}
-
-	// Get exercise time distribution
-	exerciseDistQuery := `
-		SELECT 
-			e.id, 
-			e.name, 
-			COALESCE(
-				SUM(
-					CASE 
-						WHEN duration_seconds > 0 THEN duration_seconds
-						ELSE strftime('%s', eh.end_time) - strftime('%s', eh.start_time)
-					END
-				), 0
-			) as duration,
-			ROUND(COALESCE(SUM(strftime('%s', eh.end_time) - strftime('%s', eh.start_time)), 0) * 100.0 / ?, 2) as percentage
-		FROM 
-			exercises e
-		JOIN 
-			exercise_history eh ON e.id = eh.exercise_id
-		JOIN 
-			practice_sessions ps ON eh.session_id = ps.id
-	` + whereClause + `
-		GROUP BY 
-			e.id
-		ORDER BY 
-			duration DESC
-		LIMIT 10
-	`
-
-	exerciseDistParams := append([]any{totalDurationSeconds}, queryParams...)
-	exerciseRows, err := h.db.QueryContext(ctx, exerciseDistQuery, exerciseDistParams...)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to calculate exercise distribution: %v", err)
+		return nil, storeError(err, "failed to retrieve practice statistics")
 	}
-	defer exerciseRows.Close()
 
-	var exerciseDistribution []*pb.ExerciseTimeDistribution
-	for exerciseRows.Next() {
-		var dist pb.ExerciseTimeDistribution
-		var percentage float64
+	return stats, nil
+}
 
-		if err := exerciseRows.Scan(&dist.ExerciseId, &dist.ExerciseName, &dist.DurationSeconds, &percentage); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse exercise distribution: %v", err)
+// GetTargetProgress returns weekly practice time against the category targets
+func (h *PracticeSessionHandler) GetTargetProgress(ctx context.Context, req *pb.GetTargetProgressRequest) (*pb.TargetProgress, error) {
+	if req.StartDate != nil && req.EndDate != nil {
+		if err := validateTimes(req.StartDate.AsTime(), req.EndDate.AsTime()); err != nil {
+			return nil, err
 		}
-
-		dist.Percentage = percentage
-		exerciseDistribution = append(exerciseDistribution, &dist)
 	}
 
-	if err = exerciseRows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading exercise distribution: %v", err)
+	loc, err := timeZone(req.TimeZone)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get overall practice frequency by day
-	frequencyQuery := `
-		SELECT 
-			date(ps.start_time) as practice_date,
-			SUM(strftime('%s', ps.end_time) - strftime('%s', ps.start_time)) as duration
-		FROM 
-			practice_sessions ps
-	` + whereClause + `
-		GROUP BY 
-			practice_date
-		ORDER BY 
-			practice_date ASC
-	`
-
-	frequencyRows, err := h.db.QueryContext(ctx, frequencyQuery, queryParams...)
+	progress, err := h.sessions.TargetProgress(ctx, storage.PracticeStatsFilter{
+		DateRange:  dateRange(req.StartDate, req.EndDate),
+		CategoryID: req.CategoryId,
+		Location:   loc,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to calculate practice frequency: %v", err)
+		return nil, storeError(err, "failed to retrieve target progress")
 	}
-	defer frequencyRows.Close()
-
-	var practiceFrequency []*pb.PracticeTimePoint
-	for frequencyRows.Next() {
-		var dateStr string
-		var durationSeconds int32
-		var practiceDate time.Time
-
-		if err := frequencyRows.Scan(&dateStr, &durationSeconds); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse practice frequency: %v", err)
-		}
 
-		// Parse the date string (format: YYYY-MM-DD)
-		practiceDate, err = time.Parse("2006-01-02", dateStr)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse date: %v", err)
-		}
+	return progress, nil
+}
 
-		point := &pb.PracticeTimePoint{
-			Date:            timestamppb.New(practiceDate),
-			DurationSeconds: durationSeconds,
-		}
-		practiceFrequency = append(practiceFrequency, point)
-	}
-
-	if err = frequencyRows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading practice frequency: %v", err)
-	}
-
-	// Get category time distribution with daily breakdown
-	categoryDistQuery := `
-		SELECT 
-			c.id, 
-			c.name, 
-			COALESCE(
-				SUM(
-					CASE 
-						WHEN duration_seconds > 0 THEN duration_seconds
-						ELSE strftime('%s', eh.end_time) - strftime('%s', eh.start_time)
-					END
-				), 0
-			) as duration,
-			ROUND(COALESCE(SUM(strftime('%s', eh.end_time) - strftime('%s', eh.start_time)), 0) * 100.0 / ?, 2) as percentage
-		FROM 
-			categories c
-		JOIN tag_categories tc ON tc.category_id = c.id
-		JOIN exercise_tags et on tc.tag_id = et.tag_id
-		JOIN 
-			exercise_history eh ON et.exercise_id = eh.exercise_id
-		JOIN 
-			practice_sessions ps ON eh.session_id = ps.id
-	` + whereClause + `
-		GROUP BY 
-			c.id
-		ORDER BY 
-			duration DESC
-	`
-
-	categoryDistParams := append([]any{totalDurationSeconds}, queryParams...)
-	categoryRows, err := h.db.QueryContext(ctx, categoryDistQuery, categoryDistParams...)
+// GetConsistencyStats returns practice streaks, a calendar heatmap and time
+// distributions in the requested time zone
+func (h *PracticeSessionHandler) GetConsistencyStats(ctx context.Context, req *pb.GetConsistencyStatsRequest) (*pb.ConsistencyStats, error) {
+	loc, err := timeZone(req.TimeZone)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to calculate category distribution: %v", err)
+		return nil, err
 	}
-	defer categoryRows.Close()
 
-	var categoryDistribution []*pb.CategoryTimeDistribution
-
-	for categoryRows.Next() {
-		var dist pb.CategoryTimeDistribution
-		var percentage float64
+	end := time.Now()
+	if req.EndDate != nil {
+		end = req.EndDate.AsTime()
+	}
 
-		if err := categoryRows.Scan(&dist.CategoryId, &dist.CategoryName, &dist.DurationSeconds, &percentage); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse category distribution: %v", err)
-		}
+	stats, err := h.sessions.Consistency(ctx, loc, end)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve consistency statistics")
+	}
 
-		dist.Percentage = percentage
+	return stats, nil
+}
 
-		// For each category, get daily practice times
-		categoryFrequency, err := h.getCategoryDailyPractice(ctx, dist.CategoryId, whereClause, queryParams)
-		if err != nil {
-			return nil, err
-		}
+// validateTimes checks that a start time does not come after its end time
+func validateTimes(startTime, endTime time.Time) error {
+	if startTime.After(endTime) {
+		return status.Error(codes.InvalidArgument, "start time cannot be after end time")
+	}
+	return nil
+}
 
-		dist.PracticeFrequency = categoryFrequency
-		categoryDistribution = append(categoryDistribution, &dist)
+// PauseSession pauses the timer of an active practice session
+func (h *PracticeSessionHandler) PauseSession(ctx context.Context, req *pb.PauseSessionRequest) (*pb.PracticeSession, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid session ID")
 	}
 
-	if err = categoryRows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading category distribution: %v", err)
+	session, err := h.sessions.Pause(ctx, req.Id, time.Now())
+	if err != nil {
+		return nil, storeError(err, "failed to pause practice session")
 	}
 
-	// Return the statistics
-	return &pb.PracticeStats{
-		TotalSessions:             totalSessions,
-		TotalDurationSeconds:      totalDurationSeconds,
-		AvgSessionDurationSeconds: avgSessionDurationSeconds,
-		ExerciseDistribution:      exerciseDistribution,
-		CategoryDistribution:      categoryDistribution,
-		PracticeFrequency:         practiceFrequency,
-	}, nil
+	h.events.PublishSession(auth.UserFromContext(ctx).Id, pb.SessionEventType_SESSION_EVENT_TYPE_UPDATED, session)
+
+	return session, nil
 }
 
-// getCategoryDailyPractice retrieves the daily practice data for a specific category
-func (h *PracticeSessionHandler) getCategoryDailyPractice(ctx context.Context, categoryId int32, baseWhereClause string, baseParams []any) ([]*pb.PracticeTimePoint, error) {
-	// Build the query for category daily practice
-	categoryDailyQuery := `
-		SELECT 
-			date(ps.start_time) as practice_date,
-			COALESCE(
-				SUM(
-					CASE 
-						WHEN duration_seconds > 0 THEN duration_seconds
-						ELSE strftime('%s', eh.end_time) - strftime('%s', eh.start_time)
-					END
-				), 0
-			) as duration
-		FROM 
-			exercise_history eh
-		JOIN 
-			practice_sessions ps ON eh.session_id = ps.id
-		JOIN exercise_tags et on et.exercise_id = eh.exercise_id
-		JOIN tag_categories tc on et.tag_id = tc.tag_id
-		WHERE 
-			tc.category_id = ?
-	`
-
-	// Create parameters for query, starting with category ID
-	categoryDailyParams := []any{categoryId}
-
-	// Add date range filters if they exist in the base query
-	if baseWhereClause != "" {
-		// Convert "WHERE ps.start_time >= ?" to "AND ps.start_time >= ?"
-		categoryFilter := strings.Replace(baseWhereClause, "WHERE", "AND", 1)
-		categoryDailyQuery += categoryFilter
-		categoryDailyParams = append(categoryDailyParams, baseParams...)
-	}
-
-	// Add grouping and ordering
-	categoryDailyQuery += `
-		GROUP BY 
-			practice_date
-		ORDER BY 
-			practice_date ASC
-	`
-
-	// Execute the query
-	categoryDailyRows, err := h.db.QueryContext(ctx, categoryDailyQuery, categoryDailyParams...)
+// ResumeSession resumes the timer of a paused practice session
+func (h *PracticeSessionHandler) ResumeSession(ctx context.Context, req *pb.ResumeSessionRequest) (*pb.PracticeSession, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid session ID")
+	}
+
+	session, err := h.sessions.Resume(ctx, req.Id, time.Now())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to calculate daily practice for category %d: %v", categoryId, err)
+		return nil, storeError(err, "failed to resume practice session")
 	}
-	defer categoryDailyRows.Close()
 
-	var categoryDailyPoints []*pb.PracticeTimePoint
+	h.events.PublishSession(auth.UserFromContext(ctx).Id, pb.SessionEventType_SESSION_EVENT_TYPE_UPDATED, session)
 
-	for categoryDailyRows.Next() {
-		var dateStr string
-		var durationSeconds int32
+	return session, nil
+}
 
-		if err := categoryDailyRows.Scan(&dateStr, &durationSeconds); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse daily category practice: %v", err)
-		}
+// StartExercise starts timing an exercise in an active practice session
+func (h *PracticeSessionHandler) StartExercise(ctx context.Context, req *pb.StartExerciseRequest) (*pb.PracticeSession, error) {
+	if req.SessionId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid session ID")
+	}
 
-		// Parse the date string (format: YYYY-MM-DD)
-		practiceDate, err := time.Parse("2006-01-02", dateStr)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse date: %v", err)
-		}
+	if req.ExerciseId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "exercise ID is required")
+	}
 
-		point := &pb.PracticeTimePoint{
-			Date:            timestamppb.New(practiceDate),
-			DurationSeconds: durationSeconds,
-		}
-		categoryDailyPoints = append(categoryDailyPoints, point)
+	session, err := h.sessions.StartExercise(ctx, req.SessionId, &pb.ExerciseHistory{
+		ExerciseId:    req.ExerciseId,
+		Bpms:          req.Bpms,
+		TimeSignature: req.TimeSignature,
+	}, time.Now())
+	if err != nil {
+		return nil, storeError(err, "failed to start exercise")
 	}
 
-	if err = categoryDailyRows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading daily category practice: %v", err)
+	h.events.PublishSession(auth.UserFromContext(ctx).Id, pb.SessionEventType_SESSION_EVENT_TYPE_UPDATED, session)
+	if entry := currentExercise(session); entry != nil {
+		h.events.PublishExercise(auth.UserFromContext(ctx).Id, pb.SessionEventType_SESSION_EVENT_TYPE_EXERCISE_ADDED, entry)
 	}
 
-	return categoryDailyPoints, nil
+	return session, nil
 }
 
-// Helper method to get exercise details
-func (h *PracticeSessionHandler) getExerciseDetails(ctx context.Context, tx *sql.Tx, exerciseId int32) (*pb.Exercise, error) {
-	// Get basic exercise info
-	var exercise pb.Exercise
-	var createdAt, updatedAt time.Time
-
-	err := tx.QueryRowContext(
-		ctx,
-		"SELECT id, name, description, created_at, updated_at FROM exercises WHERE id = ?",
-		exerciseId,
-	).Scan(&exercise.Id, &exercise.Name, &exercise.Description, &createdAt, &updatedAt)
+// StopExercise stops timing the exercise in progress
+func (h *PracticeSessionHandler) StopExercise(ctx context.Context, req *pb.StopExerciseRequest) (*pb.PracticeSession, error) {
+	if req.SessionId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid session ID")
+	}
 
-	if err == sql.ErrNoRows {
-		return nil, status.Errorf(codes.NotFound, "exercise with ID %d not found", exerciseId)
-	} else if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve exercise: %v", err)
+	session, err := h.sessions.StopExercise(ctx, req.SessionId, time.Now())
+	if err != nil {
+		return nil, storeError(err, "failed to stop exercise")
 	}
 
-	exercise.CreatedAt = timestamppb.New(createdAt)
-	exercise.UpdatedAt = timestamppb.New(updatedAt)
+	h.events.PublishSession(auth.UserFromContext(ctx).Id, pb.SessionEventType_SESSION_EVENT_TYPE_UPDATED, session)
 
-	// Get associated tag IDs
-	tagRows, err := tx.QueryContext(
-		ctx,
-		"SELECT tag_id FROM exercise_tags WHERE exercise_id = ?",
-		exerciseId,
-	)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve exercise tags: %v", err)
+	return session, nil
+}
+
+// WatchSession streams the session events published from now on, of a single
+// session when one is given
+func (h *PracticeSessionHandler) WatchSession(req *pb.WatchSessionRequest, stream pb.PracticeSessionService_WatchSessionServer) error {
+	ctx := stream.Context()
+	if req.SessionId < 0 {
+		return status.Error(codes.InvalidArgument, "invalid session ID")
 	}
-	defer tagRows.Close()
 
-	var tagIDs []int32
-	for tagRows.Next() {
-		var tagID int32
-		if err := tagRows.Scan(&tagID); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse tag ID: %v", err)
+	if req.SessionId > 0 {
+		if _, err := h.sessions.Get(ctx, req.SessionId); err != nil {
+			return storeError(err, "failed to retrieve practice session")
 		}
-		tagIDs = append(tagIDs, tagID)
-	}
-	if err = tagRows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading exercise tags: %v", err)
-	}
-	exercise.TagIds = tagIDs
-
-	// Get associated category IDs
-	categoryRows, err := tx.QueryContext(
-		ctx,
-		`SELECT category_id 
-		FROM exercise_tags et
-		JOIN tag_categories tc ON tc.tag_id = et.tag_id
-		WHERE et.exercise_id = ?
-		GROUP BY tc.category_id`,
-		exerciseId,
-	)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to retrieve exercise categories: %v", err)
 	}
-	defer categoryRows.Close()
 
-	var categoryIDs []int32
-	for categoryRows.Next() {
-		var categoryID int32
-		if err := categoryRows.Scan(&categoryID); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse category ID: %v", err)
+	feed, cancel := h.events.Subscribe(auth.UserFromContext(ctx).Id)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-feed:
+			if !ok {
+				return status.Error(codes.Unavailable, "fell too far behind the session events, reconnect to continue")
+			}
+			if req.SessionId > 0 && event.SessionId != req.SessionId {
+				continue
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
 		}
-		categoryIDs = append(categoryIDs, categoryID)
-	}
-	if err = categoryRows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "error reading exercise categories: %v", err)
 	}
-	exercise.CategoryIds = categoryIDs
+}
 
-	return &exercise, nil
+// currentExercise returns the exercise history entry in progress in a session
+func currentExercise(session *pb.PracticeSession) *pb.ExerciseHistory {
+	for _, entry := range session.Exercises {
+		if entry.Id == session.CurrentHistoryId {
+			return entry
+		}
+	}
+	return nil
 }
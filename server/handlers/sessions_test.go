@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	sessionStart = time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC)
+	sessionEnd   = sessionStart.Add(time.Hour)
+)
+
+func TestCreatePracticeSession(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.CreatePracticeSessionRequest
+		code codes.Code
+	}{
+		{"valid", &pb.CreatePracticeSessionRequest{StartTime: timestamppb.New(sessionStart), EndTime: timestamppb.New(sessionEnd)}, codes.OK},
+		{"missing start", &pb.CreatePracticeSessionRequest{EndTime: timestamppb.New(sessionEnd)}, codes.InvalidArgument},
+		{"missing end", &pb.CreatePracticeSessionRequest{StartTime: timestamppb.New(sessionStart)}, codes.InvalidArgument},
+		{"end before start", &pb.CreatePracticeSessionRequest{StartTime: timestamppb.New(sessionEnd), EndTime: timestamppb.New(sessionStart)}, codes.InvalidArgument},
+	}
+
+	user := &pb.User{Id: 1, Username: "alice"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := authenticate(t, newFakeUsers(), user)
+			broker := events.NewBroker()
+			received, stop := broker.Subscribe(user.Id)
+			defer stop()
+
+			h := NewPracticeSessionHandler(newFakeSessions(), broker)
+			session, err := h.CreatePracticeSession(ctx, tt.req)
+			wantCode(t, err, tt.code)
+			if err != nil {
+				return
+			}
+
+			select {
+			case event := <-received:
+				if event.Type != pb.SessionEventType_SESSION_EVENT_TYPE_STARTED || event.SessionId != session.Id {
+					t.Errorf("published %v, want the session started", event)
+				}
+			default:
+				t.Error("no event published")
+			}
+		})
+	}
+}
+
+func TestUpdatePracticeSession(t *testing.T) {
+	user := &pb.User{Id: 1, Username: "alice"}
+	ctx := authenticate(t, newFakeUsers(), user)
+	broker := events.NewBroker()
+	sessions := newFakeSessions()
+	h := NewPracticeSessionHandler(sessions, broker)
+
+	created, err := sessions.Create(ctx, &pb.PracticeSession{
+		StartTime: timestamppb.New(sessionStart),
+		EndTime:   timestamppb.New(sessionEnd),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	active, err := sessions.Create(ctx, &pb.PracticeSession{
+		StartTime: timestamppb.New(sessionEnd),
+		EndTime:   timestamppb.New(sessionEnd.Add(time.Hour)),
+		Active:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mask := func(paths ...string) *fieldmaskpb.FieldMask {
+		return &fieldmaskpb.FieldMask{Paths: paths}
+	}
+	tests := []struct {
+		name  string
+		req   *pb.UpdatePracticeSessionRequest
+		code  codes.Code
+		event pb.SessionEventType
+	}{
+		{
+			name:  "notes",
+			req:   &pb.UpdatePracticeSessionRequest{Id: created.Id, Session: &pb.PracticeSession{Notes: "Slow"}, UpdateMask: mask("notes")},
+			event: pb.SessionEventType_SESSION_EVENT_TYPE_UPDATED,
+		},
+		{
+			// The new end is checked against the stored start
+			name: "end before the stored start",
+			req:  &pb.UpdatePracticeSessionRequest{Id: created.Id, Session: &pb.PracticeSession{EndTime: timestamppb.New(sessionStart.Add(-time.Minute))}, UpdateMask: mask("end_time")},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:  "end after the stored start",
+			req:   &pb.UpdatePracticeSessionRequest{Id: created.Id, Session: &pb.PracticeSession{EndTime: timestamppb.New(sessionStart.Add(time.Minute))}, UpdateMask: mask("end_time")},
+			event: pb.SessionEventType_SESSION_EVENT_TYPE_UPDATED,
+		},
+		{
+			name: "empty start",
+			req:  &pb.UpdatePracticeSessionRequest{Id: created.Id, Session: &pb.PracticeSession{}, UpdateMask: mask("start_time")},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "second active session",
+			req:  &pb.UpdatePracticeSessionRequest{Id: created.Id, Session: &pb.PracticeSession{Active: true}, UpdateMask: mask("active")},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:  "end the active session",
+			req:   &pb.UpdatePracticeSessionRequest{Id: active.Id, Session: &pb.PracticeSession{}, UpdateMask: mask("active")},
+			event: pb.SessionEventType_SESSION_EVENT_TYPE_ENDED,
+		},
+		{
+			name:  "activate",
+			req:   &pb.UpdatePracticeSessionRequest{Id: created.Id, Session: &pb.PracticeSession{Active: true}, UpdateMask: mask("active")},
+			event: pb.SessionEventType_SESSION_EVENT_TYPE_STARTED,
+		},
+		{
+			name: "not found",
+			req:  &pb.UpdatePracticeSessionRequest{Id: 99, Session: &pb.PracticeSession{Notes: "x"}, UpdateMask: mask("notes")},
+			code: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received, stop := broker.Subscribe(user.Id)
+			defer stop()
+
+			_, err := h.UpdatePracticeSession(ctx, tt.req)
+			wantCode(t, err, tt.code)
+
+			select {
+			case event := <-received:
+				if event.Type != tt.event || event.SessionId != tt.req.Id {
+					t.Errorf("published %v, want %v of session %d", event, tt.event, tt.req.Id)
+				}
+			default:
+				if err == nil {
+					t.Error("no event published")
+				}
+			}
+		})
+	}
+
+	if got := sessions.rows[created.Id]; got.Notes != "Slow" || !got.EndTime.AsTime().Equal(sessionStart.Add(time.Minute)) || !got.Active {
+		t.Errorf("stored session %v", got)
+	}
+}
+
+func TestDeletePracticeSession(t *testing.T) {
+	user := &pb.User{Id: 1, Username: "alice"}
+	ctx := authenticate(t, newFakeUsers(), user)
+	broker := events.NewBroker()
+	sessions := newFakeSessions()
+	h := NewPracticeSessionHandler(sessions, broker)
+
+	created, err := sessions.Create(ctx, &pb.PracticeSession{StartTime: timestamppb.New(sessionStart), EndTime: timestamppb.New(sessionEnd)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Other users do not see the events
+	other, stop := broker.Subscribe(2)
+	defer stop()
+	received, stop := broker.Subscribe(user.Id)
+	defer stop()
+
+	if _, err := h.DeletePracticeSession(ctx, &pb.DeletePracticeSessionRequest{Id: created.Id}); err != nil {
+		t.Fatalf("DeletePracticeSession: %v", err)
+	}
+	if event := <-received; event.Type != pb.SessionEventType_SESSION_EVENT_TYPE_DELETED || event.SessionId != created.Id {
+		t.Errorf("published %v, want the session deleted", event)
+	}
+	select {
+	case event := <-other:
+		t.Errorf("another user received %v", event)
+	default:
+	}
+
+	_, err = h.DeletePracticeSession(ctx, &pb.DeletePracticeSessionRequest{Id: created.Id})
+	wantCode(t, err, codes.NotFound)
+}
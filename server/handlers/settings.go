@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SettingsHandler implements the SettingsService gRPC service
+type SettingsHandler struct {
+	pb.UnimplementedSettingsServiceServer
+	settings storage.SettingsRepo
+}
+
+// NewSettingsHandler creates a new SettingsHandler
+func NewSettingsHandler(settings storage.SettingsRepo) *SettingsHandler {
+	return &SettingsHandler{settings: settings}
+}
+
+// GetSettings retrieves the settings
+func (h *SettingsHandler) GetSettings(ctx context.Context, req *pb.GetSettingsRequest) (*pb.Settings, error) {
+	settings, err := h.settings.Get(ctx)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve settings")
+	}
+
+	return settings, nil
+}
+
+// UpdateSettings updates the settings
+func (h *SettingsHandler) UpdateSettings(ctx context.Context, req *pb.UpdateSettingsRequest) (*pb.Settings, error) {
+	if req.Settings == nil {
+		return nil, status.Error(codes.InvalidArgument, "settings data is required")
+	}
+
+	var upd storage.SettingsUpdate
+	for _, path := range updatePaths(req.UpdateMask, "time_zone") {
+		switch path {
+		case "time_zone":
+			if req.Settings.TimeZone == "" {
+				return nil, status.Error(codes.InvalidArgument, "time zone cannot be empty")
+			}
+			if _, err := timeZone(req.Settings.TimeZone); err != nil {
+				return nil, err
+			}
+			upd.TimeZone = &req.Settings.TimeZone
+		}
+	}
+
+	if upd == (storage.SettingsUpdate{}) {
+		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+	}
+
+	settings, err := h.settings.Update(ctx, upd)
+	if err != nil {
+		return nil, storeError(err, "failed to update settings")
+	}
+
+	return settings, nil
+}
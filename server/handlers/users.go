@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/auth"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// UserHandler implements the UserService gRPC service, the authenticator
+// restricts it to admins
+type UserHandler struct {
+	pb.UnimplementedUserServiceServer
+	users storage.UserRepo
+}
+
+// NewUserHandler creates a new UserHandler
+func NewUserHandler(users storage.UserRepo) *UserHandler {
+	return &UserHandler{users: users}
+}
+
+// CreateUser creates a new user
+func (h *UserHandler) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
+	if req.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	}
+
+	passwordHash, err := hashPassword(req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.users.Create(ctx, req.Username, passwordHash, req.Admin, req.Teacher)
+	if err != nil {
+		return nil, storeError(err, "failed to create user")
+	}
+
+	return user, nil
+}
+
+// ListUsers lists the users
+func (h *UserHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	users, err := h.users.List(ctx)
+	if err != nil {
+		return nil, storeError(err, "failed to list users")
+	}
+
+	return &pb.ListUsersResponse{Users: users}, nil
+}
+
+// UpdateUser changes the roles of a user. Only the teacher flag can change
+// once the user is created. Teachers losing it keep their students and
+// assignments until it is given back.
+func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if req.User == nil {
+		return nil, status.Error(codes.InvalidArgument, "user data is required")
+	}
+
+	var upd storage.UserUpdate
+	for _, path := range updatePaths(req.UpdateMask, "teacher") {
+		switch path {
+		case "teacher":
+			upd.Teacher = &req.User.Teacher
+		}
+	}
+
+	if upd == (storage.UserUpdate{}) {
+		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+	}
+
+	user, err := h.users.Update(ctx, req.Id, upd)
+	if err != nil {
+		return nil, storeError(err, "failed to update user")
+	}
+
+	return user, nil
+}
+
+// DeleteUser deletes a user other than the authenticated one, so that an
+// admin cannot lock everyone out
+func (h *UserHandler) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*emptypb.Empty, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if req.Id == auth.UserFromContext(ctx).Id {
+		return nil, status.Error(codes.FailedPrecondition, "cannot delete the authenticated user")
+	}
+
+	if err := h.users.Delete(ctx, req.Id); err != nil {
+		return nil, storeError(err, "failed to delete user")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// AddStudent makes a user a student of a teacher
+func (h *UserHandler) AddStudent(ctx context.Context, req *pb.AddStudentRequest) (*emptypb.Empty, error) {
+	if req.TeacherId <= 0 || req.StudentId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "teacher and student IDs are required")
+	}
+	if req.TeacherId == req.StudentId {
+		return nil, status.Error(codes.InvalidArgument, "a teacher cannot be their own student")
+	}
+
+	teacher, err := h.users.Get(ctx, req.TeacherId)
+	if err != nil {
+		return nil, storeError(err, "failed to retrieve teacher")
+	}
+	if !teacher.Teacher {
+		return nil, status.Errorf(codes.FailedPrecondition, "user %d is not a teacher", req.TeacherId)
+	}
+
+	if err := h.users.AddStudent(ctx, req.TeacherId, req.StudentId); err != nil {
+		return nil, storeError(err, "failed to add student")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveStudent ends the teaching of a student
+func (h *UserHandler) RemoveStudent(ctx context.Context, req *pb.RemoveStudentRequest) (*emptypb.Empty, error) {
+	if req.TeacherId <= 0 || req.StudentId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "teacher and student IDs are required")
+	}
+
+	if err := h.users.RemoveStudent(ctx, req.TeacherId, req.StudentId); err != nil {
+		return nil, storeError(err, "failed to remove student")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"testing"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdateUser(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *pb.UpdateUserRequest
+		code    codes.Code
+		teacher bool
+	}{
+		{"make a teacher", &pb.UpdateUserRequest{Id: 2, User: &pb.User{Teacher: true}}, codes.OK, true},
+		{"teacher with a mask", &pb.UpdateUserRequest{Id: 2, User: &pb.User{Teacher: true}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"teacher"}}}, codes.OK, true},
+		{"unset", &pb.UpdateUserRequest{Id: 2, User: &pb.User{}}, codes.OK, false},
+		// The other fields are set once, when the user is created
+		{"admin", &pb.UpdateUserRequest{Id: 2, User: &pb.User{Admin: true}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"admin"}}}, codes.InvalidArgument, false},
+		{"username", &pb.UpdateUserRequest{Id: 2, User: &pb.User{Username: "root"}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"username"}}}, codes.InvalidArgument, false},
+		{"missing user", &pb.UpdateUserRequest{Id: 2}, codes.InvalidArgument, false},
+		{"invalid ID", &pb.UpdateUserRequest{User: &pb.User{Teacher: true}}, codes.InvalidArgument, false},
+		{"unknown user", &pb.UpdateUserRequest{Id: 9, User: &pb.User{Teacher: true}}, codes.NotFound, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin := &pb.User{Id: 1, Username: "admin", Admin: true}
+			users := newFakeUsers(admin, &pb.User{Id: 2, Username: "alice"})
+			h := NewUserHandler(users)
+
+			user, err := h.UpdateUser(authenticate(t, users, admin), tt.req)
+			wantCode(t, err, tt.code)
+			if err == nil && (user.Teacher != tt.teacher || user.Admin || user.Username != "alice") {
+				t.Errorf("UpdateUser = %v, want teacher %v", user, tt.teacher)
+			}
+
+			stored, _ := users.Get(t.Context(), 2)
+			if stored.Teacher != tt.teacher || stored.Admin {
+				t.Errorf("stored %v, want teacher %v", stored, tt.teacher)
+			}
+		})
+	}
+}
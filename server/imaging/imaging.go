@@ -0,0 +1,191 @@
+// Package imaging validates uploaded exercise images, normalizes their
+// orientation and renders the thumbnails exercise lists preview them with.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	// Decoders of the accepted formats
+	_ "image/gif"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// ErrInvalid is returned for data that is not an acceptable image
+var ErrInvalid = errors.New("invalid image")
+
+const (
+	// MaxSize is the largest accepted image in bytes
+	MaxSize = 10 << 20
+
+	// MaxPixels is the largest accepted image in pixels, it bounds the memory
+	// decoding takes whatever the compressed size
+	MaxPixels = 40_000_000
+
+	// jpegQuality is the quality images are encoded with after rotating them
+	jpegQuality = 90
+
+	// thumbnailQuality is the quality opaque thumbnails are encoded with
+	thumbnailQuality = 80
+)
+
+// mimeTypes maps the accepted formats, as named by image.Decode, to their
+// MIME types
+var mimeTypes = map[string]string{
+	"png":  "image/png",
+	"jpeg": "image/jpeg",
+	"gif":  "image/gif",
+	"webp": "image/webp",
+}
+
+// Size is a thumbnail size, thumbnails fit in a square of Max pixels
+type Size struct {
+	Name string
+	Max  int
+}
+
+// Sizes are the thumbnails made for every image
+var Sizes = []Size{
+	{Name: "small", Max: 160},
+	{Name: "medium", Max: 480},
+}
+
+// Image is a validated image ready to store
+type Image struct {
+	// Data is the image to store, rotated upright if its EXIF orientation
+	// said so and the upload otherwise
+	Data       []byte
+	MimeType   string
+	Width      int
+	Height     int
+	Thumbnails []Thumbnail
+}
+
+// Thumbnail is a scaled down copy of an image
+type Thumbnail struct {
+	Size     string
+	Data     []byte
+	MimeType string
+	Width    int
+	Height   int
+}
+
+// Process validates image data and renders its thumbnails. The format is
+// detected from the data, errors wrap ErrInvalid when the data is rejected.
+func Process(data []byte) (*Image, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: no image data", ErrInvalid)
+	}
+	if len(data) > MaxSize {
+		return nil, fmt.Errorf("%w: larger than %d MB", ErrInvalid, MaxSize>>20)
+	}
+
+	// The header is enough to reject an unknown format or a huge image
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if errors.Is(err, image.ErrFormat) {
+		return nil, fmt.Errorf("%w: not a PNG, JPEG, GIF or WebP image", ErrInvalid)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	mimeType, ok := mimeTypes[format]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported format %s", ErrInvalid, format)
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, fmt.Errorf("%w: empty image", ErrInvalid)
+	}
+	if config.Width*config.Height > MaxPixels {
+		return nil, fmt.Errorf("%w: %dx%d is more than %d megapixels", ErrInvalid, config.Width, config.Height, MaxPixels/1_000_000)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	// Rotated JPEGs are stored upright so nothing downstream needs to honor
+	// the orientation, the re-encoded image drops the EXIF data
+	if format == "jpeg" {
+		if orientation := exifOrientation(data); orientation > 1 {
+			img = orient(img, orientation)
+			var buf bytes.Buffer
+			if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+				return nil, fmt.Errorf("encode rotated image: %w", err)
+			}
+			data = buf.Bytes()
+		}
+	}
+
+	bounds := img.Bounds()
+	processed := &Image{
+		Data:     data,
+		MimeType: mimeType,
+		Width:    bounds.Dx(),
+		Height:   bounds.Dy(),
+	}
+
+	for _, size := range Sizes {
+		thumbnail, err := thumbnail(img, size)
+		if err != nil {
+			return nil, err
+		}
+		processed.Thumbnails = append(processed.Thumbnails, thumbnail)
+	}
+
+	return processed, nil
+}
+
+// thumbnail scales an image down to fit a size, smaller images keep their
+// dimensions. Opaque thumbnails are JPEGs and others PNGs to keep
+// transparency.
+func thumbnail(img image.Image, size Size) (Thumbnail, error) {
+	bounds := img.Bounds()
+	width, height := fit(bounds.Dx(), bounds.Dy(), size.Max)
+
+	scaled := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(scaled, scaled.Bounds(), img, bounds, draw.Src, nil)
+
+	var buf bytes.Buffer
+	mimeType := "image/jpeg"
+	var err error
+	if scaled.Opaque() {
+		err = jpeg.Encode(&buf, scaled, &jpeg.Options{Quality: thumbnailQuality})
+	} else {
+		mimeType = "image/png"
+		err = png.Encode(&buf, scaled)
+	}
+	if err != nil {
+		return Thumbnail{}, fmt.Errorf("encode %s thumbnail: %w", size.Name, err)
+	}
+
+	return Thumbnail{
+		Size:     size.Name,
+		Data:     buf.Bytes(),
+		MimeType: mimeType,
+		Width:    width,
+		Height:   height,
+	}, nil
+}
+
+// fit returns the dimensions of an image scaled down to fit in a square,
+// keeping its aspect ratio and at least a pixel on each side
+func fit(width, height, bound int) (int, int) {
+	if width <= bound && height <= bound {
+		return width, height
+	}
+	if width >= height {
+		return bound, scaledSide(height, bound, width)
+	}
+	return scaledSide(width, bound, height), bound
+}
+
+// scaledSide scales side by num/den, rounded and at least 1
+func scaledSide(side, num, den int) int {
+	return max(1, (side*num+den/2)/den)
+}
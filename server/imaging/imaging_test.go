@@ -0,0 +1,116 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestProcessRejects(t *testing.T) {
+	huge := readFixture(t, "huge-dimensions.png")
+	transparent := readFixture(t, "transparent.png")
+
+	tests := []struct {
+		name string
+		data []byte
+		msg  string
+	}{
+		{"empty", nil, "no image data"},
+		{"text", readFixture(t, "not-an-image.txt"), "not a PNG, JPEG, GIF or WebP image"},
+		{"HTML", []byte("<!DOCTYPE html><script>alert(1)</script>"), "not a PNG, JPEG, GIF or WebP image"},
+		{"too many bytes", append(bytes.Clone(transparent), make([]byte, MaxSize)...), "larger than 10 MB"},
+		{"too many pixels", huge, "10000x10000 is more than 40 megapixels"},
+		{"truncated header", transparent[:20], "invalid image"},
+		{"truncated data", transparent[:len(transparent)-20], "invalid image"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processed, err := Process(tt.data)
+			if !errors.Is(err, ErrInvalid) {
+				t.Fatalf("Process = %v, %v, want ErrInvalid", processed, err)
+			}
+			if !strings.Contains(err.Error(), tt.msg) {
+				t.Errorf("error %q, want it to mention %q", err, tt.msg)
+			}
+		})
+	}
+}
+
+func TestProcessThumbnails(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		mimeType string
+		// Dimensions of the small and medium thumbnails
+		small, medium [2]int
+	}{
+		{"opaque JPEG", readFixture(t, "orientation-1.jpg"), "image/jpeg", [2]int{16, 8}, [2]int{16, 8}},
+		{"transparent PNG", readFixture(t, "transparent.png"), "image/png", [2]int{4, 4}, [2]int{4, 4}},
+		{"wide PNG", encodePNG(t, 1000, 250), "image/jpeg", [2]int{160, 40}, [2]int{480, 120}},
+		{"tall PNG", encodePNG(t, 10, 2000), "image/jpeg", [2]int{1, 160}, [2]int{2, 480}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processed, err := Process(tt.data)
+			if err != nil {
+				t.Fatalf("Process: %v", err)
+			}
+			if len(processed.Thumbnails) != len(Sizes) {
+				t.Fatalf("%d thumbnails, want %d", len(processed.Thumbnails), len(Sizes))
+			}
+
+			for i, want := range [][2]int{tt.small, tt.medium} {
+				thumbnail := processed.Thumbnails[i]
+				if thumbnail.Size != Sizes[i].Name || thumbnail.MimeType != tt.mimeType {
+					t.Errorf("thumbnail %d is a %s %s, want a %s %s", i, thumbnail.Size, thumbnail.MimeType, Sizes[i].Name, tt.mimeType)
+				}
+				config, _, err := image.DecodeConfig(bytes.NewReader(thumbnail.Data))
+				if err != nil {
+					t.Fatalf("decode %s thumbnail: %v", thumbnail.Size, err)
+				}
+				got := [2]int{config.Width, config.Height}
+				if got != want || got != [2]int{thumbnail.Width, thumbnail.Height} {
+					t.Errorf("%s thumbnail %v, recorded as %dx%d, want %v", thumbnail.Size, got, thumbnail.Width, thumbnail.Height, want)
+				}
+			}
+		})
+	}
+}
+
+// encodePNG returns an opaque gray PNG of the given dimensions
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		width, height, bound int
+		want                 [2]int
+	}{
+		{100, 50, 160, [2]int{100, 50}},
+		{160, 160, 160, [2]int{160, 160}},
+		{320, 160, 160, [2]int{160, 80}},
+		{160, 320, 160, [2]int{80, 160}},
+		{1000, 3, 160, [2]int{160, 1}},
+		{333, 1000, 160, [2]int{53, 160}},
+	}
+
+	for _, tt := range tests {
+		width, height := fit(tt.width, tt.height, tt.bound)
+		if got := [2]int{width, height}; got != tt.want {
+			t.Errorf("fit(%d, %d, %d) = %v, want %v", tt.width, tt.height, tt.bound, got, tt.want)
+		}
+	}
+}
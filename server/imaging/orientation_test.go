@@ -0,0 +1,177 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The orientation fixtures are the same 16x8 JPEG, red at the top left,
+// green at the top right, blue at the bottom left and white at the bottom
+// right, with an EXIF orientation. Odd orientations are big endian and even
+// ones little endian.
+
+// readFixture reads a file of testdata
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// corner names the color of a pixel, the fixtures are JPEGs so the colors
+// are only close to the ones drawn
+func corner(img image.Image, x, y int) string {
+	r, g, b, _ := img.At(x, y).RGBA()
+	high := func(v uint32) bool { return v > 0x8000 }
+	switch {
+	case high(r) && high(g) && high(b):
+		return "white"
+	case high(r):
+		return "red"
+	case high(g):
+		return "green"
+	case high(b):
+		return "blue"
+	}
+	return "black"
+}
+
+func TestOrientationFixtures(t *testing.T) {
+	tests := []struct {
+		orientation   int
+		width, height int
+		corners       [4]string // Top left, top right, bottom left, bottom right
+	}{
+		{1, 16, 8, [4]string{"red", "green", "blue", "white"}},
+		{2, 16, 8, [4]string{"green", "red", "white", "blue"}},
+		{3, 16, 8, [4]string{"white", "blue", "green", "red"}},
+		{4, 16, 8, [4]string{"blue", "white", "red", "green"}},
+		{5, 8, 16, [4]string{"red", "blue", "green", "white"}},
+		{6, 8, 16, [4]string{"blue", "red", "white", "green"}},
+		{7, 8, 16, [4]string{"white", "green", "blue", "red"}},
+		{8, 8, 16, [4]string{"green", "white", "red", "blue"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.orientation), func(t *testing.T) {
+			data := readFixture(t, fmt.Sprintf("orientation-%d.jpg", tt.orientation))
+			if got := exifOrientation(data); got != tt.orientation {
+				t.Fatalf("exifOrientation = %d, want %d", got, tt.orientation)
+			}
+
+			processed, err := Process(data)
+			if err != nil {
+				t.Fatalf("Process: %v", err)
+			}
+			if processed.Width != tt.width || processed.Height != tt.height {
+				t.Errorf("processed %dx%d, want %dx%d", processed.Width, processed.Height, tt.width, tt.height)
+			}
+			// Only rotated images are encoded again, without their EXIF data
+			if rotated := !bytes.Equal(processed.Data, data); rotated != (tt.orientation > 1) {
+				t.Errorf("re-encoded = %v, want %v", rotated, tt.orientation > 1)
+			}
+			if got := exifOrientation(processed.Data); got > 1 {
+				t.Errorf("processed image keeps orientation %d", got)
+			}
+
+			img, _, err := image.Decode(bytes.NewReader(processed.Data))
+			if err != nil {
+				t.Fatalf("decode processed image: %v", err)
+			}
+			right, bottom := tt.width-1, tt.height-1
+			got := [4]string{corner(img, 0, 0), corner(img, right, 0), corner(img, 0, bottom), corner(img, right, bottom)}
+			if got != tt.corners {
+				t.Errorf("corners %v, want %v", got, tt.corners)
+			}
+		})
+	}
+}
+
+// exifJPEG returns the start of a JPEG with an APP1 segment of the given
+// payload
+func exifJPEG(payload []byte) []byte {
+	data := []byte{0xff, 0xd8, 0xff, 0xe1, 0, 0}
+	binary.BigEndian.PutUint16(data[4:], uint16(len(payload)+2))
+	return append(append(data, payload...), 0xff, 0xda, 0, 2)
+}
+
+// tiff returns big endian EXIF data with an IFD at offset ifd holding the
+// given number of orientation entries
+func tiff(ifd uint32, entries uint16, orientation uint16) []byte {
+	data := []byte("Exif\x00\x00MM\x00\x2a")
+	data = binary.BigEndian.AppendUint32(data, ifd)
+	data = binary.BigEndian.AppendUint16(data, entries)
+	data = binary.BigEndian.AppendUint16(data, orientationTag)
+	data = binary.BigEndian.AppendUint16(data, 3)
+	data = binary.BigEndian.AppendUint32(data, 1)
+	data = binary.BigEndian.AppendUint16(data, orientation)
+	return append(data, 0, 0)
+}
+
+func TestExifOrientationMalformed(t *testing.T) {
+	valid := exifJPEG(tiff(8, 1, 6))
+	// An IFD claiming more entries than it holds, none of them the orientation
+	overrun := tiff(8, 40, 6)
+	binary.BigEndian.PutUint16(overrun[16:], 0x010f)
+
+	if got := exifOrientation(valid); got != 6 {
+		t.Fatalf("exifOrientation of the valid case = %d, want 6", got)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"not a JPEG", []byte("GIF89a")},
+		{"no segments", []byte{0xff, 0xd8}},
+		{"no marker", []byte{0xff, 0xd8, 0x00, 0xe1, 0, 8}},
+		{"segment length past the end", []byte{0xff, 0xd8, 0xff, 0xe1, 0xff, 0xff, 'E', 'x'}},
+		{"segment length too short", []byte{0xff, 0xd8, 0xff, 0xe1, 0, 1, 0, 0}},
+		{"scan before EXIF", append([]byte{0xff, 0xd8, 0xff, 0xda, 0, 2}, valid[2:]...)},
+		{"not EXIF", exifJPEG([]byte("XMP\x00 data"))},
+		{"truncated header", exifJPEG([]byte("Exif\x00\x00MM\x00"))},
+		{"unknown byte order", exifJPEG(append([]byte("Exif\x00\x00XX"), tiff(8, 1, 6)[8:]...))},
+		{"wrong magic", exifJPEG(append([]byte("Exif\x00\x00MM\x00\x2b"), tiff(8, 1, 6)[10:]...))},
+		{"IFD offset past the end", exifJPEG(tiff(0xfffffff0, 1, 6))},
+		{"IFD offset inside the header", exifJPEG(tiff(4, 1, 6))},
+		{"entries past the end", exifJPEG(overrun)},
+		{"orientation 0", exifJPEG(tiff(8, 1, 0))},
+		{"orientation 9", exifJPEG(tiff(8, 1, 9))},
+		{"truncated segment", valid[:len(valid)-8]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exifOrientation(tt.data); got != 0 {
+				t.Errorf("exifOrientation = %d, want 0", got)
+			}
+		})
+	}
+
+	// Every truncation of valid EXIF data is read without panicking
+	for i := range valid {
+		exifOrientation(valid[:i])
+	}
+}
+
+func TestProcessBadExifOffset(t *testing.T) {
+	// The image is valid, only its EXIF data is not
+	data := readFixture(t, "exif-bad-offset.jpg")
+
+	processed, err := Process(data)
+	if err != nil {
+		t.Fatalf("Process: %v", err)
+	}
+	if !bytes.Equal(processed.Data, data) || processed.Width != 16 || processed.Height != 8 {
+		t.Errorf("processed %dx%d, want the upload kept as it is", processed.Width, processed.Height)
+	}
+}
@@ -0,0 +1,169 @@
+package midi
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+)
+
+// General MIDI percussion used by click tracks
+const (
+	// PercussionChannel is channel 10, zero-based
+	PercussionChannel = 9
+
+	// KeyAccent is the hi wood block, played on downbeats and accents
+	KeyAccent = 76
+
+	// KeyClick is the low wood block, played on the other notes
+	KeyClick = 77
+)
+
+// Click velocities
+const (
+	VelocityAccent = 127
+	VelocityBeat   = 96
+	VelocityOffset = 64 // Notes between beats
+)
+
+// DefaultTimeSignature is used for sections without a time signature
+const DefaultTimeSignature = "4/4"
+
+// Section is a part of a click track, an exercise played at each of its
+// BPMs in turn
+type Section struct {
+	Name          string
+	TimeSignature string  // Defaults to 4/4
+	BPMs          []int32 // Beats per minute, a beat being a note of the time signature
+	BarsPerStep   int     // Bars played at each BPM
+	NotesPerBeat  int     // Clicks per beat, at least 1
+	Accents       string  // A character per note, '>' accented and '.' not, repeated through each bar
+}
+
+// NotesPerBeat returns the number of notes played per beat in a subdivision,
+// one for quarter notes or when unspecified
+func NotesPerBeat(s pb.Subdivision) int {
+	switch s {
+	case pb.Subdivision_SUBDIVISION_EIGHTH:
+		return 2
+	case pb.Subdivision_SUBDIVISION_EIGHTH_TRIPLET:
+		return 3
+	case pb.Subdivision_SUBDIVISION_SIXTEENTH:
+		return 4
+	case pb.Subdivision_SUBDIVISION_SIXTEENTH_TRIPLET:
+		return 6
+	case pb.Subdivision_SUBDIVISION_THIRTY_SECOND:
+		return 8
+	default:
+		return 1
+	}
+}
+
+// ClickTrack renders sections one after the other into a type 1 MIDI file.
+// The first track holds the tempo, time signature and a marker for every
+// step, the second one the clicks on the percussion channel.
+func ClickTrack(name string, sections []Section) (*File, error) {
+	if len(sections) == 0 {
+		return nil, errors.New("no sections to render")
+	}
+
+	conductor, click := &Track{}, &Track{}
+	conductor.Meta(0, MetaTrackName, []byte(name))
+	click.Meta(0, MetaTrackName, []byte("Click"))
+
+	var tick uint32
+	for _, section := range sections {
+		beats, value, err := parseTimeSignature(section.TimeSignature)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", section.Name, err)
+		}
+		if section.BarsPerStep < 1 {
+			return nil, fmt.Errorf("%s: bars per step must be at least 1", section.Name)
+		}
+		notes := max(section.NotesPerBeat, 1)
+		beatTicks := uint32(Division * 4 / value)
+
+		conductor.Meta(tick, MetaTimeSignature, []byte{
+			byte(beats),
+			byte(bits.TrailingZeros(uint(value))),
+			byte(24 * 4 / value), // MIDI clocks per metronome click
+			8,                    // Thirty-second notes per quarter note
+		})
+
+		for _, bpm := range section.BPMs {
+			tempo, err := microsPerQuarter(bpm, value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", section.Name, err)
+			}
+			conductor.Meta(tick, MetaTempo, tempo)
+			conductor.Meta(tick, MetaMarker, []byte(fmt.Sprintf("%s %d BPM", section.Name, bpm)))
+
+			for range section.BarsPerStep {
+				for note := range beats * notes {
+					at := tick + uint32(note/notes)*beatTicks + uint32(note%notes)*beatTicks/uint32(notes)
+					key, velocity := clickNote(section.Accents, note, notes)
+					click.Note(at, PercussionChannel, key, velocity, beatTicks/uint32(notes)/2)
+				}
+				tick += uint32(beats) * beatTicks
+			}
+		}
+	}
+	conductor.End, click.End = tick, tick
+
+	return &File{Tracks: []*Track{conductor, click}}, nil
+}
+
+// clickNote picks the key and velocity of a note of a bar, the downbeat and
+// notes accented by the pattern are accents and the other beats stand out
+// from the notes between them
+func clickNote(accents string, note, notesPerBeat int) (key, velocity byte) {
+	switch {
+	case note == 0:
+		return KeyAccent, VelocityAccent
+	case accents != "" && accents[note%len(accents)] == '>':
+		return KeyAccent, VelocityAccent
+	case note%notesPerBeat == 0:
+		return KeyClick, VelocityBeat
+	default:
+		return KeyClick, VelocityOffset
+	}
+}
+
+// parseTimeSignature splits a time signature such as 6/8 into beats per bar
+// and the note value of a beat, a power of two up to 32
+func parseTimeSignature(s string) (beats, value int, err error) {
+	if s == "" {
+		s = DefaultTimeSignature
+	}
+	b, v, ok := strings.Cut(s, "/")
+	if ok {
+		beats, err = strconv.Atoi(b)
+	}
+	if ok && err == nil {
+		value, err = strconv.Atoi(v)
+	}
+	if !ok || err != nil || beats < 1 || beats > 255 || value < 1 || value > 32 || value&(value-1) != 0 {
+		return 0, 0, fmt.Errorf("invalid time signature %q", s)
+	}
+	return beats, value, nil
+}
+
+// microsPerQuarter encodes the tempo meta event data of a BPM counted in
+// notes of the given value
+func microsPerQuarter(bpm int32, value int) ([]byte, error) {
+	if bpm <= 0 {
+		return nil, fmt.Errorf("invalid BPM %d", bpm)
+	}
+	micros := int64(60_000_000) * int64(value) / (4 * int64(bpm))
+	if micros < 1 || micros > 0xffffff {
+		return nil, fmt.Errorf("BPM %d is out of range", bpm)
+	}
+
+	data := make([]byte, 4)
+	binary.BigEndian.PutUint32(data, uint32(micros))
+	return data[1:], nil
+}
@@ -0,0 +1,117 @@
+package midi
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"github.com/Zach-Johnson/tempus/server/tempo"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestClickTrackGolden(t *testing.T) {
+	ladder := &pb.TempoPlan{
+		StartBpm:      80,
+		EndBpm:        105,
+		Increment:     10,
+		BarsPerStep:   2,
+		TimeSignature: "6/8",
+		Subdivision:   pb.Subdivision_SUBDIVISION_EIGHTH,
+		AccentPattern: ">.....>.....",
+	}
+
+	tests := []struct {
+		golden   string
+		sections []Section
+	}{
+		{
+			golden: "single_tempo.mid",
+			sections: []Section{{
+				Name:        "Single stroke roll",
+				BPMs:        []int32{120},
+				BarsPerStep: 2,
+			}},
+		},
+		{
+			golden: "tempo_ladder.mid",
+			sections: []Section{{
+				Name:          "Paradiddle",
+				TimeSignature: ladder.TimeSignature,
+				BPMs:          tempo.Rungs(ladder),
+				BarsPerStep:   int(ladder.BarsPerStep),
+				NotesPerBeat:  NotesPerBeat(ladder.Subdivision),
+				Accents:       ladder.AccentPattern,
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			file, err := ClickTrack("Tempus click track", tt.sections)
+			if err != nil {
+				t.Fatalf("ClickTrack: %v", err)
+			}
+			got := file.Bytes()
+
+			path := filepath.Join("testdata", tt.golden)
+			if *update {
+				if err := os.WriteFile(path, got, 0o644); err != nil {
+					t.Fatalf("write golden: %v", err)
+				}
+			}
+
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("read golden: %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s differs from the golden file, run with -update if the change is intended", tt.golden)
+			}
+		})
+	}
+}
+
+func TestClickTrackErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		section Section
+	}{
+		{"time signature", Section{TimeSignature: "4/3", BPMs: []int32{100}, BarsPerStep: 1}},
+		{"bars per step", Section{BPMs: []int32{100}}},
+		{"zero BPM", Section{BPMs: []int32{0}, BarsPerStep: 1}},
+	}
+
+	if _, err := ClickTrack("empty", nil); err == nil {
+		t.Error("ClickTrack without sections succeeded")
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ClickTrack("invalid", []Section{tt.section}); err == nil {
+				t.Error("ClickTrack succeeded")
+			}
+		})
+	}
+}
+
+func TestVLQ(t *testing.T) {
+	tests := []struct {
+		n    uint32
+		want []byte
+	}{
+		{0, []byte{0x00}},
+		{0x7f, []byte{0x7f}},
+		{0x80, []byte{0x81, 0x00}},
+		{0x2000, []byte{0xc0, 0x00}},
+		{0x0fffffff, []byte{0xff, 0xff, 0xff, 0x7f}},
+	}
+
+	for _, tt := range tests {
+		if got := vlq(tt.n); !bytes.Equal(got, tt.want) {
+			t.Errorf("vlq(%#x) = % x, want % x", tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Package midi writes Standard MIDI Files, in particular click tracks for
+// practicing exercises at a series of tempos.
+package midi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+)
+
+// Division is the number of ticks per quarter note, divisible by every
+// subdivision of the notes a click track plays
+const Division = 960
+
+// Meta event types
+const (
+	MetaTrackName     byte = 0x03
+	MetaMarker        byte = 0x06
+	MetaEndOfTrack    byte = 0x2f
+	MetaTempo         byte = 0x51
+	MetaTimeSignature byte = 0x58
+)
+
+// event is a MIDI or meta event at an absolute tick. At the same tick meta
+// events come first, then note offs and then note ons so notes never overlap.
+type event struct {
+	tick  uint32
+	order int
+	data  []byte
+}
+
+// Track is a track of a MIDI file, events may be added in any order
+type Track struct {
+	// End is the tick the track ends at, the track always lasts until its
+	// last event
+	End uint32
+
+	events []event
+}
+
+// Meta adds a meta event
+func (t *Track) Meta(tick uint32, kind byte, data []byte) {
+	e := append([]byte{0xff, kind}, vlq(uint32(len(data)))...)
+	t.events = append(t.events, event{tick: tick, order: 0, data: append(e, data...)})
+}
+
+// Note adds a note on and the matching note off after length ticks on a
+// zero-based channel
+func (t *Track) Note(tick uint32, channel, key, velocity byte, length uint32) {
+	t.events = append(t.events,
+		event{tick: tick, order: 2, data: []byte{0x90 | channel&0x0f, key & 0x7f, velocity & 0x7f}},
+		event{tick: tick + length, order: 1, data: []byte{0x80 | channel&0x0f, key & 0x7f, 0}},
+	)
+}
+
+// encode returns the track chunk, ending the track after its last event
+func (t *Track) encode() []byte {
+	events := slices.Clone(t.events)
+	slices.SortStableFunc(events, func(a, b event) int {
+		if a.tick != b.tick {
+			return int(a.tick) - int(b.tick)
+		}
+		return a.order - b.order
+	})
+
+	var body bytes.Buffer
+	var last uint32
+	for _, e := range events {
+		body.Write(vlq(e.tick - last))
+		body.Write(e.data)
+		last = e.tick
+	}
+	body.Write(vlq(max(t.End, last) - last))
+	body.Write([]byte{0xff, MetaEndOfTrack, 0x00})
+
+	return chunk("MTrk", body.Bytes())
+}
+
+// File is a type 1 MIDI file, its tracks are played simultaneously
+type File struct {
+	Tracks []*Track
+}
+
+// Bytes encodes the file in the Standard MIDI File format
+func (f *File) Bytes() []byte {
+	header := make([]byte, 6)
+	binary.BigEndian.PutUint16(header[0:], 1)
+	binary.BigEndian.PutUint16(header[2:], uint16(len(f.Tracks)))
+	binary.BigEndian.PutUint16(header[4:], Division)
+
+	out := chunk("MThd", header)
+	for _, track := range f.Tracks {
+		out = append(out, track.encode()...)
+	}
+	return out
+}
+
+// chunk prefixes data with a chunk type and length
+func chunk(kind string, data []byte) []byte {
+	out := make([]byte, 8, 8+len(data))
+	copy(out, kind)
+	binary.BigEndian.PutUint32(out[4:], uint32(len(data)))
+	return append(out, data...)
+}
+
+// vlq encodes a variable-length quantity, seven bits per byte with the most
+// significant byte first
+func vlq(n uint32) []byte {
+	out := []byte{byte(n & 0x7f)}
+	for n >>= 7; n > 0; n >>= 7 {
+		out = append([]byte{byte(n&0x7f) | 0x80}, out...)
+	}
+	return out
+}
@@ -0,0 +1,167 @@
+package notation
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A drum tab is plain text with optional headers followed by systems of
+// instrument lines, one column per subdivision and measures between bars.
+// Any character but a dash or space is a hit:
+//
+//	Title: Basic rock beat
+//	Time: 4/4
+//
+//	HH|x-x-x-x-x-x-x-x-|x-x-x-x-x-x-x-x-|
+//	SD|----o-------o---|----o-------o---|
+//	BD|o-------o-------|o-------o-o-----|
+//
+// Systems are separated by blank lines and all lines of a system must have
+// the same measures.
+
+// drum is where an instrument of a drum tab sits on the staff
+type drum struct {
+	position int
+	cross    bool
+}
+
+// drums maps drum tab instrument names to staff positions following the
+// common drum set notation key
+var drums = map[string]drum{
+	"C":  {position: 10, cross: true}, // Crash
+	"CC": {position: 10, cross: true},
+	"CR": {position: 10, cross: true},
+	"H":  {position: 9, cross: true}, // Hi-hat
+	"HH": {position: 9, cross: true},
+	"R":  {position: 8, cross: true}, // Ride
+	"RD": {position: 8, cross: true},
+	"RC": {position: 8, cross: true},
+	"T":  {position: 7}, // High tom
+	"T1": {position: 7},
+	"HT": {position: 7},
+	"T2": {position: 6}, // Mid tom
+	"MT": {position: 6},
+	"S":  {position: 5}, // Snare
+	"SD": {position: 5},
+	"SN": {position: 5},
+	"T3": {position: 3}, // Floor tom
+	"FT": {position: 3},
+	"LT": {position: 3},
+	"F":  {position: 3},
+	"B":  {position: 1}, // Bass drum
+	"BD": {position: 1},
+	"K":  {position: 1},
+	"KD": {position: 1},
+	"HF": {position: -1, cross: true}, // Hi-hat foot
+	"P":  {position: -1, cross: true},
+	"PH": {position: -1, cross: true},
+}
+
+// tabLine is an instrument line of a drum tab
+type tabLine struct {
+	number   int
+	drum     drum
+	measures []string
+}
+
+// parseDrumTab reads a drum tab
+func parseDrumTab(data []byte) (*Score, error) {
+	score := &Score{}
+	var system []tabLine
+
+	flush := func() error {
+		measures, err := tabSystem(system)
+		if err != nil {
+			return err
+		}
+		if len(score.Measures) == 0 && len(measures) > 0 {
+			measures[0].TimeSignature = score.TimeSignature
+		}
+		score.Measures = append(score.Measures, measures...)
+		system = nil
+		return nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, MaxSize)
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		name, rest, isTab := strings.Cut(line, "|")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if isTab && name != "" && !strings.ContainsAny(name, ": ") {
+			d, ok := drums[name]
+			if !ok {
+				return nil, fmt.Errorf("%w: unknown instrument %s on line %d", ErrInvalid, name, number)
+			}
+			measures := strings.Split(strings.TrimSuffix(rest, "|"), "|")
+			system = append(system, tabLine{number: number, drum: d, measures: measures})
+			continue
+		}
+
+		// Anything else ends a system
+		if err := flush(); err != nil {
+			return nil, err
+		}
+
+		key, value, isHeader := strings.Cut(line, ":")
+		if !isHeader || len(score.Measures) > 0 {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "title":
+			score.Title = value
+		case "time", "time signature":
+			if _, ok := timeSignature(value); !ok {
+				return nil, fmt.Errorf("%w: invalid time signature %s on line %d", ErrInvalid, value, number)
+			}
+			score.TimeSignature = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return score, nil
+}
+
+// tabSystem reads the measures of the lines of a system
+func tabSystem(lines []tabLine) ([]Measure, error) {
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	first := lines[0]
+	measures := make([]Measure, len(first.measures))
+	for _, line := range lines {
+		if len(line.measures) != len(first.measures) {
+			return nil, fmt.Errorf("%w: line %d has %d measures where line %d has %d",
+				ErrInvalid, line.number, len(line.measures), first.number, len(first.measures))
+		}
+
+		for i, columns := range line.measures {
+			columns = strings.TrimRight(columns, " ")
+			if len(columns) == 0 {
+				return nil, fmt.Errorf("%w: empty measure on line %d", ErrInvalid, line.number)
+			}
+			for j, c := range []byte(columns) {
+				if c == '-' || c == ' ' {
+					continue
+				}
+				measures[i].Notes = append(measures[i].Notes, Note{
+					Offset:   float64(j) / float64(len(columns)),
+					Position: line.drum.position,
+					Cross:    line.drum.cross,
+				})
+			}
+		}
+	}
+
+	return measures, nil
+}
@@ -0,0 +1,246 @@
+package notation
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// xmlScore is the part of a partwise MusicXML score that previews need
+type xmlScore struct {
+	XMLName       xml.Name
+	WorkTitle     string    `xml:"work>work-title"`
+	MovementTitle string    `xml:"movement-title"`
+	Parts         []xmlPart `xml:"part"`
+}
+
+type xmlPart struct {
+	Measures []xmlMeasure `xml:"measure"`
+}
+
+// xmlMeasure keeps the elements of a measure in order, the position of a
+// note depends on the notes, backups and forwards before it
+type xmlMeasure struct {
+	Elements []xmlElement `xml:",any"`
+}
+
+// xmlElement is any element of a measure, only the fields of its kind are set
+type xmlElement struct {
+	XMLName xml.Name
+
+	// attributes
+	Divisions int       `xml:"divisions"`
+	Times     []xmlTime `xml:"time"`
+
+	// note, backup and forward
+	Duration  int       `xml:"duration"`
+	Chord     *struct{} `xml:"chord"`
+	Grace     *struct{} `xml:"grace"`
+	Rest      *struct{} `xml:"rest"`
+	Pitch     *xmlStep  `xml:"pitch"`
+	Unpitched *xmlStep  `xml:"unpitched"`
+	Notehead  string    `xml:"notehead"`
+}
+
+type xmlTime struct {
+	Beats    string `xml:"beats"`
+	BeatType string `xml:"beat-type"`
+}
+
+// xmlStep is a pitch, or the display position of an unpitched note
+type xmlStep struct {
+	Step          string `xml:"step"`
+	Octave        int    `xml:"octave"`
+	DisplayStep   string `xml:"display-step"`
+	DisplayOctave int    `xml:"display-octave"`
+}
+
+// crossNoteheads are the notehead values drawn as an x
+var crossNoteheads = map[string]bool{
+	"x":        true,
+	"cross":    true,
+	"circle-x": true,
+}
+
+// parseMusicXML reads a partwise MusicXML score, compressed (.mxl) or not
+func parseMusicXML(data []byte) (*Score, error) {
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		var err error
+		if data, err = uncompressMusicXML(data); err != nil {
+			return nil, err
+		}
+	}
+
+	var doc xmlScore
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	// Scores declare their encoding, which is UTF-8 in practice
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) { return input, nil }
+	if err := decoder.Decode(&doc); err == io.EOF {
+		return nil, fmt.Errorf("%w: not an XML document", ErrInvalid)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	switch doc.XMLName.Local {
+	case "score-partwise":
+	case "score-timewise":
+		return nil, fmt.Errorf("%w: timewise MusicXML is not supported", ErrInvalid)
+	default:
+		return nil, fmt.Errorf("%w: %s is not a MusicXML score", ErrInvalid, doc.XMLName.Local)
+	}
+	if len(doc.Parts) == 0 {
+		return nil, fmt.Errorf("%w: score has no parts", ErrInvalid)
+	}
+
+	score := &Score{Title: strings.TrimSpace(doc.WorkTitle)}
+	if score.Title == "" {
+		score.Title = strings.TrimSpace(doc.MovementTitle)
+	}
+
+	divisions := 1
+	length := 4.0 // 4/4 until a time signature says otherwise
+	for i, m := range doc.Parts[0].Measures {
+		var measure Measure
+		var cursor, start int
+
+		var notes []Note
+		var starts []int
+		for _, element := range m.Elements {
+			switch element.XMLName.Local {
+			case "attributes":
+				if element.Divisions > 0 {
+					divisions = element.Divisions
+				}
+				if len(element.Times) > 0 {
+					t := element.Times[0]
+					signature := strings.TrimSpace(t.Beats) + "/" + strings.TrimSpace(t.BeatType)
+					quarters, ok := timeSignature(signature)
+					if !ok {
+						return nil, fmt.Errorf("%w: invalid time signature %s in measure %d", ErrInvalid, signature, i+1)
+					}
+					length = quarters
+					measure.TimeSignature = signature
+					if score.TimeSignature == "" && i == 0 {
+						score.TimeSignature = signature
+					}
+				}
+			case "backup":
+				cursor = max(0, cursor-element.Duration)
+			case "forward":
+				cursor += element.Duration
+			case "note":
+				if element.Chord == nil {
+					start = cursor
+				}
+				if element.Grace == nil && element.Chord == nil {
+					cursor += element.Duration
+				}
+				if element.Rest != nil || element.Grace != nil {
+					continue
+				}
+
+				position, ok := element.position()
+				if !ok {
+					continue
+				}
+				notes = append(notes, Note{
+					Position: position,
+					Cross:    crossNoteheads[strings.TrimSpace(element.Notehead)],
+				})
+				starts = append(starts, start)
+			}
+		}
+
+		// Offsets are fractions of the length of the measure in divisions
+		measureDivisions := length * float64(divisions)
+		for j := range notes {
+			notes[j].Offset = min(float64(starts[j])/measureDivisions, 1)
+		}
+		measure.Notes = notes
+		score.Measures = append(score.Measures, measure)
+	}
+
+	return score, nil
+}
+
+// position returns the staff position of a note, from its display position
+// when unpitched and its pitch otherwise, as on a treble or percussion clef
+func (e *xmlElement) position() (int, bool) {
+	switch {
+	case e.Unpitched != nil:
+		return staffPosition(e.Unpitched.DisplayStep, e.Unpitched.DisplayOctave)
+	case e.Pitch != nil:
+		return staffPosition(e.Pitch.Step, e.Pitch.Octave)
+	default:
+		return 0, false
+	}
+}
+
+// staffPosition returns the staff position of a step, E4 is the bottom line
+func staffPosition(step string, octave int) (int, bool) {
+	index := strings.Index("CDEFGAB", strings.ToUpper(strings.TrimSpace(step)))
+	if index < 0 || len(strings.TrimSpace(step)) != 1 {
+		return 0, false
+	}
+	return octave*7 + index - (4*7 + 2), true
+}
+
+// uncompressMusicXML extracts the score of a compressed MusicXML file, named
+// by its container or else the first MusicXML file in the archive
+func uncompressMusicXML(data []byte) ([]byte, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	name := ""
+	if container, err := readZipFile(archive, "META-INF/container.xml"); err == nil {
+		var doc struct {
+			Rootfiles []struct {
+				FullPath string `xml:"full-path,attr"`
+			} `xml:"rootfiles>rootfile"`
+		}
+		if xml.Unmarshal(container, &doc) == nil && len(doc.Rootfiles) > 0 {
+			name = doc.Rootfiles[0].FullPath
+		}
+	}
+	if name == "" {
+		for _, file := range archive.File {
+			ext := path.Ext(file.Name)
+			if !strings.HasPrefix(file.Name, "META-INF/") && (ext == ".xml" || ext == ".musicxml") {
+				name = file.Name
+				break
+			}
+		}
+	}
+	if name == "" {
+		return nil, fmt.Errorf("%w: no score in compressed MusicXML", ErrInvalid)
+	}
+
+	score, err := readZipFile(archive, name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	return score, nil
+}
+
+// readZipFile reads a file from a zip archive, at most MaxSize bytes of it
+func readZipFile(archive *zip.Reader, name string) ([]byte, error) {
+	file, err := archive.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxSize {
+		return nil, fmt.Errorf("%s is larger than %d MB", name, MaxSize>>20)
+	}
+	return data, nil
+}
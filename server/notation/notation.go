@@ -0,0 +1,122 @@
+// Package notation reads the drum notation attached to exercises, MusicXML
+// and a plain text drum tab format, and renders SVG previews of it.
+package notation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+)
+
+// ErrInvalid is returned for data that is not notation in the given format
+var ErrInvalid = errors.New("invalid notation")
+
+// MaxSize is the largest accepted notation file in bytes
+const MaxSize = 1 << 20
+
+// Score is the part of a piece of notation that previews show, the first
+// part of multi-part scores
+type Score struct {
+	Title string
+
+	// TimeSignature is the time signature of the first measure such as 4/4,
+	// empty when the notation does not say
+	TimeSignature string
+
+	Measures []Measure
+}
+
+// Measure is a bar of notation
+type Measure struct {
+	// TimeSignature is set on the measures the time signature changes at
+	TimeSignature string
+
+	Notes []Note
+}
+
+// Note is a notehead. Rests are not kept, previews leave the space empty.
+type Note struct {
+	// Offset is where the note starts within its measure, from 0 to 1
+	Offset float64
+
+	// Position is the staff position, 0 is the bottom line of a five line
+	// staff and each step is a line or space up
+	Position int
+
+	// Cross marks cymbal notes drawn with an x notehead
+	Cross bool
+}
+
+// Parse reads notation in a format, detecting the format when it is
+// unspecified. It returns the format read, errors wrap ErrInvalid when the
+// data is rejected.
+func Parse(format pb.NotationFormat, data []byte) (*Score, pb.NotationFormat, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, format, fmt.Errorf("%w: no notation data", ErrInvalid)
+	}
+	if len(data) > MaxSize {
+		return nil, format, fmt.Errorf("%w: larger than %d MB", ErrInvalid, MaxSize>>20)
+	}
+
+	if format == pb.NotationFormat_NOTATION_FORMAT_UNSPECIFIED {
+		format = Detect(data)
+	}
+
+	var score *Score
+	var err error
+	switch format {
+	case pb.NotationFormat_NOTATION_FORMAT_MUSICXML:
+		score, err = parseMusicXML(data)
+	case pb.NotationFormat_NOTATION_FORMAT_DRUM_TAB:
+		score, err = parseDrumTab(data)
+	default:
+		return nil, format, fmt.Errorf("%w: unknown format %v", ErrInvalid, format)
+	}
+	if err != nil {
+		return nil, format, err
+	}
+
+	if len(score.Measures) == 0 {
+		return nil, format, fmt.Errorf("%w: no measures", ErrInvalid)
+	}
+	return score, format, nil
+}
+
+// Detect guesses the format of notation, XML and zip files are MusicXML and
+// anything else is taken for a drum tab
+func Detect(data []byte) pb.NotationFormat {
+	trimmed := bytes.TrimLeft(data, "\ufeff \t\r\n")
+	if bytes.HasPrefix(trimmed, []byte("<")) || bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		return pb.NotationFormat_NOTATION_FORMAT_MUSICXML
+	}
+	return pb.NotationFormat_NOTATION_FORMAT_DRUM_TAB
+}
+
+// timeSignature parses a time signature such as 4/4 or 3+2/8, returning the
+// length of its measures in quarter notes
+func timeSignature(s string) (float64, bool) {
+	beats, beatType, ok := strings.Cut(s, "/")
+	if !ok {
+		return 0, false
+	}
+
+	count := 0
+	for part := range strings.SplitSeq(beats, "+") {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || n <= 0 {
+			return 0, false
+		}
+		count += n
+	}
+
+	value, err := strconv.Atoi(strings.TrimSpace(beatType))
+	if err != nil || value <= 0 || value&(value-1) != 0 {
+		return 0, false
+	}
+
+	return float64(count) * 4 / float64(value), true
+}
@@ -0,0 +1,251 @@
+package notation
+
+import (
+	"archive/zip"
+	"bytes"
+	"cmp"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden SVG files in testdata")
+
+// readFixture reads a file of testdata
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// zipFiles returns a zip archive of the given files, in order
+func zipFiles(t *testing.T, files ...[2]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, file := range files {
+		f, err := w.Create(file[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(file[1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// container is the META-INF/container.xml of a compressed MusicXML file
+// whose score is at path
+func container(path string) [2]string {
+	return [2]string{"META-INF/container.xml", `<?xml version="1.0" encoding="UTF-8"?>
+<container><rootfiles><rootfile full-path="` + path + `"/></rootfiles></container>`}
+}
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		file   string
+		format pb.NotationFormat
+		golden string
+	}{
+		{"rock.tab", pb.NotationFormat_NOTATION_FORMAT_DRUM_TAB, "rock.svg"},
+		{"rock.musicxml", pb.NotationFormat_NOTATION_FORMAT_MUSICXML, "rock.svg"},
+		{"odd-meters.tab", pb.NotationFormat_NOTATION_FORMAT_DRUM_TAB, "odd-meters.svg"},
+		{"changes.musicxml", pb.NotationFormat_NOTATION_FORMAT_MUSICXML, "changes.svg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			data := readFixture(t, tt.file)
+			if got := Detect(data); got != tt.format {
+				t.Errorf("Detect = %v, want %v", got, tt.format)
+			}
+
+			score, format, err := Parse(pb.NotationFormat_NOTATION_FORMAT_UNSPECIFIED, data)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if format != tt.format {
+				t.Errorf("Parse read %v, want %v", format, tt.format)
+			}
+
+			got := SVG(score)
+			path := filepath.Join("testdata", tt.golden)
+			if *update {
+				if err := os.WriteFile(path, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if want := readFixture(t, tt.golden); !bytes.Equal(got, want) {
+				t.Errorf("SVG differs from %s, run go test -update and review the diff", path)
+			}
+		})
+	}
+}
+
+// sortNotes orders the notes of each measure by offset and position, drum
+// tabs list them by instrument and MusicXML by voice
+func sortNotes(score *Score) *Score {
+	for _, measure := range score.Measures {
+		slices.SortFunc(measure.Notes, func(a, b Note) int {
+			return cmp.Or(cmp.Compare(a.Offset, b.Offset), cmp.Compare(a.Position, b.Position))
+		})
+	}
+	return score
+}
+
+func TestFormatsAgree(t *testing.T) {
+	// The fixtures notate the same groove in each format
+	tab, _, err := Parse(pb.NotationFormat_NOTATION_FORMAT_DRUM_TAB, readFixture(t, "rock.tab"))
+	if err != nil {
+		t.Fatalf("parse drum tab: %v", err)
+	}
+	musicXML := readFixture(t, "rock.musicxml")
+	score, _, err := Parse(pb.NotationFormat_NOTATION_FORMAT_MUSICXML, musicXML)
+	if err != nil {
+		t.Fatalf("parse MusicXML: %v", err)
+	}
+	if !reflect.DeepEqual(sortNotes(tab), sortNotes(score)) {
+		t.Errorf("drum tab and MusicXML differ:\n%+v\n%+v", tab, score)
+	}
+
+	compressed := []struct {
+		name string
+		data []byte
+	}{
+		{"container", zipFiles(t, container("score/rock.xml"), [2]string{"score/rock.xml", string(musicXML)})},
+		{"no container", zipFiles(t, [2]string{"mimetype", "application/vnd.recordare.musicxml"}, [2]string{"rock.musicxml", string(musicXML)})},
+	}
+	for _, tt := range compressed {
+		t.Run(tt.name, func(t *testing.T) {
+			got, format, err := Parse(pb.NotationFormat_NOTATION_FORMAT_UNSPECIFIED, tt.data)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if format != pb.NotationFormat_NOTATION_FORMAT_MUSICXML || !reflect.DeepEqual(sortNotes(got), score) {
+				t.Errorf("compressed score differs from the uncompressed one")
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	const (
+		tab      = pb.NotationFormat_NOTATION_FORMAT_DRUM_TAB
+		musicXML = pb.NotationFormat_NOTATION_FORMAT_MUSICXML
+	)
+	score := func(body string) []byte {
+		return []byte(`<score-partwise><part id="P1">` + body + `</part></score-partwise>`)
+	}
+
+	tests := []struct {
+		name   string
+		format pb.NotationFormat
+		data   []byte
+		msg    string
+	}{
+		{"empty", tab, []byte(" \n\t"), "no notation data"},
+		{"too large", tab, bytes.Repeat([]byte("-"), MaxSize+1), "larger than 1 MB"},
+		{"unknown format", pb.NotationFormat(99), []byte("HH|x-|"), "unknown format 99"},
+
+		{"tab without measures", tab, []byte("Title: Nothing\n"), "no measures"},
+		{"unknown instrument", tab, []byte("Time: 4/4\n\nXY|x---|\n"), "unknown instrument XY on line 3"},
+		{"tab time signature", tab, []byte("Time: 4/3\nHH|x---|\n"), "invalid time signature 4/3 on line 1"},
+		{"tab time signature without beats", tab, []byte("Time: /4\nHH|x---|\n"), "invalid time signature /4 on line 1"},
+		{"uneven system", tab, []byte("HH|x---|x---|\nSD|--o-|\n"), "line 2 has 1 measures where line 1 has 2"},
+		{"empty measure", tab, []byte("HH|x---||\n"), "empty measure on line 1"},
+
+		{"XML syntax", musicXML, []byte("<score-partwise><part>"), "XML syntax error"},
+		{"no XML element", musicXML, []byte("<!-- nothing -->"), "not an XML document"},
+		{"timewise", musicXML, []byte("<score-timewise/>"), "timewise MusicXML is not supported"},
+		{"not a score", musicXML, []byte("<html><script>alert(1)</script></html>"), "html is not a MusicXML score"},
+		{"no parts", musicXML, []byte("<score-partwise/>"), "score has no parts"},
+		{"no measures", musicXML, score(""), "no measures"},
+		{"time signature", musicXML, score(`<measure><attributes><time><beats>3</beats><beat-type>5</beat-type></time></attributes></measure>`), "invalid time signature 3/5 in measure 1"},
+		{"time signature in a later measure", musicXML, score(`<measure/><measure><attributes><time><beats>x</beats><beat-type>4</beat-type></time></attributes></measure>`), "invalid time signature x/4 in measure 2"},
+
+		{"corrupt zip", musicXML, []byte("PK\x03\x04 not a zip"), "zip: not a valid zip file"},
+		{"zip without a score", musicXML, zipFiles(t, [2]string{"notes.txt", "RLRR LRLL"}), "no score in compressed MusicXML"},
+		{"zip with a missing score", musicXML, zipFiles(t, container("score.xml")), "open score.xml: file does not exist"},
+		{"zip with a large score", musicXML, zipFiles(t, [2]string{"score.xml", strings.Repeat(" ", MaxSize+1)}), "score.xml is larger than 1 MB"},
+		{"zip with a text score", musicXML, zipFiles(t, [2]string{"score.xml", "HH|x---|"}), "not an XML document"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, _, err := Parse(tt.format, tt.data)
+			if !errors.Is(err, ErrInvalid) {
+				t.Fatalf("Parse = %v, %v, want ErrInvalid", score, err)
+			}
+			if !strings.Contains(err.Error(), tt.msg) {
+				t.Errorf("error %q, want it to mention %q", err, tt.msg)
+			}
+		})
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want pb.NotationFormat
+	}{
+		{"XML", `<?xml version="1.0"?><score-partwise/>`, pb.NotationFormat_NOTATION_FORMAT_MUSICXML},
+		{"XML after a byte order mark", "\ufeff\n<score-partwise/>", pb.NotationFormat_NOTATION_FORMAT_MUSICXML},
+		{"zip", "PK\x03\x04rest", pb.NotationFormat_NOTATION_FORMAT_MUSICXML},
+		{"drum tab", "HH|x-x-|", pb.NotationFormat_NOTATION_FORMAT_DRUM_TAB},
+		{"drum tab with headers", "Title: <none>\nHH|x-x-|", pb.NotationFormat_NOTATION_FORMAT_DRUM_TAB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Detect([]byte(tt.data)); got != tt.want {
+				t.Errorf("Detect = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeSignature(t *testing.T) {
+	tests := []struct {
+		signature string
+		quarters  float64
+		ok        bool
+	}{
+		{"4/4", 4, true},
+		{"3/4", 3, true},
+		{"7/8", 3.5, true},
+		{"3+2/8", 2.5, true},
+		{" 6 / 8 ", 3, true},
+		{"2/2", 4, true},
+		{"4/3", 0, false},
+		{"4/0", 0, false},
+		{"0/4", 0, false},
+		{"-3/4", 0, false},
+		{"3+/8", 0, false},
+		{"4", 0, false},
+		{"four/4", 0, false},
+	}
+
+	for _, tt := range tests {
+		quarters, ok := timeSignature(tt.signature)
+		if quarters != tt.quarters || ok != tt.ok {
+			t.Errorf("timeSignature(%q) = %v, %v, want %v, %v", tt.signature, quarters, ok, tt.quarters, tt.ok)
+		}
+	}
+}
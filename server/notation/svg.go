@@ -0,0 +1,146 @@
+package notation
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+)
+
+// Layout of SVG previews in pixels
+const (
+	measuresPerRow = 4
+	measureWidth   = 200
+	marginX        = 20
+	titleHeight    = 30
+	rowHeight      = 100
+	staffTop       = 34 // From the top of a row to the top staff line
+	halfSpace      = 4  // Between a line and the next space
+	notePadding    = 14 // Between a bar line and the first beat
+	signatureWidth = 24
+	stemLength     = 28
+)
+
+// SVG renders a score as a percussion staff, measures wrapping onto new rows.
+// The preview shows noteheads and stems but no rests, beams or flags.
+func SVG(score *Score) []byte {
+	rows := (len(score.Measures) + measuresPerRow - 1) / measuresPerRow
+	top := 10
+	if score.Title != "" {
+		top += titleHeight
+	}
+	width := 2*marginX + signatureWidth + measuresPerRow*measureWidth
+	height := top + rows*rowHeight
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n", width, height, width, height)
+	b.WriteString(`<rect width="100%" height="100%" fill="white"/>` + "\n")
+	b.WriteString(`<g stroke="black" fill="black" font-family="serif">` + "\n")
+	if score.Title != "" {
+		fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="18" text-anchor="middle" stroke="none">%s</text>`+"\n",
+			width/2, 10+18, html.EscapeString(score.Title))
+	}
+
+	for row := range rows {
+		y := top + row*rowHeight + staffTop
+		first := row * measuresPerRow
+		last := min(first+measuresPerRow, len(score.Measures))
+		renderRow(&b, score.Measures[first:last], y, last == len(score.Measures))
+	}
+
+	b.WriteString("</g>\n</svg>\n")
+	return b.Bytes()
+}
+
+// renderRow draws a row of measures on a staff whose top line is at y
+func renderRow(b *bytes.Buffer, measures []Measure, y int, final bool) {
+	x := marginX
+	end := x + signatureWidth + len(measures)*measureWidth
+
+	// Percussion clef and staff lines
+	fmt.Fprintf(b, `<rect x="%d" y="%d" width="3" height="%d" stroke="none"/>`, x+4, y+2*halfSpace, 4*halfSpace)
+	fmt.Fprintf(b, `<rect x="%d" y="%d" width="3" height="%d" stroke="none"/>`+"\n", x+10, y+2*halfSpace, 4*halfSpace)
+	for line := range 5 {
+		ly := y + line*2*halfSpace
+		fmt.Fprintf(b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke-width="1"/>`+"\n", x, ly, end, ly)
+	}
+	x += signatureWidth
+
+	for _, measure := range measures {
+		left := x
+		if measure.TimeSignature != "" {
+			renderTimeSignature(b, measure.TimeSignature, left+2, y)
+			left += signatureWidth
+		}
+		renderNotes(b, measure.Notes, left, x+measureWidth, y)
+
+		x += measureWidth
+		fmt.Fprintf(b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke-width="1"/>`+"\n", x, y, x, y+8*halfSpace)
+	}
+	if final {
+		fmt.Fprintf(b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke-width="4"/>`+"\n", x-2, y, x-2, y+8*halfSpace)
+	}
+}
+
+// renderTimeSignature draws a time signature, its numbers stacked on the
+// staff from x
+func renderTimeSignature(b *bytes.Buffer, signature string, x, y int) {
+	beats, beatType, _ := strings.Cut(signature, "/")
+	for i, number := range []string{beats, beatType} {
+		fmt.Fprintf(b, `<text x="%d" y="%d" font-size="17" font-weight="bold" text-anchor="middle" stroke="none">%s</text>`+"\n",
+			x+signatureWidth/2-2, y+14+i*4*halfSpace, html.EscapeString(number))
+	}
+}
+
+// renderNotes draws the notes of a measure between left and right, notes
+// starting together share a stem
+func renderNotes(b *bytes.Buffer, notes []Note, left, right, y int) {
+	bottom := y + 8*halfSpace
+	span := float64(right - left - 2*notePadding)
+
+	sorted := slices.Clone(notes)
+	slices.SortStableFunc(sorted, func(a, b Note) int {
+		return cmp.Or(cmp.Compare(a.Offset, b.Offset), cmp.Compare(a.Position, b.Position))
+	})
+
+	for start := 0; start < len(sorted); {
+		end := start
+		for end < len(sorted) && sorted[end].Offset == sorted[start].Offset {
+			end++
+		}
+		chord := sorted[start:end]
+		nx := left + notePadding + int(chord[0].Offset*span)
+
+		for _, note := range chord {
+			ny := bottom - note.Position*halfSpace
+			renderLedgerLines(b, note.Position, nx, bottom)
+			if note.Cross {
+				fmt.Fprintf(b, `<path d="M%d %dl8 8M%d %dl8 -8" stroke-width="1.5"/>`+"\n", nx-4, ny-4, nx-4, ny+4)
+			} else {
+				fmt.Fprintf(b, `<ellipse cx="%d" cy="%d" rx="5" ry="3.5" transform="rotate(-20 %d %d)" stroke="none"/>`+"\n", nx, ny, nx, ny)
+			}
+		}
+
+		// Stems go up from the lowest note of the chord
+		low := bottom - chord[0].Position*halfSpace
+		high := bottom - chord[len(chord)-1].Position*halfSpace - stemLength
+		fmt.Fprintf(b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke-width="1.2"/>`+"\n", nx+4, low, nx+4, high)
+
+		start = end
+	}
+}
+
+// renderLedgerLines draws the ledger lines a note above or below the staff
+// needs
+func renderLedgerLines(b *bytes.Buffer, position, x, bottom int) {
+	for p := 10; p <= position; p += 2 {
+		ly := bottom - p*halfSpace
+		fmt.Fprintf(b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke-width="1"/>`+"\n", x-8, ly, x+8, ly)
+	}
+	for p := -2; p >= position; p -= 2 {
+		ly := bottom - p*halfSpace
+		fmt.Fprintf(b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke-width="1"/>`+"\n", x-8, ly, x+8, ly)
+	}
+}
@@ -0,0 +1,102 @@
+package notation
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+)
+
+// wellFormed decodes every token of an SVG, failing on markup the text of a
+// score broke
+func wellFormed(t *testing.T, svg []byte) {
+	t.Helper()
+
+	decoder := xml.NewDecoder(bytes.NewReader(svg))
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			return
+		} else if err != nil {
+			t.Fatalf("SVG is not well-formed XML: %v\n%s", err, svg)
+		}
+	}
+}
+
+func TestSVGEscapesText(t *testing.T) {
+	title := `</text><script>alert("x")</script> & <a href='javascript:alert(1)'>`
+	svg := SVG(&Score{
+		Title: title,
+		Measures: []Measure{
+			{TimeSignature: `<img src=x onerror=alert(1)>/4`, Notes: []Note{{Position: 5}}},
+		},
+	})
+	wellFormed(t, svg)
+
+	for _, markup := range []string{"<script", "</text><", "<a ", "<img"} {
+		if bytes.Contains(svg, []byte(markup)) {
+			t.Errorf("SVG contains %q", markup)
+		}
+	}
+
+	// The text reads back as written
+	var doc struct {
+		Texts []string `xml:"g>text"`
+	}
+	if err := xml.Unmarshal(svg, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Texts) != 3 || doc.Texts[0] != title || doc.Texts[1] != "<img src=x onerror=alert(1)>" {
+		t.Errorf("SVG text %q, want the title and time signature as written", doc.Texts)
+	}
+}
+
+func TestSVGEscapesParsedTitles(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		title string
+	}{
+		{"drum tab", "Title: <script>alert(1)</script>\nHH|x---|\n", "<script>alert(1)</script>"},
+		{"MusicXML", `<score-partwise><work><work-title>&lt;script&gt;alert(1)&lt;/script&gt;</work-title></work><part><measure/></part></score-partwise>`, "<script>alert(1)</script>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, _, err := Parse(pb.NotationFormat_NOTATION_FORMAT_UNSPECIFIED, []byte(tt.data))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if score.Title != tt.title {
+				t.Errorf("title %q, want %q", score.Title, tt.title)
+			}
+
+			svg := SVG(score)
+			wellFormed(t, svg)
+			if strings.Contains(string(svg), "<script") {
+				t.Errorf("SVG contains the title unescaped:\n%s", svg)
+			}
+		})
+	}
+}
+
+func TestSVGLayout(t *testing.T) {
+	measures := make([]Measure, 5)
+	svg := string(SVG(&Score{Measures: measures}))
+	wellFormed(t, []byte(svg))
+
+	// Five measures wrap onto a second row, each row has a staff of five
+	// lines and a single final bar line ends the score
+	if !strings.Contains(svg, `height="210"`) {
+		t.Errorf("SVG is not two rows high:\n%s", svg)
+	}
+	if got := strings.Count(svg, `stroke-width="4"`); got != 1 {
+		t.Errorf("%d final bar lines, want 1", got)
+	}
+	if strings.Contains(svg, "<text") {
+		t.Error("SVG without a title or time signature has text")
+	}
+}
@@ -0,0 +1,244 @@
+// Package pack reads and writes exercise packs, the zip archives exercises
+// are shared between instances with. A pack holds its manifest and the data
+// of the images the manifest refers to.
+package pack
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrInvalid is returned for data that is not a readable exercise pack
+var ErrInvalid = errors.New("invalid exercise pack")
+
+const (
+	// Version is the manifest version written and the only one read
+	Version = 1
+
+	// ManifestFile is the path of the manifest in the archive
+	ManifestFile = "manifest.json"
+
+	// MaxSize is the largest accepted pack in bytes, uncompressed
+	MaxSize = 200 << 20
+
+	// maxFiles bounds the files of an accepted pack
+	maxFiles = 5000
+
+	// imageDir holds the image files, named by the SHA-256 of their data
+	imageDir = "images/"
+)
+
+// Pack is an exercise pack
+type Pack struct {
+	Manifest *pb.ExercisePack
+	// Images holds the image data by path in the archive
+	Images map[string][]byte
+}
+
+// New returns an empty pack
+func New(name, description string) *Pack {
+	return &Pack{
+		Manifest: &pb.ExercisePack{
+			Version:     Version,
+			Name:        name,
+			Description: description,
+			ExportedAt:  timestamppb.New(time.Now().UTC()),
+		},
+		Images: make(map[string][]byte),
+	}
+}
+
+// AddImage adds image data to the pack and returns its path, images with the
+// same data share a file
+func (p *Pack) AddImage(data []byte) string {
+	sum := sha256.Sum256(data)
+	path := imageDir + hex.EncodeToString(sum[:])
+	p.Images[path] = data
+	return path
+}
+
+// Bytes writes the pack as a zip archive
+func (p *Pack) Bytes() ([]byte, error) {
+	manifest, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(p.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf("marshal manifest: %w", err)
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	modified := p.Manifest.ExportedAt.AsTime()
+	if err := writeFile(zw, ManifestFile, zip.Deflate, modified, manifest); err != nil {
+		return nil, err
+	}
+
+	// Images are compressed already, they are stored as they are
+	paths := make([]string, 0, len(p.Images))
+	for path := range p.Images {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	for _, path := range paths {
+		if err := writeFile(zw, path, zip.Store, modified, p.Images[path]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("close archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func writeFile(zw *zip.Writer, name string, method uint16, modified time.Time, data []byte) error {
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: method, Modified: modified})
+	if err != nil {
+		return fmt.Errorf("create %s: %w", name, err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
+
+// Read reads and validates a pack from a zip archive
+func Read(data []byte) (*Pack, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	if len(zr.File) > maxFiles {
+		return nil, fmt.Errorf("%w: more than %d files", ErrInvalid, maxFiles)
+	}
+
+	p := &Pack{Images: make(map[string][]byte)}
+	var manifest []byte
+	remaining := int64(MaxSize)
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		isImage := strings.HasPrefix(f.Name, imageDir) && !strings.ContainsAny(f.Name[len(imageDir):], "/\\")
+		if f.Name != ManifestFile && !isImage {
+			return nil, fmt.Errorf("%w: unexpected file %s", ErrInvalid, f.Name)
+		}
+
+		contents, err := readFile(f, remaining)
+		if err != nil {
+			return nil, err
+		}
+		remaining -= int64(len(contents))
+
+		if isImage {
+			p.Images[f.Name] = contents
+		} else {
+			manifest = contents
+		}
+	}
+
+	if manifest == nil {
+		return nil, fmt.Errorf("%w: missing %s", ErrInvalid, ManifestFile)
+	}
+	p.Manifest = &pb.ExercisePack{}
+	if err := protojson.Unmarshal(manifest, p.Manifest); err != nil {
+		return nil, fmt.Errorf("%w: manifest: %v", ErrInvalid, err)
+	}
+	if p.Manifest.Version != Version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalid, p.Manifest.Version)
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	return p, nil
+}
+
+// readFile reads a file of the archive, failing when it is larger than limit
+func readFile(f *zip.File, limit int64) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: open %s: %v", ErrInvalid, f.Name, err)
+	}
+	defer rc.Close()
+
+	contents, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: read %s: %v", ErrInvalid, f.Name, err)
+	}
+	if int64(len(contents)) > limit {
+		return nil, fmt.Errorf("%w: larger than %d MB", ErrInvalid, MaxSize>>20)
+	}
+	return contents, nil
+}
+
+// validate checks that the names of the manifest are set and unique and that
+// its references resolve
+func (p *Pack) validate() error {
+	categories := make(map[string]bool)
+	for _, category := range p.Manifest.Categories {
+		if category.Name == "" {
+			return errors.New("category without a name")
+		}
+		if categories[category.Name] {
+			return fmt.Errorf("duplicate category %q", category.Name)
+		}
+		categories[category.Name] = true
+	}
+
+	tags := make(map[string]bool)
+	for _, tag := range p.Manifest.Tags {
+		if tag.Name == "" {
+			return errors.New("tag without a name")
+		}
+		if tags[tag.Name] {
+			return fmt.Errorf("duplicate tag %q", tag.Name)
+		}
+		tags[tag.Name] = true
+
+		for _, name := range tag.Categories {
+			if !categories[name] {
+				return fmt.Errorf("tag %q refers to unknown category %q", tag.Name, name)
+			}
+		}
+	}
+
+	uids := make(map[string]bool)
+	for _, exercise := range p.Manifest.Exercises {
+		if exercise.Uid == "" || exercise.Name == "" {
+			return errors.New("exercise without a UID or name")
+		}
+		if uids[exercise.Uid] {
+			return fmt.Errorf("duplicate exercise %q", exercise.Uid)
+		}
+		uids[exercise.Uid] = true
+
+		if exercise.UpdatedAt == nil {
+			return fmt.Errorf("exercise %q is missing its update time", exercise.Name)
+		}
+		for _, name := range exercise.Tags {
+			if !tags[name] {
+				return fmt.Errorf("exercise %q refers to unknown tag %q", exercise.Name, name)
+			}
+		}
+		for _, image := range exercise.Images {
+			if _, ok := p.Images[image.Path]; !ok {
+				return fmt.Errorf("exercise %q refers to missing image %s", exercise.Name, image.Path)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package pack
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testPack returns a valid pack with a category, two tags and two exercises,
+// the first with an image
+func testPack() *Pack {
+	p := New("Rudiments", "The essential rudiments")
+	updated := timestamppb.New(time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC))
+	image := p.AddImage([]byte("image data"))
+
+	p.Manifest.Categories = []*pb.PackCategory{{Name: "Hands", Description: "Stick control"}}
+	p.Manifest.Tags = []*pb.PackTag{
+		{Name: "Rudiment", Categories: []string{"Hands"}},
+		{Name: "Warmup"},
+	}
+	p.Manifest.Exercises = []*pb.PackExercise{
+		{
+			Uid:       "UID1",
+			Name:      "Paradiddle",
+			UpdatedAt: updated,
+			Tags:      []string{"Rudiment", "Warmup"},
+			Images:    []*pb.PackImage{{Path: image, Filename: "sticking.png", MimeType: "image/png"}},
+			Links:     []*pb.PackLink{{Url: "https://example.com/paradiddle"}},
+			TempoPlan: &pb.TempoPlan{StartBpm: 80, EndBpm: 120, Increment: 5},
+		},
+		{Uid: "UID2", Name: "Flam", UpdatedAt: updated},
+	}
+	return p
+}
+
+// archive returns a zip archive of the given files, in order
+func archive(t *testing.T, files ...[2]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, file := range files {
+		w, err := zw.Create(file[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(file[1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestRoundTrip(t *testing.T) {
+	p := testPack()
+	data, err := p.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes: %v", err)
+	}
+
+	read, err := Read(data)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if !proto.Equal(read.Manifest, p.Manifest) {
+		t.Errorf("manifest differs after a round trip:\n%v\n%v", read.Manifest, p.Manifest)
+	}
+	if len(read.Images) != 1 {
+		t.Fatalf("read %d images, want 1", len(read.Images))
+	}
+	path := p.Manifest.Exercises[0].Images[0].Path
+	if !bytes.Equal(read.Images[path], p.Images[path]) {
+		t.Errorf("image %s differs after a round trip", path)
+	}
+
+	// Writing is deterministic
+	again, err := read.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes: %v", err)
+	}
+	if !bytes.Equal(again, data) {
+		t.Error("writing a pack read back gives a different archive")
+	}
+}
+
+func TestAddImage(t *testing.T) {
+	p := New("Pack", "")
+	first := p.AddImage([]byte("image data"))
+	if second := p.AddImage([]byte("image data")); second != first {
+		t.Errorf("same data stored at %s and %s", first, second)
+	}
+	if other := p.AddImage([]byte("other data")); other == first {
+		t.Errorf("different data stored at the same path %s", other)
+	}
+	if len(p.Images) != 2 || !strings.HasPrefix(first, imageDir) {
+		t.Errorf("images %v, want 2 under %s", p.Images, imageDir)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(p *Pack)
+		msg    string
+	}{
+		{"valid", func(p *Pack) {}, ""},
+		{"empty", func(p *Pack) { p.Manifest.Categories, p.Manifest.Tags, p.Manifest.Exercises = nil, nil, nil }, ""},
+
+		{"category without a name", func(p *Pack) { p.Manifest.Categories[0].Name = "" }, "category without a name"},
+		{"duplicate category", func(p *Pack) {
+			p.Manifest.Categories = append(p.Manifest.Categories, &pb.PackCategory{Name: "Hands"})
+		}, `duplicate category "Hands"`},
+
+		{"tag without a name", func(p *Pack) { p.Manifest.Tags[1].Name = "" }, "tag without a name"},
+		{"duplicate tag", func(p *Pack) { p.Manifest.Tags[1].Name = "Rudiment" }, `duplicate tag "Rudiment"`},
+		{"tag with an unknown category", func(p *Pack) {
+			p.Manifest.Tags[1].Categories = []string{"Feet"}
+		}, `tag "Warmup" refers to unknown category "Feet"`},
+		{"category listed after its tag", func(p *Pack) {
+			p.Manifest.Categories = nil
+		}, `tag "Rudiment" refers to unknown category "Hands"`},
+
+		{"exercise without a UID", func(p *Pack) { p.Manifest.Exercises[1].Uid = "" }, "exercise without a UID or name"},
+		{"exercise without a name", func(p *Pack) { p.Manifest.Exercises[1].Name = "" }, "exercise without a UID or name"},
+		{"duplicate exercise", func(p *Pack) { p.Manifest.Exercises[1].Uid = "UID1" }, `duplicate exercise "UID1"`},
+		{"exercise without an update time", func(p *Pack) {
+			p.Manifest.Exercises[1].UpdatedAt = nil
+		}, `exercise "Flam" is missing its update time`},
+		{"exercise with an unknown tag", func(p *Pack) {
+			p.Manifest.Exercises[1].Tags = []string{"Hands"}
+		}, `exercise "Flam" refers to unknown tag "Hands"`},
+		{"exercise with a missing image", func(p *Pack) {
+			p.Images = map[string][]byte{}
+		}, `exercise "Paradiddle" refers to missing image images/`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := testPack()
+			tt.modify(p)
+
+			err := p.validate()
+			if tt.msg == "" {
+				if err != nil {
+					t.Fatalf("validate: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.msg) {
+				t.Fatalf("validate = %v, want an error mentioning %q", err, tt.msg)
+			}
+
+			// Read rejects the archive of the pack
+			data, err := p.Bytes()
+			if err != nil {
+				t.Fatalf("Bytes: %v", err)
+			}
+			if _, err := Read(data); !errors.Is(err, ErrInvalid) || !strings.Contains(err.Error(), tt.msg) {
+				t.Errorf("Read = %v, want ErrInvalid mentioning %q", err, tt.msg)
+			}
+		})
+	}
+}
+
+func TestReadRejects(t *testing.T) {
+	manifest := `{"version": 1, "name": "Pack"}`
+	many := make([][2]string, maxFiles+1)
+	for i := range many {
+		many[i] = [2]string{fmt.Sprintf("%s%d", imageDir, i), ""}
+	}
+
+	// The large file is written in chunks rather than held in memory
+	var large bytes.Buffer
+	zw := zip.NewWriter(&large)
+	w, err := zw.Create(imageDir + "large")
+	if err != nil {
+		t.Fatal(err)
+	}
+	chunk := make([]byte, 1<<20)
+	for range MaxSize>>20 + 1 {
+		if _, err := w.Write(chunk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		msg  string
+	}{
+		{"not a zip", []byte("manifest"), "zip: not a valid zip file"},
+		{"no manifest", archive(t, [2]string{imageDir + "abc", "image data"}), "missing manifest.json"},
+		{"unexpected file", archive(t, [2]string{ManifestFile, manifest}, [2]string{"notes.txt", ""}), "unexpected file notes.txt"},
+		{"image in a subdirectory", archive(t, [2]string{ManifestFile, manifest}, [2]string{imageDir + "a/b", ""}), "unexpected file images/a/b"},
+		{"image path with a backslash", archive(t, [2]string{ManifestFile, manifest}, [2]string{imageDir + `..\b`, ""}), `unexpected file images/..\b`},
+		{"manifest not JSON", archive(t, [2]string{ManifestFile, "name: Pack"}), "manifest:"},
+		{"unknown manifest field", archive(t, [2]string{ManifestFile, `{"version": 1, "owner": "alice"}`}), "manifest:"},
+		{"no version", archive(t, [2]string{ManifestFile, `{"name": "Pack"}`}), "unsupported version 0"},
+		{"newer version", archive(t, [2]string{ManifestFile, `{"version": 2}`}), "unsupported version 2"},
+		{"too many files", archive(t, many...), "more than 5000 files"},
+		{"too large", large.Bytes(), "larger than 200 MB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Read(tt.data)
+			if !errors.Is(err, ErrInvalid) {
+				t.Fatalf("Read = %v, %v, want ErrInvalid", p, err)
+			}
+			if !strings.Contains(err.Error(), tt.msg) {
+				t.Errorf("error %q, want it to mention %q", err, tt.msg)
+			}
+		})
+	}
+}
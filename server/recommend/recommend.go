@@ -0,0 +1,213 @@
+// Package recommend ranks exercises into a practice plan. Scoring only
+// depends on its inputs and the given clock, so plans are deterministic.
+package recommend
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// RecentEntries is the number of latest history entries scoring looks at
+	RecentEntries = 10
+
+	// recencyHorizon is the time since last practice that scores full recency
+	recencyHorizon = 14 * 24 * time.Hour
+
+	// ratingWindow is the number of latest rated entries averaged
+	ratingWindow = 3
+
+	// stallWindow is the number of latest entries that must beat the earlier
+	// best BPM for the exercise to count as progressing
+	stallWindow = 3
+
+	// Bounds of the time suggested for a single exercise
+	defaultMinutes = 10
+	minMinutes     = 5
+	maxMinutes     = 30
+)
+
+// Weights balance the score components
+type Weights struct {
+	Recency         float64
+	LowRating       float64
+	StalledProgress float64
+	CategoryBalance float64
+}
+
+// DefaultWeights favor exercises that have not been practiced in a while
+var DefaultWeights = Weights{
+	Recency:         0.4,
+	LowRating:       0.2,
+	StalledProgress: 0.2,
+	CategoryBalance: 0.2,
+}
+
+// CategoryTarget is the weekly target of a category and the time spent on it
+// so far this week
+type CategoryTarget struct {
+	Name          string
+	TargetMinutes int32
+	ActualMinutes int32
+}
+
+// Plan ranks the exercises by score and fills the available minutes in rank
+// order. Ties are broken by the oldest last practice, then by exercise ID.
+func Plan(activity []*storage.ExerciseActivity, targets map[int32]CategoryTarget, availableMinutes int32, weights Weights, now time.Time) *pb.PracticePlan {
+	items := make([]*pb.PlanItem, 0, len(activity))
+	for _, a := range activity {
+		breakdown, reasons := Score(a, targets, now)
+		items = append(items, &pb.PlanItem{
+			ExerciseId:       a.Exercise.Id,
+			ExerciseName:     a.Exercise.Name,
+			SuggestedMinutes: suggestedMinutes(a.Entries),
+			Score:            total(breakdown, weights),
+			Breakdown:        breakdown,
+			Reasons:          reasons,
+			LastPractice:     a.Exercise.LastPractice,
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if !proto.Equal(a.LastPractice, b.LastPractice) {
+			// Never practiced comes first
+			if a.LastPractice == nil || b.LastPractice == nil {
+				return a.LastPractice == nil
+			}
+			return a.LastPractice.AsTime().Before(b.LastPractice.AsTime())
+		}
+		return a.ExerciseId < b.ExerciseId
+	})
+
+	plan := &pb.PracticePlan{}
+	remaining := availableMinutes
+	for _, item := range items {
+		if remaining < minMinutes {
+			break
+		}
+		item.SuggestedMinutes = min(item.SuggestedMinutes, remaining)
+		remaining -= item.SuggestedMinutes
+		plan.TotalMinutes += item.SuggestedMinutes
+		plan.Items = append(plan.Items, item)
+	}
+
+	return plan
+}
+
+// Score computes the score components of an exercise along with the reasons
+// behind the non-zero ones
+func Score(a *storage.ExerciseActivity, targets map[int32]CategoryTarget, now time.Time) (*pb.ScoreBreakdown, []string) {
+	breakdown := &pb.ScoreBreakdown{}
+	var reasons []string
+
+	// Recency
+	if len(a.Entries) == 0 {
+		breakdown.Recency = 1
+		reasons = append(reasons, "never practiced")
+	} else {
+		since := now.Sub(a.Entries[0].StartTime)
+		breakdown.Recency = clamp(float64(since) / float64(recencyHorizon))
+		if days := int(since.Hours() / 24); days > 0 {
+			reasons = append(reasons, fmt.Sprintf("not practiced in %d days", days))
+		}
+	}
+
+	// Low recent ratings, unrated entries are skipped
+	var ratingSum, rated int32
+	for _, entry := range a.Entries {
+		if entry.Rating <= 0 {
+			continue
+		}
+		ratingSum += entry.Rating
+		if rated++; rated == ratingWindow {
+			break
+		}
+	}
+	if rated > 0 {
+		avg := float64(ratingSum) / float64(rated)
+		breakdown.LowRating = clamp((5 - avg) / 4)
+		if avg < 3 {
+			reasons = append(reasons, fmt.Sprintf("recent rating averages %.1f", avg))
+		}
+	}
+
+	// Stalled progress, the latest entries did not beat the earlier best
+	var bpms []int32
+	for _, entry := range a.Entries {
+		if entry.MaxBPM > 0 {
+			bpms = append(bpms, entry.MaxBPM)
+		}
+	}
+	if len(bpms) > stallWindow {
+		recent, earlier := slices.Max(bpms[:stallWindow]), slices.Max(bpms[stallWindow:])
+		if recent <= earlier {
+			breakdown.StalledProgress = 1
+			reasons = append(reasons, fmt.Sprintf("no BPM progress past %d in the last %d sessions", earlier, stallWindow))
+		}
+	}
+
+	// Category balance, the largest shortfall of the exercise categories
+	var behind string
+	for _, categoryID := range a.Exercise.CategoryIds {
+		target, ok := targets[categoryID]
+		if !ok || target.TargetMinutes <= 0 {
+			continue
+		}
+		deficit := clamp(float64(target.TargetMinutes-target.ActualMinutes) / float64(target.TargetMinutes))
+		if deficit > breakdown.CategoryBalance {
+			breakdown.CategoryBalance = deficit
+			behind = fmt.Sprintf("%s is %d minutes short of its weekly target", target.Name, target.TargetMinutes-target.ActualMinutes)
+		}
+	}
+	if behind != "" {
+		reasons = append(reasons, behind)
+	}
+
+	return breakdown, reasons
+}
+
+// total combines the score components into a score out of 100
+func total(b *pb.ScoreBreakdown, w Weights) float64 {
+	sum := w.Recency + w.LowRating + w.StalledProgress + w.CategoryBalance
+	if sum <= 0 {
+		return 0
+	}
+	score := b.Recency*w.Recency +
+		b.LowRating*w.LowRating +
+		b.StalledProgress*w.StalledProgress +
+		b.CategoryBalance*w.CategoryBalance
+	return math.Round(score/sum*10000) / 100
+}
+
+// suggestedMinutes is the average time recently spent on an exercise, within
+// the per exercise bounds
+func suggestedMinutes(entries []storage.ActivityEntry) int32 {
+	var seconds, count int32
+	for _, entry := range entries {
+		if entry.DurationSeconds > 0 {
+			seconds += entry.DurationSeconds
+			count++
+		}
+	}
+	if count == 0 {
+		return defaultMinutes
+	}
+	minutes := int32(math.Round(float64(seconds) / float64(count) / 60))
+	return max(min(minutes, maxMinutes), minMinutes)
+}
+
+// clamp limits v to between 0 and 1
+func clamp(v float64) float64 {
+	return max(min(v, 1), 0)
+}
@@ -0,0 +1,250 @@
+package recommend
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+	storage "github.com/Zach-Johnson/tempus/server/db"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// now is the fixed clock of the tests
+var now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func daysAgo(days float64) time.Time {
+	return now.Add(-time.Duration(days * 24 * float64(time.Hour)))
+}
+
+// activity builds the activity of an exercise from its entries, newest first
+func activity(id int32, categories []int32, entries ...storage.ActivityEntry) *storage.ExerciseActivity {
+	exercise := &pb.Exercise{Id: id, Name: "Exercise", CategoryIds: categories}
+	if len(entries) > 0 {
+		exercise.LastPractice = timestamppb.New(entries[0].StartTime)
+	}
+	return &storage.ExerciseActivity{Exercise: exercise, Entries: entries}
+}
+
+func TestScore(t *testing.T) {
+	targets := map[int32]CategoryTarget{
+		1: {Name: "Hands", TargetMinutes: 60, ActualMinutes: 15},
+		2: {Name: "Feet", TargetMinutes: 30, ActualMinutes: 30},
+		3: {Name: "Reading", TargetMinutes: 20, ActualMinutes: 45},
+	}
+
+	tests := []struct {
+		name     string
+		activity *storage.ExerciseActivity
+		want     *pb.ScoreBreakdown
+		reasons  []string
+	}{
+		{
+			name:     "never practiced",
+			activity: activity(1, nil),
+			want:     &pb.ScoreBreakdown{Recency: 1},
+			reasons:  []string{"never practiced"},
+		},
+		{
+			name:     "fresh",
+			activity: activity(1, nil, storage.ActivityEntry{StartTime: now.Add(-time.Hour)}),
+			want:     &pb.ScoreBreakdown{Recency: float64(time.Hour) / float64(recencyHorizon)},
+		},
+		{
+			name:     "halfway to the horizon",
+			activity: activity(1, nil, storage.ActivityEntry{StartTime: daysAgo(7)}),
+			want:     &pb.ScoreBreakdown{Recency: 0.5},
+			reasons:  []string{"not practiced in 7 days"},
+		},
+		{
+			name:     "overdue",
+			activity: activity(1, nil, storage.ActivityEntry{StartTime: daysAgo(20)}),
+			want:     &pb.ScoreBreakdown{Recency: 1},
+			reasons:  []string{"not practiced in 20 days"},
+		},
+		{
+			name: "low ratings skip unrated entries",
+			activity: activity(1, nil,
+				storage.ActivityEntry{StartTime: now, Rating: 2},
+				storage.ActivityEntry{StartTime: now},
+				storage.ActivityEntry{StartTime: now, Rating: 1},
+				storage.ActivityEntry{StartTime: now, Rating: 3},
+				storage.ActivityEntry{StartTime: now, Rating: 5},
+			),
+			want:    &pb.ScoreBreakdown{LowRating: 0.75},
+			reasons: []string{"recent rating averages 2.0"},
+		},
+		{
+			name: "good ratings",
+			activity: activity(1, nil,
+				storage.ActivityEntry{StartTime: now, Rating: 5},
+				storage.ActivityEntry{StartTime: now, Rating: 4},
+			),
+			want: &pb.ScoreBreakdown{LowRating: 0.125},
+		},
+		{
+			name: "stalled progress",
+			activity: activity(1, nil,
+				storage.ActivityEntry{StartTime: now, MaxBPM: 100},
+				storage.ActivityEntry{StartTime: now, MaxBPM: 105},
+				storage.ActivityEntry{StartTime: now, MaxBPM: 100},
+				storage.ActivityEntry{StartTime: now, MaxBPM: 110},
+			),
+			want:    &pb.ScoreBreakdown{StalledProgress: 1},
+			reasons: []string{"no BPM progress past 110 in the last 3 sessions"},
+		},
+		{
+			name: "progressing",
+			activity: activity(1, nil,
+				storage.ActivityEntry{StartTime: now, MaxBPM: 120},
+				storage.ActivityEntry{StartTime: now, MaxBPM: 105},
+				storage.ActivityEntry{StartTime: now, MaxBPM: 100},
+				storage.ActivityEntry{StartTime: now, MaxBPM: 110},
+			),
+			want: &pb.ScoreBreakdown{},
+		},
+		{
+			name: "too few entries to stall",
+			activity: activity(1, nil,
+				storage.ActivityEntry{StartTime: now, MaxBPM: 100},
+				storage.ActivityEntry{StartTime: now, MaxBPM: 100},
+				storage.ActivityEntry{StartTime: now, MaxBPM: 100},
+			),
+			want: &pb.ScoreBreakdown{},
+		},
+		{
+			name:     "category behind its target",
+			activity: activity(1, []int32{2, 1, 3}, storage.ActivityEntry{StartTime: now}),
+			want:     &pb.ScoreBreakdown{CategoryBalance: 0.75},
+			reasons:  []string{"Hands is 45 minutes short of its weekly target"},
+		},
+		{
+			name:     "categories on or past their targets",
+			activity: activity(1, []int32{2, 3, 4}, storage.ActivityEntry{StartTime: now}),
+			want:     &pb.ScoreBreakdown{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reasons := Score(tt.activity, targets, now)
+			if got.Recency != tt.want.Recency || got.LowRating != tt.want.LowRating ||
+				got.StalledProgress != tt.want.StalledProgress || got.CategoryBalance != tt.want.CategoryBalance {
+				t.Errorf("Score = %v, want %v", got, tt.want)
+			}
+			if !slices.Equal(reasons, tt.reasons) {
+				t.Errorf("reasons = %q, want %q", reasons, tt.reasons)
+			}
+		})
+	}
+}
+
+// planIDs returns the exercise IDs of a plan in order
+func planIDs(plan *pb.PracticePlan) []int32 {
+	ids := make([]int32, 0, len(plan.Items))
+	for _, item := range plan.Items {
+		ids = append(ids, item.ExerciseId)
+	}
+	return ids
+}
+
+func TestPlanWeights(t *testing.T) {
+	exercises := []*storage.ExerciseActivity{
+		activity(1, nil),
+		activity(2, nil, storage.ActivityEntry{StartTime: daysAgo(1), Rating: 1}),
+	}
+
+	tests := []struct {
+		name    string
+		weights Weights
+		ids     []int32
+		scores  []float64
+	}{
+		{"default", DefaultWeights, []int32{1, 2}, []float64{40, 22.86}},
+		{"ratings only", Weights{LowRating: 1}, []int32{2, 1}, []float64{100, 0}},
+		{"recency only", Weights{Recency: 2}, []int32{1, 2}, []float64{100, 7.14}},
+		{"no weights", Weights{}, []int32{1, 2}, []float64{0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := Plan(exercises, nil, 60, tt.weights, now)
+			if ids := planIDs(plan); !slices.Equal(ids, tt.ids) {
+				t.Fatalf("plan order = %v, want %v", ids, tt.ids)
+			}
+			for i, item := range plan.Items {
+				if item.Score != tt.scores[i] {
+					t.Errorf("score of exercise %d = %v, want %v", item.ExerciseId, item.Score, tt.scores[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPlanTies(t *testing.T) {
+	// Equal scores, ordered by the oldest last practice and then by ID
+	older := activity(4, nil)
+	older.Exercise.LastPractice = timestamppb.New(daysAgo(3))
+	newer := activity(2, nil)
+	newer.Exercise.LastPractice = timestamppb.New(daysAgo(1))
+
+	exercises := []*storage.ExerciseActivity{newer, older, activity(5, nil), activity(3, nil)}
+	plan := Plan(exercises, nil, 120, DefaultWeights, now)
+
+	want := []int32{3, 5, 4, 2}
+	if ids := planIDs(plan); !slices.Equal(ids, want) {
+		t.Errorf("plan order = %v, want %v", ids, want)
+	}
+}
+
+func TestPlanAvailableMinutes(t *testing.T) {
+	minutes := func(m int32) storage.ActivityEntry {
+		return storage.ActivityEntry{StartTime: now, DurationSeconds: m * 60}
+	}
+	exercises := []*storage.ExerciseActivity{
+		activity(1, nil),
+		activity(2, nil),
+		activity(3, nil),
+	}
+
+	tests := []struct {
+		name      string
+		exercises []*storage.ExerciseActivity
+		available int32
+		minutes   []int32
+	}{
+		{"fits", exercises, 40, []int32{10, 10, 10}},
+		{"last one shortened", exercises, 25, []int32{10, 10, 5}},
+		{"too little left for another", exercises, 24, []int32{10, 10}},
+		{"too little for any", exercises, 4, nil},
+		{
+			name: "recent durations within bounds",
+			exercises: []*storage.ExerciseActivity{
+				activity(1, nil, minutes(50), minutes(40)),
+				activity(2, nil, minutes(1)),
+				activity(3, nil, minutes(12), storage.ActivityEntry{StartTime: now}, minutes(16)),
+			},
+			available: 120,
+			minutes:   []int32{30, 5, 14},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := Plan(tt.exercises, nil, tt.available, Weights{}, now)
+
+			var got []int32
+			var total int32
+			for _, item := range plan.Items {
+				got = append(got, item.SuggestedMinutes)
+				total += item.SuggestedMinutes
+			}
+			if !slices.Equal(got, tt.minutes) {
+				t.Errorf("suggested minutes = %v, want %v", got, tt.minutes)
+			}
+			if plan.TotalMinutes != total {
+				t.Errorf("total minutes = %d, want %d", plan.TotalMinutes, total)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Package tempo works out the rungs of exercise tempo plans and which one to
+// practice next.
+package tempo
+
+import (
+	"slices"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+)
+
+const (
+	// AdvanceRating is the lowest rating that moves up a rung
+	AdvanceRating = 4
+
+	// BackOffRating is the highest rating that moves down a rung
+	BackOffRating = 2
+)
+
+// Planned reports whether an exercise has a tempo plan
+func Planned(plan *pb.TempoPlan) bool {
+	return plan.GetStartBpm() > 0
+}
+
+// Rungs lists the tempos of a plan from the start BPM up to the end BPM, the
+// last rung is the end BPM even when the increment overshoots it
+func Rungs(plan *pb.TempoPlan) []int32 {
+	if !Planned(plan) {
+		return nil
+	}
+
+	rungs := []int32{plan.StartBpm}
+	if plan.Increment <= 0 {
+		return rungs
+	}
+	for bpm := plan.StartBpm + plan.Increment; bpm < plan.EndBpm; bpm += plan.Increment {
+		rungs = append(rungs, bpm)
+	}
+	if plan.EndBpm > plan.StartBpm {
+		rungs = append(rungs, plan.EndBpm)
+	}
+	return rungs
+}
+
+// Next suggests the tempo to practice after playing bpms with a rating. The
+// rung reached is the highest one at or below the fastest BPM played, a good
+// rating advances a rung, a poor one backs off a rung and anything else,
+// including no rating, repeats it. Without earlier practice the plan starts
+// at its first rung. Zero means the exercise has no tempo plan.
+func Next(plan *pb.TempoPlan, bpms []int32, rating int32) int32 {
+	rungs := Rungs(plan)
+	if len(rungs) == 0 {
+		return 0
+	}
+
+	played := int32(0)
+	if len(bpms) > 0 {
+		played = slices.Max(bpms)
+	}
+	if played < rungs[0] {
+		return rungs[0]
+	}
+
+	// Highest rung not faster than what was played
+	current := 0
+	for i, rung := range rungs {
+		if rung <= played {
+			current = i
+		}
+	}
+
+	switch {
+	case rating >= AdvanceRating:
+		current = min(current+1, len(rungs)-1)
+	case rating > 0 && rating <= BackOffRating:
+		current = max(current-1, 0)
+	}
+	return rungs[current]
+}
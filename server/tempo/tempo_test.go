@@ -0,0 +1,78 @@
+package tempo
+
+import (
+	"slices"
+	"testing"
+
+	pb "github.com/Zach-Johnson/tempus/proto/api/v1/tempus"
+)
+
+func TestRungs(t *testing.T) {
+	tests := []struct {
+		name string
+		plan *pb.TempoPlan
+		want []int32
+	}{
+		{"no plan", nil, nil},
+		{"no start", &pb.TempoPlan{EndBpm: 120, Increment: 5}, nil},
+		{"even steps", &pb.TempoPlan{StartBpm: 100, EndBpm: 120, Increment: 10}, []int32{100, 110, 120}},
+		{"last step overshoots", &pb.TempoPlan{StartBpm: 100, EndBpm: 125, Increment: 10}, []int32{100, 110, 120, 125}},
+		{"step past the end", &pb.TempoPlan{StartBpm: 100, EndBpm: 105, Increment: 10}, []int32{100, 105}},
+		{"no increment", &pb.TempoPlan{StartBpm: 100, EndBpm: 120}, []int32{100}},
+		{"negative increment", &pb.TempoPlan{StartBpm: 100, EndBpm: 120, Increment: -5}, []int32{100}},
+		{"end at the start", &pb.TempoPlan{StartBpm: 100, EndBpm: 100, Increment: 5}, []int32{100}},
+		{"end below the start", &pb.TempoPlan{StartBpm: 100, EndBpm: 90, Increment: 5}, []int32{100}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Rungs(tt.plan); !slices.Equal(got, tt.want) {
+				t.Errorf("Rungs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	// Rungs 100, 110, 120, 125
+	plan := &pb.TempoPlan{StartBpm: 100, EndBpm: 125, Increment: 10}
+
+	tests := []struct {
+		name   string
+		plan   *pb.TempoPlan
+		bpms   []int32
+		rating int32
+		want   int32
+	}{
+		{"no plan", nil, []int32{110}, 5, 0},
+		{"no history", plan, nil, 0, 100},
+		{"no history with a rating", plan, nil, 5, 100},
+		{"below the first rung", plan, []int32{90}, 5, 100},
+
+		{"unrated repeats", plan, []int32{110}, 0, 110},
+		{"rating 1 backs off", plan, []int32{110}, 1, 100},
+		{"back off rating backs off", plan, []int32{110}, BackOffRating, 100},
+		{"rating 3 repeats", plan, []int32{110}, 3, 110},
+		{"advance rating advances", plan, []int32{110}, AdvanceRating, 120},
+		{"rating 5 advances", plan, []int32{110}, 5, 120},
+
+		// The rung reached is the highest at or below the fastest BPM
+		{"fastest BPM counts", plan, []int32{100, 120, 105}, 3, 120},
+		{"between rungs rounds down", plan, []int32{118}, 3, 110},
+		{"between rungs advances from below", plan, []int32{118}, 5, 120},
+		{"overshoot rung", plan, []int32{124}, 5, 125},
+
+		{"advancing stops at the end", plan, []int32{125}, 5, 125},
+		{"faster than the end", plan, []int32{140}, 3, 125},
+		{"backing off stops at the start", plan, []int32{100}, 1, 100},
+		{"single rung", &pb.TempoPlan{StartBpm: 80}, []int32{80}, 5, 80},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Next(tt.plan, tt.bpms, tt.rating); got != tt.want {
+				t.Errorf("Next(%v, %d) = %d, want %d", tt.bpms, tt.rating, got, tt.want)
+			}
+		})
+	}
+}